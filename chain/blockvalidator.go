@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
@@ -28,6 +30,7 @@ var (
 	ErrorBlockVerifyExistStateRoot = errors.New("Block verify failed, because state root hash is already exist")
 	ErrorBlockVerifyStateRoot      = errors.New("Block verify failed, because state root hash is not equal")
 	ErrorBlockVerifyReceiptRoot    = errors.New("Block verify failed, because receipt root hash is not equal")
+	ErrorBlockVerifyRetiredKey     = errors.New("Block verify failed, because block is signed by retired BP key")
 )
 
 func NewBlockValidator(comm component.IComponentRequester, sdb *state.ChainStateDB, verbose bool) *BlockValidator {
@@ -45,7 +48,9 @@ func (bv *BlockValidator) Stop() {
 	bv.signVerifier.Stop()
 }
 
-func (bv *BlockValidator) ValidateBlock(block *types.Block) error {
+// ValidateBlock validates block. parentRoot is the state root of the parent
+// block.
+func (bv *BlockValidator) ValidateBlock(block *types.Block, parentRoot []byte) error {
 	if err := bv.ValidateHeader(block.GetHeader()); err != nil {
 		return err
 	}
@@ -53,6 +58,37 @@ func (bv *BlockValidator) ValidateBlock(block *types.Block) error {
 	if err := bv.ValidateBody(block); err != nil {
 		return err
 	}
+
+	if err := bv.ValidateSigner(block, parentRoot); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateSigner checks that the block isn't signed by the key which the
+// block producer has rotated away before the block. The keys are read from
+// the state of the parent block, whose root is parentRoot, so that a block of
+// side chain is validated against the keys of its own chain.
+func (bv *BlockValidator) ValidateSigner(block *types.Block, parentRoot []byte) error {
+	if len(block.GetHeader().GetPubKey()) == 0 {
+		return nil
+	}
+	signer, err := block.BPID()
+	if err != nil {
+		return err
+	}
+	scs, err := bv.sdb.OpenNewStateDB(parentRoot).GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	if _, err := system.ResolveBlockProducer(scs, signer, block.BlockNo()); err != nil {
+		if err == system.ErrRetiredBPKey {
+			logger.Error().Str("block", block.ID()).Str("signer", types.IDB58Encode(signer)).
+				Msg("block signed by retired BP key")
+			return ErrorBlockVerifyRetiredKey
+		}
+		return err
+	}
 	return nil
 }
 
@@ -798,6 +798,12 @@ func (cdb *ChainDB) Hardfork() config.HardforkDbConfig {
 }
 
 func (cdb *ChainDB) WriteHardfork(c *config.HardforkConfig) error {
+	return cdb.WriteHardforkDb(c.DbConfig())
+}
+
+// WriteHardforkDb writes the block numbers of hardfork versions, which may
+// include the versions unknown to the node.
+func (cdb *ChainDB) WriteHardforkDb(c config.HardforkDbConfig) error {
 	data, err := json.Marshal(c)
 	if err != nil {
 		return err
@@ -125,7 +125,7 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 		return nil, errors.New("cannot find a receipt")
 	}
 
-	r, err := cs.cdb.getReceipt(block.BlockHash(), block.GetHeader().BlockNo, i.Idx, cs.cfg.Hardfork)
+	r, err := cs.cdb.getReceipt(block.BlockHash(), block.GetHeader().BlockNo, i.Idx, cs.hardforkConfig())
 	if err != nil {
 		return r, err
 	}
@@ -135,13 +135,62 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	return r, nil
 }
 
-func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, filter *types.FilterInfo,
+// getReceiptWithProof returns the receipt of the transaction with the merkle
+// path from it to the receipts root of the block.
+func (cs *ChainService) getReceiptWithProof(txHash []byte) (*types.ReceiptProof, error) {
+	tx, i, err := cs.cdb.getTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := cs.cdb.getBlock(i.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	blockNo := block.GetHeader().GetBlockNo()
+	blockInMainChain, err := cs.cdb.GetBlockByNo(blockNo)
+	if err != nil || !bytes.Equal(block.BlockHash(), blockInMainChain.BlockHash()) {
+		return nil, errors.New("cannot find a receipt")
+	}
+
+	receipts, err := cs.cdb.getReceipts(block.BlockHash(), blockNo, cs.hardforkConfig())
+	if err != nil {
+		return nil, err
+	}
+	path, err := receipts.MerklePath(int(i.Idx))
+	if err != nil {
+		return nil, err
+	}
+	r := receipts.Get()[i.Idx]
+	r.ContractAddress = types.AddressOrigin(r.ContractAddress)
+	r.From = tx.GetBody().GetAccount()
+	r.To = tx.GetBody().GetRecipient()
+	return &types.ReceiptProof{
+		Receipt:    r,
+		BlockHash:  block.BlockHash(),
+		BlockNo:    blockNo,
+		Index:      uint32(i.Idx),
+		MerklePath: path,
+	}, nil
+}
+
+// getReceipts returns all receipts of the block, as they are committed to
+// the receipts root of its header.
+func (cs *ChainService) getReceipts(blockHash []byte) (*types.Receipts, error) {
+	block, err := cs.cdb.getBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return cs.cdb.getReceipts(block.BlockHash(), block.GetHeader().BlockNo, cs.hardforkConfig())
+}
+
+func (cs *ChainService) getEvents(events *[]*types.Event, cursors *[][]byte, blkNo types.BlockNo, filter *types.FilterInfo,
 	argFilter []types.ArgFilter) uint64 {
 	blkHash, err := cs.cdb.getHashByNo(blkNo)
 	if err != nil {
 		return 0
 	}
-	receipts, err := cs.cdb.getReceipts(blkHash, blkNo, cs.cfg.Hardfork)
+	receipts, err := cs.cdb.getReceipts(blkHash, blkNo, cs.hardforkConfig())
 	if err != nil {
 		return 0
 	}
@@ -157,6 +206,7 @@ func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, f
 			if e.Filter(filter, argFilter) {
 				e.SetMemoryInfo(r, blkHash, blkNo, int32(idx))
 				*events = append(*events, e)
+				*cursors = append(*cursors, e.Cursor())
 				totalSize += uint64(proto.Size(e))
 			}
 		}
@@ -166,7 +216,10 @@ func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, f
 
 const MaxEventSize = 4 * 1024 * 1024
 
-func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, error) {
+// listEvents returns the events matching filter, and the cursor of the next
+// page if filter.Size limits the number of events. The events are served by
+// the event index if it's enabled, otherwise by scanning the blocks in range.
+func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, []byte, error) {
 	from := filter.Blockfrom
 	to := filter.Blockto
 
@@ -182,32 +235,53 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 			to = cs.cdb.getBestBlockNo()
 		}
 	}
-	err := filter.ValidateCheck(to)
+	useIndex := cs.eventIndex.isReady()
+	var err error
+	if useIndex {
+		// the index has no limit of block range
+		err = filter.ValidateAddress()
+	} else {
+		err = filter.ValidateCheck(to)
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	argFilter, err := filter.GetExArgFilter()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	if useIndex {
+		return cs.listIndexedEvents(filter, argFilter, from, to)
+	}
+	if len(filter.Cursor) != 0 && !filter.Desc {
+		no, _, _, err := types.ParseEventCursor(filter.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if no > from {
+			from = no
+		}
+	}
+
 	events := []*types.Event{}
+	cursors := [][]byte{}
 	var totalSize uint64
 	if filter.Desc {
 		for i := to; i >= from && i != 0; i-- {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+			totalSize += cs.getEvents(&events, &cursors, types.BlockNo(i), filter, argFilter)
 			if totalSize > MaxEventSize {
-				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+				return nil, nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
 			}
 		}
 	} else {
 		for i := from; i <= to; i++ {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+			totalSize += cs.getEvents(&events, &cursors, types.BlockNo(i), filter, argFilter)
 			if totalSize > MaxEventSize {
-				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+				return nil, nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
 			}
 		}
 	}
-	return events, nil
+	return paginateEvents(events, cursors, filter)
 }
 
 type chainProcessor struct {
@@ -455,6 +529,7 @@ func (cs *ChainService) addBlockInternal(newBlock *types.Block, usedBstate *stat
 	if err := cs.VerifySign(newBlock); err != nil {
 		return err, true
 	}
+	cs.checkDoubleSign(newBlock)
 
 	// handle orphan
 	if cs.isOrphan(newBlock) {
@@ -525,6 +600,12 @@ func (cs *ChainService) addBlock(newBlock *types.Block, usedBstate *state.BlockS
 	return nil
 }
 
+// isErroredBlock reports whether the block has failed the verification, and is cached
+// as an errored one.
+func (cs *ChainService) isErroredBlock(blockHash []byte) bool {
+	return cs.errBlocks.Contains(types.ToHashID(blockHash))
+}
+
 func (cs *ChainService) CountTxsInChain() int {
 	var txCount int
 
@@ -567,6 +648,7 @@ type blockExecutor struct {
 	verifyOnly       bool
 	validateSignWait ValidateSignWaitFn
 	bi               *types.BlockHeaderInfo
+	parallel         bool
 }
 
 func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.Block, verifyOnly bool) (*blockExecutor, error) {
@@ -581,7 +663,11 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 	// contrary, the block propagated from the network is not half-executed.
 	// Hence we need a new block state and tx executor (execTx).
 	if bState == nil {
-		if err := cs.validator.ValidateBlock(block); err != nil {
+		parent, err := cs.getBlock(block.GetHeader().GetPrevBlockHash())
+		if err != nil {
+			return nil, err
+		}
+		if err := cs.validator.ValidateBlock(block, parent.GetHeader().GetBlocksRootHash()); err != nil {
 			return nil, err
 		}
 
@@ -602,7 +688,7 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		commitOnly = true
 	}
 	bState.SetGasPrice(system.GetGasPriceFromState(bState))
-	bState.Receipts().SetHardFork(cs.cfg.Hardfork, block.BlockNo())
+	bState.Receipts().SetHardFork(cs.hardfork.config, block.BlockNo())
 
 	return &blockExecutor{
 		BlockState:       bState,
@@ -617,6 +703,7 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		verifyOnly:       verifyOnly,
 		validateSignWait: validateSignWait,
 		bi:               bi,
+		parallel:         cs.hardfork.config.IsV3Fork(block.BlockNo()),
 	}, nil
 }
 
@@ -650,19 +737,14 @@ func (e *blockExecutor) execute() error {
 	// Receipt must be committed unconditionally.
 	if !e.commitOnly {
 		defer contract.CloseDatabase()
-		var preLoadTx *types.Tx
-		nCand := len(e.txs)
-		for i, tx := range e.txs {
-			if i != nCand-1 {
-				preLoadTx = e.txs[i+1]
-				contract.PreLoadRequest(e.BlockState, e.bi, preLoadTx, tx, contract.ChainService)
-			}
-			if err := e.execTx(e.BlockState, types.NewTransaction(tx)); err != nil {
-				//FIXME maybe system error. restart or panic
-				// all txs have executed successfully in BP node
+		if e.parallel && canExecuteParallel(e.BlockState, e.txs) {
+			// The preloaded VM is useless since the txs aren't executed in turn.
+			contract.SetPreloadTx(nil, contract.ChainService)
+			if err := newParallelExecutor(e.BlockState, e.sdb, e.execTx, e.txs).execute(); err != nil {
 				return err
 			}
-			contract.SetPreloadTx(preLoadTx, contract.ChainService)
+		} else if err := e.executeTxs(); err != nil {
+			return err
 		}
 
 		if e.validateSignWait != nil {
@@ -701,6 +783,24 @@ func (e *blockExecutor) execute() error {
 	return nil
 }
 
+func (e *blockExecutor) executeTxs() error {
+	var preLoadTx *types.Tx
+	nCand := len(e.txs)
+	for i, tx := range e.txs {
+		if i != nCand-1 {
+			preLoadTx = e.txs[i+1]
+			contract.PreLoadRequest(e.BlockState, e.bi, preLoadTx, tx, contract.ChainService)
+		}
+		if err := e.execTx(e.BlockState, types.NewTransaction(tx)); err != nil {
+			//FIXME maybe system error. restart or panic
+			// all txs have executed successfully in BP node
+			return err
+		}
+		contract.SetPreloadTx(preLoadTx, contract.ChainService)
+	}
+	return nil
+}
+
 func (e *blockExecutor) commit() error {
 	if err := e.BlockState.Commit(); err != nil {
 		return err
@@ -732,6 +832,10 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 	if err = cs.IsBlockValid(block, bestBlock); err != nil {
 		return err
 	}
+	if err = cs.checkSupportedHardfork(block.BlockNo()); err != nil {
+		logger.Error().Err(err).Uint64("no", block.BlockNo()).Msg("upgrade the node to connect the block")
+		return err
+	}
 	bstate = bstate.SetPrevBlockHash(block.GetHeader().GetPrevBlockHash())
 	// TODO refactoring: receive execute function as argument (executeBlock or executeBlockReco)
 	ex, err := newBlockExecutor(cs, bstate, block, false)
@@ -746,12 +850,16 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 
 	if len(ex.BlockState.Receipts().Get()) != 0 {
 		cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), ex.BlockState.Receipts())
+		cs.indexEvents(block, ex.BlockState.Receipts())
 	}
+	cs.addStakingRecords(block, ex.BlockState.Receipts())
 
 	cs.notifyEvents(block, ex.BlockState)
 
 	cs.Update(block)
 
+	cs.updateHardfork(block.BlockNo())
+
 	logger.Debug().Uint64("no", block.GetHeader().BlockNo).Msg("end to execute")
 
 	return nil
@@ -851,6 +959,34 @@ func (cs *ChainService) notifyEvents(block *types.Block, bstate *state.BlockStat
 	}
 }
 
+// notifyRemovedEvents notifies the events of the blocks disconnected from the
+// main chain by reorganization, in the reverse order of execution. blocks must
+// be ordered from the highest one.
+func (cs *ChainService) notifyRemovedEvents(blocks []*types.Block) {
+	events := []*types.Event{}
+	for _, block := range blocks {
+		receipts, err := cs.cdb.getReceipts(block.BlockHash(), block.BlockNo(), cs.hardforkConfig())
+		if err != nil {
+			// the block has no receipt
+			continue
+		}
+		rs := receipts.Get()
+		for idx := len(rs) - 1; idx >= 0; idx-- {
+			for i := len(rs[idx].Events) - 1; i >= 0; i-- {
+				e := rs[idx].Events[i]
+				e.SetMemoryInfo(rs[idx], block.BlockHash(), block.BlockNo(), int32(idx))
+				e.Removed = true
+				events = append(events, e)
+			}
+		}
+	}
+
+	if len(events) != 0 {
+		logger.Debug().Int("count", len(events)).Msg("notify events removed by reorg")
+		cs.TellTo(message.RPCSvc, events)
+	}
+}
+
 const maxRetSize = 1024
 
 func adjustRv(ret string) string {
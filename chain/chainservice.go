@@ -8,13 +8,16 @@ package chain
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"os"
 	"reflect"
 	"runtime"
 	"strings"
 	"sync/atomic"
 
 	"github.com/aergoio/aergo-actor/actor"
+	"github.com/aergoio/aergo-lib/db"
 	"github.com/aergoio/aergo-lib/log"
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
@@ -172,11 +175,37 @@ func (core *Core) InitGenesisBlock(gb *types.Genesis, useTestnet bool) error {
 	return nil
 }
 
+// ComputeGenesisBlock generates the genesis block of gb on a temporary state
+// DB, without creating any chain. The block is the same as the one generated
+// by InitGenesisBlock from gb.
+func ComputeGenesisBlock(gb *types.Genesis) (*types.Block, error) {
+	dataDir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dataDir)
+
+	sdb := state.NewChainStateDB()
+	if err := sdb.Init(string(db.MemoryImpl), dataDir, nil, false); err != nil {
+		return nil, err
+	}
+	defer sdb.Close()
+
+	if err := sdb.SetGenesis(gb, InitGenesisBPs); err != nil {
+		return nil, err
+	}
+	block := gb.Block()
+	block.BlockID()
+	return block, nil
+}
+
 type IChainHandler interface {
 	getBlock(blockHash []byte) (*types.Block, error)
 	getBlockByNo(blockNo types.BlockNo) (*types.Block, error)
 	getTx(txHash []byte) (*types.Tx, *types.TxIdx, error)
 	getReceipt(txHash []byte) (*types.Receipt, error)
+	getReceiptWithProof(txHash []byte) (*types.ReceiptProof, error)
+	getReceipts(blockHash []byte) (*types.Receipts, error)
 	getAccountVote(addr []byte) (*types.AccountVoteInfo, error)
 	getVotes(id string, n uint32) (*types.VoteList, error)
 	getStaking(addr []byte) (*types.Staking, error)
@@ -186,8 +215,12 @@ type IChainHandler interface {
 	getAnchorsNew() (ChainAnchor, types.BlockNo, error)
 	findAncestor(Hashes [][]byte) (*types.BlockInfo, error)
 	setSkipMempool(val bool)
-	listEvents(filter *types.FilterInfo) ([]*types.Event, error)
+	listEvents(filter *types.FilterInfo) ([]*types.Event, []byte, error)
+	addDoubleSignEvidence(evidence *types.DoubleSignEvidence, from types.PeerID) error
+	listDoubleSignEvidence(bpID types.PeerID) ([]*types.DoubleSignEvidence, error)
+	getStakingHistory(account []byte, from, to types.BlockNo) ([]*types.StakingRecord, error)
 	verifyBlock(block *types.Block) error
+	isErroredBlock(blockHash []byte) bool
 }
 
 // ChainService manage connectivity of blocks
@@ -206,6 +239,11 @@ type ChainService struct {
 	chainManager  *ChainManager
 	chainVerifier *ChainVerifier
 
+	eventIndex    *eventIndex
+	stakingLedger *stakingLedger
+	signedHeaders *signedHeaders
+	hardfork      hardforkSchedule
+
 	stat stats
 
 	recovered  atomic.Value
@@ -217,9 +255,10 @@ var _ types.ChainAccessor = (*ChainService)(nil)
 // NewChainService creates an instance of ChainService.
 func NewChainService(cfg *cfg.Config) *ChainService {
 	cs := &ChainService{
-		cfg:  cfg,
-		op:   NewOrphanPool(DfltOrphanPoolSize),
-		stat: newStats(),
+		cfg:           cfg,
+		op:            NewOrphanPool(DfltOrphanPoolSize),
+		signedHeaders: newSignedHeaders(),
+		stat:          newStats(),
 	}
 
 	cs.setRecovered(false)
@@ -291,7 +330,7 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 	contract.TraceBlockNo = cfg.Blockchain.StateTrace
 	contract.SetStateSQLMaxDBSize(cfg.SQL.MaxDbSize)
 	contract.StartLStateFactory((cfg.Blockchain.NumWorkers+2)*(contract.MaxCallDepth+2), cfg.Blockchain.NumLStateClosers, cfg.Blockchain.CloseLimit)
-	contract.HardforkConfig = cs.cfg.Hardfork
+	contract.HardforkConfig = cs.hardfork.config
 	contract.InitContext(cfg.Blockchain.NumWorkers + 2)
 
 	// For a strict governance transaction validation.
@@ -308,6 +347,13 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 	// init Debugger
 	cs.initDebugger()
 
+	if cfg.Blockchain.EventIndex {
+		cs.initEventIndex()
+	}
+	if cfg.Blockchain.StakingLedger {
+		cs.initStakingLedger()
+	}
+
 	cs.startChilds()
 
 	return cs
@@ -380,6 +426,12 @@ func (cs *ChainService) AfterStart() {
 
 // BeforeStop close chain database and stop BlockValidator
 func (cs *ChainService) BeforeStop() {
+	if cs.eventIndex != nil {
+		cs.eventIndex.close()
+	}
+	if cs.stakingLedger != nil {
+		cs.stakingLedger.close()
+	}
 	cs.Close()
 
 	cs.chainManager.Stop()
@@ -426,6 +478,7 @@ func (cs *ChainService) Receive(context actor.Context) {
 
 	switch msg := context.Message().(type) {
 	case *message.AddBlock,
+		*message.AddDoubleSignEvidence,
 		*message.GetAnchors, //TODO move to ChainWorker (need chain lock)
 		*message.GetAncestor:
 		cs.chainManager.Request(msg, context.Sender())
@@ -437,6 +490,8 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetStateAndProof,
 		*message.GetTx,
 		*message.GetReceipt,
+		*message.GetReceiptWithProof,
+		*message.GetReceipts,
 		*message.GetABI,
 		*message.GetQuery,
 		*message.GetStateQuery,
@@ -447,6 +502,8 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetEnterpriseConf,
 		*message.GetParams,
 		*message.ListEvents,
+		*message.ListDoubleSignEvidence,
+		*message.GetStakingHistory,
 		*message.CheckFeeDelegation:
 		cs.chainWorker.Request(msg, context.Sender())
 
@@ -455,6 +512,10 @@ func (cs *ChainService) Receive(context actor.Context) {
 		context.Respond(message.GetBestBlockNoRsp{
 			BlockNo: cs.getBestBlockNo(),
 		})
+	case *message.GetHardforkSchedule:
+		context.Respond(&message.GetHardforkScheduleRsp{
+			Schedule: cs.getHardforkSchedule(),
+		})
 	case *message.GetBestBlock:
 		block, err := cs.GetBestBlock()
 		if err != nil {
@@ -661,9 +722,15 @@ func (cm *ChainManager) Receive(context actor.Context) {
 			BlockNo:   blkNo,
 			BlockHash: blkHash,
 			Err:       err,
+			PeerID:    msg.PeerID,
+			Invalid:   err != nil && cm.isErroredBlock(blkHash),
 		}
 
 		context.Respond(&rsp)
+	case *message.AddDoubleSignEvidence:
+		if err := cm.addDoubleSignEvidence(msg.Evidence, msg.From); err != nil {
+			logger.Debug().Err(err).Str("from", types.IDB58Encode(msg.From)).Msg("invalid double-sign evidence")
+		}
 	case *message.GetAnchors:
 		anchor, lastNo, err := cm.getAnchorsNew()
 		context.Respond(message.GetAnchorsRsp{
@@ -774,6 +841,18 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Receipt: receipt,
 			Err:     err,
 		})
+	case *message.GetReceiptWithProof:
+		proof, err := cw.getReceiptWithProof(msg.TxHash)
+		context.Respond(message.GetReceiptWithProofRsp{
+			Proof: proof,
+			Err:   err,
+		})
+	case *message.GetReceipts:
+		receipts, err := cw.getReceipts(msg.BlockHash)
+		context.Respond(message.GetReceiptsRsp{
+			Receipts: receipts,
+			Err:      err,
+		})
 	case *message.GetABI:
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
 		address, err := getAddressNameResolved(sdb, msg.Contract)
@@ -884,11 +963,24 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:  err,
 		})
 	case *message.ListEvents:
-		events, err := cw.listEvents(msg.Filter)
+		events, cursor, err := cw.listEvents(msg.Filter)
 		context.Respond(&message.ListEventsRsp{
 			Events: events,
+			Cursor: cursor,
 			Err:    err,
 		})
+	case *message.GetStakingHistory:
+		records, err := cw.getStakingHistory(msg.Account, msg.BlockFrom, msg.BlockTo)
+		context.Respond(&message.GetStakingHistoryRsp{
+			Records: records,
+			Err:     err,
+		})
+	case *message.ListDoubleSignEvidence:
+		evidences, err := cw.listDoubleSignEvidence(msg.BPID)
+		context.Respond(&message.ListDoubleSignEvidenceRsp{
+			Evidences: evidences,
+			Err:       err,
+		})
 	case *message.GetParams:
 		context.Respond(&message.GetParamsRsp{
 			BpCount:      system.GetBpCount(),
@@ -932,14 +1024,23 @@ func (cs *ChainService) checkHardfork() error {
 	} else if Genesis.IsTestNet() {
 		*config = *cfg.TestNetHardforkConfig
 	}
+	cs.hardfork.base = *config
+	cs.hardfork.config = cfg.NewSharedHardforkConfig(config)
+	best := cs.cdb.getBestBlockNo()
+	if _, err := cs.applyHardforkSchedule(best); err != nil {
+		return err
+	}
 	dbConfig := cs.cdb.Hardfork()
 	if len(dbConfig) == 0 {
-		return cs.cdb.WriteHardfork(config)
+		return cs.writeHardfork()
 	}
-	if err := config.CheckCompatibility(dbConfig, cs.cdb.getBestBlockNo()); err != nil {
+	if err := config.CheckCompatibility(dbConfig, best); err != nil {
 		return err
 	}
-	return cs.cdb.WriteHardfork(config)
+	if missing := dbConfig.MissingVersions(config); len(missing) > 0 {
+		logger.Info().Strs("versions", missing).Uint64("best", best).Msg("adding new hardfork versions to chain db")
+	}
+	return cs.writeHardfork()
 }
 
 func (cs *ChainService) ChainID(bno types.BlockNo) *types.ChainID {
@@ -952,6 +1053,6 @@ func (cs *ChainService) ChainID(bno types.BlockNo) *types.ChainID {
 	if err != nil {
 		return nil
 	}
-	cid.Version = cs.cfg.Hardfork.Version(bno)
+	cid.Version = cs.hardfork.config.Version(bno)
 	return cid
 }
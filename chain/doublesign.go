@@ -0,0 +1,169 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// maxSignedHeaders is the number of recent block headers kept to detect
+// double-signing.
+const maxSignedHeaders = 4096
+
+var (
+	doubleSignKeyPrefix   = []byte("doublesign.")
+	doubleSignIndexPrefix = []byte("doublesign_idx.")
+	doubleSignCountKey    = []byte("doublesign_cnt")
+)
+
+// signedHeaders remembers the block headers of recent blocks by their signer
+// and production slot.
+type signedHeaders struct {
+	sync.Mutex
+	headers map[string]*types.BlockHeader
+	keys    []string // in the order of insertion to evict the oldest
+}
+
+func newSignedHeaders() *signedHeaders {
+	return &signedHeaders{headers: make(map[string]*types.BlockHeader)}
+}
+
+// add adds bh and returns the other header of the same signer and slot if
+// exists.
+func (sh *signedHeaders) add(bh *types.BlockHeader, slot uint64) *types.BlockHeader {
+	key := string(bh.PubKey) + string(types.BlockNoToBytes(slot))
+
+	sh.Lock()
+	defer sh.Unlock()
+
+	if prev, exist := sh.headers[key]; exist {
+		return prev
+	}
+	sh.headers[key] = bh
+	sh.keys = append(sh.keys, key)
+	if len(sh.keys) > maxSignedHeaders {
+		delete(sh.headers, sh.keys[0])
+		sh.keys = sh.keys[1:]
+	}
+	return nil
+}
+
+func doubleSignKey(id types.PeerID, slot uint64) []byte {
+	key := append(append([]byte{}, doubleSignKeyPrefix...), id...)
+	return append(key, types.BlockNoToBytes(slot)...)
+}
+
+func doubleSignIndexKey(seq uint64) []byte {
+	key := make([]byte, len(doubleSignIndexPrefix)+8)
+	copy(key, doubleSignIndexPrefix)
+	binary.BigEndian.PutUint64(key[len(doubleSignIndexPrefix):], seq)
+	return key
+}
+
+// slotInterval returns the block interval by which the production slot of a
+// block is decided, which is the block interval of consensus. It's zero unless
+// the consensus is DPoS, since the other ones have no production slot and
+// double-signing can't be detected.
+func (cs *ChainService) slotInterval() time.Duration {
+	if cs.ConsensusType() == consensus.ConsensusName[consensus.ConsensusDPOS] {
+		return consensus.BlockInterval
+	}
+	return 0
+}
+
+// checkDoubleSign reports the evidence if the signer of block has signed
+// another block for the same slot.
+func (cs *ChainService) checkDoubleSign(block *types.Block) {
+	if cs.slotInterval() <= 0 || cs.IsBlockValid(block, nil) != nil {
+		return
+	}
+	header := block.GetHeader()
+	prev := cs.signedHeaders.add(header, types.ProductionSlot(header, cs.slotInterval()))
+	if prev != nil && !bytes.Equal((&types.Block{Header: prev}).BlockHash(), block.BlockHash()) {
+		if err := cs.addDoubleSignEvidence(types.NewDoubleSignEvidence(prev, header), ""); err != nil {
+			logger.Error().Err(err).Str("hash", block.ID()).Msg("failed to add double-sign evidence")
+		}
+	}
+}
+
+// addDoubleSignEvidence verifies and keeps the evidence, and then notifies it
+// to the peers except the one which it's received from.
+func (cs *ChainService) addDoubleSignEvidence(evidence *types.DoubleSignEvidence, from types.PeerID) error {
+	id, err := evidence.Verify(cs.slotInterval())
+	if err != nil {
+		return err
+	}
+	for _, bh := range []*types.BlockHeader{evidence.First, evidence.Second} {
+		if err := cs.IsBlockValid(&types.Block{Header: bh}, nil); err != nil {
+			return err
+		}
+	}
+
+	slot := evidence.Slot(cs.slotInterval())
+	key := doubleSignKey(id, slot)
+	if len(cs.cdb.Get(key)) != 0 {
+		// already known
+		return nil
+	}
+	raw, err := proto.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+	var seq uint64
+	if count := cs.cdb.Get(doubleSignCountKey); len(count) != 0 {
+		seq = binary.BigEndian.Uint64(count)
+	}
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, seq+1)
+
+	dbTx := cs.cdb.NewTx()
+	dbTx.Set(key, raw)
+	dbTx.Set(doubleSignIndexKey(seq), key)
+	dbTx.Set(doubleSignCountKey, next)
+	dbTx.Commit()
+
+	logger.Warn().Str("BP", enc.ToString([]byte(id))).Uint64("slot", slot).
+		Uint64("no", evidence.First.BlockNo).Str("from", types.IDB58Encode(from)).Msg("double-signed blocks detected")
+
+	cs.TellTo(message.P2PSvc, &message.NotifyDoubleSignEvidence{Evidence: evidence, From: from})
+	return nil
+}
+
+// listDoubleSignEvidence returns the kept evidences in the order of detection.
+// Those of all block producers are returned if bpID is empty.
+func (cs *ChainService) listDoubleSignEvidence(bpID types.PeerID) ([]*types.DoubleSignEvidence, error) {
+	var seq uint64
+	if count := cs.cdb.Get(doubleSignCountKey); len(count) != 0 {
+		seq = binary.BigEndian.Uint64(count)
+	}
+	var prefix []byte
+	if len(bpID) != 0 {
+		prefix = append(append([]byte{}, doubleSignKeyPrefix...), bpID...)
+	}
+
+	var evidences []*types.DoubleSignEvidence
+	for i := uint64(0); i < seq; i++ {
+		key := cs.cdb.Get(doubleSignIndexKey(i))
+		if len(bpID) != 0 && !(bytes.HasPrefix(key, prefix) && len(key) == len(prefix)+8) {
+			continue
+		}
+		evidence := &types.DoubleSignEvidence{}
+		if err := proto.Unmarshal(cs.cdb.Get(key), evidence); err != nil {
+			return nil, err
+		}
+		evidences = append(evidences, evidence)
+	}
+	return evidences, nil
+}
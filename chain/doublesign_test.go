@@ -0,0 +1,35 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignedHeaders(t *testing.T) {
+	sh := newSignedHeaders()
+	header := func(pubKey string, no types.BlockNo) *types.BlockHeader {
+		return &types.BlockHeader{PubKey: []byte(pubKey), BlockNo: no}
+	}
+
+	first := header("bp1", 1)
+	assert.Nil(t, sh.add(first, 1))
+	assert.Nil(t, sh.add(header("bp2", 1), 1))
+	assert.Nil(t, sh.add(header("bp1", 2), 2))
+	assert.Equal(t, first, sh.add(header("bp1", 1), 1))
+
+	// the oldest header is evicted
+	for i := 0; i < maxSignedHeaders; i++ {
+		sh.add(header("bp3", types.BlockNo(i)), uint64(i))
+	}
+	assert.Len(t, sh.headers, maxSignedHeaders)
+	assert.Nil(t, sh.add(header("bp1", 1), 1))
+}
+
+func TestDoubleSignKeys(t *testing.T) {
+	id := types.PeerID("bp")
+	assert.Equal(t, append([]byte("doublesign.bp"), types.BlockNoToBytes(3)...), doubleSignKey(id, 3))
+	assert.Equal(t, "doublesign.", string(doubleSignKeyPrefix))
+	assert.True(t, string(doubleSignIndexKey(1)) < string(doubleSignIndexKey(256)))
+}
@@ -0,0 +1,531 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	eventIndexDBName = "event"
+
+	// only the leading arguments of events are indexed. the others are
+	// checked after the events are loaded.
+	maxIndexedEventArgs = 4
+
+	// the block numbers of entries are summarized by the bitmaps of 3 levels,
+	// each of which has a bit per 1, 2^10 and 2^20 blocks.
+	eventBitmapLevels = 3
+	eventBitmapBits   = 10
+	eventBitmapSize   = 1 << eventBitmapBits / 8
+)
+
+var (
+	eventAddrPrefix   = byte('a')
+	eventNamePrefix   = byte('n')
+	eventArgPrefix    = byte('g')
+	eventEntryPrefix  = byte('e')
+	eventBitmapPrefix = byte('m')
+	eventIndexTipKey  = []byte("tip")
+)
+
+// eventIndex is an on-disk index of contract events, which is keyed by the
+// contract address, the event name and the values of leading arguments.
+//
+// The entry of a key and a block holds the hash of the block and the positions
+// of matching events in the block. As the index is maintained by executing
+// blocks, the entries of blocks which are no longer in the main chain are
+// detected by the block hash and skipped. To find blocks without scanning the
+// whole range, the block numbers of each key are marked in hierarchical
+// bitmaps.
+type eventIndex struct {
+	store db.DB
+	ready int32 // set when the index caught up the chain
+
+	mutex sync.Mutex // protects read-modify-write of bitmaps
+	quitC chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newEventIndex(dbType string, dataDir string) *eventIndex {
+	return openEventIndex(dbType, dataDir, eventIndexDBName)
+}
+
+// openEventIndex opens the index DB of the name. The other indexes keyed by
+// block numbers share the entries and the bitmaps of the event index.
+func openEventIndex(dbType string, dataDir string, name string) *eventIndex {
+	dbPath := common.PathMkdirAll(dataDir, name)
+	logger.Info().Str("datadir", dbPath).Str("name", name).Msg("block index initialized")
+	return &eventIndex{
+		store: db.NewDB(db.ImplType(dbType), dbPath),
+		quitC: make(chan struct{}),
+	}
+}
+
+func (ei *eventIndex) close() {
+	close(ei.quitC)
+	ei.wg.Wait()
+	ei.store.Close()
+}
+
+// isReady reports whether queries can be served by the index.
+func (ei *eventIndex) isReady() bool {
+	return ei != nil && atomic.LoadInt32(&ei.ready) == 1
+}
+
+func (ei *eventIndex) tip() (types.BlockNo, bool) {
+	data := ei.store.Get(eventIndexTipKey)
+	if len(data) != 8 {
+		return 0, false
+	}
+	return types.BlockNoFromBytes(data), true
+}
+
+func eventPrefixHash(b []byte) []byte {
+	return common.Hasher(b)[:8]
+}
+
+func eventAddrKey(address []byte) []byte {
+	key := make([]byte, 0, 1+len(address))
+	key = append(key, eventAddrPrefix)
+	return append(key, address...)
+}
+
+func eventNameKey(address []byte, eventName string) []byte {
+	key := make([]byte, 0, 1+len(address)+8)
+	key = append(key, eventNamePrefix)
+	key = append(key, address...)
+	return append(key, eventPrefixHash([]byte(eventName))...)
+}
+
+// eventArgKey returns the key of the argument value, which is the same for
+// the values decoded from the event and the filter.
+func eventArgKey(address []byte, eventName string, argNo int, value interface{}) ([]byte, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 0, 1+len(address)+8+1+8)
+	key = append(key, eventArgPrefix)
+	key = append(key, address...)
+	key = append(key, eventPrefixHash([]byte(eventName))...)
+	key = append(key, byte(argNo))
+	return append(key, eventPrefixHash(raw)...), nil
+}
+
+// eventKeys returns all keys of the index which the event matches.
+func eventKeys(ev *types.Event) [][]byte {
+	keys := [][]byte{eventAddrKey(ev.ContractAddress), eventNameKey(ev.ContractAddress, ev.EventName)}
+	var args []interface{}
+	if err := json.Unmarshal([]byte(ev.JsonArgs), &args); err != nil {
+		return keys
+	}
+	for i := 0; i < len(args) && i < maxIndexedEventArgs; i++ {
+		if key, err := eventArgKey(ev.ContractAddress, ev.EventName, i, args[i]); err == nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// filterKey returns the most selective key of the index for filter.
+func filterKey(filter *types.FilterInfo, argFilter []types.ArgFilter) []byte {
+	if len(filter.EventName) == 0 {
+		return eventAddrKey(filter.ContractAddress)
+	}
+	for _, af := range argFilter {
+		if af.ArgNo() >= maxIndexedEventArgs {
+			continue
+		}
+		if key, err := eventArgKey(filter.ContractAddress, filter.EventName, af.ArgNo(), af.Value()); err == nil {
+			return key
+		}
+	}
+	return eventNameKey(filter.ContractAddress, filter.EventName)
+}
+
+func eventEntryKey(key []byte, blockNo types.BlockNo) []byte {
+	entryKey := make([]byte, 0, 1+len(key)+8)
+	entryKey = append(entryKey, eventEntryPrefix)
+	entryKey = append(entryKey, key...)
+	return append(entryKey, types.BlockNoToBytes(blockNo)...)
+}
+
+func eventBitmapKey(key []byte, level uint, index uint64) []byte {
+	bitmapKey := make([]byte, 0, 2+len(key)+8)
+	bitmapKey = append(bitmapKey, eventBitmapPrefix, byte(level))
+	bitmapKey = append(bitmapKey, key...)
+	l := make([]byte, 8)
+	binary.BigEndian.PutUint64(l, index)
+	return append(bitmapKey, l...)
+}
+
+// eventEntry is the entry of a key and a block. it holds the hash of block
+// and the (tx index, event index) pairs of matching events.
+type eventEntry []byte
+
+func (e eventEntry) blockHash() []byte {
+	return e[:32]
+}
+
+func (e eventEntry) positions() int {
+	return (len(e) - 32) / 8
+}
+
+func (e eventEntry) position(i int) (int32, int32) {
+	p := e[32+i*8:]
+	return int32(binary.BigEndian.Uint32(p)), int32(binary.BigEndian.Uint32(p[4:]))
+}
+
+// addBlock indexes the events of the block. tip is updated only if the index
+// has already caught up the chain.
+func (ei *eventIndex) addBlock(blockHash []byte, blockNo types.BlockNo, receipts *types.Receipts, updateTip bool) {
+	entries := make(map[string][]byte)
+	var keys []string
+	for txIdx, r := range receipts.Get() {
+		for evIdx, ev := range r.Events {
+			pos := make([]byte, 8)
+			binary.BigEndian.PutUint32(pos, uint32(txIdx))
+			binary.BigEndian.PutUint32(pos[4:], uint32(evIdx))
+			for _, key := range eventKeys(ev) {
+				entry, exist := entries[string(key)]
+				if !exist {
+					entry = append([]byte{}, blockHash...)
+					keys = append(keys, string(key))
+				}
+				entries[string(key)] = append(entry, pos...)
+			}
+		}
+	}
+
+	ei.putEntries(entries, keys, blockNo, updateTip)
+}
+
+// putEntries writes the entries of keys at the block, and marks the block in
+// the bitmaps of keys.
+func (ei *eventIndex) putEntries(entries map[string][]byte, keys []string, blockNo types.BlockNo, updateTip bool) {
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	dbTx := ei.store.NewTx()
+	defer dbTx.Discard()
+
+	bitmaps := make(map[string][]byte)
+	for _, key := range keys {
+		dbTx.Set(eventEntryKey([]byte(key), blockNo), entries[key])
+		for level := uint(1); level <= eventBitmapLevels; level++ {
+			index := blockNo >> (eventBitmapBits * level)
+			bit := (blockNo >> (eventBitmapBits * (level - 1))) & (1<<eventBitmapBits - 1)
+			bitmapKey := string(eventBitmapKey([]byte(key), level, index))
+			bitmap, exist := bitmaps[bitmapKey]
+			if !exist {
+				if bitmap = ei.store.Get([]byte(bitmapKey)); len(bitmap) != eventBitmapSize {
+					bitmap = make([]byte, eventBitmapSize)
+				}
+				bitmaps[bitmapKey] = bitmap
+			}
+			bitmap[bit/8] |= 1 << (bit % 8)
+		}
+	}
+	for key, bitmap := range bitmaps {
+		dbTx.Set([]byte(key), bitmap)
+	}
+	if updateTip {
+		dbTx.Set(eventIndexTipKey, types.BlockNoToBytes(blockNo))
+	}
+	dbTx.Commit()
+}
+
+// removeBlock removes the entries of the block, which is rolled back by
+// reorganization. The bits of bitmaps are left, and just cost a lookup.
+func (ei *eventIndex) removeBlock(blockHash []byte, blockNo types.BlockNo, receipts *types.Receipts) {
+	var keys [][]byte
+	for _, r := range receipts.Get() {
+		for _, ev := range r.Events {
+			keys = append(keys, eventKeys(ev)...)
+		}
+	}
+	ei.deleteEntries(keys, blockHash, blockNo)
+}
+
+// deleteEntries removes the entries of keys at the block, which are added by
+// the block of blockHash.
+func (ei *eventIndex) deleteEntries(keys [][]byte, blockHash []byte, blockNo types.BlockNo) {
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	dbTx := ei.store.NewTx()
+	defer dbTx.Discard()
+
+	for _, key := range keys {
+		entryKey := eventEntryKey(key, blockNo)
+		if entry := eventEntry(ei.store.Get(entryKey)); len(entry) >= 32 && bytes.Equal(entry.blockHash(), blockHash) {
+			dbTx.Delete(entryKey)
+		}
+	}
+	dbTx.Commit()
+}
+
+// walk calls fn with the block numbers in [from, to] which have entries of
+// key, in ascending or descending order. It stops when fn returns false.
+func (ei *eventIndex) walk(key []byte, from, to types.BlockNo, desc bool, fn func(types.BlockNo) bool) {
+	shift := uint(eventBitmapBits * eventBitmapLevels)
+	first, last := from>>shift, to>>shift
+	for i := first; i <= last; i++ {
+		index := i
+		if desc {
+			index = last - (i - first)
+		}
+		if !ei.walkLevel(key, eventBitmapLevels, index, from, to, desc, fn) {
+			return
+		}
+	}
+}
+
+func (ei *eventIndex) walkLevel(key []byte, level uint, index uint64, from, to types.BlockNo, desc bool,
+	fn func(types.BlockNo) bool) bool {
+	if level == 0 {
+		return fn(types.BlockNo(index))
+	}
+	bitmap := ei.store.Get(eventBitmapKey(key, level, index))
+	if len(bitmap) != eventBitmapSize {
+		return true
+	}
+	shift := eventBitmapBits * (level - 1)
+	for i := uint64(0); i < 1<<eventBitmapBits; i++ {
+		bit := i
+		if desc {
+			bit = 1<<eventBitmapBits - 1 - i
+		}
+		if bitmap[bit/8]&(1<<(bit%8)) == 0 {
+			continue
+		}
+		child := index<<eventBitmapBits | bit
+		lo := child << shift
+		hi := lo + (1<<shift - 1)
+		if hi < from || lo > to {
+			continue
+		}
+		if !ei.walkLevel(key, level-1, child, from, to, desc, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// initEventIndex opens the event index and catches up the blocks which are
+// connected while the index is disabled, in background.
+func (cs *ChainService) initEventIndex() {
+	ei := newEventIndex(cs.cfg.DbType, cs.cfg.DataDir)
+	cs.eventIndex = ei
+
+	cs.buildIndex(ei, eventIndexDBName, func(no types.BlockNo, hash []byte) error {
+		// blocks without receipts have no events
+		if receipts, err := cs.cdb.getReceipts(hash, no, cs.hardforkConfig()); err == nil {
+			ei.addBlock(hash, no, receipts, true)
+		}
+		return nil
+	})
+}
+
+// buildIndex calls add with the blocks from the next of the index tip to the
+// best block in background, and marks the index ready after all.
+func (cs *ChainService) buildIndex(ei *eventIndex, name string, add func(no types.BlockNo, hash []byte) error) {
+	from := types.BlockNo(0)
+	if tip, exist := ei.tip(); exist {
+		from = tip + 1
+	}
+	best := cs.cdb.getBestBlockNo()
+	if from > best {
+		atomic.StoreInt32(&ei.ready, 1)
+		return
+	}
+
+	logger.Info().Uint64("from", from).Uint64("to", best).Str("name", name).Msg("start to build block index")
+	ei.wg.Add(1)
+	go func() {
+		defer ei.wg.Done()
+		for no := from; no <= best; no++ {
+			select {
+			case <-ei.quitC:
+				return
+			default:
+			}
+			hash, err := cs.cdb.getHashByNo(no)
+			if err == nil {
+				err = add(no, hash)
+			}
+			if err != nil {
+				logger.Error().Err(err).Uint64("no", no).Str("name", name).Msg("failed to build block index")
+				return
+			}
+			if no%10000 == 0 {
+				logger.Info().Uint64("no", no).Uint64("to", best).Str("name", name).Msg("building block index")
+			}
+		}
+		atomic.StoreInt32(&ei.ready, 1)
+		logger.Info().Uint64("no", best).Str("name", name).Msg("block index is ready")
+	}()
+}
+
+// indexEvents adds the events of the block to the event index if enabled.
+func (cs *ChainService) indexEvents(block *types.Block, receipts *types.Receipts) {
+	if cs.eventIndex == nil {
+		return
+	}
+	cs.eventIndex.addBlock(block.BlockHash(), block.BlockNo(), receipts, cs.eventIndex.isReady())
+}
+
+// unindexEvents removes the events of the block from the event index if enabled.
+func (cs *ChainService) unindexEvents(block *types.Block) {
+	if cs.eventIndex == nil {
+		return
+	}
+	receipts, err := cs.cdb.getReceipts(block.BlockHash(), block.BlockNo(), cs.hardforkConfig())
+	if err != nil {
+		return
+	}
+	cs.eventIndex.removeBlock(block.BlockHash(), block.BlockNo(), receipts)
+}
+
+// listIndexedEvents returns the events in the block range [from, to] by the
+// event index. At most size events are returned if size isn't 0, and the
+// cursor of the last one is returned to get the next page.
+func (cs *ChainService) listIndexedEvents(filter *types.FilterInfo, argFilter []types.ArgFilter,
+	from, to types.BlockNo) ([]*types.Event, []byte, error) {
+	size := int(filter.Size)
+	if len(filter.Cursor) != 0 {
+		no, _, _, err := types.ParseEventCursor(filter.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if filter.Desc && no < to {
+			to = no
+		} else if !filter.Desc && no > from {
+			from = no
+		}
+	}
+
+	key := filterKey(filter, argFilter)
+	events := []*types.Event{}
+	cursors := [][]byte{}
+	var totalSize uint64
+	var err error
+	cs.eventIndex.walk(key, from, to, filter.Desc, func(no types.BlockNo) bool {
+		var added []*types.Event
+		var addedCursors [][]byte
+		added, addedCursors, err = cs.getIndexedEvents(key, no, filter, argFilter)
+		if err != nil {
+			return false
+		}
+		for i, ev := range added {
+			if c := bytes.Compare(addedCursors[i], filter.Cursor); len(filter.Cursor) != 0 &&
+				(c == 0 || (c < 0) != filter.Desc) {
+				continue
+			}
+			events = append(events, ev)
+			cursors = append(cursors, addedCursors[i])
+			totalSize += uint64(proto.Size(ev))
+			if totalSize > MaxEventSize {
+				err = fmt.Errorf("too large size of event (%v)", totalSize)
+				return false
+			}
+			// one more event tells whether the next page exists
+			if size != 0 && len(events) > size {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if size != 0 && len(events) > size {
+		return events[:size], cursors[size-1], nil
+	}
+	return events, nil, nil
+}
+
+// paginateEvents returns the page of events after filter.Cursor, which are
+// listed by scanning blocks. In descending order, the events of a block are
+// still in ascending order, so the cursor is looked up among them.
+func paginateEvents(events []*types.Event, cursors [][]byte, filter *types.FilterInfo) ([]*types.Event, []byte, error) {
+	if len(filter.Cursor) != 0 && !filter.Desc {
+		i := 0
+		for i < len(cursors) && bytes.Compare(cursors[i], filter.Cursor) <= 0 {
+			i++
+		}
+		events, cursors = events[i:], cursors[i:]
+	} else if len(filter.Cursor) != 0 {
+		found := false
+		for i, c := range cursors {
+			if bytes.Equal(c, filter.Cursor) {
+				events, cursors = events[i+1:], cursors[i+1:]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, types.ErrInvalidEventCursor
+		}
+	}
+	if size := int(filter.Size); size != 0 && len(events) > size {
+		return events[:size], cursors[size-1], nil
+	}
+	return events, nil, nil
+}
+
+// getIndexedEvents loads the events of the entry of key and the block, which
+// match filter, with their cursors. The entry of the block no longer in the
+// main chain is ignored.
+func (cs *ChainService) getIndexedEvents(key []byte, no types.BlockNo, filter *types.FilterInfo,
+	argFilter []types.ArgFilter) ([]*types.Event, [][]byte, error) {
+	entry := eventEntry(cs.eventIndex.store.Get(eventEntryKey(key, no)))
+	if len(entry) < 32 {
+		return nil, nil, nil
+	}
+	hash, err := cs.cdb.getHashByNo(no)
+	if err != nil || !bytes.Equal(hash, entry.blockHash()) {
+		return nil, nil, nil
+	}
+	receipts, err := cs.cdb.getReceipts(hash, no, cs.hardforkConfig())
+	if err != nil {
+		return nil, nil, err
+	}
+	var events []*types.Event
+	var cursors [][]byte
+	for i := 0; i < entry.positions(); i++ {
+		txIdx, evIdx := entry.position(i)
+		if int(txIdx) >= len(receipts.Get()) || int(evIdx) >= len(receipts.Get()[txIdx].Events) {
+			continue
+		}
+		r := receipts.Get()[txIdx]
+		ev := r.Events[evIdx]
+		if !ev.Filter(filter, argFilter) {
+			continue
+		}
+		ev.SetMemoryInfo(r, hash, no, txIdx)
+		events = append(events, ev)
+		cursors = append(cursors, ev.Cursor())
+	}
+	if filter.Desc {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+			cursors[i], cursors[j] = cursors[j], cursors[i]
+		}
+	}
+	return events, cursors, nil
+}
@@ -0,0 +1,111 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package chain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEventIndex(t *testing.T) (*eventIndex, func()) {
+	dir, err := ioutil.TempDir("", "eventindex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ei := newEventIndex(string(db.MemoryImpl), dir)
+	return ei, func() {
+		ei.close()
+		os.RemoveAll(dir)
+	}
+}
+
+func testEventReceipts(address []byte, args ...string) *types.Receipts {
+	var events []*types.Event
+	for i, arg := range args {
+		events = append(events, &types.Event{ContractAddress: address, EventName: "transfer", JsonArgs: arg, EventIdx: int32(i)})
+	}
+	receipts := &types.Receipts{}
+	receipts.Set([]*types.Receipt{{ContractAddress: address, Status: "SUCCESS", Events: events}})
+	return receipts
+}
+
+func walkBlocks(ei *eventIndex, key []byte, from, to types.BlockNo, desc bool) []types.BlockNo {
+	var blocks []types.BlockNo
+	ei.walk(key, from, to, desc, func(no types.BlockNo) bool {
+		blocks = append(blocks, no)
+		return true
+	})
+	return blocks
+}
+
+func TestEventIndexWalk(t *testing.T) {
+	ei, cleanup := newTestEventIndex(t)
+	defer cleanup()
+
+	address := types.AddressPadding([]byte("contract"))
+	blocks := []types.BlockNo{3, 1023, 1024, 5000, 1 << 20, 1<<20 + 7, 1 << 30}
+	for _, no := range blocks {
+		hash := common.Hasher([]byte(fmt.Sprint(no)))
+		ei.addBlock(hash, no, testEventReceipts(address, fmt.Sprintf(`["alice", %d]`, no)), true)
+	}
+	tip, exist := ei.tip()
+	assert.True(t, exist)
+	assert.Equal(t, types.BlockNo(1<<30), tip)
+
+	key := eventAddrKey(address)
+	assert.Equal(t, blocks, walkBlocks(ei, key, 0, 1<<31, false))
+	assert.Equal(t, []types.BlockNo{1 << 20, 5000, 1024, 1023}, walkBlocks(ei, key, 1000, 1<<20+6, true))
+
+	// the key of argument
+	argFilter, err := (&types.FilterInfo{ArgFilter: []byte(`{"1": 5000}`)}).GetExArgFilter()
+	assert.NoError(t, err)
+	key = filterKey(&types.FilterInfo{ContractAddress: address, EventName: "transfer"}, argFilter)
+	assert.Equal(t, []types.BlockNo{5000}, walkBlocks(ei, key, 0, 1<<31, false))
+
+	// the events of other contract
+	assert.Empty(t, walkBlocks(ei, eventAddrKey(types.AddressPadding([]byte("other"))), 0, 1<<31, false))
+
+	// the walk stops as requested
+	var visited int
+	ei.walk(eventNameKey(address, "transfer"), 0, 1<<31, false, func(no types.BlockNo) bool {
+		visited++
+		return visited < 2
+	})
+	assert.Equal(t, 2, visited)
+}
+
+func TestEventIndexRemove(t *testing.T) {
+	ei, cleanup := newTestEventIndex(t)
+	defer cleanup()
+
+	address := types.AddressPadding([]byte("contract"))
+	hash := common.Hasher([]byte("block"))
+	receipts := testEventReceipts(address, `["alice"]`, `["bob"]`)
+	ei.addBlock(hash, 10, receipts, false)
+	_, exist := ei.tip()
+	assert.False(t, exist)
+
+	entry := eventEntry(ei.store.Get(eventEntryKey(eventAddrKey(address), 10)))
+	assert.Equal(t, hash, entry.blockHash())
+	assert.Equal(t, 2, entry.positions())
+	txIdx, evIdx := entry.position(1)
+	assert.Equal(t, int32(0), txIdx)
+	assert.Equal(t, int32(1), evIdx)
+
+	// the entry of the other block of the same number is kept
+	ei.removeBlock(common.Hasher([]byte("other")), 10, receipts)
+	assert.NotEmpty(t, ei.store.Get(eventEntryKey(eventAddrKey(address), 10)))
+
+	ei.removeBlock(hash, 10, receipts)
+	assert.Empty(t, ei.store.Get(eventEntryKey(eventAddrKey(address), 10)))
+	assert.Empty(t, ei.store.Get(eventEntryKey(eventNameKey(address, "transfer"), 10)))
+}
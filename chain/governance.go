@@ -31,7 +31,6 @@ func executeGovernanceTx(ccc consensus.ChainConsensusCluster, bs *state.BlockSta
 	if err != nil {
 		return nil, err
 	}
-	blockNo := blockInfo.No
 	var events []*types.Event
 	switch governance {
 	case types.AergoSystem:
@@ -39,7 +38,7 @@ func executeGovernanceTx(ccc consensus.ChainConsensusCluster, bs *state.BlockSta
 	case types.AergoName:
 		events, err = name.ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
 	case types.AergoEnterprise:
-		events, err = enterprise.ExecuteEnterpriseTx(bs, ccc, scs, txBody, sender, receiver, blockNo)
+		events, err = enterprise.ExecuteEnterpriseTx(bs, ccc, scs, txBody, sender, receiver, blockInfo)
 		if err != nil {
 			err = contract.NewGovEntErr(err)
 		}
@@ -55,34 +54,71 @@ func executeGovernanceTx(ccc consensus.ChainConsensusCluster, bs *state.BlockSta
 }
 
 // InitGenesisBPs opens system contract and put initial voting result
-// it also set *State in Genesis to use statedb
+// it also set *State in Genesis to use statedb. The enterprise admins and the
+// BFT validators of the genesis are initialized as well.
 func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
-	aid := types.ToAccountID([]byte(types.AergoSystem))
-	scs, err := states.OpenContractStateAccount(aid)
-	if err != nil {
-		return err
-	}
+	if len(genesis.BPs) > 0 {
+		aid := types.ToAccountID([]byte(types.AergoSystem))
+		scs, err := states.OpenContractStateAccount(aid)
+		if err != nil {
+			return err
+		}
 
-	voteResult := make(map[string]*big.Int)
-	for _, v := range genesis.BPs {
-		voteResult[v] = new(big.Int).SetUint64(0)
-	}
-	if err = system.InitVoteResult(scs, voteResult); err != nil {
-		return err
-	}
+		voteResult := make(map[string]*big.Int)
+		for _, v := range genesis.BPs {
+			voteResult[v] = new(big.Int).SetUint64(0)
+		}
+		if err = system.InitVoteResult(scs, voteResult); err != nil {
+			return err
+		}
 
-	// Set genesis.BPs to the votes-ordered BPs. This will be used later for
-	// bootstrapping.
-	genesis.BPs = system.BuildOrderedCandidates(voteResult)
-	if err = states.StageContractState(scs); err != nil {
-		return err
+		// Set genesis.BPs to the votes-ordered BPs. This will be used later for
+		// bootstrapping.
+		genesis.BPs = system.BuildOrderedCandidates(voteResult)
+		if err = states.StageContractState(scs); err != nil {
+			return err
+		}
 	}
-	if err = states.Update(); err != nil {
+	validators := genesisValidators(genesis)
+	if len(genesis.Admins) > 0 || len(validators) > 0 {
+		aid := types.ToAccountID([]byte(types.AergoEnterprise))
+		scs, err := states.OpenContractStateAccount(aid)
+		if err != nil {
+			return err
+		}
+		if len(genesis.Admins) > 0 {
+			if err = enterprise.InitAdmins(scs, genesis.AdminAddresses()); err != nil {
+				return err
+			}
+		}
+		if len(validators) > 0 {
+			if err = enterprise.InitValidators(scs, validators); err != nil {
+				return err
+			}
+		}
+		if err = states.StageContractState(scs); err != nil {
+			return err
+		}
+	}
+	if err := states.Update(); err != nil {
 		return err
 	}
-	if err = states.Commit(); err != nil {
+	if err := states.Commit(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// genesisValidators returns the peer IDs of the BFT validators of genesis, or
+// nil if it's not a genesis of BFT.
+func genesisValidators(genesis *types.Genesis) []string {
+	if genesis.ConsensusType() != consensus.ConsensusName[consensus.ConsensusBFT] {
+		return nil
+	}
+	ids := make([]string, len(genesis.EnterpriseBPs))
+	for i, bp := range genesis.EnterpriseBPs {
+		ids[i] = bp.PeerID
+	}
+	return ids
+}
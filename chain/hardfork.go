@@ -0,0 +1,168 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/contract/enterprise"
+	"github.com/aergoio/aergo/types"
+)
+
+// hardforkSchedule keeps the hardfork versions scheduled by the enterprise
+// governance of private chains, over the hardfork config of the node.
+type hardforkSchedule struct {
+	// base is the hardfork config before the governance is applied.
+	base cfg.HardforkConfig
+	// config is base with the schedule applied, which is shared by the
+	// services.
+	config *cfg.SharedHardforkConfig
+	// scheduled is the block numbers of the versions set by the governance.
+	scheduled map[string]types.BlockNo
+	// unsupported is the scheduled versions which the node doesn't support.
+	unsupported map[string]types.BlockNo
+}
+
+// applyHardforkSchedule updates the hardfork config of the node by the
+// schedule in the latest state, and reports whether the config is changed.
+// It's called whenever the state is changed, so the schedule of the blocks
+// rolled back by reorganization is reverted. The versions activated by the
+// node config can't be rescheduled.
+func (cs *ChainService) applyHardforkSchedule(blockNo types.BlockNo) (bool, error) {
+	if cs.IsPublic() {
+		return false, nil
+	}
+	schedule, err := enterprise.GetHardforkSchedule(cs.sdb.GetStateDB())
+	if err != nil {
+		return false, err
+	}
+
+	hs := &cs.hardfork
+	config := hs.base
+	scheduled := make(map[string]types.BlockNo)
+	unsupported := make(map[string]types.BlockNo)
+	versions := make([]string, 0, len(schedule))
+	for v := range schedule {
+		versions = append(versions, v)
+	}
+	sortHardforkVersions(versions)
+	for _, v := range versions {
+		h := schedule[v]
+		if baseHeight, supported := hs.base.Height(v); !supported {
+			unsupported[v] = h
+			continue
+		} else if baseHeight <= blockNo && baseHeight != h {
+			logger.Warn().Str("version", v).Uint64("height", baseHeight).Msg("ignore the schedule of the activated hardfork")
+			continue
+		}
+		if err := config.Schedule(v, h); err != nil {
+			return false, err
+		}
+		scheduled[v] = h
+	}
+
+	old := hs.config.Get()
+	changed := *old != config || !reflect.DeepEqual(hs.unsupported, unsupported)
+	if changed {
+		logger.Info().Str("old", fmt.Sprint(*old)).Str("new", fmt.Sprint(config)).Msg("hardfork schedule changed")
+		for v, h := range unsupported {
+			logger.Warn().Str("version", v).Uint64("height", h).Msg("the scheduled hardfork is not supported by the node, upgrade it before the height")
+		}
+	}
+	// the services follow the new schedule from the next block.
+	hs.config.Set(config)
+	hs.scheduled = scheduled
+	hs.unsupported = unsupported
+	return changed, nil
+}
+
+// SharedHardforkConfig returns the hardfork config with the schedule of the
+// governance applied, which the services must use instead of the node config.
+func (cs *ChainService) SharedHardforkConfig() *cfg.SharedHardforkConfig {
+	return cs.hardfork.config
+}
+
+// hardforkConfig returns the current hardfork config.
+func (cs *ChainService) hardforkConfig() *cfg.HardforkConfig {
+	return cs.hardfork.config.Get()
+}
+
+// writeHardfork writes the hardfork config including the unsupported
+// versions to the chain DB, which refuses the older node to start after the
+// versions.
+func (cs *ChainService) writeHardfork() error {
+	dbCfg := cs.hardforkConfig().DbConfig()
+	for v, h := range cs.hardfork.unsupported {
+		dbCfg[v] = h
+	}
+	return cs.cdb.WriteHardforkDb(dbCfg)
+}
+
+// updateHardfork applies the hardfork schedule after the state is changed to
+// that of the block.
+func (cs *ChainService) updateHardfork(blockNo types.BlockNo) {
+	changed, err := cs.applyHardforkSchedule(blockNo)
+	if err != nil {
+		logger.Error().Err(err).Uint64("no", blockNo).Msg("failed to apply hardfork schedule")
+		return
+	}
+	if !changed {
+		return
+	}
+	if err := cs.writeHardfork(); err != nil {
+		logger.Error().Err(err).Msg("failed to write hardfork config")
+	}
+}
+
+// checkSupportedHardfork returns an error if the block is after a scheduled
+// hardfork which the node doesn't support.
+func (cs *ChainService) checkSupportedHardfork(blockNo types.BlockNo) error {
+	for v, h := range cs.hardfork.unsupported {
+		if h <= blockNo {
+			return fmt.Errorf("hardfork %s activated at %d is not supported by the node", v, h)
+		}
+	}
+	return nil
+}
+
+// getHardforkSchedule returns the block numbers of the hardfork versions in
+// the chain DB.
+func (cs *ChainService) getHardforkSchedule() *types.HardforkSchedule {
+	best := cs.cdb.getBestBlockNo()
+	sched := &types.HardforkSchedule{
+		BestBlockNo:    best,
+		CurrentVersion: cs.hardforkConfig().Version(best),
+		MaxVersion:     cs.hardforkConfig().MaxVersion(),
+	}
+	dbCfg := cs.cdb.Hardfork()
+	versions := make([]string, 0, len(dbCfg))
+	for v := range dbCfg {
+		versions = append(versions, v)
+	}
+	sortHardforkVersions(versions)
+	for _, v := range versions {
+		_, supported := cs.hardforkConfig().Height(v)
+		_, scheduled := cs.hardfork.scheduled[v]
+		sched.Forks = append(sched.Forks, &types.HardforkInfo{
+			Version:   v,
+			Height:    dbCfg[v],
+			Scheduled: scheduled,
+			Supported: supported,
+		})
+	}
+	return sched
+}
+
+func sortHardforkVersions(versions []string) {
+	sort.Slice(versions, func(i, j int) bool {
+		vi, _ := cfg.ParseHardforkVersion(versions[i])
+		vj, _ := cfg.ParseHardforkVersion(versions[j])
+		return vi < vj
+	})
+}
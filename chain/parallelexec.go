@@ -0,0 +1,208 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/aergoio/aergo/contract/name"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// specResult is the outcome of a transaction speculatively executed over an
+// isolated view of the block state.
+type specResult struct {
+	view   *state.BlockState
+	access *state.AccessSet
+	err    error
+	done   bool
+}
+
+// parallelExecutor executes the transactions of a block optimistically in
+// parallel. Each transaction which doesn't run a contract is first executed
+// over its own view of the block state, recording the keys it reads and
+// writes. The results are then merged into the block state in the order of
+// the block body; a transaction whose accesses overlap the writes of the
+// preceding ones is discarded and re-executed sequentially. Transactions
+// calling contracts or the system, name and enterprise accounts are always
+// executed sequentially, so the block state ends up bit-identical to the one
+// of the sequential execution.
+type parallelExecutor struct {
+	bs      *state.BlockState
+	sdb     *state.ChainStateDB
+	execTx  TxExecFn
+	txs     []*types.Tx
+	workers int
+}
+
+func newParallelExecutor(bs *state.BlockState, sdb *state.ChainStateDB, execTx TxExecFn, txs []*types.Tx) *parallelExecutor {
+	return &parallelExecutor{
+		bs:      bs,
+		sdb:     sdb,
+		execTx:  execTx,
+		txs:     txs,
+		workers: runtime.NumCPU(),
+	}
+}
+
+func (pe *parallelExecutor) execute() error {
+	results := pe.speculate()
+
+	dirty := state.NewAccessSet()
+	pe.bs.SetAccessSet(dirty)
+	defer pe.bs.SetAccessSet(nil)
+
+	nApplied := 0
+	for i, tx := range pe.txs {
+		r := results[i]
+		if r.done && r.err == nil && !r.access.HasStorageWrite() && !dirty.Conflicts(r.access) {
+			if err := pe.apply(r); err != nil {
+				return err
+			}
+			nApplied++
+			continue
+		}
+		// Either the transaction isn't allowed to be speculated, or its
+		// speculation is stale. A failed speculation is also re-executed to
+		// make the error be returned from the block state itself.
+		if err := pe.execTx(pe.bs, types.NewTransaction(tx)); err != nil {
+			return err
+		}
+	}
+	logger.Debug().Int("txs", len(pe.txs)).Int("applied", nApplied).Msg("parallel execution finished")
+
+	return nil
+}
+
+// speculate executes the eligible transactions concurrently, each over its
+// own view of the block state.
+func (pe *parallelExecutor) speculate() []*specResult {
+	results := make([]*specResult, len(pe.txs))
+	jobs := make(chan int, len(pe.txs))
+	for i := range pe.txs {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for w := 0; w < pe.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the eligibility of transactions is checked over a read-only view
+			// shared by the transactions of this worker.
+			var check *state.BlockState
+			for i := range jobs {
+				tx := pe.txs[i]
+				if !isSpeculativeType(tx) {
+					results[i] = &specResult{}
+					continue
+				}
+				if check == nil {
+					check = pe.newView()
+				}
+				results[i] = pe.speculateTx(check, tx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (pe *parallelExecutor) newView() *state.BlockState {
+	return state.NewBlockState(
+		pe.sdb.OpenNewStateDB(pe.bs.GetRoot()),
+		state.SetPrevBlockHash(pe.bs.PrevBlockHash()),
+		state.SetGasPrice(pe.bs.GasPrice),
+	)
+}
+
+func (pe *parallelExecutor) speculateTx(check *state.BlockState, tx *types.Tx) *specResult {
+	// the reads of the check are recorded too, since the eligibility depends on them.
+	access := state.NewAccessSet()
+	check.SetAccessSet(access)
+	eligible := isSpeculative(check, tx)
+	check.SetAccessSet(nil)
+	if !eligible {
+		return &specResult{}
+	}
+
+	view := pe.newView()
+	view.SetAccessSet(access)
+	return &specResult{
+		view:   view,
+		access: access,
+		err:    pe.execTx(view, types.NewTransaction(tx)),
+		done:   true,
+	}
+}
+
+// apply copies the account states written by a speculated transaction and
+// its receipt into the block state.
+func (pe *parallelExecutor) apply(r *specResult) error {
+	for _, id := range r.access.WrittenAccounts() {
+		st, err := r.view.GetState(id)
+		if err != nil {
+			return err
+		}
+		if err := pe.bs.PutState(id, st); err != nil {
+			return err
+		}
+	}
+	for _, receipt := range r.view.Receipts().Get() {
+		if err := pe.bs.AddReceipt(receipt); err != nil {
+			return err
+		}
+	}
+	pe.bs.BpReward.Add(&pe.bs.BpReward, &r.view.BpReward)
+
+	return nil
+}
+
+// isSpeculative reports whether tx may be executed over an isolated view,
+// i.e. it only transfers balance between accounts without running the Lua VM
+// nor any system contract.
+func isSpeculative(view *state.BlockState, tx *types.Tx) bool {
+	if !isSpeculativeType(tx) {
+		return false
+	}
+	txBody := tx.GetBody()
+	recipient, err := name.Resolve(view, txBody.GetRecipient(), types.IsQuirkTx(tx.GetHash()))
+	if err != nil || len(recipient) == 0 {
+		return false
+	}
+	switch string(recipient) {
+	case types.AergoSystem, types.AergoName, types.AergoEnterprise:
+		return false
+	}
+
+	receiver, err := view.GetAccountState(types.ToAccountID(recipient))
+	if err != nil {
+		return false
+	}
+	return len(receiver.GetCodeHash()) == 0
+}
+
+// isSpeculativeType reports whether tx may be speculated by its type and
+// recipient, which is checked before reading any state.
+func isSpeculativeType(tx *types.Tx) bool {
+	txBody := tx.GetBody()
+	switch txBody.GetType() {
+	case types.TxType_NORMAL, types.TxType_TRANSFER:
+	default:
+		return false
+	}
+	return len(txBody.GetRecipient()) != 0
+}
+
+// canExecuteParallel reports whether the block state is in the initial state
+// of the block, which every speculative view is opened at.
+func canExecuteParallel(bs *state.BlockState, txs []*types.Tx) bool {
+	return len(txs) > 1 && bs.Snapshot() == 0
+}
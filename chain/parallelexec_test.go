@@ -0,0 +1,122 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelExecuteTxs(t *testing.T) {
+	initTest(t, true)
+	defer deinitTest()
+
+	newTx := func(from, to []byte, nonce uint64, amount uint64) *types.Tx {
+		tx := &types.Tx{Body: &types.TxBody{
+			ChainIdHash: common.Hasher(chainID),
+			Account:     from,
+			Recipient:   to,
+			Nonce:       nonce,
+			Amount:      new(big.Int).SetUint64(amount).Bytes(),
+			Type:        types.TxType_TRANSFER,
+		}}
+		signTestAddress(t, tx)
+		return tx
+	}
+	a, b, c, d := makeTestAddress(t), makeTestAddress(t), makeTestAddress(t), makeTestAddress(t)
+	txs := []*types.Tx{
+		newTx(a, b, 1, 100),
+		newTx(c, d, 1, 200),
+		// depends on the 1st tx (same sender)
+		newTx(a, c, 2, 300),
+		// depends on the 1st tx (receiver becomes sender)
+		newTx(b, d, 1, 400),
+		newTx(d, a, 1, 500),
+	}
+
+	bi := newTestBlockInfo(chainID)
+	execTx := NewTxExecutor(nil, nil, bi, contract.ChainService)
+
+	seq := state.NewBlockState(sdb.OpenNewStateDB(sdb.GetRoot()))
+	for _, tx := range txs {
+		assert.NoError(t, execTx(seq, types.NewTransaction(tx)))
+	}
+	assert.NoError(t, seq.Update())
+
+	par := state.NewBlockState(sdb.OpenNewStateDB(sdb.GetRoot()))
+	assert.True(t, canExecuteParallel(par, txs))
+	assert.NoError(t, newParallelExecutor(par, sdb, execTx, txs).execute())
+	assert.NoError(t, par.Update())
+
+	assert.Equal(t, seq.GetRoot(), par.GetRoot(), "state root")
+	assert.Equal(t, seq.BpReward.String(), par.BpReward.String(), "bp reward")
+	assert.Equal(t, len(seq.Receipts().Get()), len(par.Receipts().Get()))
+	for i, r := range seq.Receipts().Get() {
+		assert.Equal(t, r.TxHash, par.Receipts().Get()[i].TxHash, "receipt order")
+	}
+}
+
+func TestParallelExecuteFailedTx(t *testing.T) {
+	initTest(t, true)
+	defer deinitTest()
+
+	a, b := makeTestAddress(t), makeTestAddress(t)
+	tx := &types.Tx{Body: &types.TxBody{
+		ChainIdHash: common.Hasher(chainID),
+		Account:     a,
+		Recipient:   b,
+		Nonce:       1,
+		Amount:      types.MaxAER.Bytes(),
+		Type:        types.TxType_TRANSFER,
+	}}
+	signTestAddress(t, tx)
+
+	bi := newTestBlockInfo(chainID)
+	execTx := NewTxExecutor(nil, nil, bi, contract.ChainService)
+	par := state.NewBlockState(sdb.OpenNewStateDB(sdb.GetRoot()))
+	err := newParallelExecutor(par, sdb, execTx, []*types.Tx{tx, tx}).execute()
+	assert.EqualError(t, err, types.ErrInsufficientBalance.Error())
+}
+
+func TestParallelSpeculateEligibleOnly(t *testing.T) {
+	initTest(t, true)
+	defer deinitTest()
+
+	a, b := makeTestAddress(t), makeTestAddress(t)
+	transfer := &types.Tx{Body: &types.TxBody{
+		ChainIdHash: common.Hasher(chainID),
+		Account:     a,
+		Recipient:   b,
+		Nonce:       1,
+		Amount:      new(big.Int).SetUint64(100).Bytes(),
+		Type:        types.TxType_TRANSFER,
+	}}
+	signTestAddress(t, transfer)
+	governance := &types.Tx{Body: &types.TxBody{
+		ChainIdHash: common.Hasher(chainID),
+		Account:     b,
+		Recipient:   []byte(types.AergoSystem),
+		Nonce:       1,
+		Payload:     []byte(`{"Name":"v1stake"}`),
+		Type:        types.TxType_GOVERNANCE,
+	}}
+	signTestAddress(t, governance)
+
+	bi := newTestBlockInfo(chainID)
+	execTx := NewTxExecutor(nil, nil, bi, contract.ChainService)
+	par := state.NewBlockState(sdb.OpenNewStateDB(sdb.GetRoot()))
+	results := newParallelExecutor(par, sdb, execTx, []*types.Tx{transfer, governance}).speculate()
+
+	assert.True(t, results[0].done, "transfer is speculated")
+	assert.NotNil(t, results[0].view)
+	assert.False(t, results[1].done, "governance tx is not speculated")
+	assert.Nil(t, results[1].view, "no view is opened for governance tx")
+}
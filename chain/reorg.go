@@ -489,6 +489,13 @@ func (reorg *reorganizer) rollback() error {
 	}
 
 	reorg.cs.Update(brStartBlock)
+	reorg.cs.updateHardfork(brStartBlockNo)
+
+	// the subscribers of events are notified before the events of new blocks.
+	// there's no subscriber while recovering.
+	if !reorg.recover {
+		reorg.cs.notifyRemovedEvents(reorg.oldBlocks)
+	}
 
 	return nil
 }
@@ -496,6 +503,8 @@ func (reorg *reorganizer) rollback() error {
 func (reorg *reorganizer) deleteOldReceipts() {
 	dbTx := reorg.cs.cdb.NewTx()
 	for _, blk := range reorg.oldBlocks {
+		reorg.cs.unindexEvents(blk)
+		reorg.cs.removeStakingRecords(blk)
 		reorg.cs.cdb.deleteReceipts(&dbTx, blk.GetHash(), blk.BlockNo())
 	}
 	dbTx.Commit()
@@ -0,0 +1,257 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	stakingLedgerDBName = "staking"
+
+	// StakingRewardOp is the operation of the records of voting rewards.
+	StakingRewardOp = "reward"
+
+	// MaxStakingRecords limits the number of records returned at once.
+	MaxStakingRecords = 10000
+)
+
+var (
+	ErrStakingLedgerDisabled = errors.New("staking ledger is disabled")
+	ErrStakingLedgerNotReady = errors.New("staking ledger is not ready")
+)
+
+// stakingLedger indexes the staking, unstaking, voting and the voting rewards
+// of each account. The entry of an account and a block holds the hash of the
+// block followed by the records of the account in the block, which are kept
+// in the event index structure keyed by the account address.
+type stakingLedger struct {
+	*eventIndex
+}
+
+func newStakingLedger(dbType string, dataDir string) *stakingLedger {
+	return &stakingLedger{eventIndex: openEventIndex(dbType, dataDir, stakingLedgerDBName)}
+}
+
+// stakingRecords returns the records of the block grouped by the account, and
+// the accounts in the order of appearance. reward is the amount of voting
+// reward paid by the block, or nil if there's no reward.
+func stakingRecords(block *types.Block, receipts *types.Receipts, reward *big.Int) (map[string][]*types.StakingRecord, []string) {
+	records := make(map[string][]*types.StakingRecord)
+	var accounts []string
+	add := func(r *types.StakingRecord) {
+		r.BlockNo = block.BlockNo()
+		r.BlockHash = block.BlockHash()
+		r.Timestamp = block.GetHeader().GetTimestamp()
+		if _, exist := records[string(r.Account)]; !exist {
+			accounts = append(accounts, string(r.Account))
+		}
+		records[string(r.Account)] = append(records[string(r.Account)], r)
+	}
+
+	for i, tx := range block.GetBody().GetTxs() {
+		body := tx.GetBody()
+		if string(body.GetRecipient()) != types.AergoSystem || !txSucceeded(receipts, i, tx) {
+			continue
+		}
+		var ci types.CallInfo
+		if err := json.Unmarshal(body.GetPayload(), &ci); err != nil {
+			continue
+		}
+		r := &types.StakingRecord{Op: ci.Name, Account: body.GetAccount(), TxHash: tx.GetHash()}
+		switch types.GetOpSysTx(ci.Name) {
+		case types.Opstake, types.Opunstake:
+			r.Amount = body.GetAmount()
+		case types.OpvoteBP, types.OpvoteDAO:
+			for _, arg := range ci.Args {
+				r.Args = append(r.Args, fmt.Sprint(arg))
+			}
+		default:
+			continue
+		}
+		add(r)
+	}
+
+	if reward != nil && reward.Sign() > 0 {
+		add(&types.StakingRecord{Op: StakingRewardOp, Account: block.GetHeader().GetConsensus(), Amount: reward.Bytes()})
+	}
+	return records, accounts
+}
+
+func txSucceeded(receipts *types.Receipts, i int, tx *types.Tx) bool {
+	if receipts == nil || i >= len(receipts.Get()) {
+		return false
+	}
+	r := receipts.Get()[i]
+	return r.GetStatus() == "SUCCESS" && bytes.Equal(r.GetTxHash(), tx.GetHash())
+}
+
+// addBlock adds the records of the block. tip is updated only if the ledger
+// has already caught up the chain.
+func (sl *stakingLedger) addBlock(block *types.Block, receipts *types.Receipts, reward *big.Int, updateTip bool) error {
+	records, accounts := stakingRecords(block, receipts, reward)
+	entries := make(map[string][]byte, len(records))
+	for _, account := range accounts {
+		b, err := proto.Marshal(&types.StakingHistory{Records: records[account]})
+		if err != nil {
+			return err
+		}
+		entries[account] = append(append([]byte{}, block.BlockHash()...), b...)
+	}
+	sl.putEntries(entries, accounts, block.BlockNo(), updateTip)
+	return nil
+}
+
+// removeBlock removes the records of the block rolled back by reorganization.
+func (sl *stakingLedger) removeBlock(block *types.Block, receipts *types.Receipts) {
+	records, accounts := stakingRecords(block, receipts, nil)
+	keys := make([][]byte, 0, len(accounts)+1)
+	for _, account := range accounts {
+		keys = append(keys, []byte(account))
+	}
+	// the reward record is removed regardless of the amount
+	if _, exist := records[string(block.GetHeader().GetConsensus())]; !exist && len(block.GetHeader().GetConsensus()) == types.AddressLength {
+		keys = append(keys, block.GetHeader().GetConsensus())
+	}
+	sl.deleteEntries(keys, block.BlockHash(), block.BlockNo())
+}
+
+// votingReward returns the amount of voting reward paid by the block. As the
+// reward is paid from the voting reward vault, it's the decrease of the vault
+// balance excluding the transfers into the vault in the block.
+func (cs *ChainService) votingReward(block *types.Block, receipts *types.Receipts) (*big.Int, error) {
+	if ConsensusName() != consensus.ConsensusName[consensus.ConsensusDPOS] || block.BlockNo() == 0 ||
+		len(block.GetHeader().GetConsensus()) != types.AddressLength {
+		return nil, nil
+	}
+	prev, err := cs.cdb.getBlock(block.GetHeader().GetPrevBlockHash())
+	if err != nil {
+		return nil, err
+	}
+
+	vaultID := types.ToAccountID([]byte(types.AergoVault))
+	balance := func(root []byte) (*big.Int, error) {
+		s, err := cs.sdb.OpenNewStateDB(root).GetAccountState(vaultID)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetBalanceBigInt(), nil
+	}
+	before, err := balance(prev.GetHeader().GetBlocksRootHash())
+	if err != nil {
+		return nil, err
+	}
+	after, err := balance(block.GetHeader().GetBlocksRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	reward := new(big.Int).Sub(before, after)
+	for i, tx := range block.GetBody().GetTxs() {
+		if string(tx.GetBody().GetRecipient()) == types.AergoVault && txSucceeded(receipts, i, tx) {
+			reward.Add(reward, tx.GetBody().GetAmountBigInt())
+		}
+	}
+	return reward, nil
+}
+
+// initStakingLedger opens the staking ledger and catches up the blocks which
+// are connected while the ledger is disabled, in background.
+func (cs *ChainService) initStakingLedger() {
+	sl := newStakingLedger(cs.cfg.DbType, cs.cfg.DataDir)
+	cs.stakingLedger = sl
+
+	cs.buildIndex(sl.eventIndex, stakingLedgerDBName, func(no types.BlockNo, hash []byte) error {
+		block, err := cs.cdb.getBlock(hash)
+		if err != nil {
+			return err
+		}
+		// blocks without receipts have no transactions
+		receipts, _ := cs.cdb.getReceipts(hash, no, cs.hardforkConfig())
+		reward, err := cs.votingReward(block, receipts)
+		if err != nil {
+			return err
+		}
+		return sl.addBlock(block, receipts, reward, true)
+	})
+}
+
+// addStakingRecords adds the records of the block to the staking ledger if
+// enabled.
+func (cs *ChainService) addStakingRecords(block *types.Block, receipts *types.Receipts) {
+	if cs.stakingLedger == nil {
+		return
+	}
+	reward, err := cs.votingReward(block, receipts)
+	if err == nil {
+		err = cs.stakingLedger.addBlock(block, receipts, reward, cs.stakingLedger.isReady())
+	}
+	if err != nil {
+		logger.Error().Err(err).Uint64("no", block.BlockNo()).Msg("failed to add staking records")
+	}
+}
+
+// removeStakingRecords removes the records of the block from the staking
+// ledger if enabled.
+func (cs *ChainService) removeStakingRecords(block *types.Block) {
+	if cs.stakingLedger == nil {
+		return
+	}
+	receipts, _ := cs.cdb.getReceipts(block.BlockHash(), block.BlockNo(), cs.hardforkConfig())
+	cs.stakingLedger.removeBlock(block, receipts)
+}
+
+// getStakingHistory returns the records of the account in the block range
+// [from, to] in ascending order. The best block is used if to is 0.
+func (cs *ChainService) getStakingHistory(account []byte, from, to types.BlockNo) ([]*types.StakingRecord, error) {
+	if cs.stakingLedger == nil {
+		return nil, ErrStakingLedgerDisabled
+	}
+	if !cs.stakingLedger.isReady() {
+		return nil, ErrStakingLedgerNotReady
+	}
+	if best := cs.cdb.getBestBlockNo(); to == 0 || to > best {
+		to = best
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range (%d > %d)", from, to)
+	}
+
+	records := []*types.StakingRecord{}
+	var err error
+	cs.stakingLedger.walk(account, from, to, false, func(no types.BlockNo) bool {
+		entry := eventEntry(cs.stakingLedger.store.Get(eventEntryKey(account, no)))
+		if len(entry) < 32 {
+			return true
+		}
+		// skip the entry of the block no longer in the main chain
+		if hash, e := cs.cdb.getHashByNo(no); e != nil || !bytes.Equal(hash, entry.blockHash()) {
+			return true
+		}
+		var history types.StakingHistory
+		if err = proto.Unmarshal(entry[32:], &history); err != nil {
+			return false
+		}
+		records = append(records, history.Records...)
+		if len(records) > MaxStakingRecords {
+			err = fmt.Errorf("too many staking records (more than %d), narrow the block range", MaxStakingRecords)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,127 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package chain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSystemTx(account []byte, amount int64, name string, args ...interface{}) *types.Tx {
+	payload, _ := json.Marshal(&types.CallInfo{Name: name, Args: args})
+	tx := &types.Tx{Body: &types.TxBody{
+		Account:   account,
+		Recipient: []byte(types.AergoSystem),
+		Amount:    big.NewInt(amount).Bytes(),
+		Payload:   payload,
+		Type:      types.TxType_GOVERNANCE,
+	}}
+	tx.Hash = tx.CalculateTxHash()
+	return tx
+}
+
+func testStakingBlock(no types.BlockNo, winner []byte, txs []*types.Tx, status []string) (*types.Block, *types.Receipts) {
+	block := &types.Block{
+		Header: &types.BlockHeader{BlockNo: no, Timestamp: int64(no) * 1e9, Consensus: winner},
+		Body:   &types.BlockBody{Txs: txs},
+	}
+	var rs []*types.Receipt
+	for i, tx := range txs {
+		rs = append(rs, &types.Receipt{Status: status[i], TxHash: tx.GetHash()})
+	}
+	receipts := &types.Receipts{}
+	receipts.Set(rs)
+	return block, receipts
+}
+
+func testLedgerRecords(t *testing.T, sl *stakingLedger, account []byte, no types.BlockNo) []*types.StakingRecord {
+	entry := eventEntry(sl.store.Get(eventEntryKey(account, no)))
+	if len(entry) < 32 {
+		return nil
+	}
+	var history types.StakingHistory
+	assert.NoError(t, proto.Unmarshal(entry[32:], &history))
+	return history.Records
+}
+
+func TestStakingRecords(t *testing.T) {
+	alice := types.AddressPadding([]byte("alice"))
+	bob := types.AddressPadding([]byte("bob"))
+	txs := []*types.Tx{
+		testSystemTx(alice, 100, types.Opstake.Cmd()),
+		testSystemTx(alice, 0, types.OpvoteBP.Cmd(), "16Uiu2HAmBDcLEjBYeEnGU2qDD1KdpEdwDBtN7gqXzNZbHXo8Q841"),
+		testSystemTx(bob, 50, types.Opunstake.Cmd()),
+		testSystemTx(bob, 0, types.OpreportDoubleSign.Cmd(), "evidence"),
+	}
+	block, receipts := testStakingBlock(10, bob, txs, []string{"SUCCESS", "SUCCESS", "ERROR", "SUCCESS"})
+
+	records, accounts := stakingRecords(block, receipts, big.NewInt(7))
+	assert.Equal(t, []string{string(alice), string(bob)}, accounts)
+
+	// the records of alice are in the order of transactions
+	assert.Len(t, records[string(alice)], 2)
+	stake := records[string(alice)][0]
+	assert.Equal(t, types.Opstake.Cmd(), stake.Op)
+	assert.Equal(t, int64(100), new(big.Int).SetBytes(stake.Amount).Int64())
+	assert.Equal(t, txs[0].GetHash(), stake.TxHash)
+	assert.Equal(t, uint64(10), stake.BlockNo)
+	assert.Equal(t, block.BlockHash(), stake.BlockHash)
+	vote := records[string(alice)][1]
+	assert.Equal(t, types.OpvoteBP.Cmd(), vote.Op)
+	assert.Equal(t, []string{"16Uiu2HAmBDcLEjBYeEnGU2qDD1KdpEdwDBtN7gqXzNZbHXo8Q841"}, vote.Args)
+
+	// the failed unstaking and the other system transactions are skipped
+	assert.Len(t, records[string(bob)], 1)
+	reward := records[string(bob)][0]
+	assert.Equal(t, StakingRewardOp, reward.Op)
+	assert.Equal(t, int64(7), new(big.Int).SetBytes(reward.Amount).Int64())
+	assert.Nil(t, reward.TxHash)
+
+	// no reward record without the amount
+	records, accounts = stakingRecords(block, receipts, nil)
+	assert.Equal(t, []string{string(alice)}, accounts)
+	assert.Nil(t, records[string(bob)])
+}
+
+func TestStakingLedgerReorg(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stakingledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sl := newStakingLedger(string(db.MemoryImpl), dir)
+	defer func() {
+		sl.close()
+		os.RemoveAll(dir)
+	}()
+
+	alice := types.AddressPadding([]byte("alice"))
+	bob := types.AddressPadding([]byte("bob"))
+
+	old, oldReceipts := testStakingBlock(5, bob, []*types.Tx{testSystemTx(alice, 100, types.Opstake.Cmd())}, []string{"SUCCESS"})
+	assert.NoError(t, sl.addBlock(old, oldReceipts, big.NewInt(3), true))
+	assert.Len(t, testLedgerRecords(t, sl, alice, 5), 1)
+	assert.Len(t, testLedgerRecords(t, sl, bob, 5), 1)
+
+	// the block of the new branch at the same height is added before the old
+	// one is removed
+	branch, branchReceipts := testStakingBlock(5, nil, []*types.Tx{testSystemTx(alice, 200, types.Opstake.Cmd())}, []string{"SUCCESS"})
+	assert.NoError(t, sl.addBlock(branch, branchReceipts, nil, true))
+	sl.removeBlock(old, oldReceipts)
+
+	records := testLedgerRecords(t, sl, alice, 5)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, int64(200), new(big.Int).SetBytes(records[0].Amount).Int64())
+	}
+	assert.Nil(t, testLedgerRecords(t, sl, bob, 5))
+	assert.Equal(t, []types.BlockNo{5}, walkBlocks(sl.eventIndex, alice, 0, 10, false))
+}
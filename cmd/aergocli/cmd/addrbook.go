@@ -0,0 +1,39 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var addrbookCmd = &cobra.Command{
+	Use:   "addrbook",
+	Short: "Print the peers in the address book of node",
+	Args:  cobra.NoArgs,
+	Run:   execAddrBook,
+}
+
+func init() {
+	rootCmd.AddCommand(addrbookCmd)
+}
+
+func execAddrBook(cmd *cobra.Command, args []string) {
+	msg, err := client.GetAddressBook(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	// recently seen peers first
+	sort.Slice(msg.Entries, func(i, j int) bool {
+		return msg.Entries[i].LastSeen > msg.Entries[j].LastSeen
+	})
+	cmd.Println(util.AddressBookToString(msg))
+}
@@ -0,0 +1,93 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var agentCertRenewable bool
+
+func init() {
+	rootCmd.AddCommand(agentCertCmd)
+	agentCertRevokeCmd.Flags().BoolVar(&agentCertRenewable, "renew", false, "let the agent request a new certificate right after the revocation")
+	agentCertCmd.AddCommand(agentCertListCmd, agentCertIssueCmd, agentCertRevokeCmd)
+}
+
+var agentCertCmd = &cobra.Command{
+	Use:   "agentcert",
+	Short: "Certificates of agents delegated by block producer",
+}
+
+var agentCertListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Print the agent certificates and revocation lists known to node",
+	Args:  cobra.NoArgs,
+	Run:   execAgentCertList,
+}
+
+var agentCertIssueCmd = &cobra.Command{
+	Use:   "issue [producer id]",
+	Short: "Request new certificates from the block producers managed by agent",
+	Long: `Request new certificates from the block producers managed by agent.
+Only the producer with the given ID is asked if it is specified.`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  execAgentCertIssue,
+}
+
+var agentCertRevokeCmd = &cobra.Command{
+	Use:   "revoke <agent id>",
+	Short: "Revoke the certificates issued to the agent by block producer",
+	Long: `Revoke the certificates issued to the agent by block producer.
+The signed revocation list is sent to all peers. The agent cannot get a new
+certificate until it is revoked again with --renew.`,
+	Args: cobra.ExactArgs(1),
+	Run:  execAgentCertRevoke,
+}
+
+func execAgentCertList(cmd *cobra.Command, args []string) {
+	msg, err := client.ListAgentCertificates(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.AgentCertificateListToString(msg))
+}
+
+func execAgentCertIssue(cmd *cobra.Command, args []string) {
+	var bpID types.PeerID
+	if len(args) > 0 {
+		var err error
+		if bpID, err = types.IDB58Decode(args[0]); err != nil {
+			cmd.Printf("Failed: invalid producer ID %s\n", args[0])
+			return
+		}
+	}
+	msg, err := client.IssueAgentCertificate(context.Background(), &types.SingleBytes{Value: []byte(bpID)})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.AgentCertificateListToString(msg))
+}
+
+func execAgentCertRevoke(cmd *cobra.Command, args []string) {
+	agentID, err := types.IDB58Decode(args[0])
+	if err != nil {
+		cmd.Printf("Failed: invalid agent ID %s\n", args[0])
+		return
+	}
+	msg, err := client.RevokeAgentCertificates(context.Background(), &types.AgentCertRevokeRequest{AgentID: []byte(agentID), Renewable: agentCertRenewable})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.CRLToString(msg))
+}
@@ -0,0 +1,132 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bpKeyBP      string
+	bpKeyCurrent string
+	bpKeyNew     string
+	bpKeyHeight  uint64
+)
+
+func init() {
+	rootCmd.AddCommand(bpKeyCmd)
+	bpKeyRotateCmd.Flags().StringVar(&address, "address", "", "address of account sending the rotation")
+	bpKeyRotateCmd.Flags().StringVar(&bpKeyBP, "bp", "", "ID of block producer (default: ID of the current key)")
+	bpKeyRotateCmd.Flags().StringVar(&bpKeyCurrent, "current", "", "path of the current signing key file of block producer")
+	bpKeyRotateCmd.Flags().StringVar(&bpKeyNew, "new", "", "path of the new signing key file")
+	bpKeyRotateCmd.Flags().Uint64Var(&bpKeyHeight, "height", 0, "block number from which the new key signs blocks")
+	bpKeyRotateCmd.MarkFlagRequired("address")
+	bpKeyRotateCmd.MarkFlagRequired("current")
+	bpKeyRotateCmd.MarkFlagRequired("new")
+	bpKeyRotateCmd.MarkFlagRequired("height")
+	bpKeyCmd.AddCommand(bpKeyRotateCmd)
+}
+
+var bpKeyCmd = &cobra.Command{
+	Use:   "bpkey",
+	Short: "Signing key of block producer",
+}
+
+var bpKeyRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate the signing key of block producer without changing its voted ID",
+	Long: `Rotate the signing key of block producer without changing its voted ID.
+The rotation is signed by both the current and the new key. The node with the
+current key must produce the blocks below the height, and the node with the
+new key the ones from the height.`,
+	Run:    execBPKeyRotate,
+	PreRun: connectAergo,
+}
+
+func execBPKeyRotate(cmd *cobra.Command, args []string) {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	current, currentPub, err := p2putil.LoadKeyFile(bpKeyCurrent)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	next, nextPub, err := p2putil.LoadKeyFile(bpKeyNew)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	var bpID types.PeerID
+	if bpKeyBP != "" {
+		if bpID, err = types.IDB58Decode(bpKeyBP); err != nil {
+			cmd.Printf("Failed: invalid block producer ID %s\n", bpKeyBP)
+			return
+		}
+	} else if bpID, err = types.IDFromPublicKey(currentPub); err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+
+	info, err := client.GetChainInfo(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cid := info.GetId()
+	chainID, err := (&types.ChainID{
+		Version:   cid.GetVersion(),
+		PublicNet: cid.GetPublic(),
+		MainNet:   cid.GetMainnet(),
+		Magic:     cid.GetMagic(),
+		Consensus: cid.GetConsensus(),
+	}).Bytes()
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+
+	rotation, err := types.NewBPKeyRotation(bpID, nextPub, bpKeyHeight, chainID)
+	if err == nil {
+		err = rotation.Sign(current, next)
+	}
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	raw, err := proto.Marshal(rotation)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	payload, err := json.Marshal(&types.CallInfo{
+		Name: types.OprotateBPKey.Cmd(),
+		Args: []interface{}{base58.Encode(raw)},
+	})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(aergosystem),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	cmd.Println(sendTX(cmd, tx, account))
+}
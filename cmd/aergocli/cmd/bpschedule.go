@@ -0,0 +1,45 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(getBPScheduleCmd)
+}
+
+var getBPScheduleCmd = &cobra.Command{
+	Use:   "getbpschedule [slots]",
+	Short: "Print the block producers of the next slots and their statistics",
+	Long:  "Print the block producers of the next slots and their statistics. It prints a round of the current block producers if the number of slots is omitted.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		param := &aergorpc.SingleBytes{}
+		if len(args) == 1 {
+			slots, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				cmd.Printf("Failed: invalid number of slots %s\n", args[0])
+				return
+			}
+			param.Value = make([]byte, 8)
+			binary.LittleEndian.PutUint64(param.Value, slots)
+		}
+		sched, err := client.GetBPSchedule(context.Background(), param)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.JSON(sched))
+	},
+}
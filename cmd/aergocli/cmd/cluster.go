@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
 	"github.com/spf13/cobra"
 	"strconv"
@@ -12,6 +13,7 @@ var (
 	nodeidStr   string
 	peerAddress string
 	peerid      string
+	asLearner   bool
 )
 
 func init() {
@@ -26,11 +28,15 @@ func init() {
 	addCmd.MarkFlagRequired("address")
 	addCmd.Flags().StringVar(&peerid, "peerid", "", "peer id of node to add to the cluster")
 	addCmd.MarkFlagRequired("peerid")
+	addCmd.Flags().BoolVar(&asLearner, "learner", false, "add node as a learner which doesn't vote")
 
 	removeCmd.Flags().StringVar(&nodeidStr, "nodeid", "", "node id to remove to the cluster")
 	removeCmd.MarkFlagRequired("nodeid")
 
-	clusterCmd.AddCommand(addCmd, removeCmd)
+	promoteCmd.Flags().StringVar(&nodeidStr, "nodeid", "", "node id of learner to promote to voting member")
+	promoteCmd.MarkFlagRequired("nodeid")
+
+	clusterCmd.AddCommand(addCmd, removeCmd, promoteCmd, transferCmd, maintenanceCmd)
 	rootCmd.AddCommand(clusterCmd)
 }
 
@@ -53,6 +59,9 @@ var addCmd = &cobra.Command{
 			Type: aergorpc.MembershipChangeType_ADD_MEMBER,
 			Attr: &aergorpc.MemberAttr{Name: nodename, Address: peerAddress, PeerID: []byte(peerIDBytes)},
 		}
+		if asLearner {
+			changeReq.Type = aergorpc.MembershipChangeType_ADD_LEARNER
+		}
 		reply, err := client.ChangeMembership(context.Background(), changeReq)
 		if err != nil {
 			cmd.Printf("Failed to add member: %s\n", err.Error())
@@ -92,3 +101,79 @@ var removeCmd = &cobra.Command{
 		return
 	},
 }
+
+var promoteCmd = &cobra.Command{
+	Use:   "promote [flags]",
+	Short: "Promote learner node with given node id to voting member. This command can only be used for raft consensus.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(nodeidStr) == 0 {
+			cmd.Printf("Failed: nodeid flag must be string of hex format\n")
+			return
+		}
+
+		nodeid, err := strconv.ParseUint(nodeidStr, 16, 64)
+		if err != nil {
+			cmd.Printf("Failed to promote member: %s\n", err.Error())
+			return
+		}
+
+		changeReq := &aergorpc.MembershipChange{
+			Type: aergorpc.MembershipChangeType_PROMOTE_LEARNER,
+			Attr: &aergorpc.MemberAttr{ID: nodeid},
+		}
+		reply, err := client.ChangeMembership(context.Background(), changeReq)
+		if err != nil {
+			cmd.Printf("Failed to promote member: %s\n", err.Error())
+			return
+		}
+
+		cmd.Printf("promoted member of cluster: %s\n", reply.Attr.ToString())
+		return
+	},
+}
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer <name>",
+	Short: "Transfer raft leadership to the member of given name. This command can only be used for raft consensus.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		reply, err := client.TransferLeadership(context.Background(), &aergorpc.Name{Name: args[0]})
+		if err != nil {
+			cmd.Printf("Failed to transfer leadership: %s\n", err.Error())
+			return
+		}
+
+		cmd.Printf("transferred leadership to member: %s\n", reply.Attr.ToString())
+		return
+	},
+}
+
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance <on|off>",
+	Short: "Stop or resume block production of the node for maintenance. This command can only be used for raft consensus.",
+	Long: `Stop or resume block production of the node for maintenance. This command can only be used for raft consensus.
+It fails if the node is the leader and no other member can take over the leadership.
+The maintenance mode is not persisted, so it is turned off when the node restarts.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var enable bool
+		switch args[0] {
+		case "on":
+			enable = true
+		case "off":
+			enable = false
+		default:
+			cmd.Printf("Failed: argument must be on or off\n")
+			return
+		}
+
+		mode, err := client.SetMaintenanceMode(context.Background(), &aergorpc.MaintenanceMode{Enabled: enable})
+		if err != nil {
+			cmd.Printf("Failed to set maintenance mode: %s\n", err.Error())
+			return
+		}
+
+		cmd.Println(util.JSON(mode))
+		return
+	},
+}
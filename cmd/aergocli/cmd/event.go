@@ -11,6 +11,7 @@ import (
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +22,8 @@ var start uint64
 var end uint64
 var desc bool
 var recentBlockCnt int32
+var pageSize uint32
+var cursor string
 
 func init() {
 	eventCmd := &cobra.Command{
@@ -41,6 +44,8 @@ func init() {
 	listCmd.Flags().BoolVar(&desc, "desc", false, "descending order")
 	listCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
 	listCmd.Flags().Int32Var(&recentBlockCnt, "recent", 0, "recent block count")
+	listCmd.Flags().Uint32Var(&pageSize, "size", 0, "maximum number of events to list")
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "cursor of the page to list, which is printed by the previous page")
 	listCmd.MarkFlagRequired("address")
 
 	streamCmd := &cobra.Command{
@@ -52,6 +57,7 @@ func init() {
 	streamCmd.Flags().StringVarP(&contractAddress, "address", "", "", "Contract Address")
 	streamCmd.Flags().StringVarP(&eventName, "event", "", "", "Event Name")
 	streamCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
+	streamCmd.Flags().StringVar(&cursor, "cursor", "", "cursor of the last event received, to stream the events after it")
 	streamCmd.MarkFlagRequired("address")
 
 	eventCmd.AddCommand(
@@ -74,6 +80,12 @@ func execListEvent(cmd *cobra.Command, args []string) {
 		Desc:            desc,
 		ArgFilter:       []byte(argFilter),
 		RecentBlockCnt:  recentBlockCnt,
+		Size:            pageSize,
+	}
+	if cursor != "" {
+		if filter.Cursor, err = base58.Decode(cursor); err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	events, err := client.ListEvents(context.Background(), filter)
@@ -84,6 +96,9 @@ func execListEvent(cmd *cobra.Command, args []string) {
 	for _, ev := range events.GetEvents() {
 		cmd.Println(util.JSON(ev))
 	}
+	if len(events.GetCursor()) != 0 {
+		cmd.Printf("cursor: %s\n", base58.Encode(events.GetCursor()))
+	}
 }
 
 func execStreamEvent(cmd *cobra.Command, args []string) {
@@ -96,6 +111,11 @@ func execStreamEvent(cmd *cobra.Command, args []string) {
 		EventName:       eventName,
 		ArgFilter:       []byte(argFilter),
 	}
+	if cursor != "" {
+		if filter.Cursor, err = base58.Decode(cursor); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	stream, err := client.ListEventStream(context.Background(), filter)
 	if err != nil {
@@ -0,0 +1,113 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var evidenceBP string
+var evidenceIndex int
+
+func init() {
+	rootCmd.AddCommand(evidenceCmd)
+	evidenceListCmd.Flags().StringVar(&evidenceBP, "bp", "", "ID of block producer")
+	evidenceReportCmd.Flags().StringVar(&address, "address", "", "address of account reporting the evidence")
+	evidenceReportCmd.Flags().StringVar(&evidenceBP, "bp", "", "ID of block producer")
+	evidenceReportCmd.Flags().IntVar(&evidenceIndex, "index", 0, "index of evidence of the block producer")
+	evidenceReportCmd.MarkFlagRequired("address")
+	evidenceReportCmd.MarkFlagRequired("bp")
+	evidenceCmd.AddCommand(evidenceListCmd, evidenceReportCmd)
+}
+
+var evidenceCmd = &cobra.Command{
+	Use:   "evidence",
+	Short: "Double-sign evidence of block producers",
+}
+
+var evidenceListCmd = &cobra.Command{
+	Use:    "list",
+	Short:  "List double-sign evidences detected by the node",
+	Run:    execEvidenceList,
+	PreRun: connectAergo,
+}
+
+var evidenceReportCmd = &cobra.Command{
+	Use:    "report",
+	Short:  "Report double-sign evidence to aergo system to exclude the block producer from the election",
+	Run:    execEvidenceReport,
+	PreRun: connectAergo,
+}
+
+func listEvidence(cmd *cobra.Command) (*types.DoubleSignEvidenceList, bool) {
+	param := &types.SingleBytes{}
+	if evidenceBP != "" {
+		id, err := types.IDB58Decode(evidenceBP)
+		if err != nil {
+			cmd.Printf("Failed: invalid block producer ID %s\n", evidenceBP)
+			return nil, false
+		}
+		param.Value = []byte(id)
+	}
+	list, err := client.ListDoubleSignEvidence(context.Background(), param)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return nil, false
+	}
+	return list, true
+}
+
+func execEvidenceList(cmd *cobra.Command, args []string) {
+	if list, ok := listEvidence(cmd); ok {
+		cmd.Println(util.JSON(list))
+	}
+}
+
+func execEvidenceReport(cmd *cobra.Command, args []string) {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	list, ok := listEvidence(cmd)
+	if !ok {
+		return
+	}
+	if evidenceIndex < 0 || evidenceIndex >= len(list.Evidences) {
+		cmd.Printf("Failed: no evidence at index %d (%d found)\n", evidenceIndex, len(list.Evidences))
+		return
+	}
+	raw, err := proto.Marshal(list.Evidences[evidenceIndex])
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	payload, err := json.Marshal(&types.CallInfo{
+		Name: types.OpreportDoubleSign.Cmd(),
+		Args: []interface{}{base58.Encode(raw)},
+	})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(aergosystem),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	cmd.Println(sendTX(cmd, tx, account))
+}
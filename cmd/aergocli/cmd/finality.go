@@ -0,0 +1,44 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(getFinalityCmd)
+}
+
+var getFinalityCmd = &cobra.Command{
+	Use:   "getfinality [blockno]",
+	Short: "Print the finality certificate of block, or the last one",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		param := &aergorpc.SingleBytes{}
+		if len(args) == 1 {
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				cmd.Printf("Failed: invalid block number %s\n", args[0])
+				return
+			}
+			param.Value = make([]byte, 8)
+			binary.LittleEndian.PutUint64(param.Value, number)
+		}
+		cert, err := client.GetFinalityCertificate(context.Background(), param)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.JSON(cert))
+	},
+}
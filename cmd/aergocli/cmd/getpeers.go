@@ -25,6 +25,7 @@ var getpeersCmd = &cobra.Command{
 
 var nohidden bool
 var showself bool
+var showbanned bool
 var sortFlag string
 var detailed int
 
@@ -45,13 +46,14 @@ func init() {
 	rootCmd.AddCommand(getpeersCmd)
 	getpeersCmd.Flags().BoolVar(&nohidden, "nohidden", false, "exclude hidden peers")
 	getpeersCmd.Flags().BoolVar(&showself, "self", false, "show self peer info")
+	getpeersCmd.Flags().BoolVar(&showbanned, "banned", false, "show peers banned by bad reputation")
 	getpeersCmd.Flags().StringVar(&sortFlag, "sort", "no", "sort peers by address, id or other")
 	getpeersCmd.Flags().IntVar(&detailed, "detail", 0, "detail level")
 }
 
 func execGetPeers(cmd *cobra.Command, args []string) {
 	sorter := GetSorter(cmd, sortFlag)
-	msg, err := client.GetPeers(context.Background(), &types.PeersParams{NoHidden: nohidden, ShowSelf: showself})
+	msg, err := client.GetPeers(context.Background(), &types.PeersParams{NoHidden: nohidden, ShowSelf: showself, ShowBanned: showbanned})
 	if err != nil {
 		cmd.Printf("Failed to get peer from server: %s\n", err.Error())
 		return
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(hardforkCmd)
+}
+
+var hardforkCmd = &cobra.Command{
+	Use:   "hardfork",
+	Short: "Print the block numbers of hardfork versions",
+	Long:  "Print the block numbers of hardfork versions. The versions of private chains can be scheduled by the scheduleHardfork call of the enterprise contract after the hardfork V3.",
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.GetHardforkSchedule(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.JSON(msg))
+	},
+}
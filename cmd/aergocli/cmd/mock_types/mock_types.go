@@ -96,6 +96,46 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ChangeMembership(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ChangeMembership), varargs...)
 }
 
+// TransferLeadership mocks base method
+func (m *MockAergoRPCServiceClient) TransferLeadership(arg0 context.Context, arg1 *types.Name, arg2 ...grpc.CallOption) (*types.MembershipChangeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferLeadership", varargs...)
+	ret0, _ := ret[0].(*types.MembershipChangeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership
+func (mr *MockAergoRPCServiceClientMockRecorder) TransferLeadership(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).TransferLeadership), varargs...)
+}
+
+// SetMaintenanceMode mocks base method
+func (m *MockAergoRPCServiceClient) SetMaintenanceMode(arg0 context.Context, arg1 *types.MaintenanceMode, arg2 ...grpc.CallOption) (*types.MaintenanceMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetMaintenanceMode", varargs...)
+	ret0, _ := ret[0].(*types.MaintenanceMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaintenanceMode indicates an expected call of SetMaintenanceMode
+func (mr *MockAergoRPCServiceClientMockRecorder) SetMaintenanceMode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceMode", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SetMaintenanceMode), varargs...)
+}
+
 // CommitTX mocks base method
 func (m *MockAergoRPCServiceClient) CommitTX(arg0 context.Context, arg1 *types.TxList, arg2 ...grpc.CallOption) (*types.CommitResultList, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +416,186 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetConsensusInfo(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsensusInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetConsensusInfo), varargs...)
 }
 
+// GetAddressBook mocks base method
+func (m *MockAergoRPCServiceClient) GetAddressBook(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.AddressBook, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAddressBook", varargs...)
+	ret0, _ := ret[0].(*types.AddressBook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressBook indicates an expected call of GetAddressBook
+func (mr *MockAergoRPCServiceClientMockRecorder) GetAddressBook(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressBook", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetAddressBook), varargs...)
+}
+
+// IssueAgentCertificate mocks base method
+func (m *MockAergoRPCServiceClient) IssueAgentCertificate(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.AgentCertificateList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IssueAgentCertificate", varargs...)
+	ret0, _ := ret[0].(*types.AgentCertificateList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueAgentCertificate indicates an expected call of IssueAgentCertificate
+func (mr *MockAergoRPCServiceClientMockRecorder) IssueAgentCertificate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueAgentCertificate", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).IssueAgentCertificate), varargs...)
+}
+
+// ListAgentCertificates mocks base method
+func (m *MockAergoRPCServiceClient) ListAgentCertificates(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.AgentCertificateList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAgentCertificates", varargs...)
+	ret0, _ := ret[0].(*types.AgentCertificateList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgentCertificates indicates an expected call of ListAgentCertificates
+func (mr *MockAergoRPCServiceClientMockRecorder) ListAgentCertificates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgentCertificates", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListAgentCertificates), varargs...)
+}
+
+// RevokeAgentCertificates mocks base method
+func (m *MockAergoRPCServiceClient) RevokeAgentCertificates(arg0 context.Context, arg1 *types.AgentCertRevokeRequest, arg2 ...grpc.CallOption) (*types.CertificateRevocationList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAgentCertificates", varargs...)
+	ret0, _ := ret[0].(*types.CertificateRevocationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAgentCertificates indicates an expected call of RevokeAgentCertificates
+func (mr *MockAergoRPCServiceClientMockRecorder) RevokeAgentCertificates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAgentCertificates", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).RevokeAgentCertificates), varargs...)
+}
+
+// GetFinalityCertificate mocks base method
+func (m *MockAergoRPCServiceClient) GetFinalityCertificate(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.FinalityCertificate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFinalityCertificate", varargs...)
+	ret0, _ := ret[0].(*types.FinalityCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinalityCertificate indicates an expected call of GetFinalityCertificate
+func (mr *MockAergoRPCServiceClientMockRecorder) GetFinalityCertificate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinalityCertificate", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetFinalityCertificate), varargs...)
+}
+
+// GetBPSchedule mocks base method
+func (m *MockAergoRPCServiceClient) GetBPSchedule(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.BPSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBPSchedule", varargs...)
+	ret0, _ := ret[0].(*types.BPSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBPSchedule indicates an expected call of GetBPSchedule
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBPSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBPSchedule", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBPSchedule), varargs...)
+}
+
+// GetStakingHistory mocks base method
+func (m *MockAergoRPCServiceClient) GetStakingHistory(arg0 context.Context, arg1 *types.StakingHistoryParams, arg2 ...grpc.CallOption) (*types.StakingHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStakingHistory", varargs...)
+	ret0, _ := ret[0].(*types.StakingHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakingHistory indicates an expected call of GetStakingHistory
+func (mr *MockAergoRPCServiceClientMockRecorder) GetStakingHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingHistory", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStakingHistory), varargs...)
+}
+
+// GetHardforkSchedule mocks base method
+func (m *MockAergoRPCServiceClient) GetHardforkSchedule(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.HardforkSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHardforkSchedule", varargs...)
+	ret0, _ := ret[0].(*types.HardforkSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardforkSchedule indicates an expected call of GetHardforkSchedule
+func (mr *MockAergoRPCServiceClientMockRecorder) GetHardforkSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardforkSchedule", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetHardforkSchedule), varargs...)
+}
+
+// ListDoubleSignEvidence mocks base method
+func (m *MockAergoRPCServiceClient) ListDoubleSignEvidence(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.DoubleSignEvidenceList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDoubleSignEvidence", varargs...)
+	ret0, _ := ret[0].(*types.DoubleSignEvidenceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDoubleSignEvidence indicates an expected call of ListDoubleSignEvidence
+func (mr *MockAergoRPCServiceClientMockRecorder) ListDoubleSignEvidence(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDoubleSignEvidence", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListDoubleSignEvidence), varargs...)
+}
+
 // GetEnterpriseConfig mocks base method
 func (m *MockAergoRPCServiceClient) GetEnterpriseConfig(arg0 context.Context, arg1 *types.EnterpriseConfigKey, arg2 ...grpc.CallOption) (*types.EnterpriseConfig, error) {
 	m.ctrl.T.Helper()
@@ -456,6 +676,26 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetReceipt(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceipt), varargs...)
 }
 
+// GetReceiptWithProof mocks base method
+func (m *MockAergoRPCServiceClient) GetReceiptWithProof(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ReceiptProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReceiptWithProof", varargs...)
+	ret0, _ := ret[0].(*types.ReceiptProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptWithProof indicates an expected call of GetReceiptWithProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetReceiptWithProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptWithProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceiptWithProof), varargs...)
+}
+
 // GetServerInfo mocks base method
 func (m *MockAergoRPCServiceClient) GetServerInfo(arg0 context.Context, arg1 *types.KeyParams, arg2 ...grpc.CallOption) (*types.ServerInfo, error) {
 	m.ctrl.T.Helper()
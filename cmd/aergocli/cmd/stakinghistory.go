@@ -0,0 +1,83 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/internal/enc"
+	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var historyFrom uint64
+var historyTo uint64
+var historyCSV bool
+
+func init() {
+	rootCmd.AddCommand(stakingHistoryCmd)
+	stakingHistoryCmd.Flags().StringVar(&address, "address", "", "address of account")
+	stakingHistoryCmd.Flags().Uint64Var(&historyFrom, "from", 0, "first block number of the range")
+	stakingHistoryCmd.Flags().Uint64Var(&historyTo, "to", 0, "last block number of the range (default: best block)")
+	stakingHistoryCmd.Flags().BoolVar(&historyCSV, "csv", false, "print the records as CSV")
+	stakingHistoryCmd.MarkFlagRequired("address")
+}
+
+var stakingHistoryCmd = &cobra.Command{
+	Use:    "stakinghistory",
+	Short:  "Print the staking, unstaking, voting and voting reward records of an account",
+	Long:   "Print the staking, unstaking, voting and voting reward records of an account. The node must enable the staking ledger.",
+	Run:    execStakingHistory,
+	PreRun: connectAergo,
+}
+
+func execStakingHistory(cmd *cobra.Command, args []string) {
+	account, err := aergorpc.DecodeAddress(address)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	history, err := client.GetStakingHistory(context.Background(), &aergorpc.StakingHistoryParams{
+		Account:   account,
+		BlockFrom: historyFrom,
+		BlockTo:   historyTo,
+	})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	if !historyCSV {
+		cmd.Println(util.JSON(history))
+		return
+	}
+
+	w := csv.NewWriter(cmd.OutOrStdout())
+	w.Write([]string{"block_no", "time", "op", "amount", "tx_hash", "args"})
+	for _, r := range history.GetRecords() {
+		var txHash string
+		if len(r.GetTxHash()) != 0 {
+			txHash = enc.ToString(r.GetTxHash())
+		}
+		w.Write([]string{
+			strconv.FormatUint(r.GetBlockNo(), 10),
+			time.Unix(0, r.GetTimestamp()).UTC().Format(time.RFC3339),
+			r.GetOp(),
+			new(big.Int).SetBytes(r.GetAmount()).String(),
+			txHash,
+			strings.Join(r.GetArgs(), " "),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+	}
+}
@@ -59,6 +59,20 @@ type InOutPeer struct {
 	Hidden    bool
 	Self      bool
 	Version   string
+	Score     int32
+	// LastPenalty and BannedUntil are shown only if the peer was penalized or is banned.
+	LastPenalty string     `json:",omitempty"`
+	BannedUntil *time.Time `json:",omitempty"`
+}
+
+// InOutAddressBookEntry is a peer in the address book of node.
+type InOutAddressBookEntry struct {
+	PeerId       string
+	Role         string
+	Addresses    []string
+	LastSeen     time.Time
+	SuccessCount uint32
+	FailCount    uint32
 }
 
 type LongInOutPeer struct {
@@ -76,6 +90,18 @@ type InOutCert struct {
 	Addresses   []string
 }
 
+type InOutCRL struct {
+	ProducerID  string
+	CreateTime  time.Time
+	Revocations []*InOutCertRevocation
+}
+
+type InOutCertRevocation struct {
+	AgentID    string
+	RevokeTime time.Time
+	Renewable  bool
+}
+
 func FillTxBody(source *InOutTxBody, target *types.TxBody) error {
 	var err error
 	if source == nil {
@@ -265,6 +291,12 @@ func ConvPeer(p *types.Peer) *InOutPeer {
 	} else {
 		out.Version = "(old)"
 	}
+	out.Score = p.GetScore()
+	out.LastPenalty = p.GetLastPenalty()
+	if p.GetBannedUntil() != 0 {
+		until := time.Unix(0, p.GetBannedUntil())
+		out.BannedUntil = &until
+	}
 	return out
 }
 
@@ -277,19 +309,32 @@ func ConvPeerLong(p *types.Peer) *LongInOutPeer {
 	if p.Address.Role == types.PeerRole_Agent {
 		out.Certificates = make([]*InOutCert, len(p.Certificates))
 		for i, cert := range p.Certificates {
-			addrs := []string{}
-			for _, ad := range cert.AgentAddress {
-				addrs = append(addrs, string(ad))
-			}
-			out.Certificates[i] = &InOutCert{CertVersion: cert.CertVersion,
-				ProducerID: base58.Encode(cert.BPID), AgentID: base58.Encode(cert.AgentID),
-				CreateTime: time.Unix(0, cert.CreateTime), ExpireTime: time.Unix(0, cert.ExpireTime),
-				Addresses: addrs}
+			out.Certificates[i] = ConvAgentCert(cert)
 		}
 	}
 	return out
 }
 
+func ConvAgentCert(cert *types.AgentCertificate) *InOutCert {
+	addrs := []string{}
+	for _, ad := range cert.AgentAddress {
+		addrs = append(addrs, string(ad))
+	}
+	return &InOutCert{CertVersion: cert.CertVersion,
+		ProducerID: base58.Encode(cert.BPID), AgentID: base58.Encode(cert.AgentID),
+		CreateTime: time.Unix(0, cert.CreateTime), ExpireTime: time.Unix(0, cert.ExpireTime),
+		Addresses: addrs}
+}
+
+func ConvCRL(crl *types.CertificateRevocationList) *InOutCRL {
+	out := &InOutCRL{ProducerID: base58.Encode(crl.BPID), CreateTime: time.Unix(0, crl.CreateTime)}
+	out.Revocations = make([]*InOutCertRevocation, len(crl.Revocations))
+	for i, r := range crl.Revocations {
+		out.Revocations[i] = &InOutCertRevocation{AgentID: base58.Encode(r.AgentID), RevokeTime: time.Unix(0, r.RevokeTime), Renewable: r.Renewable}
+	}
+	return out
+}
+
 func ConvBlockchainStatus(in *types.BlockchainStatus) string {
 	out := &InOutBlockchainStatus{}
 	if in == nil {
@@ -344,6 +389,34 @@ func LongPeerListToString(p *types.PeerList) string {
 	}
 	return toString(peers)
 }
+func AddressBookToString(ab *types.AddressBook) string {
+	entries := []*InOutAddressBookEntry{}
+	for _, e := range ab.GetEntries() {
+		addr := e.GetAddress()
+		entries = append(entries, &InOutAddressBookEntry{PeerId: base58.Encode(addr.GetPeerID()), Role: addr.GetRole().String(),
+			Addresses: addr.GetAddresses(), LastSeen: time.Unix(0, e.GetLastSeen()), SuccessCount: e.GetSuccessCount(), FailCount: e.GetFailCount()})
+	}
+	return toString(entries)
+}
+
+func AgentCertificateListToString(l *types.AgentCertificateList) string {
+	out := struct {
+		Certificates    []*InOutCert
+		RevocationLists []*InOutCRL
+	}{Certificates: []*InOutCert{}, RevocationLists: []*InOutCRL{}}
+	for _, cert := range l.GetCertificates() {
+		out.Certificates = append(out.Certificates, ConvAgentCert(cert))
+	}
+	for _, crl := range l.GetRevocationLists() {
+		out.RevocationLists = append(out.RevocationLists, ConvCRL(crl))
+	}
+	return toString(out)
+}
+
+func CRLToString(crl *types.CertificateRevocationList) string {
+	return toString(ConvCRL(crl))
+}
+
 func toString(out interface{}) string {
 	jsonout, err := json.MarshalIndent(out, "", " ")
 	if err != nil {
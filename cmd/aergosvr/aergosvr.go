@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/transport"
 
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/account"
@@ -95,6 +96,10 @@ func initConfig() {
 	if useTestnet {
 		cfg.UseTestnet = true
 	}
+	if err := transport.CheckTransports(cfg.P2P); err != nil {
+		fmt.Printf("Invalid p2p.nptransports configuration: %v\n", err)
+		os.Exit(1)
+	}
 	if cfg.EnableTestmode && cfg.UseTestnet {
 		fmt.Println("Turn off test mode for Aergo Public Chains")
 		os.Exit(1)
@@ -151,6 +156,12 @@ func rootRun(cmd *cobra.Command, args []string) {
 
 	p2pkey.InitNodeInfo(&cfg.BaseConfig, cfg.P2P, githash, svrlog)
 
+	if cfg.Light != nil && cfg.Light.Enable {
+		svrlog.Info().Msg("Running as light client")
+		lightRun()
+		return
+	}
+
 	compMng := component.NewComponentHub()
 
 	chainSvc := chain.NewChainService(cfg)
@@ -0,0 +1,66 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package main
+
+import (
+	"os"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/light"
+	"github.com/aergoio/aergo/p2p"
+	"github.com/aergoio/aergo/pkg/component"
+	polarisclient "github.com/aergoio/aergo/polaris/client"
+	"github.com/aergoio/aergo/rpc"
+	"github.com/aergoio/aergo/types"
+)
+
+// lightRun runs aergosvr as a light client, which keeps only the block
+// headers and serves state queries by verifying proofs from full nodes.
+func lightRun() {
+	genesis := loadGenesis()
+	if genesis == nil {
+		svrlog.Error().Msg("Failed to load genesis block")
+		os.Exit(1)
+	}
+
+	compMng := component.NewComponentHub()
+
+	lightSvc, err := light.NewLightService(cfg, genesis)
+	if err != nil {
+		svrlog.Error().Err(err).Msg("Failed to start light service")
+		os.Exit(1)
+	}
+	hc := lightSvc.HeaderChain()
+	rpcSvc := rpc.NewRPC(cfg, hc, githash)
+	p2pSvc := p2p.NewP2P(cfg, hc)
+	pmapSvc := polarisclient.NewPolarisConnectSvc(cfg.P2P, p2pSvc)
+
+	compMng.Register(lightSvc, rpcSvc, p2pSvc, pmapSvc)
+	p2pSvc.SetConsensusAccessor(lightSvc)
+	rpcSvc.SetConsensusAccessor(lightSvc)
+
+	compMng.Start()
+
+	var interrupt = common.HandleKillSig(func() {
+		compMng.Stop()
+	}, svrlog)
+
+	// Wait main routine to stop
+	<-interrupt.C
+}
+
+// loadGenesis returns the genesis block in the chain db, which is created
+// with the default one of the mainnet or the testnet if missing.
+func loadGenesis() *types.Genesis {
+	core := getCore(cfg.DataDir)
+	if core == nil {
+		return nil
+	}
+	defer core.Close()
+	if err := core.InitGenesisBlock(nil, !cfg.UseTestnet); err != nil {
+		return nil
+	}
+	return core.GetGenesisInfo()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/transport"
 	"github.com/aergoio/aergo/pkg/component"
 	common2 "github.com/aergoio/aergo/polaris/common"
 	"github.com/aergoio/aergo/polaris/server"
@@ -59,6 +60,10 @@ func initConfig() {
 		fmt.Printf("Fail to load configuration file %v: %v", serverCtx.Vc.ConfigFileUsed(), err.Error())
 		os.Exit(1)
 	}
+	if err := transport.CheckTransports(cfg.P2P); err != nil {
+		fmt.Printf("Invalid p2p.nptransports configuration: %v\n", err)
+		os.Exit(1)
+	}
 	if enableTestmode {
 		cfg.EnableTestmode = true
 	}
@@ -52,6 +52,7 @@ func (ctx *ServerContext) GetDefaultConfig() interface{} {
 		Polaris:    ctx.GetDefaultPolarisConfig(),
 		Hardfork:   ctx.GetDefaultHardforkConfig(),
 		SQL:        ctx.GetDefaultSQLConfig(),
+		Light:      ctx.GetDefaultLightConfig(),
 	}
 }
 
@@ -85,6 +86,7 @@ func (ctx *ServerContext) GetDefaultP2PConfig() *P2PConfig {
 		NPBindAddr:      "",
 		NPBindPort:      -1,
 		NPEnableTLS:     false,
+		NPTransports:    []string{"tcp"},
 		NPCert:          "",
 		NPKey:           "",
 		NPAddPeers:      nil,
@@ -94,6 +96,9 @@ func (ctx *ServerContext) GetDefaultP2PConfig() *P2PConfig {
 		NPUsePolaris:    true,
 		NPExposeSelf:    true,
 		PeerRole:        "",
+
+		NPCaptureFileSize: 64,
+		NPCaptureFiles:    10,
 	}
 }
 
@@ -180,6 +185,15 @@ func (ctx *ServerContext) GetDefaultSQLConfig() *SQLConfig {
 	}
 }
 
+func (ctx *ServerContext) GetDefaultLightConfig() *LightConfig {
+	return &LightConfig{
+		Enable:       false,
+		BPCount:      0,
+		SyncInterval: 10,
+		MaxHeaders:   100,
+	}
+}
+
 // GetDefaultDumpPort return the default port of the dumper.
 func GetDefaultDumpPort() int {
 	return defaultDumpPort
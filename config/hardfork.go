@@ -8,8 +8,14 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync/atomic"
+	"text/template"
 
 	"github.com/aergoio/aergo/types"
 )
@@ -48,3 +54,149 @@ func checkOlderNode(maxVer uint64, latest types.BlockNo, dbCfg HardforkDbConfig)
 	}
 	return nil
 }
+
+// height returns the block number of the version stored in a chain db. A
+// version missing from the db is regarded as not activated in the chain, which
+// was written by an older node that does not know it.
+func (dbCfg HardforkDbConfig) height(version string) types.BlockNo {
+	if h, exist := dbCfg[version]; exist {
+		return h
+	}
+	return math.MaxUint64
+}
+
+// MissingVersions returns the versions of c which are not stored in the chain
+// db. They are added to the db, if c is compatible with the chain.
+func (dbCfg HardforkDbConfig) MissingVersions(c *HardforkConfig) []string {
+	var missing []string
+	t := reflect.TypeOf(*c)
+	for i := 0; i < t.NumField(); i++ {
+		if _, exist := dbCfg[t.Field(i).Name]; !exist {
+			missing = append(missing, t.Field(i).Name)
+		}
+	}
+	return missing
+}
+
+// ParseHardforkVersion returns the version number of the version name such as
+// "V3".
+func ParseHardforkVersion(name string) (uint64, error) {
+	if len(name) < 2 || !strings.EqualFold(name[:1], "V") {
+		return 0, fmt.Errorf("invalid hardfork version: %s", name)
+	}
+	ver, err := strconv.ParseUint(name[1:], 10, 64)
+	if err != nil || ver < 2 {
+		return 0, fmt.Errorf("invalid hardfork version: %s", name)
+	}
+	return ver, nil
+}
+
+// MaxVersion returns the latest hardfork version which the node supports.
+func (c *HardforkConfig) MaxVersion() uint64 {
+	return uint64(reflect.TypeOf(*c).NumField() + 1)
+}
+
+// Height returns the block number of the version, and whether the node
+// supports the version.
+func (c *HardforkConfig) Height(version string) (types.BlockNo, bool) {
+	ver, err := ParseHardforkVersion(version)
+	if err != nil || ver > c.MaxVersion() {
+		return 0, false
+	}
+	return reflect.ValueOf(*c).Field(int(ver - 2)).Uint(), true
+}
+
+// Set sets the block number of the version.
+func (c *HardforkConfig) Set(version string, h types.BlockNo) error {
+	ver, err := ParseHardforkVersion(version)
+	if err != nil {
+		return err
+	}
+	if ver > c.MaxVersion() {
+		return fmt.Errorf("hardfork version %s is not supported by the node", version)
+	}
+	reflect.ValueOf(c).Elem().Field(int(ver - 2)).SetUint(h)
+	return nil
+}
+
+// Schedule sets the block number of the version. As a version includes all
+// the lower ones, the lower versions scheduled after h are moved to h.
+func (c *HardforkConfig) Schedule(version string, h types.BlockNo) error {
+	if err := c.Set(version, h); err != nil {
+		return err
+	}
+	ver, _ := ParseHardforkVersion(version)
+	v := reflect.ValueOf(c).Elem()
+	for i := int(ver - 3); i >= 0; i-- {
+		if v.Field(i).Uint() > h {
+			v.Field(i).SetUint(h)
+		}
+	}
+	return nil
+}
+
+// DbConfig returns the hardfork config in the form stored in a chain db.
+func (c *HardforkConfig) DbConfig() HardforkDbConfig {
+	dbCfg := make(HardforkDbConfig)
+	v := reflect.ValueOf(*c)
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		dbCfg[t.Field(i).Name] = v.Field(i).Uint()
+	}
+	return dbCfg
+}
+
+// Validate returns an error if the versions are not activated in order.
+func (c *HardforkConfig) Validate() error {
+	return c.validate()
+}
+
+// TOML returns the hardfork section of the node config file for c.
+func (c *HardforkConfig) TOML() (string, error) {
+	t, err := template.New("hardfork").Parse(hardforkConfigTmpl)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, struct{ Hardfork *HardforkConfig }{c}); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// SharedHardforkConfig is the hardfork config read by the services while the
+// governance of a private chain reschedules it. Set publishes a new config,
+// so a config returned by Get is never modified.
+type SharedHardforkConfig struct {
+	cur atomic.Value
+}
+
+// NewSharedHardforkConfig returns the shared config initialized with a copy
+// of c.
+func NewSharedHardforkConfig(c *HardforkConfig) *SharedHardforkConfig {
+	s := &SharedHardforkConfig{}
+	s.Set(*c)
+	return s
+}
+
+// Get returns the current config, which must not be modified.
+func (s *SharedHardforkConfig) Get() *HardforkConfig {
+	return s.cur.Load().(*HardforkConfig)
+}
+
+// Set replaces the current config by c.
+func (s *SharedHardforkConfig) Set(c HardforkConfig) {
+	s.cur.Store(&c)
+}
+
+func (s *SharedHardforkConfig) Version(h types.BlockNo) int32 {
+	return s.Get().Version(h)
+}
+
+func (s *SharedHardforkConfig) IsV2Fork(h types.BlockNo) bool {
+	return s.Get().IsV2Fork(h)
+}
+
+func (s *SharedHardforkConfig) IsV3Fork(h types.BlockNo) bool {
+	return s.Get().IsV3Fork(h)
+}
@@ -12,21 +12,26 @@ import (
 var (
 	MainNetHardforkConfig = &HardforkConfig{
 		V2: types.BlockNo(19611555),
+		V3: types.BlockNo(18446744073709551615),
 	}
 	TestNetHardforkConfig = &HardforkConfig{
 		V2: types.BlockNo(18714241),
+		V3: types.BlockNo(18446744073709551615),
 	}
 	AllEnabledHardforkConfig = &HardforkConfig{
 		V2: types.BlockNo(0),
+		V3: types.BlockNo(0),
 	}
 )
 
 const hardforkConfigTmpl = `[hardfork]
 v2 = "{{.Hardfork.V2}}"
+v3 = "{{.Hardfork.V3}}"
 `
 
 type HardforkConfig struct {
 	V2 types.BlockNo `mapstructure:"v2" description:"a block number of the hardfork version 2"`
+	V3 types.BlockNo `mapstructure:"v3" description:"a block number of the hardfork version 3"`
 }
 
 type HardforkDbConfig map[string]types.BlockNo
@@ -35,14 +40,21 @@ func (c *HardforkConfig) IsV2Fork(h types.BlockNo) bool {
 	return isFork(c.V2, h)
 }
 
+func (c *HardforkConfig) IsV3Fork(h types.BlockNo) bool {
+	return isFork(c.V3, h)
+}
+
 func (c *HardforkConfig) CheckCompatibility(dbCfg HardforkDbConfig, h types.BlockNo) error {
 	if err := c.validate(); err != nil {
 		return err
 	}
-	if (isFork(c.V2, h) || isFork(dbCfg["V2"], h)) && c.V2 != dbCfg["V2"] {
-		return newForkError("V2", h, c.V2, dbCfg["V2"])
+	if cdb := dbCfg.height("V2"); (isFork(c.V2, h) || isFork(cdb, h)) && c.V2 != cdb {
+		return newForkError("V2", h, c.V2, cdb)
+	}
+	if cdb := dbCfg.height("V3"); (isFork(c.V3, h) || isFork(cdb, h)) && c.V3 != cdb {
+		return newForkError("V3", h, c.V3, cdb)
 	}
-	return checkOlderNode(2, h, dbCfg)
+	return checkOlderNode(3, h, dbCfg)
 }
 
 func (c *HardforkConfig) Version(h types.BlockNo) int32 {
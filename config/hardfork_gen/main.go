@@ -67,8 +67,8 @@ func (c *HardforkConfig) CheckCompatibility(dbCfg HardforkDbConfig, h types.Bloc
 		return err
 	}
 {{- range .Hardforks}}
-	if (isFork(c.V{{.Version}}, h) || isFork(dbCfg["V{{.Version}}"], h)) && c.V{{.Version}} != dbCfg["V{{.Version}}"] {
-		return newForkError("V{{.Version}}", h, c.V{{.Version}}, dbCfg["V{{.Version}}"])
+	if cdb := dbCfg.height("V{{.Version}}"); (isFork(c.V{{.Version}}, h) || isFork(cdb, h)) && c.V{{.Version}} != cdb {
+		return newForkError("V{{.Version}}", h, c.V{{.Version}}, cdb)
 	}
 {{- end}}
 	return checkOlderNode({{.MaxVersion}}, h, dbCfg)
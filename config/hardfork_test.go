@@ -72,7 +72,8 @@ v2 = "9223"
 func TestCompatibility(t *testing.T) {
 	cfg := readConfig(`
 [hardfork]
-v2 = "9223"`,
+v2 = "9223"
+v3 = "18446744073709551615"`,
 	)
 	dbCfg, _ := readDbConfig(`
 {
@@ -154,6 +155,57 @@ v2 = "9223"`,
 	}
 }
 
+func TestCompatibilityV3(t *testing.T) {
+	cfg := readConfig(`
+[hardfork]
+v2 = "9223"
+v3 = "10000"`,
+	)
+	// the chain written by an older node, which does not know V3
+	dbCfg, _ := readDbConfig(`
+{
+	"V2": 9223
+}`,
+	)
+	err := cfg.CheckCompatibility(dbCfg, 9500)
+	if err != nil {
+		t.Error(err)
+	}
+	if missing := dbCfg.MissingVersions(cfg); len(missing) != 1 || missing[0] != "V3" {
+		t.Errorf("MissingVersions() = %v, want [V3]", missing)
+	}
+
+	err = cfg.CheckCompatibility(dbCfg, 10001)
+	if err == nil {
+		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10001), node(10000), and chain(18446744073709551615)`)
+	}
+
+	dbCfg, _ = readDbConfig(`
+{
+	"V2": 9223,
+	"V3": 10001
+}`,
+	)
+	err = cfg.CheckCompatibility(dbCfg, 10000)
+	if err == nil {
+		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10000), node(10000), and chain(10001)`)
+	}
+	if missing := dbCfg.MissingVersions(cfg); len(missing) != 0 {
+		t.Errorf("MissingVersions() = %v, want none", missing)
+	}
+
+	dbCfg, _ = readDbConfig(`
+{
+	"V2": 9223,
+	"V3": 10000
+}`,
+	)
+	err = cfg.CheckCompatibility(dbCfg, 10001)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestVersion(t *testing.T) {
 	cfg := readConfig(`
 [hardfork]
@@ -192,6 +244,11 @@ v3 = "10000"`,
 				3,
 			},
 		*/
+		{
+			"equal v3",
+			10000,
+			3,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -202,6 +259,101 @@ v3 = "10000"`,
 	}
 }
 
+func TestSchedule(t *testing.T) {
+	cfg := readConfig(`
+[hardfork]
+v2 = "9223"
+v3 = "10000"`,
+	)
+	if cfg.MaxVersion() != 3 {
+		t.Errorf("MaxVersion() = %v, want 3", cfg.MaxVersion())
+	}
+	if h, ok := cfg.Height("v3"); !ok || h != 10000 {
+		t.Errorf("Height(v3) = %v, %v", h, ok)
+	}
+	if _, ok := cfg.Height("V4"); ok {
+		t.Error("V4 must be unsupported")
+	}
+
+	if err := cfg.Schedule("V4", 20000); err == nil {
+		t.Error("the unsupported version must not be scheduled")
+	}
+	if err := cfg.Schedule("X3", 20000); err == nil {
+		t.Error("the invalid version must not be scheduled")
+	}
+
+	// the lower version is moved to the height of the higher one
+	if err := cfg.Schedule("V3", 5000); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.V2 != 5000 || cfg.V3 != 5000 {
+		t.Errorf("V2 = %v, V3 = %v, want 5000", cfg.V2, cfg.V3)
+	}
+	if err := cfg.Schedule("V3", 30000); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.V2 != 5000 || cfg.V3 != 30000 {
+		t.Errorf("V2 = %v, V3 = %v", cfg.V2, cfg.V3)
+	}
+
+	dbCfg := cfg.DbConfig()
+	if len(dbCfg) != 2 || dbCfg["V2"] != 5000 || dbCfg["V3"] != 30000 {
+		t.Errorf("DbConfig() = %v", dbCfg)
+	}
+}
+
+func TestSharedHardforkConfig(t *testing.T) {
+	cfg := readConfig(`
+[hardfork]
+v2 = "100"
+v3 = "10000"`,
+	)
+	shared := NewSharedHardforkConfig(cfg)
+	old := shared.Get()
+	if v := shared.Version(5000); v != 2 {
+		t.Errorf("Version(5000) = %v, want 2", v)
+	}
+
+	rescheduled := *old
+	if err := rescheduled.Schedule("V3", 5000); err != nil {
+		t.Fatal(err)
+	}
+	shared.Set(rescheduled)
+	if v := shared.Version(5000); v != 3 || !shared.IsV3Fork(5000) {
+		t.Errorf("Version(5000) = %v, want 3", v)
+	}
+	if old.V3 != 10000 || cfg.V3 != 10000 {
+		t.Errorf("the published config is modified: %v, %v", old.V3, cfg.V3)
+	}
+}
+
+func TestHardforkTOML(t *testing.T) {
+	cfg := *AllEnabledHardforkConfig
+	if err := cfg.Set("V3", 300); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Set("V4", 400); err == nil {
+		t.Error("the unsupported version must not be set")
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Error(err)
+	}
+	toml, err := cfg.TOML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed := readConfig(toml); *parsed != cfg {
+		t.Errorf("readConfig(TOML()) = %v, want %v", *parsed, cfg)
+	}
+
+	if err := cfg.Set("V2", 500); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Error("V2 must not be activated after V3")
+	}
+}
+
 func readConfig(c string) *HardforkConfig {
 	v := viper.New()
 	v.SetConfigType("toml")
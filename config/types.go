@@ -25,6 +25,7 @@ type Config struct {
 	Auth       *AuthConfig       `mapstructure:"auth"`
 	Hardfork   *HardforkConfig   `mapstructure:"hardfork"`
 	SQL        *SQLConfig        `mapstructure:"sql"`
+	Light      *LightConfig      `mapstructure:"light"`
 }
 
 // BaseConfig defines base configurations for aergo server
@@ -63,6 +64,7 @@ type P2PConfig struct {
 	NPBindAddr      string   `mapstructure:"npbindaddr" description:"N2N bind address. If it was set, it only accept connection to this addresse only"`
 	NPBindPort      int      `mapstructure:"npbindport" description:"N2N bind port. It not set, bind port is same as netprotocolport. Set if server is configured with NAT and port is differ."`
 	NPEnableTLS     bool     `mapstructure:"nptls" description:"Enable TLS on N2N network"`
+	NPTransports    []string `mapstructure:"nptransports" description:"Transports of N2N network, tcp or quic. QUIC is experimental, which needs npexperimentalquic, and listens on the udp port of the same number"`
 	NPCert          string   `mapstructure:"npcert" description:"Certificate file for N2N network"`
 	NPKey           string   `mapstructure:"npkey" description:"Private Key file for N2N network"`
 	NPAddPeers      []string `mapstructure:"npaddpeers" description'':"Add peers to connect to at startup"`
@@ -75,6 +77,24 @@ type P2PConfig struct {
 	NPUsePolaris   bool     `mapstructure:"npusepolaris" description:"Whether to connect and get node list from polaris"`
 	NPAddPolarises []string `mapstructure:"npaddpolarises" description:"Add addresses of polarises if default polaris is not sufficient"`
 
+	NPUseDHT        bool     `mapstructure:"npusedht" description:"Whether to discover peers of the same chain by kademlia DHT, which works without polaris"`
+	NPDHTBootstraps []string `mapstructure:"npdhtbootstraps" description:"Addresses of nodes to which local node queries first when joining DHT"`
+
+	NPExperimentalQUIC bool     `mapstructure:"npexperimentalquic" description:"Enable the experimental quic transport, which is not ready for production and doesn't interoperate with QUIC of libp2p"`
+	NPNATPortMap       bool     `mapstructure:"npnatportmap" description:"Whether to map the listen port on NAT device by UPnP or NAT-PMP"`
+	NPRelayHop         bool     `mapstructure:"nprelayhop" description:"Whether to relay connections to the peers behind NAT"`
+	NPRelays           []string `mapstructure:"nprelays" description:"Addresses of relay nodes through which other peers connect to this node, if this node has no public address. The connections through relays are upgraded to direct ones by hole punching, if both peers listen on quic"`
+
+	NPMaxBandwidth     int `mapstructure:"npmaxbandwidth" description:"Maximum bandwidth of all peers in KB/s for each direction, which doesn't delay consensus messages. 0 is unlimited"`
+	NPMaxPeerBandwidth int `mapstructure:"npmaxpeerbandwidth" description:"Maximum bandwidth of each peer in KB/s for each direction, which doesn't delay consensus messages. 0 is unlimited"`
+	NPMaxSyncBandwidth int `mapstructure:"npmaxsyncbandwidth" description:"Maximum bandwidth of block sync and light client messages of all peers in KB/s for each direction. 0 is unlimited"`
+	NPMaxTxBandwidth   int `mapstructure:"npmaxtxbandwidth" description:"Maximum bandwidth of tx relay messages of all peers in KB/s for each direction. 0 is unlimited"`
+	NPMaxRaftBandwidth int `mapstructure:"npmaxraftbandwidth" description:"Maximum bandwidth of raft messages of all peers in KB/s for each direction. 0 is unlimited"`
+
+	NPCaptureDir      string `mapstructure:"npcapturedir" description:"Directory to record all p2p messages for debugging. Messages are not recorded if empty"`
+	NPCaptureFileSize int    `mapstructure:"npcapturefilesize" description:"Size of each capture file in MB, after which a new file is started"`
+	NPCaptureFiles    int    `mapstructure:"npcapturefiles" description:"Number of capture files to keep. The oldest files are removed"`
+
 	LogFullPeerID bool `mapstructure:"logfullpeerid" description:"Whether to use full legnth peerID or short form"`
 
 	PeerRole      string   `mapstructure:"peerrole" description:"Role of peer. It must be sync with enablebp field in consensus config "`
@@ -109,6 +129,8 @@ type BlockchainConfig struct {
 	NumWorkers       int    `mapstructure:"numworkers" description:"maximum worker count for chainservice"`
 	NumLStateClosers int    `mapstructure:"numclosers" description:"maximum LuaVM state closer count for chainservice"`
 	CloseLimit       int    `mapstructure:"closelimit" description:"number of LuaVM states which a LuaVM state closer closes at one time"`
+	EventIndex       bool   `mapstructure:"eventindex" description:"maintain an on-disk index of contract events, which serves ListEvents without block range limit"`
+	StakingLedger    bool   `mapstructure:"stakingledger" description:"maintain an on-disk ledger of staking, unstaking, voting and voting rewards of each account"`
 }
 
 // MempoolConfig defines configurations for mempool service
@@ -126,6 +148,7 @@ type ConsensusConfig struct {
 	BlockInterval       int64       `mapstructure:"blockinterval" description:"block production interval (sec)"`
 	Raft                *RaftConfig `mapstructure:"raft"`
 	NoTimeoutTxEviction bool        `mapstructure:"notte" description:"disable timeout tx eviction"`
+	Finality            bool        `mapstructure:"finality" description:"finalize blocks by the signed votes of block producers (dpos only)"`
 }
 
 type RaftConfig struct {
@@ -163,6 +186,15 @@ type SQLConfig struct {
 	MaxDbSize uint32 `mapstructure:"maxdbsize" description:"maximum database size of a contract (MB)"`
 }
 
+// LightConfig defines configurations for light client mode
+type LightConfig struct {
+	Enable       bool     `mapstructure:"enable" description:"run as light client, which keeps only block headers and verifies proofs from full nodes"`
+	BPCount      int      `mapstructure:"bpcount" description:"number of BPs of DPoS chain until it is changed by voting (0: number of BPs in genesis)"`
+	SyncInterval int      `mapstructure:"syncinterval" description:"interval of header sync (sec)"`
+	MaxHeaders   uint32   `mapstructure:"maxheaders" description:"maximum number of headers requested at once"`
+	Members      []string `mapstructure:"members" description:"peer IDs of raft members added after the genesis block, which sign blocks as well as the genesis ones"`
+}
+
 /*
 How to write this template
 =======================================
@@ -204,6 +236,12 @@ netprotocoladdr = "{{.P2P.NetProtocolAddr}}"
 netprotocolport = {{.P2P.NetProtocolPort}}
 npbindaddr = "{{.P2P.NPBindAddr}}"
 npbindport = {{.P2P.NPBindPort}}
+# Set transports to listen and advertise, tcp and/or quic. The first one is the primary address of node.
+nptransports = [{{range .P2P.NPTransports}}
+"{{.}}", {{end}}
+]
+# quic in nptransports is refused unless the experimental quic transport is enabled.
+npexperimentalquic = {{.P2P.NPExperimentalQUIC}}
 # TLS and certificate is not applied in alpha release.
 nptls = {{.P2P.NPEnableTLS}}
 npcert = "{{.P2P.NPCert}}"
@@ -220,6 +258,26 @@ npusepolaris = {{.P2P.NPUsePolaris}}
 npaddpolarises = [{{range .P2P.NPAddPolarises}}
 "{{.}}", {{end}}
 ]
+npusedht = {{.P2P.NPUseDHT}}
+npdhtbootstraps = [{{range .P2P.NPDHTBootstraps}}
+"{{.}}", {{end}}
+]
+npnatportmap = {{.P2P.NPNATPortMap}}
+nprelayhop = {{.P2P.NPRelayHop}}
+nprelays = [{{range .P2P.NPRelays}}
+"{{.}}", {{end}}
+]
+# Set bandwidth caps in KB/s for each direction, and 0 is unlimited. Consensus messages are not delayed by
+# npmaxbandwidth and npmaxpeerbandwidth.
+npmaxbandwidth = {{.P2P.NPMaxBandwidth}}
+npmaxpeerbandwidth = {{.P2P.NPMaxPeerBandwidth}}
+npmaxsyncbandwidth = {{.P2P.NPMaxSyncBandwidth}}
+npmaxtxbandwidth = {{.P2P.NPMaxTxBandwidth}}
+npmaxraftbandwidth = {{.P2P.NPMaxRaftBandwidth}}
+# Set directory to record all p2p messages for debugging, and the records can be printed or replayed by p2pcapdiag
+npcapturedir = "{{.P2P.NPCaptureDir}}"
+npcapturefilesize = {{.P2P.NPCaptureFileSize}}
+npcapturefiles = {{.P2P.NPCaptureFiles}}
 peerrole = "{{.P2P.PeerRole}}"
 
 [polaris]
@@ -237,6 +295,8 @@ forceresetheight = "{{.Blockchain.ForceResetHeight}}"
 numworkers = "{{.Blockchain.NumWorkers}}"
 numclosers = "{{.Blockchain.NumLStateClosers}}"
 closelimit = "{{.Blockchain.CloseLimit}}"
+eventindex = {{.Blockchain.EventIndex}}
+stakingledger = {{.Blockchain.StakingLedger}}
 
 [mempool]
 showmetrics = {{.Mempool.ShowMetrics}}
@@ -248,6 +308,7 @@ dumpfilepath = "{{.Mempool.DumpFilePath}}"
 [consensus]
 enablebp = {{.Consensus.EnableBp}}
 blockinterval = {{.Consensus.BlockInterval}}
+finality = {{.Consensus.Finality}}
 
 [monitor]
 protocol = "{{.Monitor.ServerProtocol}}"
@@ -259,4 +320,13 @@ unlocktimeout = "{{.Account.UnlockTimeout}}"
 [auth]
 enablelocalconf = "{{.Auth.EnableLocalConf}}"
 
+[light]
+enable = {{.Light.Enable}}
+bpcount = {{.Light.BPCount}}
+syncinterval = {{.Light.SyncInterval}}
+maxheaders = {{.Light.MaxHeaders}}
+members = [{{range .Light.Members}}
+"{{.}}", {{end}}
+]
+
 ` + hardforkConfigTmpl
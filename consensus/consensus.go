@@ -80,10 +80,45 @@ type ConsensusAccessor interface {
 	ClusterInfo([]byte) *types.GetClusterInfoResponse
 	ConfChange(req *types.MembershipChange) (*Member, error)
 	ConfChangeInfo(requestID uint64) (*types.ConfChangeProgress, error)
+	// TransferLeadership moves the raft leadership to the member of the given name
+	TransferLeadership(name string) (*Member, error)
+	// SetMaintenance stops or resumes the block production of this node
+	SetMaintenance(enable bool) (*types.MaintenanceMode, error)
 	// RaftAccessor returns AergoRaftAccessor. It is only valid if chain is raft consensus
 	RaftAccessor() AergoRaftAccessor
 }
 
+// FinalityAccessor is implemented by the consensus which finalizes blocks by
+// the signed votes of block producers.
+type FinalityAccessor interface {
+	// HandleFinalityVote handles the vote received from other peer. It
+	// returns true if the vote is valid and new, so that it must be relayed.
+	HandleFinalityVote(vote *types.FinalityVote) (bool, error)
+	// HandleVoteEquivocation keeps the evidence of conflicting votes
+	// received from other peer. It returns true if the evidence is valid and
+	// new, so that it must be relayed.
+	HandleVoteEquivocation(evidence *types.VoteEquivocation) (bool, error)
+	// FinalityCertificate returns the certificate of block finalized at
+	// blockNo, or the last one if blockNo is 0.
+	FinalityCertificate(blockNo types.BlockNo) (*types.FinalityCertificate, error)
+}
+
+// BPScheduleAccessor is implemented by the consensus whose block producers
+// take turns by time slots.
+type BPScheduleAccessor interface {
+	// BPSchedule returns the block producers of the next slots, and the
+	// statistics of the block producers.
+	BPSchedule(slots uint64) (*types.BPSchedule, error)
+}
+
+// BFTAccessor is implemented by the BFT consensus, whose validators exchange
+// the proposals and votes over p2p.
+type BFTAccessor interface {
+	// HandleBFTMessage handles the message received from other peer. It
+	// returns true if the message is valid and new, so that it must be relayed.
+	HandleBFTMessage(msg *types.BFTMessage) (bool, error)
+}
+
 // ChainDB is a reader interface for the ChainDB.
 type ChainDB interface {
 	GetBestBlock() (*types.Block, error)
@@ -114,10 +149,11 @@ const (
 	ConsensusDPOS ConsensusType = iota
 	ConsensusRAFT
 	ConsensusSBP
+	ConsensusBFT
 )
 
-var ConsensusName = []string{"dpos", "raft", "sbp"}
-var ConsensusTypes = map[string]ConsensusType{"dpos": ConsensusDPOS, "raft": ConsensusRAFT, "sbp": ConsensusSBP}
+var ConsensusName = []string{"dpos", "raft", "sbp", "bft"}
+var ConsensusTypes = map[string]ConsensusType{"dpos": ConsensusDPOS, "raft": ConsensusRAFT, "sbp": ConsensusSBP, "bft": ConsensusBFT}
 
 var CurConsensusType ConsensusType
 
@@ -140,6 +176,10 @@ func UseDpos() bool {
 	return CurConsensusType == ConsensusDPOS
 }
 
+func UseBFT() bool {
+	return CurConsensusType == ConsensusBFT
+}
+
 // ChainConsensus includes chainstatus and validation API.
 type ChainConsensus interface {
 	ChainConsensusCluster
@@ -0,0 +1,615 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package bft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	bc "github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/consensus/chain"
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/contract/enterprise"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+var (
+	logger = log.NewLogger("bft")
+
+	// CommitKeyPrefix is the prefix of the key by which the commit of a block
+	// is put into the chain DB.
+	CommitKeyPrefix = []byte("bft.commit.")
+	// voteKey and lockKey are the keys of the vote state of this node.
+	voteKey = []byte("bft.vote")
+	lockKey = []byte("bft.lock")
+
+	ErrNoValidator        = errors.New("no BFT validator")
+	ErrInvalidConsensName = errors.New("invalid consensus name")
+	errNotValidator       = errors.New("block signed by non validator")
+	errNoCommit           = errors.New("commit not found")
+	errNotBestChild       = errors.New("block isn't a child of the best block")
+	errBadTimestamp       = errors.New("invalid block timestamp")
+)
+
+const (
+	// maxCachedValidatorSets limits the validator sets of the recent heights
+	// kept in memory.
+	maxCachedValidatorSets = 16
+	// maxClockDrift is how far the timestamp of a block may be ahead of the
+	// local time.
+	maxClockDrift = 5 * time.Second
+)
+
+type txExec struct {
+	execTx bc.TxExecFn
+}
+
+func newTxExec(cdb consensus.ChainDB, bi *types.BlockHeaderInfo) chain.TxOp {
+	// Block hash not determined yet
+	return &txExec{
+		execTx: bc.NewTxExecutor(nil, contract.ChainAccessor(cdb), bi, contract.BlockFactory),
+	}
+}
+
+func (te *txExec) Apply(bState *state.BlockState, tx types.Transaction) error {
+	return te.execTx(bState, tx)
+}
+
+// BFT is the Tendermint-style consensus, where a block is committed by the
+// precommits of more than 2/3 of validators and never reverted. The validator
+// set is changed by the enterprise contract.
+type BFT struct {
+	*component.ComponentHub
+	consensus.ChainDB
+	sdb      *state.ChainStateDB
+	bv       types.BlockVersionner
+	privKey  crypto.PrivKey
+	core     *core
+	jobQueue chan interface{}
+	quit     chan interface{}
+
+	sync.Mutex
+	valSets map[uint64]*validatorSet
+	// the block states of the blocks built by this node, which are passed to
+	// the chain service not to execute them again
+	built map[string]*state.BlockState
+}
+
+// GetName returns the name of the consensus.
+func GetName() string {
+	return consensus.ConsensusName[consensus.ConsensusBFT]
+}
+
+// GetConstructor build and returns consensus.Constructor from New function.
+func GetConstructor(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainDB, sdb *state.ChainStateDB) consensus.Constructor {
+	return func() (consensus.Consensus, error) {
+		return New(cfg, bv, hub, cdb, sdb)
+	}
+}
+
+// New returns a new BFT consensus.
+func New(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainDB, sdb *state.ChainStateDB) (*BFT, error) {
+	bft := &BFT{
+		ComponentHub: hub,
+		ChainDB:      cdb,
+		sdb:          sdb,
+		bv:           bv,
+		jobQueue:     make(chan interface{}),
+		quit:         make(chan interface{}),
+		valSets:      make(map[uint64]*validatorSet),
+		built:        make(map[string]*state.BlockState),
+	}
+	if cfg.Consensus.EnableBp {
+		bft.privKey = p2pkey.NodePrivKey()
+	}
+	bft.core = newCore(bft, bft.privKey, newTimeouts(consensus.BlockInterval))
+
+	return bft, nil
+}
+
+func newTimeouts(blockInterval time.Duration) timeouts {
+	return timeouts{
+		propose:   blockInterval,
+		prevote:   blockInterval / 2,
+		precommit: blockInterval / 2,
+		delta:     blockInterval / 2,
+		commit:    blockInterval,
+	}
+}
+
+func genesisValidators(genesis *types.Genesis) []string {
+	ids := make([]string, len(genesis.EnterpriseBPs))
+	for i, bp := range genesis.EnterpriseBPs {
+		ids[i] = bp.PeerID
+	}
+	return ids
+}
+
+// ValidateGenesis checks that the genesis has the initial validators.
+func ValidateGenesis(genesis *types.Genesis) error {
+	if strings.ToLower(genesis.ID.Consensus) != GetName() {
+		return ErrInvalidConsensName
+	}
+	_, err := newValidatorSet(genesisValidators(genesis))
+	return err
+}
+
+// Ticker returns a time.Ticker for the main consensus loop. The rounds of BFT
+// are driven by its own timeouts.
+func (bft *BFT) Ticker() *time.Ticker {
+	return time.NewTicker(consensus.BlockInterval)
+}
+
+// QueueJob has nothing to do.
+func (bft *BFT) QueueJob(now time.Time, jq chan<- interface{}) {
+}
+
+// BlockFactory returns bft itself.
+func (bft *BFT) BlockFactory() consensus.BlockFactory {
+	return bft
+}
+
+// JobQueue returns the queue for block production triggering.
+func (bft *BFT) JobQueue() chan<- interface{} {
+	return bft.jobQueue
+}
+
+// QuitChan returns the channel from which consensus-related goroutines check
+// when shutdown is initiated.
+func (bft *BFT) QuitChan() chan interface{} {
+	return bft.quit
+}
+
+// Start runs the consensus from the next height of the best block.
+func (bft *BFT) Start() {
+	best, err := bft.GetBestBlock()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to get best block")
+	}
+	lastCommit, _ := bft.commitOf(best.BlockNo())
+
+	logger.Info().Uint64("height", best.BlockNo()+1).Bool("validator", bft.privKey != nil).Msg("start BFT consensus")
+	bft.core.start(best.BlockNo()+1, lastCommit)
+
+	<-bft.quit
+	bft.core.stop()
+	logger.Info().Msg("shutdown initiated. stop the service")
+}
+
+func (bft *BFT) GetType() consensus.ConsensusType {
+	return consensus.ConsensusBFT
+}
+
+// IsTransactionValid checks the onsensus level validity of a transaction
+func (bft *BFT) IsTransactionValid(tx *types.Tx) bool {
+	return true
+}
+
+// VerifyTimestamp checks the validity of the block timestamp. It must be
+// later than the one of the parent block and not later than the local time by
+// more than maxClockDrift.
+func (bft *BFT) VerifyTimestamp(block *types.Block) bool {
+	ts := block.GetHeader().GetTimestamp()
+	if limit := time.Now().Add(maxClockDrift).UnixNano(); ts > limit {
+		logger.Error().Str("id", block.ID()).Time("timestamp", time.Unix(0, ts)).
+			Msg("block has a future timestamp")
+		return false
+	}
+	prev, err := bft.GetBlock(block.GetHeader().GetPrevBlockHash())
+	if err != nil {
+		logger.Error().Err(err).Str("id", block.ID()).Msg("failed to get parent block to verify timestamp")
+		return false
+	}
+	if ts <= prev.GetHeader().GetTimestamp() {
+		logger.Error().Str("id", block.ID()).Time("timestamp", time.Unix(0, ts)).
+			Time("parent", time.Unix(0, prev.GetHeader().GetTimestamp())).
+			Msg("block timestamp isn't later than the parent")
+		return false
+	}
+	return true
+}
+
+// VerifySign reports the validity of the block signature.
+func (bft *BFT) VerifySign(block *types.Block) error {
+	valid, err := block.VerifySign()
+	if !valid || err != nil {
+		return &consensus.ErrorConsensus{Msg: "bad block signature", Err: err}
+	}
+	return nil
+}
+
+// IsBlockValid checks that the block is signed by a validator and includes
+// the commit of the previous block.
+func (bft *BFT) IsBlockValid(block *types.Block, bestBlock *types.Block) error {
+	no := block.BlockNo()
+
+	vals, err := bft.validators(no)
+	if err != nil {
+		return &consensus.ErrorConsensus{Msg: "failed to get validators", Err: err}
+	}
+	id, err := block.BPID()
+	if err != nil {
+		return &consensus.ErrorConsensus{Msg: "bad public key in block", Err: err}
+	}
+	if !vals.has(id) {
+		return &consensus.ErrorConsensus{Msg: block.BPID2Str(), Err: errNotValidator}
+	}
+
+	if no <= 1 {
+		return nil
+	}
+	commit, err := unmarshalCommit(block.GetHeader().GetConsensus())
+	if err != nil {
+		return &consensus.ErrorConsensus{Msg: "bad commit in block", Err: err}
+	}
+	if err := bft.verifyCommit(commit, no-1, block.GetHeader().GetPrevBlockHash()); err != nil {
+		return &consensus.ErrorConsensus{Msg: "invalid commit in block", Err: err}
+	}
+	return nil
+}
+
+func (bft *BFT) verifyCommit(commit *types.BFTCommit, no types.BlockNo, hash []byte) error {
+	if commit.Height != no || !bytes.Equal(commit.BlockHash, hash) {
+		return fmt.Errorf("commit of other block: no=%d, hash=%s", commit.Height, types.EncodeB64(commit.BlockHash))
+	}
+	vals, err := bft.validators(no)
+	if err != nil {
+		return err
+	}
+	return commit.Verify(vals.toMap(), vals.quorum())
+}
+
+// Update notifies the core that block is connected, and keeps the commit of
+// the previous block included in it.
+func (bft *BFT) Update(block *types.Block) {
+	if no := block.BlockNo(); no > 1 {
+		if _, err := bft.commitOf(no - 1); err == errNoCommit {
+			if commit, err := unmarshalCommit(block.GetHeader().GetConsensus()); err == nil {
+				bft.saveCommit(commit)
+			}
+		}
+	}
+	bft.core.notifyBlock(block)
+}
+
+// Save has nothing to do.
+func (bft *BFT) Save(tx consensus.TxWriter) error {
+	return nil
+}
+
+// NeedReorganization always returns false since a committed block is never
+// reverted.
+func (bft *BFT) NeedReorganization(rootNo types.BlockNo) bool {
+	return false
+}
+
+func (bft *BFT) NeedNotify() bool {
+	return true
+}
+
+func (bft *BFT) HasWAL() bool {
+	return false
+}
+
+func (bft *BFT) IsForkEnable() bool {
+	return false
+}
+
+func (bft *BFT) IsConnectedBlock(block *types.Block) bool {
+	_, err := bft.ChainDB.GetBlock(block.BlockHash())
+	return err == nil
+}
+
+// Info returns the current height of the consensus and the validators.
+func (bft *BFT) Info() string {
+	info := consensus.NewInfo(GetName())
+	ci := bft.ConsensusInfo()
+	if len(ci.Info) != 0 {
+		raw := json.RawMessage(ci.Info)
+		info.Status = &raw
+	}
+	return info.AsJSON()
+}
+
+// ConsensusInfo returns the validators of the current height.
+func (bft *BFT) ConsensusInfo() *types.ConsensusInfo {
+	ci := &types.ConsensusInfo{Type: GetName()}
+
+	height := bft.core.currentHeight()
+	if vals, err := bft.validators(height); err == nil {
+		ci.Bps = vals.toStrings()
+	}
+	s := struct {
+		Height    types.BlockNo
+		Validator bool
+	}{
+		Height:    height,
+		Validator: bft.privKey != nil,
+	}
+	if m, err := json.Marshal(s); err == nil {
+		ci.Info = string(m)
+	}
+	return ci
+}
+
+// HandleBFTMessage handles the proposal or vote received from other peer.
+func (bft *BFT) HandleBFTMessage(msg *types.BFTMessage) (bool, error) {
+	return bft.core.handleMessage(msg)
+}
+
+var dummyRaft consensus.DummyRaftAccessor
+
+func (bft *BFT) RaftAccessor() consensus.AergoRaftAccessor {
+	return &dummyRaft
+}
+
+func (bft *BFT) ConfChange(req *types.MembershipChange) (*consensus.Member, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (bft *BFT) ConfChangeInfo(requestID uint64) (*types.ConfChangeProgress, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (bft *BFT) TransferLeadership(name string) (*consensus.Member, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (bft *BFT) SetMaintenance(enable bool) (*types.MaintenanceMode, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (bft *BFT) MakeConfChangeProposal(req *types.MembershipChange) (*consensus.ConfChangePropose, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (bft *BFT) ClusterInfo(bestBlockHash []byte) *types.GetClusterInfoResponse {
+	return &types.GetClusterInfoResponse{ChainID: nil, Error: consensus.ErrNotSupportedMethod.Error(), MbrAttrs: nil, HardStateInfo: nil}
+}
+
+// validators returns the validator set of height, which is decided by the
+// enterprise contract state of the previous block.
+func (bft *BFT) validators(height uint64) (*validatorSet, error) {
+	bft.Lock()
+	vals, exist := bft.valSets[height]
+	bft.Unlock()
+	if exist {
+		return vals, nil
+	}
+
+	var prevNo types.BlockNo
+	if height > 0 {
+		prevNo = height - 1
+	}
+	prev, err := bft.GetBlockByNo(prevNo)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := enterprise.GetValidators(bft.sdb.OpenNewStateDB(prev.GetHeader().GetBlocksRootHash()))
+	if err != nil {
+		return nil, err
+	}
+	if vals, err = newValidatorSet(ids); err != nil {
+		return nil, err
+	}
+
+	bft.Lock()
+	defer bft.Unlock()
+	bft.valSets[height] = vals
+	if len(bft.valSets) > maxCachedValidatorSets {
+		for h := range bft.valSets {
+			if h+maxCachedValidatorSets <= height {
+				delete(bft.valSets, h)
+			}
+		}
+	}
+	return vals, nil
+}
+
+// buildBlock produces a block on the best block, which includes the
+// transactions executed until the half of the propose timeout.
+func (bft *BFT) buildBlock(height uint64, lastCommit *types.BFTCommit) (*types.Block, error) {
+	prev, err := bft.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if prev.BlockNo()+1 != height {
+		return nil, errNotBestChild
+	}
+
+	// the timestamps increase even if the clock of this node is behind.
+	ts := time.Now().UnixNano()
+	if prevTs := prev.GetHeader().GetTimestamp(); ts <= prevTs {
+		ts = prevTs + 1
+	}
+	bi := types.NewBlockHeaderInfoFromPrevBlock(prev, ts, bft.bv)
+	bState := bft.sdb.NewBlockState(
+		prev.GetHeader().GetBlocksRootHash(),
+		state.SetPrevBlockHash(prev.BlockHash()),
+	)
+	bState.SetGasPrice(system.GetGasPriceFromState(bState))
+	bState.Receipts().SetHardFork(bft.bv, bi.No)
+	if lastCommit != nil {
+		raw, err := proto.Marshal(lastCommit)
+		if err != nil {
+			return nil, err
+		}
+		bState.SetConsensus(raw)
+	}
+
+	deadline := time.Now().Add(bft.core.timeout.propose / 2)
+	txOp := chain.NewCompTxOp(
+		chain.TxOpFn(func(bState *state.BlockState, txIn types.Transaction) error {
+			select {
+			case <-bft.quit:
+				return chain.ErrQuit
+			default:
+			}
+			if time.Now().After(deadline) {
+				return chain.ErrTimeout{Kind: "block"}
+			}
+			return nil
+		}),
+		newTxExec(bft.ChainDB, bi),
+	)
+
+	block, err := chain.NewBlockGenerator(bft, bi, bState, txOp, false).GenerateBlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := block.Sign(bft.privKey); err != nil {
+		return nil, err
+	}
+
+	bft.Lock()
+	bft.built[string(block.BlockHash())] = bState
+	bft.Unlock()
+
+	return block, nil
+}
+
+// verifyBlock checks the block proposed by other validator. The state
+// transition by the block is checked when it's connected to the chain.
+func (bft *BFT) verifyBlock(block *types.Block) error {
+	prev, err := bft.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(block.GetHeader().GetPrevBlockHash(), prev.BlockHash()) || !block.ValidChildOf(prev) {
+		return errNotBestChild
+	}
+	if err := bft.VerifySign(block); err != nil {
+		return err
+	}
+	if !bft.VerifyTimestamp(block) {
+		return errBadTimestamp
+	}
+	if !bytes.Equal(block.GetHeader().GetTxsRootHash(), types.CalculateTxsRootHash(block.GetBody().GetTxs())) {
+		return bc.ErrorBlockVerifyTxRoot
+	}
+	return bft.IsBlockValid(block, prev)
+}
+
+// broadcast sends msg to the peers.
+func (bft *BFT) broadcast(msg *types.BFTMessage) {
+	bft.Tell(message.P2PSvc, &message.NotifyBFTMessage{Message: msg})
+}
+
+// commit keeps the commit and connects block to the chain unless it's
+// already connected by the synchronization.
+func (bft *BFT) commit(block *types.Block, commit *types.BFTCommit) error {
+	bft.saveCommit(commit)
+
+	bft.Lock()
+	bState := bft.built[string(block.BlockHash())]
+	bft.built = make(map[string]*state.BlockState)
+	bft.Unlock()
+
+	if hash, err := bft.GetHashByNo(block.BlockNo()); err == nil && bytes.Equal(hash, block.BlockHash()) {
+		return nil
+	}
+	return chain.ConnectBlock(bft, block, bState, bft.core.timeout.commit+bft.core.timeout.propose)
+}
+
+func commitKey(no types.BlockNo) []byte {
+	return append(append([]byte{}, CommitKeyPrefix...), types.BlockNoToBytes(no)...)
+}
+
+func (bft *BFT) saveCommit(commit *types.BFTCommit) {
+	raw, err := proto.Marshal(commit)
+	if err != nil {
+		logger.Error().Err(err).Uint64("no", commit.Height).Msg("failed to marshal commit")
+		return
+	}
+	tx := bft.NewTx()
+	tx.Set(commitKey(commit.Height), raw)
+	tx.Commit()
+}
+
+// commitOf returns the commit of the block at no.
+func (bft *BFT) commitOf(no types.BlockNo) (*types.BFTCommit, error) {
+	raw := bft.ChainDB.Get(commitKey(no))
+	if len(raw) == 0 {
+		return nil, errNoCommit
+	}
+	return unmarshalCommit(raw)
+}
+
+func unmarshalCommit(raw []byte) (*types.BFTCommit, error) {
+	if len(raw) == 0 {
+		return nil, errNoCommit
+	}
+	commit := &types.BFTCommit{}
+	if err := proto.Unmarshal(raw, commit); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// saveVoteState puts the vote and the lock of st into the chain DB in a
+// transaction. The locked round is followed by the locked block.
+func (bft *BFT) saveVoteState(st *voteState) error {
+	vote, err := proto.Marshal(st.vote)
+	if err != nil {
+		return err
+	}
+	lock := make([]byte, 4)
+	binary.BigEndian.PutUint32(lock, uint32(st.lockedRound))
+	if st.lockedBlock != nil {
+		block, err := proto.Marshal(st.lockedBlock)
+		if err != nil {
+			return err
+		}
+		lock = append(lock, block...)
+	}
+	tx := bft.NewTx()
+	tx.Set(voteKey, vote)
+	tx.Set(lockKey, lock)
+	tx.Commit()
+	return nil
+}
+
+// voteState returns the vote state saved by saveVoteState.
+func (bft *BFT) voteState() (*voteState, error) {
+	raw := bft.ChainDB.Get(voteKey)
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	st := &voteState{vote: &types.BFTVote{}, lockedRound: types.BFTNilRound}
+	if err := proto.Unmarshal(raw, st.vote); err != nil {
+		return nil, err
+	}
+	lock := bft.ChainDB.Get(lockKey)
+	if len(lock) < 4 {
+		return st, nil
+	}
+	st.lockedRound = int32(binary.BigEndian.Uint32(lock))
+	if len(lock) > 4 {
+		st.lockedBlock = &types.Block{}
+		if err := proto.Unmarshal(lock[4:], st.lockedBlock); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
@@ -0,0 +1,751 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package bft
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/minio/sha256-simd"
+)
+
+const (
+	// maxFutureMessages limits the messages of the next height kept until
+	// this node commits the current height.
+	maxFutureMessages = 1024
+	// maxSeenMessages limits the hashes of messages kept to check if a
+	// received message is new.
+	maxSeenMessages = 16384
+	eventQueueSize  = 1024
+)
+
+type step int
+
+const (
+	stepPropose step = iota
+	stepPrevote
+	stepPrecommit
+	// stepNewHeight waits for the commit timeout before the first round of
+	// a new height.
+	stepNewHeight
+)
+
+var stepNames = []string{"propose", "prevote", "precommit", "newheight"}
+
+func (s step) String() string {
+	return stepNames[s]
+}
+
+// backend is what the consensus core requires from the blockchain.
+type backend interface {
+	// validators returns the validator set of height. It's decided by the
+	// state of the block of height - 1.
+	validators(height uint64) (*validatorSet, error)
+	// buildBlock produces a new block of height, which includes lastCommit.
+	buildBlock(height uint64, lastCommit *types.BFTCommit) (*types.Block, error)
+	// verifyBlock checks the block proposed by other validator.
+	verifyBlock(block *types.Block) error
+	// broadcast sends the message to the other validators.
+	broadcast(msg *types.BFTMessage)
+	// commit connects the block finalized by commit.
+	commit(block *types.Block, commit *types.BFTCommit) error
+	// saveVoteState persists st before the vote in it is sent.
+	saveVoteState(st *voteState) error
+	// voteState returns the state saved last, or nil if there's none.
+	voteState() (*voteState, error)
+}
+
+// voteState is what this node must remember across restarts not to sign
+// conflicting votes: the vote signed last and the block locked at the time.
+type voteState struct {
+	vote        *types.BFTVote
+	lockedRound int32
+	lockedBlock *types.Block
+}
+
+// timeouts are the durations of each step. A round takes longer by delta than
+// the previous one, so that the validators eventually catch up with each
+// other.
+type timeouts struct {
+	propose   time.Duration
+	prevote   time.Duration
+	precommit time.Duration
+	delta     time.Duration
+	// commit is the time to wait after a block is committed, which paces the
+	// block production.
+	commit time.Duration
+}
+
+func (t *timeouts) of(s step, round uint32) time.Duration {
+	switch s {
+	case stepPropose:
+		return t.propose + t.delta*time.Duration(round)
+	case stepPrevote:
+		return t.prevote + t.delta*time.Duration(round)
+	case stepPrecommit:
+		return t.precommit + t.delta*time.Duration(round)
+	default:
+		return t.commit
+	}
+}
+
+type timeoutEvent struct {
+	height uint64
+	round  uint32
+	step   step
+}
+
+// newHeightEvent notifies that the block of height is connected without
+// being committed by this node, e.g. by the synchronization with peers.
+type newHeightEvent struct {
+	block *types.Block
+}
+
+type signedMessage struct {
+	*types.BFTMessage
+	from types.PeerID
+}
+
+type roundVotes struct {
+	prevotes   map[types.PeerID]*types.BFTVote
+	precommits map[types.PeerID]*types.BFTVote
+	// the rules which are applied only for the first time in a round
+	prevoteWait   bool
+	precommitWait bool
+	polUpdated    bool
+}
+
+func newRoundVotes() *roundVotes {
+	return &roundVotes{
+		prevotes:   make(map[types.PeerID]*types.BFTVote),
+		precommits: make(map[types.PeerID]*types.BFTVote),
+	}
+}
+
+func (rv *roundVotes) of(voteType uint32) map[types.PeerID]*types.BFTVote {
+	if voteType == types.VotePrevote {
+		return rv.prevotes
+	}
+	return rv.precommits
+}
+
+// count returns the number of votes for hash. All the votes are counted if
+// hash is nil, and the votes for nil if hash is empty.
+func count(votes map[types.PeerID]*types.BFTVote, hash []byte) int {
+	if hash == nil {
+		return len(votes)
+	}
+	var n int
+	for _, v := range votes {
+		if bytes.Equal(v.BlockHash, hash) {
+			n++
+		}
+	}
+	return n
+}
+
+var nilHash = []byte{}
+
+// core runs the Tendermint consensus algorithm for a height after another.
+// In each round, the proposer proposes a block, and the validators prevote it
+// and then precommit it once 2/3+ of them prevoted it. A block precommitted by
+// 2/3+ of them is committed, and it's never reverted. All the state is
+// accessed only in the goroutine of run, and the messages and timeouts are
+// delivered by events.
+type core struct {
+	be      backend
+	privKey crypto.PrivKey
+	id      types.PeerID
+	timeout timeouts
+
+	events chan interface{}
+	quit   chan interface{}
+	done   chan interface{}
+
+	// curHeight is read by the other goroutines to filter messages
+	curHeight uint64
+	seenLock  sync.Mutex
+	seen      map[string]struct{}
+	seenOrder []string
+
+	height uint64
+	round  uint32
+	step   step
+	vals   *validatorSet
+
+	lockedRound int32
+	lockedBlock *types.Block
+	validRound  int32
+	validBlock  *types.Block
+	// lastVote is the vote signed last by this node in any height.
+	lastVote *types.BFTVote
+
+	proposals map[uint32]*types.BFTProposal
+	votes     map[uint32]*roundVotes
+	verified  map[string]error
+	future    []signedMessage
+
+	// lastCommit is the commit of height - 1, which is included in the block
+	// proposed by this node.
+	lastCommit *types.BFTCommit
+}
+
+func newCore(be backend, privKey crypto.PrivKey, timeout timeouts) *core {
+	c := &core{
+		be:      be,
+		privKey: privKey,
+		timeout: timeout,
+		events:  make(chan interface{}, eventQueueSize),
+		quit:    make(chan interface{}),
+		done:    make(chan interface{}),
+		seen:    make(map[string]struct{}),
+	}
+	c.id, _ = types.IDFromPrivateKey(privKey)
+	return c
+}
+
+// start runs the consensus from height. lastCommit is the commit of the
+// previous height if it is known.
+func (c *core) start(height uint64, lastCommit *types.BFTCommit) {
+	go c.run(height, lastCommit)
+}
+
+func (c *core) stop() {
+	close(c.quit)
+	<-c.done
+}
+
+func (c *core) run(height uint64, lastCommit *types.BFTCommit) {
+	defer close(c.done)
+
+	c.newHeight(height, lastCommit)
+	c.startRound(c.restoreVoteState())
+
+	for {
+		select {
+		case ev := <-c.events:
+			c.handleEvent(ev)
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *core) post(ev interface{}) {
+	select {
+	case c.events <- ev:
+	case <-c.quit:
+	}
+}
+
+// handleMessage checks the signature of msg and posts it to the consensus.
+// It returns true if the message is new, so that it must be relayed.
+func (c *core) handleMessage(msg *types.BFTMessage) (bool, error) {
+	var (
+		from types.PeerID
+		err  error
+		sig  []byte
+	)
+	if msg.Proposal != nil {
+		from, err = msg.Proposal.Verify()
+		sig = msg.Proposal.Signature
+	} else if msg.Vote != nil {
+		from, err = msg.Vote.Verify()
+		sig = msg.Vote.Signature
+	} else {
+		return false, types.ErrInvalidBFTProposal
+	}
+	if err != nil {
+		return false, err
+	}
+
+	height := atomic.LoadUint64(&c.curHeight)
+	if msg.Height() < height || msg.Height() > height+1 || !c.markSeen(sig) {
+		return false, nil
+	}
+
+	c.post(signedMessage{BFTMessage: msg, from: from})
+	return true, nil
+}
+
+func (c *core) markSeen(sig []byte) bool {
+	digest := sha256.Sum256(sig)
+	key := string(digest[:])
+
+	c.seenLock.Lock()
+	defer c.seenLock.Unlock()
+
+	if _, exist := c.seen[key]; exist {
+		return false
+	}
+	c.seen[key] = struct{}{}
+	c.seenOrder = append(c.seenOrder, key)
+	if len(c.seenOrder) > maxSeenMessages {
+		delete(c.seen, c.seenOrder[0])
+		c.seenOrder = c.seenOrder[1:]
+	}
+	return true
+}
+
+// currentHeight returns the height which the consensus is in.
+func (c *core) currentHeight() uint64 {
+	return atomic.LoadUint64(&c.curHeight)
+}
+
+// notifyBlock notifies that block is connected to the chain. It doesn't wait
+// for the event queue, since the chain service may be connecting the block
+// committed by the core.
+func (c *core) notifyBlock(block *types.Block) {
+	if block.BlockNo() < c.currentHeight() {
+		return
+	}
+	select {
+	case c.events <- newHeightEvent{block: block}:
+	default:
+		logger.Warn().Uint64("no", block.BlockNo()).Msg("drop block notification since event queue is full")
+	}
+}
+
+func (c *core) handleEvent(ev interface{}) {
+	switch ev := ev.(type) {
+	case signedMessage:
+		c.addMessage(ev)
+	case timeoutEvent:
+		c.handleTimeout(ev)
+	case newHeightEvent:
+		if no := ev.block.BlockNo(); no >= c.height {
+			logger.Info().Uint64("no", no).Str("hash", ev.block.ID()).Msg("block connected by synchronization")
+			c.newHeight(no+1, c.collectCommit(no, ev.block.BlockHash()))
+			c.startRound(0)
+		}
+	}
+}
+
+func (c *core) newHeight(height uint64, lastCommit *types.BFTCommit) {
+	vals, err := c.be.validators(height)
+	if err != nil {
+		logger.Error().Err(err).Uint64("height", height).Msg("failed to get validators")
+	}
+
+	c.height = height
+	c.round = 0
+	c.step = stepNewHeight
+	c.vals = vals
+	c.lockedRound, c.lockedBlock = types.BFTNilRound, nil
+	c.validRound, c.validBlock = types.BFTNilRound, nil
+	c.proposals = make(map[uint32]*types.BFTProposal)
+	c.votes = make(map[uint32]*roundVotes)
+	c.verified = make(map[string]error)
+	c.lastCommit = lastCommit
+	atomic.StoreUint64(&c.curHeight, height)
+
+	future := c.future
+	c.future = nil
+	for _, m := range future {
+		if m.Height() == height {
+			c.record(m)
+		}
+	}
+}
+
+// restoreVoteState loads the vote state saved before this node stopped. If
+// it voted in the current height, the lock is restored and the round to start
+// is returned.
+func (c *core) restoreVoteState() uint32 {
+	st, err := c.be.voteState()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load vote state")
+		return 0
+	}
+	if st == nil {
+		return 0
+	}
+	c.lastVote = st.vote
+	if st.vote.Height != c.height {
+		return 0
+	}
+	c.lockedRound, c.lockedBlock = st.lockedRound, st.lockedBlock
+	logger.Info().Uint64("height", c.height).Uint32("round", st.vote.Round).Int32("locked", st.lockedRound).
+		Msg("restore vote state")
+	return st.vote.Round
+}
+
+func (c *core) isValidator() bool {
+	return c.vals != nil && c.vals.has(c.id)
+}
+
+func (c *core) startRound(round uint32) {
+	c.round = round
+	c.step = stepPropose
+
+	if c.vals == nil {
+		return
+	}
+
+	logger.Debug().Uint64("height", c.height).Uint32("round", round).Msg("start round")
+
+	if c.vals.proposer(c.height, round) == c.id {
+		c.propose()
+	} else {
+		c.schedule(stepPropose)
+	}
+	c.process()
+}
+
+func (c *core) propose() {
+	block, polRound := c.validBlock, c.validRound
+	if block == nil {
+		if c.height > 1 && c.lastCommit == nil {
+			logger.Warn().Uint64("height", c.height).Msg("skip proposing since the commit of the previous height is unknown")
+			c.schedule(stepPropose)
+			return
+		}
+		var err error
+		if block, err = c.be.buildBlock(c.height, c.lastCommit); err != nil {
+			logger.Error().Err(err).Uint64("height", c.height).Msg("failed to build block")
+			c.schedule(stepPropose)
+			return
+		}
+		c.verified[string(block.BlockHash())] = nil
+		polRound = types.BFTNilRound
+	}
+
+	proposal := &types.BFTProposal{Height: c.height, Round: c.round, PolRound: polRound, Block: block}
+	if err := proposal.Sign(c.privKey); err != nil {
+		logger.Error().Err(err).Msg("failed to sign proposal")
+		return
+	}
+	logger.Info().Uint64("height", c.height).Uint32("round", c.round).Int32("pol", polRound).
+		Str("hash", block.ID()).Msg("propose block")
+
+	c.send(&types.BFTMessage{Proposal: proposal})
+}
+
+func (c *core) vote(voteType uint32, hash []byte) {
+	if !c.isValidator() {
+		return
+	}
+	// never sign another vote for a step already voted, which may happen
+	// after a restart. The same vote is sent again instead.
+	if last := c.lastVote; last != nil {
+		switch compareVote(last, c.height, c.round, voteType) {
+		case 0:
+			c.send(&types.BFTMessage{Vote: last})
+			return
+		case 1:
+			return
+		}
+	}
+
+	vote := &types.BFTVote{Type: voteType, Height: c.height, Round: c.round, BlockHash: hash}
+	if err := vote.Sign(c.privKey); err != nil {
+		logger.Error().Err(err).Msg("failed to sign vote")
+		return
+	}
+	st := &voteState{vote: vote, lockedRound: c.lockedRound, lockedBlock: c.lockedBlock}
+	if err := c.be.saveVoteState(st); err != nil {
+		logger.Error().Err(err).Msg("failed to save vote state")
+		return
+	}
+	c.lastVote = vote
+	c.send(&types.BFTMessage{Vote: vote})
+}
+
+// compareVote returns -1, 0 or 1 when v is before, at or after the step of
+// voteType in the round of height.
+func compareVote(v *types.BFTVote, height uint64, round uint32, voteType uint32) int {
+	switch {
+	case v.Height != height:
+		if v.Height < height {
+			return -1
+		}
+		return 1
+	case v.Round != round:
+		if v.Round < round {
+			return -1
+		}
+		return 1
+	case v.Type != voteType:
+		if v.Type < voteType {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// send broadcasts msg of this node and records it.
+func (c *core) send(msg *types.BFTMessage) {
+	if msg.Proposal != nil {
+		c.markSeen(msg.Proposal.Signature)
+	} else {
+		c.markSeen(msg.Vote.Signature)
+	}
+	c.be.broadcast(msg)
+	c.record(signedMessage{BFTMessage: msg, from: c.id})
+}
+
+func (c *core) schedule(s step) {
+	height, round := c.height, c.round
+	time.AfterFunc(c.timeout.of(s, round), func() {
+		c.post(timeoutEvent{height: height, round: round, step: s})
+	})
+}
+
+func (c *core) addMessage(m signedMessage) {
+	if c.record(m) && c.step != stepNewHeight {
+		c.process()
+	}
+}
+
+// record keeps the message of a validator. It returns true if the message is
+// new.
+func (c *core) record(m signedMessage) bool {
+	if m.Height() == c.height+1 {
+		if len(c.future) < maxFutureMessages {
+			c.future = append(c.future, m)
+		}
+		return false
+	}
+	if m.Height() != c.height || c.vals == nil || !c.vals.has(m.from) {
+		return false
+	}
+
+	if p := m.Proposal; p != nil {
+		if m.from != c.vals.proposer(c.height, p.Round) {
+			return false
+		}
+		if _, exist := c.proposals[p.Round]; exist {
+			return false
+		}
+		c.proposals[p.Round] = p
+		return true
+	}
+
+	v := m.Vote
+	votes := c.roundVotes(v.Round).of(v.Type)
+	if _, exist := votes[m.from]; exist {
+		return false
+	}
+	votes[m.from] = v
+	return true
+}
+
+func (c *core) roundVotes(round uint32) *roundVotes {
+	rv, exist := c.votes[round]
+	if !exist {
+		rv = newRoundVotes()
+		c.votes[round] = rv
+	}
+	return rv
+}
+
+func (c *core) isValid(block *types.Block) bool {
+	hash := string(block.BlockHash())
+	err, exist := c.verified[hash]
+	if !exist {
+		err = c.be.verifyBlock(block)
+		if err != nil {
+			logger.Warn().Err(err).Uint64("height", c.height).Str("hash", block.ID()).Msg("invalid block proposed")
+		}
+		c.verified[hash] = err
+	}
+	return err == nil
+}
+
+// process applies the rules of the algorithm until the state doesn't change.
+func (c *core) process() {
+	for c.step != stepNewHeight && c.applyRule() {
+	}
+}
+
+func (c *core) applyRule() bool {
+	// commit the block precommitted by 2/3+ in any round
+	for round, p := range c.proposals {
+		hash := p.Block.BlockHash()
+		if count(c.roundVotes(round).precommits, hash) >= c.vals.quorum() && c.isValid(p.Block) {
+			c.commit(round, p.Block)
+			return true
+		}
+	}
+
+	// skip to the higher round which an honest validator is in
+	if r, ok := c.higherRound(); ok {
+		c.startRound(r)
+		return true
+	}
+
+	rv := c.roundVotes(c.round)
+	proposal := c.proposals[c.round]
+
+	if c.step == stepPropose && proposal != nil {
+		block, vr := proposal.Block, proposal.PolRound
+		if vr == types.BFTNilRound {
+			if c.isValid(block) && (c.lockedRound == types.BFTNilRound || c.isLocked(block)) {
+				c.vote(types.VotePrevote, block.BlockHash())
+			} else {
+				c.vote(types.VotePrevote, nilHash)
+			}
+			c.step = stepPrevote
+			return true
+		}
+		if count(c.roundVotes(uint32(vr)).prevotes, block.BlockHash()) >= c.vals.quorum() {
+			if c.isValid(block) && (c.lockedRound <= vr || c.isLocked(block)) {
+				c.vote(types.VotePrevote, block.BlockHash())
+			} else {
+				c.vote(types.VotePrevote, nilHash)
+			}
+			c.step = stepPrevote
+			return true
+		}
+	}
+
+	if c.step >= stepPrevote && proposal != nil && !rv.polUpdated {
+		block := proposal.Block
+		if count(rv.prevotes, block.BlockHash()) >= c.vals.quorum() && c.isValid(block) {
+			rv.polUpdated = true
+			if c.step == stepPrevote {
+				c.lockedRound, c.lockedBlock = int32(c.round), block
+				c.vote(types.VotePrecommit, block.BlockHash())
+				c.step = stepPrecommit
+			}
+			c.validRound, c.validBlock = int32(c.round), block
+			return true
+		}
+	}
+
+	if c.step == stepPrevote {
+		if count(rv.prevotes, nilHash) >= c.vals.quorum() {
+			c.vote(types.VotePrecommit, nilHash)
+			c.step = stepPrecommit
+			return true
+		}
+		if !rv.prevoteWait && count(rv.prevotes, nil) >= c.vals.quorum() {
+			rv.prevoteWait = true
+			c.schedule(stepPrevote)
+			return true
+		}
+	}
+
+	if !rv.precommitWait && count(rv.precommits, nil) >= c.vals.quorum() {
+		rv.precommitWait = true
+		c.schedule(stepPrecommit)
+		return true
+	}
+
+	return false
+}
+
+func (c *core) isLocked(block *types.Block) bool {
+	return c.lockedBlock != nil && bytes.Equal(c.lockedBlock.BlockHash(), block.BlockHash())
+}
+
+// higherRound returns the lowest round higher than the current one, in which
+// the messages of f+1 validators are received.
+func (c *core) higherRound() (uint32, bool) {
+	var (
+		found bool
+		min   uint32
+	)
+	for round, rv := range c.votes {
+		if round <= c.round || (found && round >= min) {
+			continue
+		}
+		senders := make(map[types.PeerID]bool)
+		for id := range rv.prevotes {
+			senders[id] = true
+		}
+		for id := range rv.precommits {
+			senders[id] = true
+		}
+		if _, exist := c.proposals[round]; exist {
+			senders[c.vals.proposer(c.height, round)] = true
+		}
+		if len(senders) >= c.vals.skipQuorum() {
+			found, min = true, round
+		}
+	}
+	return min, found
+}
+
+func (c *core) handleTimeout(ev timeoutEvent) {
+	if ev.height != c.height || ev.round != c.round {
+		return
+	}
+
+	switch ev.step {
+	case stepPropose:
+		if c.step == stepPropose {
+			logger.Debug().Uint64("height", c.height).Uint32("round", c.round).Msg("propose timeout")
+			c.vote(types.VotePrevote, nilHash)
+			c.step = stepPrevote
+		}
+	case stepPrevote:
+		if c.step == stepPrevote {
+			c.vote(types.VotePrecommit, nilHash)
+			c.step = stepPrecommit
+		}
+	case stepPrecommit:
+		logger.Debug().Uint64("height", c.height).Uint32("round", c.round).Msg("precommit timeout. start next round")
+		c.startRound(c.round + 1)
+		return
+	case stepNewHeight:
+		if c.step == stepNewHeight {
+			c.startRound(0)
+		}
+		return
+	}
+	c.process()
+}
+
+// collectCommit returns the commit of the block at height from the recorded
+// precommits, or nil if they are not enough.
+func (c *core) collectCommit(height uint64, hash []byte) *types.BFTCommit {
+	if height != c.height || c.vals == nil {
+		return nil
+	}
+	for round, rv := range c.votes {
+		if count(rv.precommits, hash) >= c.vals.quorum() {
+			return c.makeCommit(round, hash)
+		}
+	}
+	return nil
+}
+
+func (c *core) makeCommit(round uint32, hash []byte) *types.BFTCommit {
+	commit := &types.BFTCommit{Height: c.height, Round: round, BlockHash: hash}
+	for _, v := range c.votes[round].precommits {
+		if bytes.Equal(v.BlockHash, hash) {
+			commit.Votes = append(commit.Votes, v)
+		}
+	}
+	sort.Slice(commit.Votes, func(i, j int) bool { return bytes.Compare(commit.Votes[i].PubKey, commit.Votes[j].PubKey) < 0 })
+	return commit
+}
+
+func (c *core) commit(round uint32, block *types.Block) {
+	commit := c.makeCommit(round, block.BlockHash())
+
+	logger.Info().Uint64("height", c.height).Uint32("round", round).Str("hash", block.ID()).
+		Str("prev", enc.ToString(block.GetHeader().GetPrevBlockHash())).Int("precommits", len(commit.Votes)).
+		Msg("commit block")
+
+	if err := c.be.commit(block, commit); err != nil {
+		logger.Error().Err(err).Uint64("height", c.height).Str("hash", block.ID()).Msg("failed to connect committed block")
+		// wait until the block is connected by the synchronization
+		c.step = stepNewHeight
+		return
+	}
+
+	c.newHeight(c.height+1, commit)
+	c.schedule(stepNewHeight)
+}
@@ -0,0 +1,298 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package bft
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTimeouts = timeouts{
+	propose:   200 * time.Millisecond,
+	prevote:   100 * time.Millisecond,
+	precommit: 100 * time.Millisecond,
+	delta:     50 * time.Millisecond,
+	commit:    10 * time.Millisecond,
+}
+
+// testNetwork connects the cores of validators in process. The messages to or
+// from the disconnected validators are dropped.
+type testNetwork struct {
+	sync.Mutex
+	vals         *validatorSet
+	nodes        []*core
+	backends     []*testBackend
+	disconnected map[int]bool
+}
+
+func newTestNetwork(t *testing.T, n int) *testNetwork {
+	net := &testNetwork{disconnected: make(map[int]bool)}
+
+	keys := make([]crypto.PrivKey, n)
+	ids := make([]string, n)
+	for i := range keys {
+		var err error
+		keys[i], _, err = crypto.GenerateSecp256k1Key(rand.Reader)
+		assert.NoError(t, err)
+		id, _ := types.IDFromPrivateKey(keys[i])
+		ids[i] = types.IDB58Encode(id)
+	}
+	vals, err := newValidatorSet(ids)
+	assert.NoError(t, err)
+	net.vals = vals
+
+	for i, key := range keys {
+		be := &testBackend{net: net, idx: i, privKey: key}
+		net.backends = append(net.backends, be)
+		net.nodes = append(net.nodes, newCore(be, key, testTimeouts))
+	}
+	return net
+}
+
+func (net *testNetwork) start(idx ...int) {
+	for _, i := range idx {
+		net.nodes[i].start(1, nil)
+	}
+}
+
+func (net *testNetwork) stop(idx ...int) {
+	for _, i := range idx {
+		net.nodes[i].stop()
+	}
+}
+
+func (net *testNetwork) isDisconnected(i int) bool {
+	net.Lock()
+	defer net.Unlock()
+	return net.disconnected[i]
+}
+
+func (net *testNetwork) send(from int, msg *types.BFTMessage) {
+	if net.isDisconnected(from) {
+		return
+	}
+	for i, node := range net.nodes {
+		if i == from || net.isDisconnected(i) {
+			continue
+		}
+		go node.handleMessage(msg)
+	}
+}
+
+type testBackend struct {
+	net     *testNetwork
+	idx     int
+	privKey crypto.PrivKey
+
+	sync.Mutex
+	blocks  []*types.Block
+	commits []*types.BFTCommit
+	sent    []*types.BFTMessage
+	state   *voteState
+}
+
+func (be *testBackend) best() *types.Block {
+	be.Lock()
+	defer be.Unlock()
+	if len(be.blocks) == 0 {
+		return nil
+	}
+	return be.blocks[len(be.blocks)-1]
+}
+
+func (be *testBackend) blockAt(height int) *types.Block {
+	be.Lock()
+	defer be.Unlock()
+	return be.blocks[height-1]
+}
+
+func (be *testBackend) height() int {
+	be.Lock()
+	defer be.Unlock()
+	return len(be.blocks)
+}
+
+func (be *testBackend) validators(height uint64) (*validatorSet, error) {
+	return be.net.vals, nil
+}
+
+func (be *testBackend) buildBlock(height uint64, lastCommit *types.BFTCommit) (*types.Block, error) {
+	header := &types.BlockHeader{BlockNo: height, Timestamp: time.Now().UnixNano()}
+	if best := be.best(); best != nil {
+		header.PrevBlockHash = best.BlockHash()
+	}
+	if lastCommit != nil {
+		header.Consensus, _ = proto.Marshal(lastCommit)
+	}
+	block := &types.Block{Header: header, Body: &types.BlockBody{}}
+	return block, block.Sign(be.privKey)
+}
+
+func (be *testBackend) verifyBlock(block *types.Block) error {
+	if uint64(be.height())+1 != block.BlockNo() {
+		return errNotBestChild
+	}
+	if best := be.best(); best != nil && !bytes.Equal(best.BlockHash(), block.GetHeader().GetPrevBlockHash()) {
+		return errNotBestChild
+	}
+	return nil
+}
+
+func (be *testBackend) broadcast(msg *types.BFTMessage) {
+	be.Lock()
+	be.sent = append(be.sent, msg)
+	be.Unlock()
+	be.net.send(be.idx, msg)
+}
+
+func (be *testBackend) commit(block *types.Block, commit *types.BFTCommit) error {
+	be.Lock()
+	defer be.Unlock()
+	be.blocks = append(be.blocks, block)
+	be.commits = append(be.commits, commit)
+	return nil
+}
+
+func (be *testBackend) saveVoteState(st *voteState) error {
+	be.Lock()
+	defer be.Unlock()
+	be.state = st
+	return nil
+}
+
+func (be *testBackend) voteState() (*voteState, error) {
+	be.Lock()
+	defer be.Unlock()
+	return be.state, nil
+}
+
+func waitHeight(be *testBackend, height int, timeout time.Duration) bool {
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if be.height() >= height {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidatorSet(t *testing.T) {
+	_, err := newValidatorSet(nil)
+	assert.Equal(t, ErrNoValidator, err)
+
+	net := newTestNetwork(t, 4)
+	vals := net.vals
+	assert.Equal(t, 4, vals.size())
+	assert.Equal(t, 3, vals.quorum())
+	assert.Equal(t, 2, vals.skipQuorum())
+
+	// the proposer rotates by height and round
+	assert.Equal(t, vals.proposer(1, 1), vals.proposer(2, 0))
+	assert.NotEqual(t, vals.proposer(1, 0), vals.proposer(1, 1))
+	assert.Equal(t, vals.proposer(1, 0), vals.proposer(1, 4))
+
+	dup, err := newValidatorSet(append(vals.toStrings(), vals.toStrings()[0]))
+	assert.NoError(t, err)
+	assert.Equal(t, vals.ids, dup.ids)
+}
+
+func TestCoreCommit(t *testing.T) {
+	net := newTestNetwork(t, 4)
+
+	// one of the validators is down, whose turn to propose is skipped by the
+	// round change
+	net.disconnected[3] = true
+	net.start(0, 1, 2)
+
+	const height = 5
+	for i := 0; i < 3; i++ {
+		assert.True(t, waitHeight(net.backends[i], height, 10*time.Second), "node %d didn't commit", i)
+	}
+	net.stop(0, 1, 2)
+
+	for h := 0; h < height; h++ {
+		block := net.backends[0].blocks[h]
+		for i := 1; i < 3; i++ {
+			assert.Equal(t, block.BlockHash(), net.backends[i].blocks[h].BlockHash(), "different block committed at %d", h+1)
+		}
+		// the block includes the commit of the previous one
+		if h > 0 {
+			commit, err := unmarshalCommit(block.GetHeader().GetConsensus())
+			assert.NoError(t, err)
+			assert.NoError(t, commit.Verify(net.vals.toMap(), net.vals.quorum()))
+			assert.Equal(t, net.backends[0].blocks[h-1].BlockHash(), commit.BlockHash)
+		}
+		assert.NoError(t, net.backends[0].commits[h].Verify(net.vals.toMap(), net.vals.quorum()))
+	}
+}
+
+func TestCoreNoQuorum(t *testing.T) {
+	net := newTestNetwork(t, 4)
+
+	net.disconnected[2] = true
+	net.disconnected[3] = true
+	net.start(0, 1)
+	defer net.stop(0, 1)
+
+	// the precommits of 2 validators are not enough to commit
+	assert.False(t, waitHeight(net.backends[0], 1, time.Second))
+	assert.False(t, waitHeight(net.backends[1], 1, 0))
+}
+
+func TestCoreCatchUp(t *testing.T) {
+	net := newTestNetwork(t, 4)
+
+	net.disconnected[3] = true
+	net.start(0, 1, 2)
+	defer net.stop(0, 1, 2)
+	assert.True(t, waitHeight(net.backends[0], 2, 10*time.Second))
+
+	// the lagging validator moves to the next height of the block connected
+	// by the synchronization
+	lagging := net.nodes[3]
+	lagging.start(1, nil)
+	defer lagging.stop()
+	lagging.notifyBlock(net.backends[0].blockAt(2))
+	for deadline := time.Now().Add(time.Second); lagging.currentHeight() != 3 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, uint64(3), lagging.currentHeight())
+}
+
+func TestCoreRestoreVoteState(t *testing.T) {
+	net := newTestNetwork(t, 4)
+	be := net.backends[0]
+
+	block, err := be.buildBlock(1, nil)
+	assert.NoError(t, err)
+	node := net.nodes[0]
+	node.newHeight(1, nil)
+	node.round = 2
+	node.lockedRound, node.lockedBlock = 2, block
+	node.vote(types.VotePrecommit, block.BlockHash())
+	assert.NotNil(t, be.state)
+
+	// the restarted node is locked on the block and never signs another
+	// precommit in the round.
+	restarted := newCore(be, be.privKey, testTimeouts)
+	restarted.newHeight(1, nil)
+	assert.Equal(t, uint32(2), restarted.restoreVoteState())
+	assert.Equal(t, int32(2), restarted.lockedRound)
+	assert.True(t, restarted.isLocked(block))
+
+	restarted.round = 2
+	restarted.vote(types.VotePrecommit, nilHash)
+	restarted.vote(types.VotePrevote, nilHash)
+	assert.Len(t, be.sent, 2)
+	assert.Equal(t, block.BlockHash(), be.sent[1].Vote.BlockHash, "same precommit sent again")
+}
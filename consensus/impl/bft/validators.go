@@ -0,0 +1,86 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package bft
+
+import (
+	"sort"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// validatorSet is the set of validators of a height. The validators are
+// sorted by their IDs so that all of them select the same proposer of a
+// round.
+type validatorSet struct {
+	ids   []types.PeerID
+	index map[types.PeerID]int
+}
+
+func newValidatorSet(ids []string) (*validatorSet, error) {
+	vs := &validatorSet{index: make(map[types.PeerID]int)}
+	for _, s := range ids {
+		id, err := types.IDB58Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		if _, exist := vs.index[id]; exist {
+			continue
+		}
+		vs.index[id] = 0
+		vs.ids = append(vs.ids, id)
+	}
+	if len(vs.ids) == 0 {
+		return nil, ErrNoValidator
+	}
+	sort.Slice(vs.ids, func(i, j int) bool { return vs.ids[i] < vs.ids[j] })
+	for i, id := range vs.ids {
+		vs.index[id] = i
+	}
+	return vs, nil
+}
+
+func (vs *validatorSet) size() int {
+	return len(vs.ids)
+}
+
+func (vs *validatorSet) has(id types.PeerID) bool {
+	_, exist := vs.index[id]
+	return exist
+}
+
+// quorum is the number of validators more than 2/3 of the set, by which a
+// block is locked or committed.
+func (vs *validatorSet) quorum() int {
+	return vs.size()*2/3 + 1
+}
+
+// skipQuorum is the number of validators more than the faulty ones, which
+// assures that an honest validator is in a higher round.
+func (vs *validatorSet) skipQuorum() int {
+	return (vs.size()-1)/3 + 1
+}
+
+// proposer returns the validator which proposes a block at the round of
+// height. The proposer rotates in the order of IDs.
+func (vs *validatorSet) proposer(height uint64, round uint32) types.PeerID {
+	return vs.ids[(height+uint64(round))%uint64(vs.size())]
+}
+
+func (vs *validatorSet) toMap() map[types.PeerID]bool {
+	m := make(map[types.PeerID]bool, vs.size())
+	for _, id := range vs.ids {
+		m[id] = true
+	}
+	return m
+}
+
+func (vs *validatorSet) toStrings() []string {
+	s := make([]string, vs.size())
+	for i, id := range vs.ids {
+		s[i] = types.IDB58Encode(id)
+	}
+	return s
+}
@@ -240,6 +240,20 @@ func (c *Cluster) BpID2Index(id types.PeerID) Index {
 	return indexNil
 }
 
+// SignerIndex returns the index of the block producer which signs the block
+// at blockNo by the key of signer. The BP key rotations are looked up from
+// scs, the state of the system contract, unless it's nil.
+func (c *Cluster) SignerIndex(scs *state.ContractState, signer types.PeerID, blockNo types.BlockNo) Index {
+	if scs != nil {
+		id, err := system.ResolveBlockProducer(scs, signer, blockNo)
+		if err != nil {
+			return indexNil
+		}
+		signer = id
+	}
+	return c.BpID2Index(signer)
+}
+
 // Has reports whether c includes id or not
 func (c *Cluster) Has(id types.PeerID) bool {
 	c.Lock()
@@ -269,6 +283,19 @@ func snapBlockNo(blockNo types.BlockNo) types.BlockNo {
 	return (blockNo/getElectionPeriod() - 1) * getElectionPeriod()
 }
 
+// ElectionRef returns the number of the block whose state elected the BPs
+// producing the block at blockNo. It returns 0 while the genesis BPs produce
+// blocks.
+func ElectionRef(blockNo types.BlockNo) types.BlockNo {
+	if blockNo == 0 {
+		return 0
+	}
+	// the cluster is updated after the block of every election period is
+	// connected.
+	period := getElectionPeriod()
+	return snapBlockNo((blockNo - 1) / period * period)
+}
+
 func isSnapPeriod(blockNo types.BlockNo) bool {
 	// The current snapshot period is the total BP count.
 	return blockNo%getElectionPeriod() == 0
@@ -313,15 +340,17 @@ type Snapshots struct {
 	cm            ClusterMember
 	cdb           consensus.ChainDB
 	sdb           *state.ChainStateDB
+	bv            types.BlockVersionner
 }
 
 // NewSnapshots returns a new Snapshots.
-func NewSnapshots(c ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB) *Snapshots {
+func NewSnapshots(c ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB, bv types.BlockVersionner) *Snapshots {
 	snap := &Snapshots{
 		snaps: make(map[types.BlockNo]*Snapshot),
 		cm:    c,
 		cdb:   cdb,
 		sdb:   sdb,
+		bv:    bv,
 	}
 
 	// To avoid a unit test failure.
@@ -362,7 +391,7 @@ func (sn *Snapshots) AddSnapshot(refBlockNo types.BlockNo) ([]string, error) {
 		err error
 	)
 
-	if bps, err = sn.gatherRankers(); err != nil {
+	if bps, err = sn.gatherRankers(refBlockNo); err != nil {
 		return nil, err
 	}
 
@@ -379,8 +408,8 @@ func (sn *Snapshots) AddSnapshot(refBlockNo types.BlockNo) ([]string, error) {
 	return bps, nil
 }
 
-func (sn *Snapshots) gatherRankers() ([]string, error) {
-	return system.GetRankers(sn.sdb)
+func (sn *Snapshots) gatherRankers(refBlockNo types.BlockNo) ([]string, error) {
+	return system.GetRankers(sn.sdb, sn.bv.Version(refBlockNo))
 }
 
 // UpdateCluster updates the current BP list by the ones corresponding to
@@ -492,5 +521,5 @@ func (sn *Snapshots) loadClusterSnapshot(blockNo types.BlockNo) ([]string, error
 
 	stateDB := sn.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
 
-	return system.GetRankers(stateDB)
+	return system.GetRankers(stateDB, sn.bv.Version(block.BlockNo()))
 }
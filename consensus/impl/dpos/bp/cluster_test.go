@@ -1,5 +1,12 @@
 package bp
 
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
 const (
 	BlockProducers = 32
 )
@@ -58,3 +65,21 @@ func newConfig(ids []string) *config.ConsensusConfig {
 	return &config.ConsensusConfig{}
 }
 */
+
+func TestElectionRef(t *testing.T) {
+	tcs := []struct {
+		blockNo types.BlockNo
+		ref     types.BlockNo
+	}{
+		{0, 0},
+		{1, 0},
+		{300, 0},
+		{301, 200},
+		{400, 200},
+		{401, 300},
+		{1000, 800},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.ref, ElectionRef(tc.blockNo), "block %d", tc.blockNo)
+	}
+}
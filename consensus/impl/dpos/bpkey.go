@@ -0,0 +1,70 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package dpos
+
+import (
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// systemState returns the state of the system contract at the best block, or
+// nil if it's unavailable. A BP key rotation is effective only from a block
+// above the one including it, so the best state resolves the signers of all
+// the blocks up to the next one.
+func systemState(sdb *state.ChainStateDB) *state.ContractState {
+	if sdb == nil {
+		return nil
+	}
+	scs, err := sdb.GetSystemAccountState()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to open the system contract state")
+		return nil
+	}
+	return scs
+}
+
+// producerID returns the voted identity of the block producer which signed
+// block in the same encoding as BPID2Str. The signer itself is returned if it
+// can't be resolved.
+func producerID(sdb *state.ChainStateDB, block *types.Block) string {
+	signer, err := block.BPID()
+	if err != nil {
+		return ""
+	}
+	if scs := systemState(sdb); scs != nil {
+		if id, err := system.ResolveBlockProducer(scs, signer, block.BlockNo()); err == nil {
+			signer = id
+		}
+	}
+	return enc.ToString([]byte(signer))
+}
+
+// signerIndex returns the index of the block producer whose signing key for
+// the next block is signer.
+func (dpos *DPoS) signerIndex(signer types.PeerID) bp.Index {
+	var next types.BlockNo
+	if best, err := dpos.GetBestBlock(); err == nil {
+		next = best.BlockNo() + 1
+	}
+	return dpos.bpc.SignerIndex(systemState(dpos.sdb), signer, next)
+}
+
+// signers are the block producers identified by their signing keys, which
+// vote for the finality.
+type signers struct {
+	dpos *DPoS
+}
+
+func (s signers) Size() uint16 {
+	return s.dpos.bpc.Size()
+}
+
+func (s signers) BpID2Index(id types.PeerID) bp.Index {
+	return s.dpos.signerIndex(id)
+}
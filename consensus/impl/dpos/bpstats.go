@@ -0,0 +1,189 @@
+package dpos
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/consensus/impl/dpos/slot"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	// BpStatsKey is the key when the per-BP statistics are put into the
+	// chain DB.
+	BpStatsKey = []byte("dpos.BpStats")
+
+	errNoBP = errors.New("no block producers")
+)
+
+// maxScheduleSlots limits the number of slots in a BP schedule.
+const maxScheduleSlots = 1000
+
+// bpIndexer finds the BP of a slot.
+type bpIndexer interface {
+	Size() uint16
+	BpIndex2ID(bpIdx bp.Index) (types.PeerID, bool)
+}
+
+type bpStat struct {
+	Produced     uint64
+	Missed       uint64
+	LastProduced types.BlockNo
+	// LibConfirms is the number of the blocks which completed the
+	// confirmation of a pre-LIB.
+	LibConfirms uint64
+}
+
+// bpStats keeps the statistics of each BP, which are counted by the blocks
+// connected to the best chain. They are not reverted by a reorganization.
+type bpStats struct {
+	Stats map[string]*bpStat
+	bpc   bpIndexer
+}
+
+func newBpStats(c bp.ClusterMember) *bpStats {
+	bs := &bpStats{Stats: make(map[string]*bpStat)}
+	if bpc, ok := c.(bpIndexer); ok {
+		bs.bpc = bpc
+	}
+	return bs
+}
+
+func (bs *bpStats) get(id string) *bpStat {
+	s, exist := bs.Stats[id]
+	if !exist {
+		s = &bpStat{}
+		bs.Stats[id] = s
+	}
+	return s
+}
+
+// add counts block produced by the BP of id next to prev, and the slots
+// skipped between them.
+func (bs *bpStats) add(id string, block, prev *types.Block, libConfirmed bool) {
+	s := bs.get(id)
+	s.Produced++
+	s.LastProduced = block.BlockNo()
+	if libConfirmed {
+		s.LibConfirms++
+	}
+
+	if bs.bpc == nil || prev == nil || prev.BlockNo() == 0 {
+		return
+	}
+	from := slot.NewFromUnixNano(prev.GetHeader().GetTimestamp()).Index() + 1
+	to := slot.NewFromUnixNano(block.GetHeader().GetTimestamp()).Index()
+	bs.addMissed(from, to)
+}
+
+// addMissed counts the slots from index from to to (exclusive) as missed by
+// their BPs.
+func (bs *bpStats) addMissed(from, to int64) {
+	size := int64(bs.bpc.Size())
+	n := to - from
+	if size == 0 || n <= 0 {
+		return
+	}
+	for i := int64(0); i < n && i < size; i++ {
+		id, ok := bs.bpc.BpIndex2ID(bp.Index((from + i) % size))
+		if !ok {
+			continue
+		}
+		missed := uint64(n / size)
+		if i < n%size {
+			missed++
+		}
+		bs.get(types.IDB58Encode(id)).Missed += missed
+	}
+}
+
+func (bs *bpStats) save(tx consensus.TxWriter) error {
+	b, err := common.GobEncode(bs)
+	if err != nil {
+		return err
+	}
+	tx.Set(BpStatsKey, b)
+	return nil
+}
+
+func (bs *bpStats) load(cdb consensus.ChainDB) {
+	if value := cdb.Get(BpStatsKey); len(value) != 0 {
+		if err := common.GobDecode(value, bs); err != nil {
+			logger.Error().Err(err).Msg("failed to load BP statistics")
+		}
+	}
+	if bs.Stats == nil {
+		bs.Stats = make(map[string]*bpStat)
+	}
+}
+
+// toPB returns the statistics of the BPs in ids and the others counted
+// before, ordered by the peer ID.
+func (bs *bpStats) toPB(ids []string) []*types.BPStat {
+	stats := make([]*types.BPStat, 0, len(bs.Stats))
+	for id, s := range bs.Stats {
+		stats = append(stats, &types.BPStat{
+			PeerID:       id,
+			Produced:     s.Produced,
+			Missed:       s.Missed,
+			LastProduced: s.LastProduced,
+			LibConfirms:  s.LibConfirms,
+		})
+	}
+	for _, id := range ids {
+		if _, exist := bs.Stats[id]; !exist {
+			stats = append(stats, &types.BPStat{PeerID: id})
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].PeerID < stats[j].PeerID })
+	return stats
+}
+
+// BPSchedule returns the BPs of the next slots from now, and the statistics
+// of BPs. The schedule is for a round of the current BPs if slots is 0.
+func (dpos *DPoS) BPSchedule(slots uint64) (*types.BPSchedule, error) {
+	size := dpos.bpc.Size()
+	if size == 0 {
+		return nil, errNoBP
+	}
+	if slots == 0 {
+		slots = uint64(size)
+	} else if slots > maxScheduleSlots {
+		slots = maxScheduleSlots
+	}
+
+	sched := &types.BPSchedule{}
+	if best, err := dpos.GetBestBlock(); err == nil {
+		sched.BestBlockNo = best.BlockNo()
+	}
+
+	ids := make([]string, 0, size)
+	for i := uint16(0); i < size; i++ {
+		if id, ok := dpos.bpc.BpIndex2ID(bp.Index(i)); ok {
+			ids = append(ids, types.IDB58Encode(id))
+		}
+	}
+
+	next := slot.Now().Index()
+	for i := int64(0); i < int64(slots); i++ {
+		s := slot.NewFromIndex(next + i)
+		id, ok := dpos.bpc.BpIndex2ID(bp.Index(s.NextBpIndex(size)))
+		if !ok {
+			continue
+		}
+		sched.Slots = append(sched.Slots, &types.BPSlot{
+			Index:  uint64(s.Index()),
+			Time:   s.UnixNano(),
+			PeerID: types.IDB58Encode(id),
+		})
+	}
+
+	dpos.Status.RLock()
+	sched.Stats = dpos.Status.stats.toPB(ids)
+	dpos.Status.RUnlock()
+
+	return sched, nil
+}
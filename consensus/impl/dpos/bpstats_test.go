@@ -0,0 +1,75 @@
+package dpos
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBpIndexer []types.PeerID
+
+func (c testBpIndexer) Size() uint16 {
+	return uint16(len(c))
+}
+
+func (c testBpIndexer) BpIndex2ID(bpIdx bp.Index) (types.PeerID, bool) {
+	if int(bpIdx) >= len(c) {
+		return types.PeerID(""), false
+	}
+	return c[bpIdx], true
+}
+
+func newTestBpIndexer(t *testing.T, n int) testBpIndexer {
+	c := make(testBpIndexer, n)
+	for i := range c {
+		_, pub, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		assert.NoError(t, err)
+		c[i], err = types.IDFromPublicKey(pub)
+		assert.NoError(t, err)
+	}
+	return c
+}
+
+func TestBpStatsMissed(t *testing.T) {
+	c := newTestBpIndexer(t, 3)
+	bs := &bpStats{Stats: make(map[string]*bpStat), bpc: c}
+
+	// 7 slots from the index 4: BP 1, 2, 0, 1, 2, 0, 1
+	bs.addMissed(4, 11)
+	missed := func(i int) uint64 { return bs.get(types.IDB58Encode(c[i])).Missed }
+	assert.Equal(t, uint64(2), missed(0))
+	assert.Equal(t, uint64(3), missed(1))
+	assert.Equal(t, uint64(2), missed(2))
+
+	// no slot skipped
+	bs.addMissed(11, 11)
+	assert.Equal(t, uint64(3), missed(1))
+}
+
+func TestBpStatsToPB(t *testing.T) {
+	c := newTestBpIndexer(t, 3)
+	bs := &bpStats{Stats: make(map[string]*bpStat), bpc: c}
+
+	produced := types.IDB58Encode(c[0])
+	bs.get(produced).Produced = 5
+
+	ids := []string{types.IDB58Encode(c[0]), types.IDB58Encode(c[1]), types.IDB58Encode(c[2])}
+	stats := bs.toPB(ids)
+	assert.Len(t, stats, 3)
+	for i := 1; i < len(stats); i++ {
+		assert.True(t, stats[i-1].PeerID < stats[i].PeerID)
+	}
+	for _, s := range stats {
+		if s.PeerID == produced {
+			assert.Equal(t, uint64(5), s.Produced)
+		} else {
+			assert.Zero(t, s.Produced)
+		}
+	}
+	// toPB doesn't add the BPs without statistics
+	assert.Len(t, bs.Stats, 1)
+}
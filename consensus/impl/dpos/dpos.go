@@ -19,10 +19,12 @@ import (
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
 	"github.com/aergoio/aergo/consensus/impl/dpos/slot"
 	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
 )
 
 var (
@@ -61,6 +63,8 @@ type DPoS struct {
 	bpc  *bp.Cluster
 	bf   *BlockFactory
 	quit chan interface{}
+	// finality is nil unless blocks are finalized by votes.
+	finality *finality
 }
 
 // Status shows DPoS consensus's current status
@@ -85,10 +89,10 @@ func GetName() string {
 }
 
 // GetConstructor build and returns consensus.Constructor from New function.
-func GetConstructor(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
-	sdb *state.ChainStateDB) consensus.Constructor {
+func GetConstructor(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainDB, sdb *state.ChainStateDB) consensus.Constructor {
 	return func() (consensus.Consensus, error) {
-		return New(cfg, hub, cdb, sdb)
+		return New(cfg, bv, hub, cdb, sdb)
 	}
 }
 
@@ -112,8 +116,8 @@ func getStateDB(cfg *config.Config, cdb consensus.ChainDB, sdb *state.ChainState
 }
 
 // New returns a new DPos object
-func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
-	sdb *state.ChainStateDB) (consensus.Consensus, error) {
+func New(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainDB, sdb *state.ChainStateDB) (consensus.Consensus, error) {
 
 	chain.DecorateBlockRewardFn(sendVotingReward)
 
@@ -135,14 +139,32 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
 
 	quitC := make(chan interface{})
 
-	return &DPoS{
-		Status:       NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight),
+	dpos := &DPoS{
+		Status:       NewStatus(bpc, cdb, sdb, bv, cfg.Blockchain.ForceResetHeight),
 		ComponentHub: hub,
 		ChainDB:      cdb,
 		bpc:          bpc,
-		bf:           NewBlockFactory(hub, sdb, quitC, cfg.Hardfork, cfg.Consensus.NoTimeoutTxEviction),
+		bf:           NewBlockFactory(hub, sdb, quitC, bv, cfg.Consensus.NoTimeoutTxEviction),
 		quit:         quitC,
-	}, nil
+	}
+	if cfg.Consensus.Finality {
+		var privKey crypto.PrivKey
+		if cfg.Consensus.EnableBp {
+			privKey = p2pkey.NodePrivKey()
+		}
+		notify := func(vote *types.FinalityVote) {
+			hub.Tell(message.P2PSvc, &message.NotifyFinalityVote{Vote: vote})
+		}
+		notifyEvidence := func(evidence *types.VoteEquivocation) {
+			hub.Tell(message.P2PSvc, &message.NotifyVoteEquivocation{Evidence: evidence})
+		}
+		dpos.finality = newFinality(cdb, signers{dpos}, privKey, notify, notifyEvidence, dpos.Status.finalize)
+		if cert := dpos.finality.lastCertificate(); cert != nil {
+			dpos.Status.finalize(cert)
+		}
+	}
+
+	return dpos, nil
 }
 
 func sendVotingReward(bState *state.BlockState, dummy []byte) error {
@@ -245,6 +267,57 @@ func (dpos *DPoS) QueueJob(now time.Time, jq chan<- interface{}) {
 	}
 }
 
+// Update updates the LIB status by the block connected to the best chain, and
+// votes for the block if blocks are finalized by votes.
+func (dpos *DPoS) Update(block *types.Block) {
+	dpos.Status.Update(block)
+	if dpos.finality != nil {
+		dpos.finality.onBlock(block)
+	}
+}
+
+// HandleFinalityVote handles the finality vote received from other peer.
+func (dpos *DPoS) HandleFinalityVote(vote *types.FinalityVote) (bool, error) {
+	if dpos.finality == nil {
+		return false, errFinalityNotRun
+	}
+	return dpos.finality.handleVote(vote)
+}
+
+// HandleVoteEquivocation keeps the evidence of conflicting finality votes
+// received from other peer.
+func (dpos *DPoS) HandleVoteEquivocation(evidence *types.VoteEquivocation) (bool, error) {
+	if dpos.finality == nil {
+		return false, errFinalityNotRun
+	}
+	return dpos.finality.handleEquivocation(evidence)
+}
+
+// NeedReorganization reports whether the best chain may be replaced from the
+// branch root at rootNo. Neither the LIB nor the block precommitted by this
+// node is replaced.
+func (dpos *DPoS) NeedReorganization(rootNo types.BlockNo) bool {
+	if !dpos.Status.NeedReorganization(rootNo) {
+		return false
+	}
+	return dpos.finality == nil || dpos.finality.canReorganize(rootNo)
+}
+
+// FinalityCertificate returns the certificate of the block finalized at
+// blockNo, or the last one if blockNo is 0.
+func (dpos *DPoS) FinalityCertificate(blockNo types.BlockNo) (*types.FinalityCertificate, error) {
+	if dpos.finality == nil {
+		return nil, errFinalityNotRun
+	}
+	if blockNo == 0 {
+		if cert := dpos.finality.lastCertificate(); cert != nil {
+			return cert, nil
+		}
+		return nil, errNoCertificate
+	}
+	return dpos.finality.certificate(blockNo)
+}
+
 // BlockFactory returns the BlockFactory interface in dpos.
 func (dpos *DPoS) BlockFactory() consensus.BlockFactory {
 	return dpos.bf
@@ -307,7 +380,7 @@ func (dpos *DPoS) IsBlockValid(block *types.Block, bestBlock *types.Block) error
 		return &consensus.ErrorConsensus{Msg: "bad public key in block", Err: err}
 	}
 
-	idx := dpos.bpc.BpID2Index(id)
+	idx := dpos.bpc.SignerIndex(systemState(dpos.sdb), id, block.BlockNo())
 	ns := block.GetHeader().GetTimestamp()
 	s := slot.NewFromUnixNano(ns)
 	// Check whether the BP ID is one of the current BP members and its
@@ -323,7 +396,7 @@ func (dpos *DPoS) IsBlockValid(block *types.Block, bestBlock *types.Block) error
 }
 
 func (dpos *DPoS) bpIdx() bp.Index {
-	return dpos.bpc.BpID2Index(dpos.bpid())
+	return dpos.signerIndex(dpos.bpid())
 }
 
 func (dpos *DPoS) getBpInfo(now time.Time) *bpInfo {
@@ -462,6 +535,14 @@ func (dpos *DPoS) ConfChangeInfo(requestID uint64) (*types.ConfChangeProgress, e
 	return nil, consensus.ErrNotSupportedMethod
 }
 
+func (dpos *DPoS) TransferLeadership(name string) (*consensus.Member, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (dpos *DPoS) SetMaintenance(enable bool) (*types.MaintenanceMode, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
 func (dpos *DPoS) MakeConfChangeProposal(req *types.MembershipChange) (*consensus.ConfChangePropose, error) {
 	return nil, consensus.ErrNotSupportedMethod
 }
@@ -0,0 +1,607 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package dpos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+var (
+	// FinalityCertKeyPrefix is the prefix of the key by which a finality
+	// certificate is put into the chain DB.
+	FinalityCertKeyPrefix = []byte("dpos.finality.")
+	// LastFinalityKey is the key of the block number of the last finality
+	// certificate.
+	LastFinalityKey = []byte("dpos.lastFinality")
+	// FinalityLockKey is the key of the last precommit of this node, which
+	// locks its votes to the chain of the precommitted block.
+	FinalityLockKey = []byte("dpos.finalityLock")
+	// EquivocationKeyPrefix is the prefix of the key by which the evidence of
+	// conflicting votes is put into the chain DB.
+	EquivocationKeyPrefix = []byte("dpos.equivocation.")
+
+	errVoteFromNonBP  = errors.New("finality vote from non block producer")
+	errNoCertificate  = errors.New("finality certificate not found")
+	errFinalityNotRun = errors.New("finality by votes is not enabled")
+)
+
+const (
+	// maxVoteHeightAhead limits the height of votes above the best block,
+	// which are kept until the block is connected.
+	maxVoteHeightAhead = types.BlockNo(100)
+	// maxVoteRoundAhead limits the round of votes above the current round of
+	// a height.
+	maxVoteRoundAhead = uint32(10)
+)
+
+// voters is the set of block producers which are eligible to vote.
+type voters interface {
+	Size() uint16
+	BpID2Index(id types.PeerID) bp.Index
+}
+
+type roundVotes struct {
+	// the votes of each block producer, indexed by the vote type - 1
+	votes        [2]map[types.PeerID]*types.FinalityVote
+	prevoted     bool
+	precommitted bool
+}
+
+func newRoundVotes() *roundVotes {
+	return &roundVotes{votes: [2]map[types.PeerID]*types.FinalityVote{
+		make(map[types.PeerID]*types.FinalityVote),
+		make(map[types.PeerID]*types.FinalityVote),
+	}}
+}
+
+type heightVotes struct {
+	// round is the round in which this node votes at the height.
+	round  uint32
+	rounds map[uint32]*roundVotes
+}
+
+func newHeightVotes() *heightVotes {
+	return &heightVotes{rounds: make(map[uint32]*roundVotes)}
+}
+
+func (h *heightVotes) roundVotes(round uint32) *roundVotes {
+	rv, exist := h.rounds[round]
+	if !exist {
+		rv = newRoundVotes()
+		h.rounds[round] = rv
+	}
+	return rv
+}
+
+// finality finalizes blocks by the two rounds of signed votes of the block
+// producers. A block producer prevotes a block connected to its best chain,
+// and precommits it once the prevotes of 2/3+ block producers for the block
+// are collected in the same round. The precommits of 2/3+ block producers in
+// the same round form a certificate, which makes the block and its ancestors
+// irreversible.
+//
+// A block producer is locked by its last precommit: it votes for no block and
+// refuses any reorganization off the chain of the precommitted block, until
+// 2/3+ block producers prevote a conflicting block later. So no conflicting
+// certificates are formed unless 1/3+ block producers sign conflicting votes.
+// If the prevotes of a round are split so that no block can be precommitted,
+// the block producers vote again in the next round.
+type finality struct {
+	sync.Mutex
+	cdb     consensus.ChainDB
+	bps     voters
+	privKey crypto.PrivKey // nil unless this node produces blocks
+	bpID    types.PeerID
+
+	heights   map[types.BlockNo]*heightVotes
+	best      types.BlockNo
+	finalized *types.FinalityCertificate
+	// pending is the certificate of the block not connected yet.
+	pending *types.FinalityCertificate
+	// lock is the last precommit of this node.
+	lock *types.FinalityVote
+
+	notify         func(vote *types.FinalityVote)
+	notifyEvidence func(evidence *types.VoteEquivocation)
+	onFinalize     func(cert *types.FinalityCertificate)
+}
+
+func newFinality(cdb consensus.ChainDB, bps voters, privKey crypto.PrivKey, notify func(*types.FinalityVote),
+	notifyEvidence func(*types.VoteEquivocation), onFinalize func(*types.FinalityCertificate)) *finality {
+	f := &finality{
+		cdb:            cdb,
+		bps:            bps,
+		privKey:        privKey,
+		heights:        make(map[types.BlockNo]*heightVotes),
+		notify:         notify,
+		notifyEvidence: notifyEvidence,
+		onFinalize:     onFinalize,
+	}
+	if privKey != nil {
+		f.bpID, _ = types.IDFromPrivateKey(privKey)
+	}
+	if no := cdb.Get(LastFinalityKey); len(no) != 0 {
+		f.finalized, _ = f.certificate(types.BlockNoFromBytes(no))
+	}
+	if raw := cdb.Get(FinalityLockKey); len(raw) != 0 {
+		lock := &types.FinalityVote{}
+		if err := proto.Unmarshal(raw, lock); err == nil {
+			f.lock = lock
+		}
+	}
+	return f
+}
+
+func finalityCertKey(no types.BlockNo) []byte {
+	return append(append([]byte{}, FinalityCertKeyPrefix...), types.BlockNoToBytes(no)...)
+}
+
+func (f *finality) quorum() int {
+	return int(consensusBlockCount(f.bps.Size()))
+}
+
+func (f *finality) isBP(id types.PeerID) bool {
+	return !f.bps.BpID2Index(id).IsNil()
+}
+
+func (f *finality) finalizedNo() types.BlockNo {
+	return f.finalized.GetBlockNo()
+}
+
+func (f *finality) lastCertificate() *types.FinalityCertificate {
+	f.Lock()
+	defer f.Unlock()
+	return f.finalized
+}
+
+func (f *finality) certificate(no types.BlockNo) (*types.FinalityCertificate, error) {
+	raw := f.cdb.Get(finalityCertKey(no))
+	if len(raw) == 0 {
+		return nil, errNoCertificate
+	}
+	cert := &types.FinalityCertificate{}
+	if err := proto.Unmarshal(raw, cert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// onBlock prevotes the block connected to the best chain.
+func (f *finality) onBlock(block *types.Block) {
+	var votes []*types.FinalityVote
+
+	f.Lock()
+	no, hash := block.BlockNo(), block.BlockHash()
+	f.best = no
+	for height := range f.heights {
+		if height+maxVoteHeightAhead < no {
+			delete(f.heights, height)
+		}
+	}
+	if f.pending != nil && f.pending.BlockNo == no && bytes.Equal(f.pending.BlockHash, hash) {
+		f.finalize(f.pending)
+	}
+	// the split heights move to the next round by the new block
+	for height, h := range f.heights {
+		if height < no {
+			votes = append(votes, f.tryNextRound(height, h, h.round)...)
+		}
+	}
+	if no > f.finalizedNo() && f.isVoter() {
+		h := f.heightVotes(no)
+		// a block producer prevotes only once in a round, even if the block
+		// is replaced by reorganization.
+		votes = append(votes, f.tryPrevote(no, h)...)
+		// the prevotes of the block may be collected before it is connected
+		votes = append(votes, f.tryPrecommit(no, h, h.round, hash)...)
+	}
+	f.Unlock()
+
+	for _, vote := range votes {
+		f.notify(vote)
+	}
+}
+
+// handleVote handles the vote of other block producer. It returns true if
+// the vote is valid and new.
+func (f *finality) handleVote(vote *types.FinalityVote) (bool, error) {
+	id, err := vote.Verify()
+	if err != nil {
+		return false, err
+	}
+	if !f.isBP(id) {
+		return false, errVoteFromNonBP
+	}
+
+	var (
+		votes    []*types.FinalityVote
+		evidence *types.VoteEquivocation
+	)
+
+	f.Lock()
+	if vote.BlockNo <= f.finalizedNo() || vote.BlockNo > f.best+maxVoteHeightAhead {
+		f.Unlock()
+		return false, nil
+	}
+	h := f.heightVotes(vote.BlockNo)
+	if vote.Round > h.round+maxVoteRoundAhead {
+		f.Unlock()
+		return false, nil
+	}
+	rv := h.roundVotes(vote.Round)
+	if prev, exist := rv.votes[vote.Type-1][id]; exist {
+		if prev.IsEquivocation(vote) {
+			evidence = &types.VoteEquivocation{First: prev, Second: vote}
+			if !f.saveEquivocation(id, evidence) {
+				evidence = nil
+			}
+		}
+		f.Unlock()
+		if evidence != nil {
+			f.notifyEvidence(evidence)
+		}
+		return false, nil
+	}
+	votes = f.addVote(h, id, vote)
+	f.Unlock()
+
+	for _, vote := range votes {
+		f.notify(vote)
+	}
+	return true, nil
+}
+
+// handleEquivocation keeps the evidence received from other peer. It returns
+// true if the evidence is valid and new.
+func (f *finality) handleEquivocation(evidence *types.VoteEquivocation) (bool, error) {
+	id, err := evidence.Verify()
+	if err != nil {
+		return false, err
+	}
+	if !f.isBP(id) {
+		return false, errVoteFromNonBP
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	return f.saveEquivocation(id, evidence), nil
+}
+
+func (f *finality) heightVotes(no types.BlockNo) *heightVotes {
+	h, exist := f.heights[no]
+	if !exist {
+		h = newHeightVotes()
+		f.heights[no] = h
+	}
+	return h
+}
+
+// isVoter reports whether this node is a block producer which votes.
+func (f *finality) isVoter() bool {
+	return f.privKey != nil && f.isBP(f.bpID)
+}
+
+// addVote adds the vote of id and returns the votes of this node made by it.
+func (f *finality) addVote(h *heightVotes, id types.PeerID, vote *types.FinalityVote) []*types.FinalityVote {
+	var votes []*types.FinalityVote
+
+	rv := h.roundVotes(vote.Round)
+	rv.votes[vote.Type-1][id] = vote
+	if vote.Type == types.VotePrevote {
+		if len(f.collect(rv, types.VotePrevote, vote.BlockHash)) >= f.quorum() {
+			f.unlockBy(vote.BlockNo, vote.Round, vote.BlockHash)
+		}
+		votes = f.tryPrecommit(vote.BlockNo, h, vote.Round, vote.BlockHash)
+	} else {
+		f.tryCertify(rv, vote.BlockNo, vote.BlockHash)
+	}
+	return append(votes, f.tryNextRound(vote.BlockNo, h, vote.Round)...)
+}
+
+// tryPrevote prevotes the block of the best chain at the height in the
+// current round.
+func (f *finality) tryPrevote(no types.BlockNo, h *heightVotes) []*types.FinalityVote {
+	rv := h.roundVotes(h.round)
+	if !f.isVoter() || rv.prevoted || no > f.best || !f.canVote() {
+		return nil
+	}
+	hash, err := f.cdb.GetHashByNo(no)
+	if err != nil {
+		return nil
+	}
+	rv.prevoted = true
+	vote := f.sign(types.VotePrevote, no, h.round, hash)
+	if vote == nil {
+		return nil
+	}
+	return append([]*types.FinalityVote{vote}, f.addVote(h, f.bpID, vote)...)
+}
+
+func (f *finality) tryPrecommit(no types.BlockNo, h *heightVotes, round uint32, hash []byte) []*types.FinalityVote {
+	rv := h.rounds[round]
+	if !f.isVoter() || round != h.round || rv == nil || rv.precommitted {
+		return nil
+	}
+	if len(f.collect(rv, types.VotePrevote, hash)) < f.quorum() || !f.isMainChain(no, hash) || !f.canVote() {
+		return nil
+	}
+	rv.precommitted = true
+	vote := f.sign(types.VotePrecommit, no, round, hash)
+	if vote == nil {
+		return nil
+	}
+	f.relock(vote)
+	rv.votes[types.VotePrecommit-1][f.bpID] = vote
+	f.tryCertify(rv, no, hash)
+	return []*types.FinalityVote{vote}
+}
+
+// tryNextRound moves the height to the next round if the prevotes of the
+// current round are split so that no block gets 2/3+ of them, or to a later
+// round which 1/3+ block producers have moved to, and prevotes again. A round
+// lasts until a block is connected on top of the height, so that the best
+// chains of the block producers may converge before they vote again.
+func (f *finality) tryNextRound(no types.BlockNo, h *heightVotes, round uint32) []*types.FinalityVote {
+	rv := h.rounds[round]
+	switch {
+	case rv == nil || no <= f.finalizedNo():
+		return nil
+	case round > h.round && len(f.votersOf(rv)) > int(f.bps.Size())-f.quorum():
+		h.round = round
+	case round == h.round && no+types.BlockNo(round) < f.best && f.isSplit(rv):
+		h.round = round + 1
+	default:
+		return nil
+	}
+	logger.Debug().Uint64("no", no).Uint32("round", h.round).Msg("finality votes moved to next round")
+	return f.tryPrevote(no, h)
+}
+
+// isSplit reports whether no block can get the prevotes of 2/3+ block
+// producers in the round, even if the rest of them vote.
+func (f *finality) isSplit(rv *roundVotes) bool {
+	counts := make(map[string]int)
+	var voted, most int
+	for id, vote := range rv.votes[types.VotePrevote-1] {
+		if !f.isBP(id) {
+			continue
+		}
+		voted++
+		counts[string(vote.BlockHash)]++
+		if counts[string(vote.BlockHash)] > most {
+			most = counts[string(vote.BlockHash)]
+		}
+	}
+	return most+int(f.bps.Size())-voted < f.quorum()
+}
+
+// votersOf returns the current block producers which voted in the round.
+func (f *finality) votersOf(rv *roundVotes) map[types.PeerID]bool {
+	ids := make(map[types.PeerID]bool)
+	for _, votes := range rv.votes {
+		for id := range votes {
+			if f.isBP(id) {
+				ids[id] = true
+			}
+		}
+	}
+	return ids
+}
+
+// canVote reports whether this node may vote for the blocks of the best
+// chain, which must include the block of its lock.
+func (f *finality) canVote() bool {
+	return f.lock == nil || f.isMainChain(f.lock.BlockNo, f.lock.BlockHash)
+}
+
+// relock locks this node to the block of its precommit, unless it's locked
+// by a later one.
+func (f *finality) relock(vote *types.FinalityVote) {
+	if f.lock != nil && (vote.BlockNo < f.lock.BlockNo ||
+		vote.BlockNo == f.lock.BlockNo && vote.Round <= f.lock.Round) {
+		return
+	}
+	f.setLock(vote)
+}
+
+// unlockBy releases the lock if 2/3+ block producers prevoted a block, which
+// conflicts with the locked one, later than the lock.
+func (f *finality) unlockBy(no types.BlockNo, round uint32, hash []byte) {
+	lock := f.lock
+	if lock == nil || no < lock.BlockNo || no == lock.BlockNo && round <= lock.Round {
+		return
+	}
+	// the block may be unknown yet, and then it's checked again on
+	// reorganization.
+	ancestor := f.ancestorHash(no, hash, lock.BlockNo)
+	if ancestor == nil || bytes.Equal(ancestor, lock.BlockHash) {
+		return
+	}
+	logger.Info().Uint64("no", lock.BlockNo).Str("hash", enc.ToString(lock.BlockHash)).
+		Uint64("by", no).Msg("finality lock released by conflicting prevotes")
+	f.setLock(nil)
+}
+
+func (f *finality) setLock(lock *types.FinalityVote) {
+	tx := f.cdb.NewTx()
+	if lock == nil {
+		tx.Delete(FinalityLockKey)
+	} else {
+		raw, err := proto.Marshal(lock)
+		if err != nil {
+			logger.Error().Err(err).Uint64("no", lock.BlockNo).Msg("failed to marshal finality lock")
+			return
+		}
+		tx.Set(FinalityLockKey, raw)
+	}
+	tx.Commit()
+	f.lock = lock
+}
+
+// ancestorHash returns the hash of the ancestor at height of the block, or
+// nil if the block or its ancestors are unknown.
+func (f *finality) ancestorHash(no types.BlockNo, hash []byte, height types.BlockNo) []byte {
+	for ; no > height; no-- {
+		block, err := f.cdb.GetBlock(hash)
+		if err != nil || block == nil {
+			return nil
+		}
+		hash = block.GetHeader().GetPrevBlockHash()
+	}
+	return hash
+}
+
+// canReorganize reports whether the best chain may be replaced from rootNo,
+// which is refused if the block of the lock would be replaced.
+func (f *finality) canReorganize(rootNo types.BlockNo) bool {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.lock == nil || rootNo >= f.lock.BlockNo || !f.isMainChain(f.lock.BlockNo, f.lock.BlockHash) {
+		return true
+	}
+	// the prevotes for the blocks unknown when they're collected
+	for no, h := range f.heights {
+		for round, rv := range h.rounds {
+			for hash := range f.polkas(rv) {
+				f.unlockBy(no, round, []byte(hash))
+			}
+		}
+	}
+	if f.lock != nil {
+		logger.Info().Uint64("lock", f.lock.BlockNo).Uint64("branch root no", rootNo).
+			Msg("reorganization off the precommitted block is not allowed")
+		return false
+	}
+	return true
+}
+
+// polkas returns the hashes of the blocks prevoted by 2/3+ block producers
+// in the round.
+func (f *finality) polkas(rv *roundVotes) map[string]bool {
+	counts := make(map[string]int)
+	hashes := make(map[string]bool)
+	for id, vote := range rv.votes[types.VotePrevote-1] {
+		if !f.isBP(id) {
+			continue
+		}
+		counts[string(vote.BlockHash)]++
+		if counts[string(vote.BlockHash)] >= f.quorum() {
+			hashes[string(vote.BlockHash)] = true
+		}
+	}
+	return hashes
+}
+
+func (f *finality) tryCertify(rv *roundVotes, no types.BlockNo, hash []byte) {
+	votes := f.collect(rv, types.VotePrecommit, hash)
+	if len(votes) < f.quorum() {
+		return
+	}
+	sort.Slice(votes, func(i, j int) bool { return bytes.Compare(votes[i].PubKey, votes[j].PubKey) < 0 })
+	cert := &types.FinalityCertificate{BlockNo: no, BlockHash: hash, Votes: votes}
+	if f.isMainChain(no, hash) {
+		f.finalize(cert)
+	} else if no > f.pending.GetBlockNo() {
+		f.pending = cert
+	}
+}
+
+// collect returns the votes of the current block producers for hash.
+func (f *finality) collect(rv *roundVotes, voteType uint32, hash []byte) []*types.FinalityVote {
+	var votes []*types.FinalityVote
+	for id, vote := range rv.votes[voteType-1] {
+		if bytes.Equal(vote.BlockHash, hash) && f.isBP(id) {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
+func (f *finality) isMainChain(no types.BlockNo, hash []byte) bool {
+	mainHash, err := f.cdb.GetHashByNo(no)
+	return err == nil && bytes.Equal(mainHash, hash)
+}
+
+func (f *finality) sign(voteType uint32, no types.BlockNo, round uint32, hash []byte) *types.FinalityVote {
+	vote := &types.FinalityVote{Type: voteType, BlockNo: no, BlockHash: hash, Round: round}
+	if err := vote.Sign(f.privKey); err != nil {
+		logger.Error().Err(err).Uint64("no", no).Msg("failed to sign finality vote")
+		return nil
+	}
+	return vote
+}
+
+func (f *finality) finalize(cert *types.FinalityCertificate) {
+	raw, err := proto.Marshal(cert)
+	if err != nil {
+		logger.Error().Err(err).Uint64("no", cert.BlockNo).Msg("failed to marshal finality certificate")
+		return
+	}
+	tx := f.cdb.NewTx()
+	tx.Set(finalityCertKey(cert.BlockNo), raw)
+	tx.Set(LastFinalityKey, types.BlockNoToBytes(cert.BlockNo))
+	tx.Commit()
+
+	f.finalized = cert
+	if f.pending.GetBlockNo() <= cert.BlockNo {
+		f.pending = nil
+	}
+	for no := range f.heights {
+		if no <= cert.BlockNo {
+			delete(f.heights, no)
+		}
+	}
+	// the finalized block is never replaced anyway.
+	if f.lock != nil && f.lock.BlockNo <= cert.BlockNo {
+		f.setLock(nil)
+	}
+
+	logger.Info().Uint64("no", cert.BlockNo).Str("hash", enc.ToString(cert.BlockHash)).
+		Int("votes", len(cert.Votes)).Msg("block finalized by votes")
+
+	f.onFinalize(cert)
+}
+
+func equivocationKey(id types.PeerID, vote *types.FinalityVote) []byte {
+	key := append(append([]byte{}, EquivocationKeyPrefix...), []byte(id)...)
+	key = append(append(key, byte(vote.Type)), types.BlockNoToBytes(vote.BlockNo)...)
+	round := make([]byte, 4)
+	binary.LittleEndian.PutUint32(round, vote.Round)
+	return append(key, round...)
+}
+
+// saveEquivocation keeps the evidence and returns true if it's new.
+func (f *finality) saveEquivocation(id types.PeerID, evidence *types.VoteEquivocation) bool {
+	first, second := evidence.First, evidence.Second
+	key := equivocationKey(id, first)
+	if len(f.cdb.Get(key)) != 0 {
+		return false
+	}
+	raw, err := proto.Marshal(evidence)
+	if err != nil {
+		return false
+	}
+	tx := f.cdb.NewTx()
+	tx.Set(key, raw)
+	tx.Commit()
+
+	logger.Warn().Str("BP", enc.ToString([]byte(id))).Uint32("type", first.Type).Uint64("no", first.BlockNo).
+		Uint32("round", first.Round).Str("first", enc.ToString(first.BlockHash)).
+		Str("second", enc.ToString(second.BlockHash)).Msg("conflicting finality votes detected")
+	return true
+}
@@ -0,0 +1,323 @@
+package dpos
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFinalityDB struct {
+	db.DB
+	hashes map[types.BlockNo][]byte
+	blocks map[string]*types.Block
+}
+
+func (tdb *testFinalityDB) GetBestBlock() (*types.Block, error)                 { return nil, nil }
+func (tdb *testFinalityDB) GetBlockByNo(no types.BlockNo) (*types.Block, error) { return nil, nil }
+func (tdb *testFinalityDB) GetGenesisInfo() *types.Genesis                      { return nil }
+
+func (tdb *testFinalityDB) GetBlock(hash []byte) (*types.Block, error) {
+	if block, exist := tdb.blocks[string(hash)]; exist {
+		return block, nil
+	}
+	return nil, errors.New("block not found")
+}
+
+func (tdb *testFinalityDB) GetHashByNo(no types.BlockNo) ([]byte, error) {
+	if hash, exist := tdb.hashes[no]; exist {
+		return hash, nil
+	}
+	return nil, errors.New("block not found")
+}
+
+type testVoters map[types.PeerID]bp.Index
+
+func (tv testVoters) Size() uint16 {
+	return uint16(len(tv))
+}
+
+func (tv testVoters) BpID2Index(id types.PeerID) bp.Index {
+	if idx, exist := tv[id]; exist {
+		return idx
+	}
+	return bp.Index(math.MaxUint16)
+}
+
+type testFinalityNode struct {
+	*finality
+	db        *testFinalityDB
+	finalized []types.BlockNo
+	evidences []*types.VoteEquivocation
+}
+
+// newTestFinalityNet makes the nodes of block producers, which deliver the
+// votes to each other at once unless the node is disconnected.
+func newTestFinalityNet(t *testing.T, n int) ([]*testFinalityNode, map[int]bool, func()) {
+	dir, err := ioutil.TempDir("", "finality")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]crypto.PrivKey, n)
+	bps := make(testVoters)
+	for i := range keys {
+		keys[i], _, _ = crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := types.IDFromPrivateKey(keys[i])
+		bps[id] = bp.Index(i)
+	}
+	disconnected := make(map[int]bool)
+	nodes := make([]*testFinalityNode, n)
+	for i := range nodes {
+		i := i
+		node := &testFinalityNode{db: &testFinalityDB{
+			DB:     db.NewDB(db.MemoryImpl, fmt.Sprintf("%s/%d", dir, i)),
+			hashes: make(map[types.BlockNo][]byte),
+			blocks: make(map[string]*types.Block),
+		}}
+		notify := func(vote *types.FinalityVote) {
+			if disconnected[i] {
+				return
+			}
+			for j, other := range nodes {
+				if j != i && !disconnected[j] {
+					other.handleVote(vote)
+				}
+			}
+		}
+		notifyEvidence := func(evidence *types.VoteEquivocation) {
+			node.evidences = append(node.evidences, evidence)
+		}
+		onFinalize := func(cert *types.FinalityCertificate) {
+			node.finalized = append(node.finalized, cert.BlockNo)
+		}
+		node.finality = newFinality(node.db, bps, keys[i], notify, notifyEvidence, onFinalize)
+		nodes[i] = node
+	}
+	return nodes, disconnected, func() { os.RemoveAll(dir) }
+}
+
+func testFinalityBlock(no types.BlockNo, fork string) *types.Block {
+	return &types.Block{Hash: common.Hasher([]byte(fmt.Sprint(no, fork))), Header: &types.BlockHeader{BlockNo: no}}
+}
+
+func testFinalityChild(parent *types.Block, fork string) *types.Block {
+	block := testFinalityBlock(parent.BlockNo()+1, fork)
+	block.Header.PrevBlockHash = parent.BlockHash()
+	return block
+}
+
+func (node *testFinalityNode) connect(block *types.Block) {
+	node.db.hashes[block.BlockNo()] = block.BlockHash()
+	node.db.blocks[string(block.BlockHash())] = block
+	node.onBlock(block)
+}
+
+func testFinalityVote(t *testing.T, key crypto.PrivKey, voteType uint32, block *types.Block, round uint32) *types.FinalityVote {
+	vote := &types.FinalityVote{Type: voteType, BlockNo: block.BlockNo(), BlockHash: block.BlockHash(), Round: round}
+	assert.NoError(t, vote.Sign(key))
+	return vote
+}
+
+func TestFinalityVotes(t *testing.T) {
+	nodes, disconnected, cleanup := newTestFinalityNet(t, 4)
+	defer cleanup()
+
+	// the block is finalized by 3 of 4 block producers
+	disconnected[3] = true
+	block := testFinalityBlock(1, "")
+	for _, node := range nodes[:2] {
+		node.connect(block)
+	}
+	assert.Empty(t, nodes[0].finalized)
+	nodes[2].connect(block)
+	for _, node := range nodes[:3] {
+		assert.Equal(t, []types.BlockNo{1}, node.finalized)
+		assert.Equal(t, types.BlockNo(1), node.finalizedNo())
+	}
+
+	// the certificate is persisted
+	cert, err := nodes[0].certificate(1)
+	assert.NoError(t, err)
+	assert.Len(t, cert.Votes, 3)
+	bps := make(map[types.PeerID]bool)
+	for id := range nodes[0].bps.(testVoters) {
+		bps[id] = true
+	}
+	assert.NoError(t, cert.Verify(bps, nodes[0].quorum()))
+	reloaded := newFinality(nodes[0].db, nodes[0].bps, nil, nil, nil, nil)
+	assert.True(t, proto.Equal(cert, reloaded.lastCertificate()))
+
+	// the vote of the finalized height is ignored
+	vote := &types.FinalityVote{Type: types.VotePrevote, BlockNo: 1, BlockHash: block.BlockHash()}
+	assert.NoError(t, vote.Sign(nodes[3].privKey))
+	isNew, err := nodes[0].handleVote(vote)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+
+	// the vote of non block producer
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	vote = &types.FinalityVote{Type: types.VotePrevote, BlockNo: 2, BlockHash: block.BlockHash()}
+	assert.NoError(t, vote.Sign(key))
+	_, err = nodes[0].handleVote(vote)
+	assert.Equal(t, errVoteFromNonBP, err)
+}
+
+func TestFinalityPending(t *testing.T) {
+	nodes, disconnected, cleanup := newTestFinalityNet(t, 4)
+	defer cleanup()
+
+	// node 3 receives the votes before the block is connected
+	block := testFinalityBlock(1, "")
+	for _, node := range nodes[:3] {
+		node.connect(block)
+	}
+	assert.Empty(t, nodes[3].finalized)
+	disconnected[3] = true
+	nodes[3].connect(block)
+	assert.Equal(t, []types.BlockNo{1}, nodes[3].finalized)
+}
+
+func TestFinalityEquivocation(t *testing.T) {
+	nodes, _, cleanup := newTestFinalityNet(t, 4)
+	defer cleanup()
+
+	nodes[0].best = 1
+	signer := nodes[1].privKey
+	first := testFinalityVote(t, signer, types.VotePrevote, testFinalityBlock(1, ""), 0)
+	second := testFinalityVote(t, signer, types.VotePrevote, testFinalityBlock(1, "fork"), 0)
+
+	isNew, err := nodes[0].handleVote(first)
+	assert.NoError(t, err)
+	assert.True(t, isNew)
+	isNew, err = nodes[0].handleVote(second)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+
+	id, _ := types.IDFromPrivateKey(signer)
+	evidence := &types.VoteEquivocation{}
+	assert.NoError(t, proto.Unmarshal(nodes[0].db.Get(equivocationKey(id, first)), evidence))
+	assert.True(t, proto.Equal(first, evidence.First))
+	assert.True(t, proto.Equal(second, evidence.Second))
+
+	// the evidence is gossiped once
+	if assert.Len(t, nodes[0].evidences, 1) {
+		assert.True(t, proto.Equal(evidence, nodes[0].evidences[0]))
+	}
+	_, err = nodes[0].handleVote(second)
+	assert.NoError(t, err)
+	assert.Len(t, nodes[0].evidences, 1)
+	isNew, err = nodes[2].handleEquivocation(evidence)
+	assert.NoError(t, err)
+	assert.True(t, isNew)
+	isNew, err = nodes[2].handleEquivocation(evidence)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+
+	// the votes of different rounds don't conflict
+	_, err = nodes[2].handleEquivocation(&types.VoteEquivocation{First: first,
+		Second: testFinalityVote(t, signer, types.VotePrevote, testFinalityBlock(1, "fork"), 1)})
+	assert.Equal(t, types.ErrVotesNotConflict, err)
+}
+
+func TestFinalitySplitVotes(t *testing.T) {
+	nodes, _, cleanup := newTestFinalityNet(t, 4)
+	defer cleanup()
+
+	// the prevotes are split by the fork, so that no block is precommitted
+	a, b := testFinalityBlock(1, "a"), testFinalityBlock(1, "b")
+	for i, node := range nodes {
+		if i < 2 {
+			node.connect(a)
+		} else {
+			node.connect(b)
+		}
+	}
+	for _, node := range nodes {
+		assert.Equal(t, uint32(0), node.heights[1].round)
+		assert.Nil(t, node.lock)
+	}
+
+	// the block producers vote again in the next round once the fork is
+	// resolved and the next block is connected
+	next := testFinalityChild(a, "")
+	for _, node := range nodes[2:] {
+		node.connect(a)
+	}
+	for _, node := range nodes {
+		node.connect(next)
+	}
+	for _, node := range nodes {
+		assert.Contains(t, node.finalized, types.BlockNo(1))
+	}
+	cert, err := nodes[0].certificate(1)
+	assert.NoError(t, err)
+	assert.Equal(t, a.BlockHash(), cert.BlockHash)
+	for _, vote := range cert.Votes {
+		assert.Equal(t, uint32(1), vote.Round)
+	}
+}
+
+func TestFinalityLock(t *testing.T) {
+	nodes, disconnected, cleanup := newTestFinalityNet(t, 4)
+	defer cleanup()
+	for i := range nodes {
+		disconnected[i] = true
+	}
+	node := nodes[0]
+	key := func(i int) crypto.PrivKey { return nodes[i].privKey }
+
+	// node 0 precommits a by the prevotes of 2/3+ block producers, which
+	// locks it to a
+	a, b := testFinalityBlock(1, "a"), testFinalityBlock(1, "b")
+	node.connect(a)
+	for i := 1; i < 3; i++ {
+		_, err := node.handleVote(testFinalityVote(t, key(i), types.VotePrevote, a, 0))
+		assert.NoError(t, err)
+	}
+	if assert.NotNil(t, node.lock) {
+		assert.Equal(t, a.BlockHash(), node.lock.BlockHash)
+	}
+	reloaded := newFinality(node.db, node.bps, nil, nil, nil, nil)
+	assert.True(t, proto.Equal(node.lock, reloaded.lock))
+
+	// neither the reorganization off a nor the votes for the other chain
+	assert.False(t, node.canReorganize(0))
+	assert.True(t, node.canReorganize(1))
+	node.connect(b)
+	assert.False(t, node.canVote())
+
+	// 2/3+ prevotes for b in the later round release the lock, and then node
+	// 0 precommits b
+	for i := 1; i < 4; i++ {
+		_, err := node.handleVote(testFinalityVote(t, key(i), types.VotePrevote, b, 1))
+		assert.NoError(t, err)
+	}
+	if assert.NotNil(t, node.lock) {
+		assert.Equal(t, b.BlockHash(), node.lock.BlockHash)
+		assert.Equal(t, uint32(1), node.lock.Round)
+	}
+
+	// the prevotes for the child of other block are checked again when it's
+	// known on reorganization
+	c := testFinalityBlock(1, "c")
+	d := testFinalityChild(c, "")
+	for i := 1; i < 4; i++ {
+		_, err := node.handleVote(testFinalityVote(t, key(i), types.VotePrevote, d, 0))
+		assert.NoError(t, err)
+	}
+	assert.False(t, node.canReorganize(0))
+	node.db.blocks[string(d.BlockHash())] = d
+	assert.True(t, node.canReorganize(0))
+	assert.Nil(t, node.lock)
+	assert.Empty(t, node.db.Get(FinalityLockKey))
+}
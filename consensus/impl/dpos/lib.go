@@ -70,13 +70,13 @@ func (ls libStatus) lib() *blockInfo {
 	return ls.Lib
 }
 
-func (ls *libStatus) addConfirmInfo(block *types.Block) {
+func (ls *libStatus) addConfirmInfo(block *types.Block, bpid string) {
 	// Genesis block must not be added.
 	if block.BlockNo() == 0 {
 		return
 	}
 
-	ci := newConfirmInfo(block, ls.confirmsRequired)
+	ci := newConfirmInfo(block, bpid, ls.confirmsRequired)
 
 	bi := ci.blockInfo
 
@@ -110,13 +110,15 @@ func (ls *libStatus) addConfirmInfo(block *types.Block) {
 		Msg("new confirm info added")
 }
 
-func (ls *libStatus) update() *blockInfo {
+// update updates the pre-LIB map by the last block and returns the new LIB.
+// confirmed is true if the last block completes the confirmation of a pre-LIB.
+func (ls *libStatus) update() (lib *blockInfo, confirmed bool) {
 	if bpID, pl := ls.getPreLIB(); pl != nil {
 		ls.updatePreLIB(bpID, pl)
 
-		return ls.calcLIB()
+		return ls.calcLIB(), true
 	}
-	return nil
+	return nil, false
 }
 
 func (ls *libStatus) updatePreLIB(bpID string, pl *plInfo) {
@@ -350,9 +352,9 @@ type confirmInfo struct {
 	confirmsLeft uint16
 }
 
-func newConfirmInfo(block *types.Block, confirmsRequired uint16) *confirmInfo {
+func newConfirmInfo(block *types.Block, bpid string, confirmsRequired uint16) *confirmInfo {
 	return &confirmInfo{
-		bpid:         block.BPID2Str(),
+		bpid:         bpid,
 		blockInfo:    newBlockInfo(block),
 		confirmsLeft: confirmsRequired,
 	}
@@ -406,7 +408,7 @@ func loadPlibStatus(begBlockNo, endBlockNo types.BlockNo, confirmsRequired uint1
 			logger.Error().Err(err).Msg("failed to read block")
 			return nil
 		}
-		pls.addConfirmInfo(block)
+		pls.addConfirmInfo(block, producerID(bsLoader.sdb, block))
 		pls.update()
 	}
 
@@ -40,6 +40,17 @@ func NewFromUnixNano(ns int64) *Slot {
 	return fromUnixNs(ns)
 }
 
+// NewFromIndex returns the Slot of the given index, whose block must be
+// produced until the returned slot time.
+func NewFromIndex(index int64) *Slot {
+	return fromUnixNs(index * blockIntervalMs * 1000000)
+}
+
+// Index returns the index of s.
+func (s *Slot) Index() int64 {
+	return s.nextIndex
+}
+
 // UnixNano returns UNIX time in ns.
 func (s *Slot) UnixNano() int64 {
 	return s.timeNs
@@ -59,3 +59,15 @@ func TestSlotFuture(t *testing.T) {
 	assert.True(t, Time(time.Now().Add(2*time.Second)).IsFuture(), "must be a future slot")
 	assert.True(t, Time(time.Now().Add(3*time.Second)).IsFuture(), "must be a future slot")
 }
+
+func TestSlotIndex(t *testing.T) {
+	Init(bpInterval)
+
+	s := NewFromIndex(100)
+	assert.Equal(t, int64(100), s.Index())
+	assert.Equal(t, int64(100*time.Second), s.UnixNano())
+
+	// the slot includes the time until its slot time
+	assert.Equal(t, int64(100), NewFromUnixNano(s.UnixNano()-int64(time.Millisecond)).Index())
+	assert.Equal(t, int64(101), NewFromUnixNano(s.UnixNano()+int64(time.Millisecond)).Index())
+}
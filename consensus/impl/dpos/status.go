@@ -6,6 +6,7 @@ import (
 
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
@@ -18,16 +19,20 @@ type Status struct {
 	done      bool
 	bestBlock *types.Block
 	libState  *libStatus
+	// finalized is the last block finalized by the votes of block producers.
+	finalized *blockInfo
 	bps       *bp.Snapshots
 	sdb       *state.ChainStateDB
+	stats     *bpStats
 }
 
 // NewStatus returns a newly allocated Status.
-func NewStatus(c bp.ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB, resetHeight types.BlockNo) *Status {
+func NewStatus(c bp.ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB, bv types.BlockVersionner, resetHeight types.BlockNo) *Status {
 	s := &Status{
 		libState: newLibStatus(consensusBlockCount(c.Size())),
-		bps:      bp.NewSnapshots(c, cdb, sdb),
+		bps:      bp.NewSnapshots(c, cdb, sdb, bv),
 		sdb:      sdb,
+		stats:    newBpStats(c),
 	}
 	s.init(cdb, resetHeight)
 
@@ -54,6 +59,9 @@ func (s *Status) load() {
 		BlockHash: genesisBlock.ID(),
 		BlockNo:   genesisBlock.BlockNo(),
 	}
+	if s.finalized != nil && s.libState.Lib.BlockNo < s.finalized.BlockNo {
+		s.libState.Lib = s.finalized
+	}
 
 	s.done = true
 }
@@ -68,7 +76,8 @@ func (s *Status) Update(block *types.Block) {
 
 	curBestID := s.bestBlock.ID()
 	if curBestID == block.PrevID() {
-		s.libState.addConfirmInfo(block)
+		bpid := producerID(s.sdb, block)
+		s.libState.addConfirmInfo(block, bpid)
 
 		logger.Debug().
 			Str("block hash", block.ID()).
@@ -76,9 +85,11 @@ func (s *Status) Update(block *types.Block) {
 			Msg("update LIB status")
 
 		// Block connected
-		if lib := s.libState.update(); lib != nil {
+		lib, confirmed := s.libState.update()
+		if lib != nil {
 			s.updateLIB(lib)
 		}
+		s.stats.add(bpid, block, s.bestBlock, confirmed)
 
 		s.bps.AddSnapshot(block.BlockNo())
 	} else {
@@ -149,6 +160,10 @@ func (s *Status) libAsJSON() *json.RawMessage {
 }
 
 func (s *Status) updateLIB(lib *blockInfo) {
+	// the LIB never goes back behind the block finalized by votes.
+	if s.finalized != nil && lib.BlockNo < s.finalized.BlockNo {
+		return
+	}
 	s.libState.Lib = lib
 
 	logger.Debug().
@@ -159,6 +174,17 @@ func (s *Status) updateLIB(lib *blockInfo) {
 		Msg("last irreversible block (BFT) updated")
 }
 
+// finalize advances the LIB to the block of the finality certificate.
+func (s *Status) finalize(cert *types.FinalityCertificate) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.finalized = &blockInfo{BlockHash: enc.ToString(cert.BlockHash), BlockNo: cert.BlockNo}
+	if s.libState.Lib == nil || s.libState.Lib.BlockNo < cert.BlockNo {
+		s.updateLIB(s.finalized)
+	}
+}
+
 // Save saves the consensus status information for the later recovery.
 func (s *Status) Save(tx consensus.TxWriter) error {
 	s.Lock()
@@ -168,6 +194,10 @@ func (s *Status) Save(tx consensus.TxWriter) error {
 		return err
 	}
 
+	if err := s.stats.save(tx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,10 +264,13 @@ func (s *Status) init(cdb consensus.ChainDB, resetHeight types.BlockNo) {
 		best:             best,
 		genesis:          genesis,
 		cdb:              cdb,
+		sdb:              s.sdb,
 		confirmsRequired: s.libState.confirmsRequired,
 	}
 
 	bsLoader.load(resetHeight)
+
+	s.stats.load(cdb)
 }
 
 type bootLoader struct {
@@ -245,6 +278,7 @@ type bootLoader struct {
 	best             *types.Block
 	genesis          *types.Block
 	cdb              consensus.ChainDB
+	sdb              *state.ChainStateDB
 	confirmsRequired uint16
 }
 
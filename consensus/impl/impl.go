@@ -6,11 +6,13 @@
 package impl
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/consensus/impl/bft"
 	"github.com/aergoio/aergo/consensus/impl/dpos"
 	"github.com/aergoio/aergo/consensus/impl/raftv2"
 	"github.com/aergoio/aergo/consensus/impl/sbp"
@@ -52,11 +54,14 @@ func newConsensus(cfg *config.Config, hub *component.ComponentHub,
 	cs *chain.ChainService, pa p2pcommon.PeerAccessor) (consensus.Consensus, error) {
 	cdb := cs.CDB()
 	sdb := cs.SDB()
+	// the hardfork schedule may be changed by the governance.
+	bv := cs.SharedHardforkConfig()
 
 	impl := map[string]consensus.Constructor{
-		dpos.GetName():   dpos.GetConstructor(cfg, hub, cdb, sdb),              // DPoS
-		sbp.GetName():    sbp.GetConstructor(cfg, hub, cdb, sdb),               // Simple BP
-		raftv2.GetName(): raftv2.GetConstructor(cfg, hub, cs.WalDB(), sdb, pa), // Raft BP
+		dpos.GetName():   dpos.GetConstructor(cfg, bv, hub, cdb, sdb),              // DPoS
+		sbp.GetName():    sbp.GetConstructor(bv, hub, cdb, sdb),                    // Simple BP
+		raftv2.GetName(): raftv2.GetConstructor(cfg, bv, hub, cs.WalDB(), sdb, pa), // Raft BP
+		bft.GetName():    bft.GetConstructor(cfg, bv, hub, cdb, sdb),               // BFT
 	}
 
 	consensus.SetCurConsensus(cdb.GetGenesisInfo().ConsensusType())
@@ -72,7 +77,15 @@ func ValidateGenesis(genesis *types.Genesis) error {
 		dpos.GetName():   dpos.ValidateGenesis,   // DPoS
 		sbp.GetName():    sbp.ValidateGenesis,    // Simple BP
 		raftv2.GetName(): raftv2.ValidateGenesis, // Raft BP
+		bft.GetName():    bft.ValidateGenesis,    // BFT
 	}
 
-	return validators[name](genesis)
+	validate, exist := validators[name]
+	if !exist {
+		return fmt.Errorf("unknown consensus: %s", genesis.ConsensusType())
+	}
+	if err := genesis.Validate(); err != nil {
+		return err
+	}
+	return validate(genesis)
 }
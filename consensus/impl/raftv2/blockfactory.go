@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aergoio/aergo-lib/log"
@@ -37,6 +38,8 @@ var (
 	ErrNotRaftLeader        = errors.New("this node is not leader")
 	ErrInvalidConsensusName = errors.New("invalid consensus name")
 	ErrCancelGenerate       = errors.New("cancel generating block because work becomes stale")
+	ErrTransferToLearner    = errors.New("leadership can't be transferred to a learner")
+	ErrNoTransferee         = errors.New("no healthy member to transfer leadership")
 )
 
 func init() {
@@ -135,6 +138,10 @@ type BlockFactory struct {
 	prevBlock        *types.Block // best block of last job
 	jobLock          sync.RWMutex
 
+	// maintenance stops producing blocks. It's protected by jobLock.
+	maintenance bool
+	handingOver int32
+
 	raftOp     *RaftOperator
 	raftServer *raftServer
 
@@ -147,16 +154,16 @@ func GetName() string {
 }
 
 // GetConstructor build and returns consensus.Constructor from New function.
-func GetConstructor(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainWAL,
-	sdb *state.ChainStateDB, pa p2pcommon.PeerAccessor) consensus.Constructor {
+func GetConstructor(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainWAL, sdb *state.ChainStateDB, pa p2pcommon.PeerAccessor) consensus.Constructor {
 	return func() (consensus.Consensus, error) {
-		return New(cfg, hub, cdb, sdb, pa)
+		return New(cfg, bv, hub, cdb, sdb, pa)
 	}
 }
 
 // New returns a BlockFactory.
-func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainWAL,
-	sdb *state.ChainStateDB, pa p2pcommon.PeerAccessor) (*BlockFactory, error) {
+func New(cfg *config.Config, bv types.BlockVersionner, hub *component.ComponentHub,
+	cdb consensus.ChainWAL, sdb *state.ChainStateDB, pa p2pcommon.PeerAccessor) (*BlockFactory, error) {
 
 	bf := &BlockFactory{
 		ComponentHub:     hub,
@@ -169,7 +176,7 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainWAL
 		ID:               p2pkey.NodeSID(),
 		privKey:          p2pkey.NodePrivKey(),
 		sdb:              sdb,
-		bv:               cfg.Hardfork,
+		bv:               bv,
 	}
 
 	if cfg.Consensus.EnableBp {
@@ -232,6 +239,23 @@ func (bf *BlockFactory) QueueJob(now time.Time, jq chan<- interface{}) {
 		term    uint64
 	)
 
+	if bf.maintenance {
+		if !bf.raftServer.IsLeader() {
+			return
+		}
+		// the leader keeps producing blocks until another member can take
+		// over, otherwise the whole cluster would stop.
+		if bf.hasTransferee() {
+			go func() {
+				if err := bf.handOverLeadership(); err != nil {
+					logger.Debug().Err(err).Msg("failed to hand over leadership in maintenance mode")
+				}
+			}()
+			return
+		}
+		logger.Debug().Msg("keep producing blocks in maintenance mode since no member can take over leadership")
+	}
+
 	if isReady, term = bf.isLeaderReady(); !isReady {
 		//logger.Debug().Msg("skip producing block because this bp is leader but it's not ready to produce new block")
 		return
@@ -705,6 +729,115 @@ func (bf *BlockFactory) ConfChange(req *types.MembershipChange) (*consensus.Memb
 	return member, nil
 }
 
+// TransferLeadership moves the leadership of cluster to the voting member of
+// the given name. This node must be the leader.
+func (bf *BlockFactory) TransferLeadership(name string) (*consensus.Member, error) {
+	if bf.bpc == nil || bf.raftServer == nil {
+		return nil, ErrClusterNotReady
+	}
+
+	if !bf.raftServer.IsLeader() {
+		return nil, ErrNotRaftLeader
+	}
+
+	bf.bpc.Lock()
+	member := bf.bpc.AppliedMembers().getMemberByName(name)
+	bf.bpc.Unlock()
+
+	if member == nil {
+		return nil, ErrNotExistRaftMember
+	}
+	if member.Learner {
+		return nil, ErrTransferToLearner
+	}
+	if member.ID == bf.bpc.NodeID() {
+		return member, nil
+	}
+
+	if err := bf.raftServer.TransferLeadership(member.ID); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// SetMaintenance stops or resumes producing blocks. If this node is the leader
+// on enabling, the leadership is handed over to another member so that the
+// cluster keeps producing blocks while this node is drained. It's refused if
+// no member can take over the leadership. The mode is kept only in memory, so
+// it's disabled when the node restarts.
+func (bf *BlockFactory) SetMaintenance(enable bool) (*types.MaintenanceMode, error) {
+	if bf.raftServer == nil {
+		return nil, ErrClusterNotReady
+	}
+	if enable && bf.raftServer.IsLeader() && !bf.hasTransferee() {
+		return nil, ErrNoTransferee
+	}
+
+	bf.jobLock.Lock()
+	bf.maintenance = enable
+	bf.jobLock.Unlock()
+
+	logger.Info().Bool("enable", enable).Msg("set maintenance mode")
+
+	if enable {
+		if err := bf.handOverLeadership(); err != nil {
+			logger.Warn().Err(err).Msg("failed to hand over leadership. it will be retried")
+		}
+	}
+
+	return bf.maintenanceMode(), nil
+}
+
+func (bf *BlockFactory) maintenanceMode() *types.MaintenanceMode {
+	bf.jobLock.RLock()
+	enabled := bf.maintenance
+	bf.jobLock.RUnlock()
+
+	isLeader := bf.raftServer.IsLeader()
+
+	return &types.MaintenanceMode{
+		Enabled:  enabled,
+		IsLeader: isLeader,
+		Drained:  enabled && !isLeader && bf.raftServer.commitProgress.IsReadyToPropose(),
+	}
+}
+
+// hasTransferee reports whether a member is able to take over the leadership
+// from this node.
+func (bf *BlockFactory) hasTransferee() bool {
+	cp, err := bf.raftServer.GetClusterProgress()
+	if err != nil {
+		return false
+	}
+	return cp.pickTransferee(bf.bpc.NodeID()) != nil
+}
+
+// handOverLeadership transfers the leadership to another member for
+// maintenance.
+func (bf *BlockFactory) handOverLeadership() error {
+	if !atomic.CompareAndSwapInt32(&bf.handingOver, 0, 1) {
+		return nil
+	}
+	defer atomic.StoreInt32(&bf.handingOver, 0)
+
+	if !bf.raftServer.IsLeader() {
+		return nil
+	}
+
+	cp, err := bf.raftServer.GetClusterProgress()
+	if err != nil {
+		return err
+	}
+
+	transferee := cp.pickTransferee(bf.bpc.NodeID())
+	if transferee == nil {
+		return ErrNoTransferee
+	}
+
+	return bf.raftServer.TransferLeadership(transferee.MemberID)
+}
+
 func (bf *BlockFactory) RaftAccessor() consensus.AergoRaftAccessor {
 	return bf.rhw
 }
@@ -40,6 +40,7 @@ var (
 	ErrNotExitRaftProgress      = errors.New("progress of this node doesn't exist")
 	ErrUnhealtyNodeExist        = errors.New("can't add some node if unhealthy nodes exist")
 	ErrRemoveHealthyNode        = errors.New("remove of a healthy node may cause the cluster to hang")
+	ErrNotLearner               = errors.New("member to promote is not a learner")
 )
 
 const (
@@ -50,11 +51,12 @@ const (
 )
 
 type RaftInfo struct {
-	Leader string
-	Total  uint32
-	Name   string
-	RaftId string
-	Status *json.RawMessage
+	Leader   string
+	Total    uint32
+	Learners uint32
+	Name     string
+	RaftId   string
+	Status   *json.RawMessage
 }
 
 type NotifyFn func(event *message.RaftClusterEvent)
@@ -77,6 +79,7 @@ type Cluster struct {
 
 	identity consensus.RaftIdentity
 
+	// Size is the number of voting members. Learners are not counted.
 	Size uint32
 
 	// @ MatchClusterAndConfState
@@ -327,16 +330,18 @@ func (cl *Cluster) ResetMembers() {
 func (cl *Cluster) isMatch(confstate *raftpb.ConfState) bool {
 	var matched int
 
-	if len(cl.AppliedMembers().MapByID) != len(confstate.Nodes) {
+	if len(cl.AppliedMembers().MapByID) != len(confstate.Nodes)+len(confstate.Learners) {
 		return false
 	}
 
-	for _, confID := range confstate.Nodes {
-		if _, ok := cl.AppliedMembers().MapByID[confID]; !ok {
-			return false
-		}
+	for _, confIDs := range [][]uint64{confstate.Nodes, confstate.Learners} {
+		for _, confID := range confIDs {
+			if _, ok := cl.AppliedMembers().MapByID[confID]; !ok {
+				return false
+			}
 
-		matched++
+			matched++
+		}
 	}
 
 	return true
@@ -366,16 +371,18 @@ func (cl *Cluster) getStartPeers() ([]raftlib.Peer, error) {
 		return nil, ErrClusterHasNoMember
 	}
 
-	rpeers := make([]raftlib.Peer, cl.Size)
+	rpeers := make([]raftlib.Peer, 0, cl.Size)
 
-	var i int
 	for _, member := range cl.members.MapByID {
+		// learners join a running cluster only by conf change
+		if member.Learner {
+			continue
+		}
 		data, err := json.Marshal(member)
 		if err != nil {
 			return nil, err
 		}
-		rpeers[i] = raftlib.Peer{ID: uint64(member.ID), Context: data}
-		i++
+		rpeers = append(rpeers, raftlib.Peer{ID: uint64(member.ID), Context: data})
 	}
 
 	return rpeers, nil
@@ -447,11 +454,42 @@ func (cl *Cluster) addMember(member *consensus.Member, applied bool) error {
 	}
 
 	cl.members.add(member)
+	if !member.Learner {
+		cl.Size++
+	}
+
+	return nil
+}
+
+// promoteMember makes the learner a voting member.
+func (cl *Cluster) promoteMember(member *consensus.Member) error {
+	logger.Info().Str("member", member.ToString()).Msg("member promote")
+
+	cl.Lock()
+	defer cl.Unlock()
+
+	m := cl.AppliedMembers().getMember(member.ID)
+	if m == nil || !m.Learner {
+		return ErrNotLearner
+	}
+	m.Learner = false
+
+	if m = cl.members.getMember(member.ID); m != nil {
+		m.Learner = false
+	}
 	cl.Size++
 
 	return nil
 }
 
+func (cl *Cluster) isLearner(id uint64) bool {
+	cl.Lock()
+	defer cl.Unlock()
+
+	m := cl.AppliedMembers().getMember(id)
+	return m != nil && m.Learner
+}
+
 func (cl *Cluster) removeMember(member *consensus.Member) error {
 	logger.Info().Str("member", member.ToString()).Msg("member remove")
 
@@ -462,7 +500,9 @@ func (cl *Cluster) removeMember(member *consensus.Member) error {
 	cl.members.remove(member)
 	cl.removedMembers.add(member)
 
-	cl.Size--
+	if !member.Learner {
+		cl.Size--
+	}
 	// notify to p2p TODO temporary code
 	peerID, err := types.IDFromBytes(member.PeerID)
 	if err != nil {
@@ -781,7 +821,7 @@ func (cl *Cluster) getRaftInfo(withStatus bool) *RaftInfo {
 		leaderName = "id=" + EtcdIDToString(leader)
 	}
 
-	rinfo := &RaftInfo{Leader: leaderName, Total: cl.Size, Name: cl.NodeName(), RaftId: EtcdIDToString(cl.NodeID())}
+	rinfo := &RaftInfo{Leader: leaderName, Total: cl.Size, Learners: uint32(cl.Members().len()) - cl.Size, Name: cl.NodeName(), RaftId: EtcdIDToString(cl.NodeID())}
 
 	if withStatus && cl.rs != nil {
 		b, err := cl.rs.Status().MarshalJSON()
@@ -801,10 +841,11 @@ func (cl *Cluster) toConsensusInfo() *types.ConsensusInfo {
 	}
 
 	type PeerInfo struct {
-		Name   string
-		RaftID string
-		PeerID string
-		Addr   string
+		Name    string
+		RaftID  string
+		PeerID  string
+		Addr    string
+		Learner bool `json:",omitempty"`
 	}
 
 	b, err := json.Marshal(cl.getRaftInfo(true))
@@ -819,20 +860,17 @@ func (cl *Cluster) toConsensusInfo() *types.ConsensusInfo {
 	cons := emptyCons
 	cons.Info = string(b)
 
-	var i int = 0
-	if cl.Size != 0 {
-		bps := make([]string, cl.Size)
+	if cl.Members().len() != 0 {
+		bps := make([]string, 0, cl.Members().len())
 
 		for id, m := range cl.Members().MapByID {
-			bp := &PeerInfo{Name: m.Name, RaftID: EtcdIDToString(m.ID), PeerID: m.GetPeerID().Pretty(), Addr: m.Address}
+			bp := &PeerInfo{Name: m.Name, RaftID: EtcdIDToString(m.ID), PeerID: m.GetPeerID().Pretty(), Addr: m.Address, Learner: m.Learner}
 			b, err = json.Marshal(bp)
 			if err != nil {
 				logger.Error().Err(err).Str("raftid", EtcdIDToString(id)).Msg("failed to marshalEntryData raft consensus bp")
 				return &emptyCons
 			}
-			bps[i] = string(b)
-
-			i++
+			bps = append(bps, string(b))
 		}
 		cons.Bps = bps
 	}
@@ -859,6 +897,27 @@ func (cl *Cluster) NewMemberFromRemoveReq(req *types.MembershipChange) (*consens
 	return member, nil
 }
 
+// NewMemberFromPromoteReq returns the voting member promoted from the learner
+// of the given ID. cluster must be locked.
+func (cl *Cluster) NewMemberFromPromoteReq(req *types.MembershipChange) (*consensus.Member, error) {
+	if req.Attr.ID == consensus.InvalidMemberID {
+		return nil, consensus.ErrInvalidMemberID
+	}
+
+	learner := cl.AppliedMembers().getMember(req.Attr.ID)
+	if learner == nil {
+		return nil, ErrNotExistRaftMember
+	}
+	if !learner.Learner {
+		return nil, ErrNotLearner
+	}
+
+	member := consensus.NewMember(learner.Name, learner.Address, learner.GetPeerID(), cl.chainID, 0)
+	member.SetMemberID(learner.ID)
+
+	return member, nil
+}
+
 func (cl *Cluster) ChangeMembership(req *types.MembershipChange, nowait bool) (*consensus.Member, error) {
 	var (
 		proposal *consensus.ConfChangePropose
@@ -913,9 +972,17 @@ func (cl *Cluster) makeProposal(req *types.MembershipChange, nowait bool) (*cons
 	case types.MembershipChangeType_ADD_MEMBER:
 		member, err = cl.NewMemberFromAddReq(req)
 
+	case types.MembershipChangeType_ADD_LEARNER:
+		if member, err = cl.NewMemberFromAddReq(req); err == nil {
+			member.Learner = true
+		}
+
 	case types.MembershipChangeType_REMOVE_MEMBER:
 		member, err = cl.NewMemberFromRemoveReq(req)
 
+	case types.MembershipChangeType_PROMOTE_LEARNER:
+		member, err = cl.NewMemberFromPromoteReq(req)
+
 	default:
 		return nil, ErrInvalidMembershipReqType
 	}
@@ -1073,7 +1140,7 @@ func (cl *Cluster) isEnableChangeMembership(cc *raftpb.ConfChange) error {
 		var healthy int
 
 		for _, mp := range cp.MemberProgresses {
-			if mp.Status == MemberProgressStateHealthy {
+			if mp.Status == MemberProgressStateHealthy && !mp.progress.IsLearner {
 				healthy++
 			}
 		}
@@ -1096,6 +1163,9 @@ func (cl *Cluster) isEnableChangeMembership(cc *raftpb.ConfChange) error {
 	}
 
 	switch {
+	case cc.Type == raftpb.ConfChangeAddLearnerNode:
+		// learners don't vote, so that they never make the cluster unavailable
+		return nil
 	case cc.Type == raftpb.ConfChangeAddNode:
 		for _, mp := range cp.MemberProgresses {
 			if mp.Status != MemberProgressStateHealthy {
@@ -1112,6 +1182,11 @@ func (cl *Cluster) isEnableChangeMembership(cc *raftpb.ConfChange) error {
 			return ErrNotExitRaftProgress
 		}
 
+		if mp.progress.IsLearner {
+			logger.Info().Uint64("memberid", mp.MemberID).Msg("try to remove learner")
+			return nil
+		}
+
 		if mp.Status != MemberProgressStateHealthy {
 			logger.Warn().Uint64("memberid", mp.MemberID).Msg("try to remove slow node")
 			return nil
@@ -1149,13 +1224,17 @@ func (cl *Cluster) validateChangeMembership(cc *raftpb.ConfChange, member *conse
 	}
 
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
 		if !member.IsValid() {
 			logger.Error().Str("member", member.ToString()).Msg("member has invalid fields")
 			return ErrInvalidMember
 		}
 
 		if m := appliedMembers.getMember(member.ID); m != nil {
+			// a learner is promoted by adding it again as a voting member
+			if cc.Type == raftpb.ConfChangeAddNode && m.Learner && m.IsCompatible(member) {
+				return nil
+			}
 			return ErrCCAlreadyAdded
 		}
 
@@ -1182,8 +1261,10 @@ func (cl *Cluster) validateChangeMembership(cc *raftpb.ConfChange, member *conse
 func (cl *Cluster) makeConfChange(reqID uint64, reqType types.MembershipChangeType, member *consensus.Member) (*raftpb.ConfChange, error) {
 	var changeType raftpb.ConfChangeType
 	switch reqType {
-	case types.MembershipChangeType_ADD_MEMBER:
+	case types.MembershipChangeType_ADD_MEMBER, types.MembershipChangeType_PROMOTE_LEARNER:
 		changeType = raftpb.ConfChangeAddNode
+	case types.MembershipChangeType_ADD_LEARNER:
+		changeType = raftpb.ConfChangeAddLearnerNode
 	case types.MembershipChangeType_REMOVE_MEMBER:
 		changeType = raftpb.ConfChangeRemoveNode
 	default:
@@ -63,6 +63,7 @@ var (
 	ErrEmptySnapshot       = errors.New("received empty snapshot")
 	ErrInvalidRaftIdentity = errors.New("raft identity is not set")
 	ErrProposeNilBlock     = errors.New("proposed block is nil")
+	ErrTransferTimeout     = errors.New("leadership transfer is not completed in time")
 )
 
 const (
@@ -1149,7 +1150,14 @@ func (rs *raftServer) applyConfChange(ent *raftpb.Entry) bool {
 	logger.Info().Uint64("requestID", cc.ID).Str("type", cc.Type.String()).Str("member", member.ToString()).Msg("publish conf change entry")
 
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+		if cc.Type == raftpb.ConfChangeAddNode && rs.cluster.isLearner(member.ID) {
+			if err := rs.cluster.promoteMember(member); err != nil {
+				logger.Fatal().Str("member", member.ToString()).Msg("failed to promote learner of cluster")
+			}
+			break
+		}
+
 		if err := rs.cluster.addMember(member, true); err != nil {
 			logger.Fatal().Str("member", member.ToString()).Msg("failed to add member to cluster")
 		}
@@ -1345,6 +1353,37 @@ func (rs *raftServer) GetLeaderStatus() LeaderStatus {
 	return tmpStatus
 }
 
+// TransferLeadership makes transferee the leader of cluster and waits until
+// it's elected. Raft aborts the transfer if it isn't done in an election
+// timeout, so it doesn't wait longer than that.
+func (rs *raftServer) TransferLeadership(transferee uint64) error {
+	node := rs.getNodeSync()
+	if node == nil {
+		return ErrRaftNotReady
+	}
+
+	timeout := RaftTick * time.Duration(ElectionTickCount*2)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	logger.Info().Str("transferee", EtcdIDToString(transferee)).Msg("transfer leadership")
+
+	node.TransferLeadership(ctx, rs.ID(), transferee)
+
+	ticker := time.NewTicker(RaftTick)
+	defer ticker.Stop()
+
+	for rs.GetLeader() != transferee {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ErrTransferTimeout
+		}
+	}
+
+	return nil
+}
+
 // IsTermLeader returns true if this node is leader of given term
 func (rs *raftServer) IsLeaderOfTerm(term uint64) bool {
 	status := rs.GetLeaderStatus()
@@ -1404,6 +1443,21 @@ func (cp *ClusterProgress) ToString() string {
 	return buf
 }
 
+// pickTransferee returns the healthy voter except self whose log is the
+// closest to the leader's.
+func (cp *ClusterProgress) pickTransferee(self uint64) *MemberProgress {
+	var transferee *MemberProgress
+	for id, mp := range cp.MemberProgresses {
+		if id == self || mp.progress.IsLearner || mp.Status != MemberProgressStateHealthy {
+			continue
+		}
+		if transferee == nil || mp.LogDifference < transferee.LogDifference {
+			transferee = mp
+		}
+	}
+	return transferee
+}
+
 func (cp *MemberProgress) ToString() string {
 	return fmt.Sprintf("{ id: %x, Staus: \"%s\", LogDifference: %d }", cp.MemberID, MemberProgressStateNames[cp.Status], cp.LogDifference)
 }
@@ -1469,8 +1523,11 @@ func (rs *raftServer) GetClusterProgress() (*ClusterProgress, error) {
 	}
 
 	prog.MemberProgresses = make(map[uint64]*MemberProgress)
-	prog.N = n
 	for id, nodeProgress := range status.Progress {
+		// N is the number of voters, which decides the quorum
+		if !nodeProgress.IsLearner {
+			prog.N++
+		}
 		prog.MemberProgresses[id] = &MemberProgress{MemberID: id, Status: getProgressState(&nodeProgress, lastIdx, rs.cluster.NodeID(), id), LogDifference: lastIdx - nodeProgress.Match, progress: nodeProgress}
 	}
 
@@ -0,0 +1,25 @@
+package raftv2
+
+import (
+	"testing"
+
+	raftlib "github.com/aergoio/etcd/raft"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickTransferee(t *testing.T) {
+	cp := &ClusterProgress{MemberProgresses: map[uint64]*MemberProgress{
+		1: {MemberID: 1, Status: MemberProgressStateHealthy},
+		2: {MemberID: 2, Status: MemberProgressStateHealthy, LogDifference: 3},
+		3: {MemberID: 3, Status: MemberProgressStateHealthy, LogDifference: 1},
+		4: {MemberID: 4, Status: MemberProgressStateSlow},
+		5: {MemberID: 5, Status: MemberProgressStateHealthy, progress: raftlib.Progress{IsLearner: true}},
+	}}
+
+	assert.Equal(t, uint64(3), cp.pickTransferee(1).MemberID)
+
+	// slow nodes and learners are never picked
+	delete(cp.MemberProgresses, 2)
+	delete(cp.MemberProgresses, 3)
+	assert.Nil(t, cp.pickTransferee(1))
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aergoio/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
-	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/chain"
 	"github.com/aergoio/aergo/contract"
@@ -65,10 +64,10 @@ func GetName() string {
 }
 
 // GetConstructor build and returns consensus.Constructor from New function.
-func GetConstructor(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
+func GetConstructor(bv types.BlockVersionner, hub *component.ComponentHub, cdb consensus.ChainDB,
 	sdb *state.ChainStateDB) consensus.Constructor {
 	return func() (consensus.Consensus, error) {
-		return New(cfg.Hardfork, hub, cdb, sdb)
+		return New(bv, hub, cdb, sdb)
 	}
 }
 
@@ -260,6 +259,14 @@ func (s *SimpleBlockFactory) ConfChangeInfo(requestID uint64) (*types.ConfChange
 	return nil, consensus.ErrNotSupportedMethod
 }
 
+func (s *SimpleBlockFactory) TransferLeadership(name string) (*consensus.Member, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (s *SimpleBlockFactory) SetMaintenance(enable bool) (*types.MaintenanceMode, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
 func (s *SimpleBlockFactory) MakeConfChangeProposal(req *types.MembershipChange) (*consensus.ConfChangePropose, error) {
 	return nil, consensus.ErrNotSupportedMethod
 }
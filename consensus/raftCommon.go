@@ -282,7 +282,7 @@ func NewMember(name string, address string, peerID types.PeerID, chainID []byte,
 }
 
 func (m *Member) Clone() *Member {
-	newM := Member{MemberAttr: types.MemberAttr{ID: m.ID, Name: m.Name, Address: m.Address}}
+	newM := Member{MemberAttr: types.MemberAttr{ID: m.ID, Name: m.Name, Address: m.Address, Learner: m.Learner}}
 
 	copy(newM.PeerID, m.PeerID)
 
@@ -330,7 +330,8 @@ func (m *Member) Equal(other *Member) bool {
 		bytes.Equal(m.PeerID, other.PeerID) &&
 		m.Name == other.Name &&
 		m.Address == other.Address &&
-		bytes.Equal([]byte(m.PeerID), []byte(other.PeerID))
+		bytes.Equal([]byte(m.PeerID), []byte(other.PeerID)) &&
+		m.Learner == other.Learner
 }
 
 func (m *Member) ToString() string {
@@ -7,7 +7,6 @@ import (
 	"math/big"
 	"strconv"
 
-	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -38,7 +37,7 @@ var (
 	preLoadInfos   [2]preLoadInfo
 	PubNet         bool
 	TraceBlockNo   uint64
-	HardforkConfig *config.HardforkConfig
+	HardforkConfig types.BlockVersionner
 	bpTimeout      <-chan struct{}
 	maxSQLDBSize   uint32
 )
@@ -27,6 +27,12 @@ func GetAdmin(r AccountStateReader) (*types.EnterpriseConfig, error) {
 	}
 	return ret, nil
 }
+
+// InitAdmins sets the initial admins given by the genesis.
+func InitAdmins(scs *state.ContractState, addresses [][]byte) error {
+	return setAdmins(scs, addresses)
+}
+
 func setAdmins(scs *state.ContractState, addresses [][]byte) error {
 	return scs.SetData([]byte(AdminsKey), bytes.Join(addresses, []byte("")))
 }
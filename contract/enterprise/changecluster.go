@@ -10,8 +10,10 @@ import (
 type CcArgument map[string]interface{}
 
 const (
-	CmdMembershipAdd    = "add"
-	CmdMembershipRemove = "remove"
+	CmdMembershipAdd        = "add"
+	CmdMembershipAddLearner = "addlearner"
+	CmdMembershipPromote    = "promote"
+	CmdMembershipRemove     = "remove"
 
 	CCCommand         = "command"
 	MemberAttrName    = "name"
@@ -29,7 +31,7 @@ var (
 	}
 )*/
 
-func ValidateChangeCluster(ci types.CallInfo, blockNo types.BlockNo) (interface{}, error) {
+func ValidateChangeCluster(ci types.CallInfo, blockInfo *types.BlockHeaderInfo) (interface{}, error) {
 	var (
 		ccArg     CcArgument
 		ok        bool
@@ -50,7 +52,15 @@ func ValidateChangeCluster(ci types.CallInfo, blockNo types.BlockNo) (interface{
 		return nil, err
 	}
 
-	changeReq.RequestID = blockNo
+	// learners are supported since the hardfork V3.
+	if blockInfo.Version < 3 {
+		switch changeReq.Type {
+		case types.MembershipChangeType_ADD_LEARNER, types.MembershipChangeType_PROMOTE_LEARNER:
+			return nil, fmt.Errorf("invalid ChangeCluster argument: learner is not supported before V3")
+		}
+	}
+
+	changeReq.RequestID = blockInfo.No
 
 	return changeReq, nil
 }
@@ -105,8 +115,11 @@ func (cc CcArgument) parse() (*types.MembershipChange, error) {
 	}
 
 	switch cmd {
-	case CmdMembershipAdd:
+	case CmdMembershipAdd, CmdMembershipAddLearner:
 		mChange.Type = types.MembershipChangeType_ADD_MEMBER
+		if cmd == CmdMembershipAddLearner {
+			mChange.Type = types.MembershipChangeType_ADD_LEARNER
+		}
 
 		if name, err = cc.get(MemberAttrName); err != nil {
 			return nil, err
@@ -129,8 +142,11 @@ func (cc CcArgument) parse() (*types.MembershipChange, error) {
 			return nil, fmt.Errorf("invalid ChangeCluster argument: %s", err.Error())
 		}
 
-	case CmdMembershipRemove:
+	case CmdMembershipRemove, CmdMembershipPromote:
 		mChange.Type = types.MembershipChangeType_REMOVE_MEMBER
+		if cmd == CmdMembershipPromote {
+			mChange.Type = types.MembershipChangeType_PROMOTE_LEARNER
+		}
 
 		if idStr, err = cc.get(MemberAttrID); err != nil {
 			return nil, err
@@ -0,0 +1,104 @@
+package enterprise
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// ValidatorsKey is the key of the validator set of BFT consensus. It's only
+// changed by ChangeValidator, not by setConf or appendConf.
+const ValidatorsKey = "VALIDATORS"
+
+const (
+	CmdValidatorAdd    = "add"
+	CmdValidatorRemove = "remove"
+
+	ValidatorAttrPeerID = "peerid"
+)
+
+// InitValidators sets the initial validators given by the genesis.
+func InitValidators(scs *state.ContractState, ids []string) error {
+	return setConf(scs, []byte(ValidatorsKey), &Conf{On: true, Values: ids})
+}
+
+// GetValidators returns the peer IDs of the BFT validators in the state of r.
+func GetValidators(r AccountStateReader) ([]string, error) {
+	scs, err := r.GetEnterpriseAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return getValidators(scs)
+}
+
+func getValidators(scs *state.ContractState) ([]string, error) {
+	return ValidatorsOf(scs, nil)
+}
+
+// ValidatorsOf returns the peer IDs of the BFT validators in the storage of
+// the enterprise contract read by g, or initial if the storage doesn't have
+// them.
+func ValidatorsOf(g dataGetter, initial []string) ([]string, error) {
+	conf, err := getConf(g, []byte(ValidatorsKey))
+	if err != nil {
+		return nil, err
+	}
+	if conf == nil {
+		return append([]string{}, initial...), nil
+	}
+	return conf.Values, nil
+}
+
+// ValidateChangeValidator returns the validator set changed by the request
+// in ci. args[0] : map{ "command": "add" or "remove", "peerid": "16Uiu2..." }
+func ValidateChangeValidator(ci types.CallInfo, scs *state.ContractState) (*Conf, error) {
+	if len(ci.Args) != 1 {
+		return nil, fmt.Errorf("invalid arguments in payload for ChangeValidator: %s", ci.Args)
+	}
+
+	arg, ok := ci.Args[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid argument in payload for ChangeValidator(map[string]interface{}) : argument=%v", reflect.TypeOf(ci.Args[0]))
+	}
+
+	cmd, err := CcArgument(arg).get(CCCommand)
+	if err != nil {
+		return nil, err
+	}
+	peerID, err := CcArgument(arg).get(ValidatorAttrPeerID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := types.IDB58Decode(peerID); err != nil {
+		return nil, fmt.Errorf("invalid ChangeValidator argument: can't decode peerid string(%s)", peerID)
+	}
+
+	validators, err := getValidators(scs)
+	if err != nil {
+		return nil, err
+	}
+	conf := &Conf{On: true, Values: validators}
+	context := &EnterpriseContext{Conf: conf}
+
+	switch cmd {
+	case CmdValidatorAdd:
+		if context.HasConfValue(peerID) {
+			return nil, fmt.Errorf("already included validator : %s", peerID)
+		}
+		conf.AppendValue(peerID)
+	case CmdValidatorRemove:
+		if !context.HasConfValue(peerID) {
+			return nil, fmt.Errorf("validator not exist : %s", peerID)
+		}
+		if len(conf.Values) == 1 {
+			return nil, fmt.Errorf("the last validator can't be removed : %s", peerID)
+		}
+		conf.RemoveValue(peerID)
+	default:
+		return nil, fmt.Errorf("invalid ChangeValidator argument: invalid command %s", cmd)
+	}
+
+	return conf, nil
+}
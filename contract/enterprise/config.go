@@ -77,6 +77,10 @@ type AccountStateReader interface {
 	GetEnterpriseAccountState() (*state.ContractState, error)
 }
 
+type dataGetter interface {
+	GetData(key []byte) ([]byte, error)
+}
+
 func GetConf(r AccountStateReader, key string) (*types.EnterpriseConfig, error) {
 	scs, err := r.GetEnterpriseAccountState()
 	if err != nil {
@@ -113,7 +117,7 @@ func enableConf(scs *state.ContractState, key []byte, value bool) (*Conf, error)
 	return conf, nil
 }
 
-func getConf(scs *state.ContractState, key []byte) (*Conf, error) {
+func getConf(scs dataGetter, key []byte) (*Conf, error) {
 	data, err := scs.GetData(append(confPrefix, genKey(key)...))
 	if err != nil || data == nil {
 		return nil, err
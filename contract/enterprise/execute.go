@@ -54,9 +54,9 @@ func (e *EnterpriseContext) HasConfValue(value string) bool {
 }
 
 func ExecuteEnterpriseTx(bs *state.BlockState, ccc consensus.ChainConsensusCluster, scs *state.ContractState, txBody *types.TxBody,
-	sender, receiver *state.V, blockNo types.BlockNo) ([]*types.Event, error) {
+	sender, receiver *state.V, blockInfo *types.BlockHeaderInfo) ([]*types.Event, error) {
 
-	context, err := ValidateEnterpriseTx(txBody, sender, scs, blockNo)
+	context, err := ValidateEnterpriseTx(txBody, sender, scs, blockInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +126,26 @@ func ExecuteEnterpriseTx(bs *state.BlockState, ccc consensus.ChainConsensusClust
 			EventIdx:        0,
 			JsonArgs:        string(jsonArgs),
 		})
+	case ChangeValidator:
+		// the validator set is applied from the next block
+		err = setConf(scs, []byte(ValidatorsKey), context.Conf)
+		if err != nil {
+			return nil, err
+		}
+		events, err = createSetEvent(receiver.ID(), ValidatorsKey, context.Conf.Values)
+		if err != nil {
+			return nil, err
+		}
+	case ScheduleHardfork:
+		// the nodes apply the schedule after the block is connected
+		err = setConf(scs, []byte(HardforkKey), context.Conf)
+		if err != nil {
+			return nil, err
+		}
+		events, err = createSetEvent(receiver.ID(), HardforkKey, context.Conf.Values)
+		if err != nil {
+			return nil, err
+		}
 	case ChangeCluster:
 		if bs.CCProposal != nil {
 			return nil, ErrTxEnterpriseAlreadyIncludeChangeCluster
@@ -29,83 +29,83 @@ func TestBasicFailEnterprise(t *testing.T) {
 	defer deinitTest()
 
 	tx := &types.TxBody{}
-	testBlockNo := types.BlockNo(1)
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
 
-	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "empty body")
 	tx.Payload = []byte("invalid")
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "invalid body")
 	tx.Payload = []byte("{}")
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "empty json")
 	tx.Payload = []byte(`{"name":"enableConf"}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "empty arg in enable conf")
 	tx.Payload = []byte(`{"name":"setConf"}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "empty arg in set conf")
 	tx.Payload = []byte(`{"name":"enableConf", "args":["raft",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "admin is not set when enble conf")
 	tx.Payload = []byte(`{"name":"setConf", "args":["raft","thisisraftid1", "thisisraftid2"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "admin is not set when set conf")
 	tx.Payload = []byte(`{"name":"setAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "invalid arg in set admin")
 	tx.Payload = []byte(`{"name":"setAdmin", "args":[]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "invalid arg in set admin")
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "set admin")
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmLqZFnwMLqLg5fMshgzmfvwBP8uiYGgfV3tBZAm36Tv7jFYcs4f"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "set admin")
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmLqZFnwMLqLg5fMshgzmfvwBP8uiYGgfV3tBZAm36Tv7jFYcs4f"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "set same admin permission")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["admins", "AmLqZFnwMLqLg5fMshgzmfvwBP8uiYGgfV3tBZAm36Tv7jFYcs4f"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "not allowed key")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions", "AmLqZ\FnwMLqLg5fMshgzmfvwBP8uiYGgfV3tBZAm36Tv7jFYcs4f"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "not allowed char")
 
 	tx.Payload = []byte(`{"name":"setConf", "args":["p2pwhite","{\"peerid\":\"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n\"}", "{\"peerid\":\"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n\"}", "{\"peerid\":\"16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9\"}"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "duplicate arguments")
 
 	tx.Payload = []byte(`{"name":"setConf", "args":["p2pwhite","{\"peerid\":\"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n\"}", "{\"peerid\":\"16Uiu2HAm4xYtGsqk7WGKUxr8prfVpJ25hD23AQ3Be6anEL9Kxkgw\"}", "{\"peerid\":\"16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9\"}"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "set conf")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["p2pwhite","16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "duplicated set conf")
 
 	tx.Payload = []byte(`{"name":"setConf", "args":["rpcpermissions","dGVzdAo=:R", "dGVzdDIK:S", "dGVzdDMK:C"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "set conf")
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["rpcpermissions",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "enable conf")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","dGVzdAo=:WR"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "append conf")
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["rpcpermissions",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "enable conf")
 
 	tx.Payload = []byte(`{"name":"removeConf", "args":["rpcpermissions","dGVzdAo=:WR"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "remove conf")
 }
 
@@ -114,15 +114,15 @@ func TestBasicEnterprise(t *testing.T) {
 	defer deinitTest()
 
 	tx := &types.TxBody{}
-	testBlockNo := types.BlockNo(1)
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
-	event, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 	assert.Equal(t, "Append ADMIN", event[0].EventName, "append admin event")
 	assert.Equal(t, "\"AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4\"", event[0].JsonArgs, "append admin event")
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 	admins, err := getAdmins(scs)
 	assert.NoError(t, err, "get after appending admin")
@@ -131,7 +131,7 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.Equal(t, "AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7", types.EncodeAddress(admins[1]), "check admin")
 
 	tx.Payload = []byte(`{"name":"removeAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "remove admin")
 	assert.Equal(t, "Remove ADMIN", event[0].EventName, "append admin event")
 	assert.Equal(t, "\"AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7\"", event[0].JsonArgs, "append admin event")
@@ -141,7 +141,7 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.Equal(t, "AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4", types.EncodeAddress(admins[0]), "check admin")
 
 	tx.Payload = []byte(`{"name":"setConf", "args":["p2pwhite","{\"peerid\":\"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n\"}", "{\"peerid\":\"16Uiu2HAm4xYtGsqk7WGKUxr8prfVpJ25hD23AQ3Be6anEL9Kxkgw\"}", "{\"peerid\":\"16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9\"}"]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "set conf")
 	assert.Equal(t, "Set P2PWHITE", event[0].EventName, "append admin event")
 	conf, err := getConf(scs, []byte("P2PWhite")) //key is ignore case
@@ -152,7 +152,7 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.Equal(t, `{"peerid":"16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9"}`, conf.Values[2], "conf value 2")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["p2pwhite","{\"peerid\":\"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B\"}"]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	t.Log(event)
 	assert.NoError(t, err, "set conf")
 	assert.Equal(t, "Set P2PWHITE", event[0].EventName, "append admin event")
@@ -162,7 +162,7 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.Equal(t, `{"peerid":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}`, conf.Values[3], "conf value 3")
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["p2pwhite",true]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	t.Log(event)
 	assert.NoError(t, err, "enable conf")
 	conf, err = getConf(scs, []byte("p2pwhite"))
@@ -172,7 +172,7 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.NotNil(t, block, "parse value 0")
 	cert := types.EncodeB64(block.Bytes)
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","` + cert + `:RWCS"]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add conf")
 	conf, err = getConf(scs, []byte("rpcpermissions"))
 	assert.Equal(t, false, conf.On, "conf on")
@@ -181,12 +181,12 @@ func TestBasicEnterprise(t *testing.T) {
 	assert.Equal(t, "RWCS", strings.Split(conf.Values[0], ":")[1], "conf value 1")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","` + strings.Split(conf.Values[0], ":")[0] + `:RWCS"]}`)
-	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	event, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, "dup add conf")
 	t.Log(event)
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["p2pwhite",false]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "enable conf")
 	conf, err = getConf(scs, []byte("p2pwhite"))
 	assert.Equal(t, false, conf.On, "conf on")
@@ -199,72 +199,218 @@ func TestEnterpriseChangeCluster(t *testing.T) {
 	defer deinitTest()
 
 	tx := &types.TxBody{}
-	testBlockNo := types.BlockNo(1)
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
-	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 
 	bs := state.NewBlockState(&state.StateDB{})
 	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "add", "name": "aergonew", "address": "/ip4/127.0.0.1/tcp/11001", "peerid":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}]}`)
-	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err)
 	assert.NotNil(t, bs.CCProposal)
 
 	bs = state.NewBlockState(&state.StateDB{})
 	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "remove", "id": "1234"}]}`)
-	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err)
 	assert.NotNil(t, bs.CCProposal)
 
+	bs = state.NewBlockState(&state.StateDB{})
+	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "addlearner", "name": "aergolearner", "address": "/ip4/127.0.0.1/tcp/11002", "peerid":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}]}`)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err)
+	assert.NotNil(t, bs.CCProposal)
+
+	bs = state.NewBlockState(&state.StateDB{})
+	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "promote", "id": "1234"}]}`)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err)
+	assert.NotNil(t, bs.CCProposal)
+
+	req, err := ValidateChangeCluster(types.CallInfo{Args: []interface{}{map[string]interface{}{"command": "promote", "id": "1234"}}}, testBlockInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, types.MembershipChangeType_PROMOTE_LEARNER, req.(*types.MembershipChange).Type)
+	assert.Equal(t, uint64(0x1234), req.(*types.MembershipChange).Attr.ID)
+
+	// learners are not supported before V3
+	v2BlockInfo := &types.BlockHeaderInfo{No: 1, Version: 2}
+	bs = state.NewBlockState(&state.StateDB{})
+	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "addlearner", "name": "aergolearner", "address": "/ip4/127.0.0.1/tcp/11002", "peerid":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}]}`)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, v2BlockInfo)
+	assert.Error(t, err)
+	assert.Nil(t, bs.CCProposal)
+	_, err = ValidateChangeCluster(types.CallInfo{Args: []interface{}{map[string]interface{}{"command": "promote", "id": "1234"}}}, v2BlockInfo)
+	assert.Error(t, err)
+	_, err = ValidateChangeCluster(types.CallInfo{Args: []interface{}{map[string]interface{}{"command": "remove", "id": "1234"}}}, v2BlockInfo)
+	assert.NoError(t, err)
+
 	bs = state.NewBlockState(&state.StateDB{})
 	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "nocmd", "name": "aergonew", "address": "/ip4/127.0.0.1/tcp/11001", "PeerID":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}]}`)
-	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err)
 	assert.Nil(t, bs.CCProposal)
 
 	bs = state.NewBlockState(&state.StateDB{})
 	tx.Payload = []byte(`{"name":"changeCluster", "args":[{"command" : "add", "name": "aergonew", "address": "http://127.0.0.1:1001", "peerid":"16Uiu2HAmAAtqye6QQbeG9EZnrWJbGK8Xw74cZxpnGGEAZAB3zJ8B"}]}`)
-	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(bs, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err)
 	assert.Nil(t, bs.CCProposal)
 }
 
+func TestEnterpriseChangeValidator(t *testing.T) {
+	const (
+		initial = "16Uiu2HAkuxyDkMTQTGFpmnex2SdfTVzYfPztTyK339rqUdsv3ZUa"
+		added   = "16Uiu2HAkvJTHFuJXxr15rFEHsJWnyn1QvGatW2E9ED9Mvy4HWjVF"
+	)
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	tx := &types.TxBody{}
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
+
+	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err, "add admin")
+
+	consensus.SetCurConsensus("raft")
+	tx.Payload = []byte(`{"name":"changeValidator", "args":[{"command" : "add", "peerid":"` + added + `"}]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Equal(t, ErrNotSupportedMethod, err, "only for bft")
+
+	consensus.SetCurConsensus("bft")
+	assert.NoError(t, InitValidators(scs, []string{initial}))
+	validators, err := getValidators(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{initial}, validators, "genesis validators")
+
+	events, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "Set VALIDATORS", events[0].EventName)
+	validators, err = getValidators(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{initial, added}, validators)
+
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "duplicated validator")
+
+	tx.Payload = []byte(`{"name":"changeValidator", "args":[{"command" : "remove", "peerid":"` + initial + `"}]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err)
+	validators, err = getValidators(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{added}, validators)
+
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "not a validator")
+
+	tx.Payload = []byte(`{"name":"changeValidator", "args":[{"command" : "remove", "peerid":"` + added + `"}]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "the last validator")
+
+	tx.Payload = []byte(`{"name":"changeValidator", "args":[{"command" : "add", "peerid":"invalid"}]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "invalid peer id")
+}
+
+func TestEnterpriseScheduleHardfork(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	tx := &types.TxBody{}
+	testBlockInfo := &types.BlockHeaderInfo{No: 100, Version: 3}
+
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V4", "1000"]}`)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "admin is not set")
+
+	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err, "add admin")
+
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["v4", "1000"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, &types.BlockHeaderInfo{No: 100, Version: 2})
+	assert.Error(t, err, "not supported before V3")
+	events, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "Set HARDFORK", events[0].EventName)
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V5", "2000"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.NoError(t, err, "the version unknown to the node")
+
+	schedule, err := getHardforkSchedule(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]types.BlockNo{"V4": 1000, "V5": 2000}, schedule)
+
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V4", "3000"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "V4 after V5")
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V6", "50"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "past block")
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V1", "5000"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "invalid version")
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V6", 5000]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "block number must be a string")
+	tx.Payload = []byte(`{"name":"setConf", "args":["hardfork", "V3:10"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "not allowed key")
+
+	// the version activated by the node config can't be scheduled
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V3", "1500"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
+	assert.Error(t, err, "activated by the block version")
+
+	// the activated version can't be rescheduled
+	tx.Payload = []byte(`{"name":"scheduleHardfork", "args":["V4", "1500"]}`)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, &types.BlockHeaderInfo{No: 1000, Version: 3})
+	assert.Error(t, err, "already activated")
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, &types.BlockHeaderInfo{No: 999, Version: 3})
+	assert.NoError(t, err)
+
+	schedule, err = getHardforkSchedule(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]types.BlockNo{"V4": 1500, "V5": 2000}, schedule)
+}
+
 func TestCheckArgs(t *testing.T) {
 	scs, sender, receiver := initTest(t)
 	defer deinitTest()
 
 	tx := &types.TxBody{}
-	testBlockNo := types.BlockNo(1)
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
-	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 
 	block, _ := pem.Decode([]byte(testCert))
 	assert.NotNil(t, block, "parse value 0")
 	cert := types.EncodeB64(block.Bytes)
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","` + cert + `:RWCS"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, RPCPermissions)
 
 	//missing permission string
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","` + cert + `"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, RPCPermissions)
 
 	//invalid rpc cert
 	tx.Payload = []byte(`{"name":"appendConf", "args":["rpcpermissions","-+TEST+-:RWCS"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, RPCPermissions)
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["accountwhite","AmMMFgzR14wdQBTCCuyXQj3NYrBenecCmurutTqPqqBZ9TEY2z7c"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, AccountWhite)
 
 	//invalid account address
 	tx.Payload = []byte(`{"name":"appendConf", "args":["accountwhite","BmMMFgzR14wdQBTCCuyXQj3NYrBenecCmurutTqPqqBZ9TEY2z7c"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.Error(t, err, AccountWhite)
 }
 
@@ -273,44 +419,44 @@ func TestEnterpriseAdminAccountWhitelist(t *testing.T) {
 	defer deinitTest()
 
 	tx := &types.TxBody{}
-	testBlockNo := types.BlockNo(1)
+	testBlockInfo := &types.BlockHeaderInfo{No: 1, Version: 3}
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
-	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err := ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["accountwhite","AmMMFgzR14wdQBTCCuyXQj3NYrBenecCmurutTqPqqBZ9TEY2z7c"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, AccountWhite)
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["accountwhite",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.EqualError(t, err, "the values of ACCOUNTWHITE should have at least one admin address", AccountWhite)
 
 	tx.Payload = []byte(`{"name":"appendConf", "args":["accountwhite","AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, AccountWhite)
 
 	tx.Payload = []byte(`{"name":"removeAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "remove admin")
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["accountwhite",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.EqualError(t, err, "the values of ACCOUNTWHITE should have at least one admin address", AccountWhite)
 
 	tx.Payload = []byte(`{"name":"appendAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, "add admin")
 
 	tx.Payload = []byte(`{"name":"enableConf", "args":["accountwhite",true]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.NoError(t, err, AccountWhite)
 
 	tx.Payload = []byte(`{"name":"removeAdmin", "args":["AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7"]}`)
-	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockNo)
+	_, err = ExecuteEnterpriseTx(nil, ccc, scs, tx, sender, receiver, testBlockInfo)
 	assert.EqualError(t, err, "admin is in the account whitelist: AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7", AccountWhite)
 }
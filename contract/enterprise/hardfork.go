@@ -0,0 +1,111 @@
+package enterprise
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// HardforkKey is the key of the hardfork versions scheduled by
+// ScheduleHardfork. Each value is a version and its block number such as
+// "V3:1000".
+const HardforkKey = "HARDFORK"
+
+// GetHardforkSchedule returns the block numbers of the hardfork versions
+// scheduled in the state of r.
+func GetHardforkSchedule(r AccountStateReader) (map[string]types.BlockNo, error) {
+	scs, err := r.GetEnterpriseAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return getHardforkSchedule(scs)
+}
+
+func getHardforkSchedule(scs *state.ContractState) (map[string]types.BlockNo, error) {
+	conf, err := getConf(scs, []byte(HardforkKey))
+	if err != nil {
+		return nil, err
+	}
+	schedule := make(map[string]types.BlockNo)
+	if conf == nil {
+		return schedule, nil
+	}
+	for _, v := range conf.Values {
+		kv := strings.SplitN(v, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid hardfork schedule: %s", v)
+		}
+		h, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hardfork schedule: %s", v)
+		}
+		schedule[kv[0]] = h
+	}
+	return schedule, nil
+}
+
+// ValidateScheduleHardfork returns the hardfork schedule changed by the
+// request in ci. A version can be rescheduled until it's activated, and the
+// versions must be activated in order. A version is activated if it's not
+// newer than the version of the current block, even though it isn't in the
+// schedule. args[0] : version such as "V3", args[1] : block number
+func ValidateScheduleHardfork(ci types.CallInfo, scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (*Conf, error) {
+	if len(ci.Args) != 2 {
+		return nil, fmt.Errorf("invalid arguments in payload for ScheduleHardfork: %s", ci.Args)
+	}
+	version, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid version argument of ScheduleHardfork: %v", ci.Args[0])
+	}
+	ver, err := config.ParseHardforkVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	version = strings.ToUpper(version)
+	if ver <= uint64(blockInfo.Version) {
+		return nil, fmt.Errorf("hardfork %s is already activated by the block version %d", version, blockInfo.Version)
+	}
+	arg, ok := ci.Args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid block number argument of ScheduleHardfork: %v", ci.Args[1])
+	}
+	height, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block number argument of ScheduleHardfork: %s", arg)
+	}
+	if height <= blockInfo.No {
+		return nil, fmt.Errorf("hardfork must be scheduled at a future block: %d", height)
+	}
+
+	schedule, err := getHardforkSchedule(scs)
+	if err != nil {
+		return nil, err
+	}
+	if prev, exist := schedule[version]; exist && prev <= blockInfo.No {
+		return nil, fmt.Errorf("hardfork %s is already activated at %d", version, prev)
+	}
+	schedule[version] = height
+
+	versions := make([]string, 0, len(schedule))
+	for v := range schedule {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		vi, _ := config.ParseHardforkVersion(versions[i])
+		vj, _ := config.ParseHardforkVersion(versions[j])
+		return vi < vj
+	})
+	conf := &Conf{On: true}
+	for i, v := range versions {
+		if i > 0 && schedule[versions[i-1]] > schedule[v] {
+			return nil, fmt.Errorf("hardfork %s must not be activated before %s", v, versions[i-1])
+		}
+		conf.AppendValue(v + ":" + strconv.FormatUint(schedule[v], 10))
+	}
+	return conf, nil
+}
@@ -21,11 +21,13 @@ const RemoveAdmin = "removeAdmin"
 const EnableConf = "enableConf"
 const DisableConf = "disableConf"
 const ChangeCluster = "changeCluster"
+const ChangeValidator = "changeValidator"
+const ScheduleHardfork = "scheduleHardfork"
 
 var ErrTxEnterpriseAdminIsNotSet = errors.New("admin is not set")
 
 func ValidateEnterpriseTx(tx *types.TxBody, sender *state.V,
-	scs *state.ContractState, blockNo types.BlockNo) (*EnterpriseContext, error) {
+	scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (*EnterpriseContext, error) {
 	var ci types.CallInfo
 	if err := json.Unmarshal(tx.Payload, &ci); err != nil {
 		return nil, err
@@ -161,7 +163,7 @@ func ValidateEnterpriseTx(tx *types.TxBody, sender *state.V,
 			return nil, ErrNotSupportedMethod
 		}
 
-		cc, err := ValidateChangeCluster(ci, blockNo)
+		cc, err := ValidateChangeCluster(ci, blockInfo)
 		if err != nil {
 			return nil, err
 		}
@@ -173,6 +175,35 @@ func ValidateEnterpriseTx(tx *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Admins = admins
+
+	case ChangeValidator:
+		if !consensus.UseBFT() {
+			return nil, ErrNotSupportedMethod
+		}
+
+		admins, err := checkAdmin(scs, sender.ID())
+		if err != nil {
+			return nil, err
+		}
+		context.Admins = admins
+
+		if context.Conf, err = ValidateChangeValidator(ci, scs); err != nil {
+			return nil, err
+		}
+	case ScheduleHardfork:
+		// the governance schedules hardforks since the hardfork V3.
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("%s is not supported before V3", ci.Name)
+		}
+		admins, err := checkAdmin(scs, sender.ID())
+		if err != nil {
+			return nil, err
+		}
+		context.Admins = admins
+
+		if context.Conf, err = ValidateScheduleHardfork(ci, scs, blockInfo); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unsupported call %s", ci.Name)
 	}
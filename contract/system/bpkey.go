@@ -0,0 +1,266 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/mr-tron/base58"
+)
+
+var (
+	bpKeyPrefix       = []byte("bpkey")
+	bpKeyOwnerPrefix  = []byte("bpowner")
+	bpKeyRetirePrefix = []byte("bpretired")
+
+	ErrRetiredBPKey  = errors.New("signing key of block producer is retired by rotation")
+	ErrBPKeyRotation = errors.New("BP key rotation must be effective after the last one")
+	ErrBPKeyBound    = errors.New("key is already bound to another block producer")
+)
+
+type rotateBPKeyCmd struct {
+	*SystemContext
+}
+
+func newRotateBPKeyCmd(ctx *SystemContext) (sysCmd, error) {
+	return &rotateBPKeyCmd{SystemContext: ctx}, nil
+}
+
+// run binds the voted identity of the block producer to the new signing key.
+// The votes for the block producer are kept, while the blocks from the height
+// of the rotation must be signed by the new key. The key replaced by the
+// rotation is recorded as retired from the height.
+func (c *rotateBPKeyCmd) run() (*types.Event, error) {
+	bpID := types.PeerID(c.Rotation.BpID)
+	history, err := getBPKeyHistory(c.scs, bpID)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := effectiveKeyID(c.scs, bpID, c.Rotation.BlockNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := retireBPKey(c.scs, replaced, c.Rotation.BlockNo); err != nil {
+		return nil, err
+	}
+	history.Rotations = append(history.Rotations, c.Rotation)
+	if err := setBPKeyHistory(c.scs, bpID, history); err != nil {
+		return nil, err
+	}
+	if err := c.scs.SetData(bpKeyOwnerKeyOf(c.NewBPKey), []byte(bpID)); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: c.Receiver.ID(),
+		EventIdx:        0,
+		EventName:       c.op.ID(),
+		JsonArgs: `["` + types.EncodeAddress(c.Sender.ID()) +
+			`", "` + base58.Encode([]byte(bpID)) +
+			`", "` + base58.Encode([]byte(c.NewBPKey)) +
+			`", ` + strconv.FormatUint(c.Rotation.BlockNo, 10) + `]`,
+	}, nil
+}
+
+// validateForRotateBPKey checks the rotation given as the base58 encoded
+// argument and returns it with the ID of the new key. The rotation must be
+// signed by the key of the block producer effective at the current block.
+func validateForRotateBPKey(ci *types.CallInfo, scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (*types.BPKeyRotation, types.PeerID, error) {
+	if blockInfo.Version < 3 || consensusType != "dpos" {
+		return nil, "", fmt.Errorf("not supported operation")
+	}
+	if len(ci.Args) != 1 {
+		return nil, "", types.ErrTxInvalidPayload
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, "", types.ErrTxInvalidPayload
+	}
+	raw, err := base58.Decode(encoded)
+	if err != nil {
+		return nil, "", types.ErrTxInvalidPayload
+	}
+	rotation := &types.BPKeyRotation{}
+	if err := proto.Unmarshal(raw, rotation); err != nil {
+		return nil, "", types.ErrTxInvalidPayload
+	}
+	// the chain ID is unknown while the tx is validated in mempool.
+	if len(blockInfo.ChainId) != 0 &&
+		!types.ChainIdEqualWithoutVersion(rotation.GetChainID(), blockInfo.ChainId) {
+		return nil, "", fmt.Errorf("BP key rotation of other chain")
+	}
+	bpID, err := types.IDFromBytes(rotation.GetBpID())
+	if err != nil {
+		return nil, "", types.ErrTxInvalidPayload
+	}
+	if rotation.GetBlockNo() <= blockInfo.No {
+		return nil, "", fmt.Errorf("BP key rotation must be effective from a future block: %d", rotation.GetBlockNo())
+	}
+
+	history, err := getBPKeyHistory(scs, bpID)
+	if err != nil {
+		return nil, "", err
+	}
+	if n := len(history.Rotations); n > 0 && rotation.GetBlockNo() <= history.Rotations[n-1].GetBlockNo() {
+		return nil, "", ErrBPKeyRotation
+	}
+	current, err := signingKey(history, bpID, blockInfo.No)
+	if err != nil {
+		return nil, "", err
+	}
+	newID, err := rotation.Verify(current)
+	if err != nil {
+		return nil, "", err
+	}
+	if owner, err := getBPKeyOwner(scs, newID); err != nil {
+		return nil, "", err
+	} else if len(owner) != 0 && owner != bpID {
+		return nil, "", ErrBPKeyBound
+	}
+	return rotation, newID, nil
+}
+
+// GetBPKeyHistory returns the signing key rotations of the block producer.
+func GetBPKeyHistory(ar AccountStateReader, bpID types.PeerID) (*types.BPKeyHistory, error) {
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return getBPKeyHistory(scs, bpID)
+}
+
+// ResolveBlockProducer returns the voted identity of the block producer which
+// signs blocks at blockNo by the key of signer. It returns ErrRetiredBPKey if
+// signer is a key which a rotation has replaced at or before blockNo, unless
+// it is the effective key of its block producer again.
+func ResolveBlockProducer(scs dataGetter, signer types.PeerID, blockNo types.BlockNo) (types.PeerID, error) {
+	owner, err := getBPKeyOwner(scs, signer)
+	if err != nil {
+		return "", err
+	}
+	if len(owner) != 0 && owner != signer {
+		if id, err := effectiveKeyID(scs, owner, blockNo); err != nil {
+			return "", err
+		} else if id == signer {
+			return owner, nil
+		}
+	}
+	if retiredAt, retired, err := getBPKeyRetirement(scs, signer); err != nil {
+		return "", err
+	} else if retired && blockNo >= retiredAt && owner != signer {
+		return "", ErrRetiredBPKey
+	}
+	id, err := effectiveKeyID(scs, signer, blockNo)
+	if err != nil {
+		return "", err
+	}
+	if id != signer {
+		return "", ErrRetiredBPKey
+	}
+	return signer, nil
+}
+
+// effectiveKeyID returns the ID of the key by which the block producer signs
+// blocks at blockNo.
+func effectiveKeyID(scs dataGetter, bpID types.PeerID, blockNo types.BlockNo) (types.PeerID, error) {
+	history, err := getBPKeyHistory(scs, bpID)
+	if err != nil {
+		return "", err
+	}
+	r := effectiveRotation(history, blockNo)
+	if r == nil {
+		return bpID, nil
+	}
+	pubKey, err := crypto.UnmarshalPublicKey(r.GetPubKey())
+	if err != nil {
+		return "", err
+	}
+	return types.IDFromPublicKey(pubKey)
+}
+
+// signingKey returns the public key by which the block producer signs blocks
+// at blockNo. It's the one embedded in the ID of the block producer if the key
+// has never been rotated.
+func signingKey(history *types.BPKeyHistory, bpID types.PeerID, blockNo types.BlockNo) (crypto.PubKey, error) {
+	if r := effectiveRotation(history, blockNo); r != nil {
+		return crypto.UnmarshalPublicKey(r.GetPubKey())
+	}
+	return bpID.ExtractPublicKey()
+}
+
+func effectiveRotation(history *types.BPKeyHistory, blockNo types.BlockNo) *types.BPKeyRotation {
+	for i := len(history.Rotations) - 1; i >= 0; i-- {
+		if r := history.Rotations[i]; r.GetBlockNo() <= blockNo {
+			return r
+		}
+	}
+	return nil
+}
+
+func bpKeyKeyOf(bpID types.PeerID) []byte {
+	return append(append([]byte{}, bpKeyPrefix...), bpID...)
+}
+
+func bpKeyOwnerKeyOf(id types.PeerID) []byte {
+	return append(append([]byte{}, bpKeyOwnerPrefix...), id...)
+}
+
+func bpKeyRetireKeyOf(id types.PeerID) []byte {
+	return append(append([]byte{}, bpKeyRetirePrefix...), id...)
+}
+
+func getBPKeyHistory(scs dataGetter, bpID types.PeerID) (*types.BPKeyHistory, error) {
+	data, err := scs.GetData(bpKeyKeyOf(bpID))
+	if err != nil {
+		return nil, err
+	}
+	history := &types.BPKeyHistory{}
+	if err := proto.Unmarshal(data, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func setBPKeyHistory(scs *state.ContractState, bpID types.PeerID, history *types.BPKeyHistory) error {
+	data, err := proto.Marshal(history)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(bpKeyKeyOf(bpID), data)
+}
+
+func getBPKeyOwner(scs dataGetter, id types.PeerID) (types.PeerID, error) {
+	data, err := scs.GetData(bpKeyOwnerKeyOf(id))
+	if err != nil {
+		return "", err
+	}
+	return types.PeerID(data), nil
+}
+
+// retireBPKey records that the key is retired from blockNo. The first
+// retirement is kept, since a key used again is resolved by the rotations of
+// its block producer while it's effective.
+func retireBPKey(scs *state.ContractState, id types.PeerID, blockNo types.BlockNo) error {
+	if _, retired, err := getBPKeyRetirement(scs, id); err != nil || retired {
+		return err
+	}
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, blockNo)
+	return scs.SetData(bpKeyRetireKeyOf(id), data)
+}
+
+func getBPKeyRetirement(scs dataGetter, id types.PeerID) (types.BlockNo, bool, error) {
+	data, err := scs.GetData(bpKeyRetireKeyOf(id))
+	if err != nil || len(data) < 8 {
+		return 0, false, err
+	}
+	return binary.LittleEndian.Uint64(data), true, nil
+}
@@ -0,0 +1,159 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateBPKey(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	var keys []crypto.PrivKey
+	var ids []types.PeerID
+	for i := 0; i < 4; i++ {
+		key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := types.IDFromPrivateKey(key)
+		keys = append(keys, key)
+		ids = append(ids, id)
+	}
+	bpID := ids[0]
+	chainID := []byte("chain")
+
+	rotateTx := func(signer, next crypto.PrivKey, blockNo types.BlockNo) *types.TxBody {
+		rotation, err := types.NewBPKeyRotation(bpID, next.GetPublic(), blockNo, chainID)
+		assert.NoError(t, err)
+		assert.NoError(t, rotation.Sign(signer, next))
+		raw, err := proto.Marshal(rotation)
+		assert.NoError(t, err)
+		tx := &types.TxBody{
+			Account:   sender.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Payload:   []byte(`{"Name":"v1rotateBPKey", "Args":["` + base58.Encode(raw) + `"]}`),
+		}
+		assert.NoError(t, types.ValidateSystemTx(tx))
+		return tx
+	}
+
+	blockInfo := &types.BlockHeaderInfo{No: 100, Version: 2, ChainId: chainID}
+	_, err := ExecuteSystemTx(scs, rotateTx(keys[0], keys[1], 200), sender, receiver, blockInfo)
+	assert.Error(t, err, "before v3")
+
+	blockInfo.Version = 3
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[0], keys[1], 100), sender, receiver, blockInfo)
+	assert.Error(t, err, "not a future block")
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[2], keys[1], 200), sender, receiver, blockInfo)
+	assert.Equal(t, types.ErrBPKeyRotationSignature, err)
+
+	events, err := ExecuteSystemTx(scs, rotateTx(keys[0], keys[1], 200), sender, receiver, blockInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, types.OprotateBPKey.ID(), events[0].EventName)
+
+	for _, tc := range []struct {
+		signer  types.PeerID
+		blockNo types.BlockNo
+		id      types.PeerID
+		err     error
+	}{
+		{ids[0], 199, bpID, nil},
+		{ids[1], 199, ids[1], nil},
+		{ids[0], 200, "", ErrRetiredBPKey},
+		{ids[1], 200, bpID, nil},
+		{ids[2], 200, ids[2], nil},
+	} {
+		id, err := ResolveBlockProducer(scs, tc.signer, tc.blockNo)
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.id, id)
+	}
+
+	// the next rotation must be signed by the key effective at the block.
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[0], keys[2], 300), sender, receiver, blockInfo)
+	assert.NoError(t, err)
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[0], keys[3], 300), sender, receiver, blockInfo)
+	assert.Equal(t, ErrBPKeyRotation, err)
+
+	blockInfo.No = 250
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[0], keys[3], 400), sender, receiver, blockInfo)
+	assert.Equal(t, types.ErrBPKeyRotationSignature, err)
+	_, err = ExecuteSystemTx(scs, rotateTx(keys[1], keys[3], 400), sender, receiver, blockInfo)
+	assert.NoError(t, err)
+
+	id, err := ResolveBlockProducer(scs, ids[2], 300)
+	assert.NoError(t, err)
+	assert.Equal(t, bpID, id)
+	history, err := GetBPKeyHistory(&TestAccountStateReader{Scs: scs}, bpID)
+	assert.NoError(t, err)
+	assert.Len(t, history.Rotations, 3)
+}
+
+func TestResolveRetiredBPKey(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	var keys []crypto.PrivKey
+	var ids []types.PeerID
+	for i := 0; i < 3; i++ {
+		key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := types.IDFromPrivateKey(key)
+		keys = append(keys, key)
+		ids = append(ids, id)
+	}
+	bpID := ids[0]
+	chainID := []byte("chain")
+	blockInfo := &types.BlockHeaderInfo{No: 100, Version: 3, ChainId: chainID}
+
+	rotate := func(signer, next crypto.PrivKey, blockNo types.BlockNo) {
+		rotation, err := types.NewBPKeyRotation(bpID, next.GetPublic(), blockNo, chainID)
+		assert.NoError(t, err)
+		assert.NoError(t, rotation.Sign(signer, next))
+		raw, err := proto.Marshal(rotation)
+		assert.NoError(t, err)
+		tx := &types.TxBody{
+			Account:   sender.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Payload:   []byte(`{"Name":"v1rotateBPKey", "Args":["` + base58.Encode(raw) + `"]}`),
+		}
+		_, err = ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+		assert.NoError(t, err)
+	}
+	// key0 -> key1 -> key2, and back to key1
+	rotate(keys[0], keys[1], 200)
+	rotate(keys[0], keys[2], 300)
+	blockInfo.No = 350
+	rotate(keys[2], keys[1], 400)
+
+	for _, tc := range []struct {
+		signer  types.PeerID
+		blockNo types.BlockNo
+		id      types.PeerID
+		err     error
+	}{
+		{ids[0], 250, "", ErrRetiredBPKey},
+		{ids[1], 250, bpID, nil},
+		// the retired key isn't resolved as its own identity
+		{ids[1], 300, "", ErrRetiredBPKey},
+		{ids[1], 350, "", ErrRetiredBPKey},
+		{ids[2], 300, bpID, nil},
+		{ids[1], 400, bpID, nil},
+		{ids[2], 400, "", ErrRetiredBPKey},
+		{ids[0], 400, "", ErrRetiredBPKey},
+	} {
+		id, err := ResolveBlockProducer(scs, tc.signer, tc.blockNo)
+		assert.Equal(t, tc.err, err, "signer %d at %d", indexOf(ids, tc.signer), tc.blockNo)
+		assert.Equal(t, tc.id, id, "signer %d at %d", indexOf(ids, tc.signer), tc.blockNo)
+	}
+}
+
+func indexOf(ids []types.PeerID, id types.PeerID) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
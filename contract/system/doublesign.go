@@ -0,0 +1,135 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/mr-tron/base58"
+)
+
+var (
+	penaltyKey      = []byte("penalty")
+	penaltyCountKey = []byte("penaltycount")
+
+	ErrAlreadyPenalized = errors.New("block producer already penalized")
+)
+
+type reportDoubleSignCmd struct {
+	*SystemContext
+}
+
+func newReportDoubleSignCmd(ctx *SystemContext) (sysCmd, error) {
+	return &reportDoubleSignCmd{SystemContext: ctx}, nil
+}
+
+// run excludes the double-signing block producer from the BP election. The
+// votes for it are kept, so that the voters may move them to others. The
+// penalty never expires: the block producer has to register a new identity to
+// be elected again.
+func (c *reportDoubleSignCmd) run() (*types.Event, error) {
+	if err := setPenalty(c.scs, c.Offender, c.BlockInfo.No); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: c.Receiver.ID(),
+		EventIdx:        0,
+		EventName:       c.op.ID(),
+		JsonArgs: `["` + types.EncodeAddress(c.Sender.ID()) +
+			`", "` + base58.Encode([]byte(c.Offender)) + `"]`,
+	}, nil
+}
+
+// validateForDoubleSign checks the evidence given as the base58 encoded
+// argument and returns the ID of the block producer who signed it.
+func validateForDoubleSign(ci *types.CallInfo, scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (types.PeerID, error) {
+	if blockInfo.Version < 3 || consensusType != "dpos" {
+		return "", fmt.Errorf("not supported operation")
+	}
+	if len(ci.Args) != 1 {
+		return "", types.ErrTxInvalidPayload
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return "", types.ErrTxInvalidPayload
+	}
+	raw, err := base58.Decode(encoded)
+	if err != nil {
+		return "", types.ErrTxInvalidPayload
+	}
+	evidence := &types.DoubleSignEvidence{}
+	if err := proto.Unmarshal(raw, evidence); err != nil {
+		return "", types.ErrTxInvalidPayload
+	}
+	// the chain ID is unknown while the tx is validated in mempool.
+	if len(blockInfo.ChainId) != 0 &&
+		!types.ChainIdEqualWithoutVersion(evidence.GetFirst().GetChainID(), blockInfo.ChainId) {
+		return "", fmt.Errorf("evidence of other chain")
+	}
+	signer, err := evidence.Verify(consensus.BlockInterval)
+	if err != nil {
+		return "", err
+	}
+	// the block producer is penalized by its voted identity, which may differ
+	// from the signing key after a key rotation.
+	id, err := ResolveBlockProducer(scs, signer, evidence.GetFirst().GetBlockNo())
+	if err != nil {
+		return "", err
+	}
+	penalized, err := isPenalized(scs, id)
+	if err != nil {
+		return "", err
+	}
+	if penalized {
+		return "", ErrAlreadyPenalized
+	}
+	return id, nil
+}
+
+func penaltyKeyOf(id types.PeerID) []byte {
+	return append(append([]byte{}, penaltyKey...), id...)
+}
+
+// setPenalty records the penalty of id, and counts it so that the election
+// loads only as many candidates as it may skip.
+func setPenalty(scs *state.ContractState, id types.PeerID, blockNo types.BlockNo) error {
+	count, err := penaltyCountOf(scs)
+	if err != nil {
+		return err
+	}
+	if err := scs.SetData(penaltyCountKey, serializeCount(count+1)); err != nil {
+		return err
+	}
+	return scs.SetData(penaltyKeyOf(id), types.BlockNoToBytes(blockNo))
+}
+
+// penaltyCountOf returns the number of the penalized block producers.
+func penaltyCountOf(scs dataGetter) (uint64, error) {
+	data, err := scs.GetData(penaltyCountKey)
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+func serializeCount(count uint64) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, count)
+	return data
+}
+
+func isPenalized(scs dataGetter, id types.PeerID) (bool, error) {
+	data, err := scs.GetData(penaltyKeyOf(id))
+	if err != nil {
+		return false, err
+	}
+	return len(data) != 0, nil
+}
@@ -0,0 +1,86 @@
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportDoubleSign(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	// 4 candidates for 3 BPs
+	var bps []types.PeerID
+	var offenderKey crypto.PrivKey
+	voteResult := make(map[string]*big.Int)
+	for i := 0; i < 4; i++ {
+		key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := types.IDFromPrivateKey(key)
+		bps = append(bps, id)
+		voteResult[base58.Encode([]byte(id))] = big.NewInt(int64(100 - i))
+		if i == 1 {
+			offenderKey = key
+		}
+	}
+	assert.NoError(t, InitVoteResult(scs, voteResult))
+	ar := &TestAccountStateReader{Scs: scs}
+	rankers, err := GetRankers(ar, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{bps[0].Pretty(), bps[1].Pretty(), bps[2].Pretty()}, rankers)
+
+	signed := func(prev string) *types.BlockHeader {
+		block := &types.Block{Header: &types.BlockHeader{
+			ChainID:       []byte("chain"),
+			PrevBlockHash: []byte(prev),
+			BlockNo:       10,
+			Timestamp:     consensus.BlockInterval.Nanoseconds() * 100,
+		}}
+		assert.NoError(t, block.Sign(offenderKey))
+		return block.Header
+	}
+	raw, err := proto.Marshal(types.NewDoubleSignEvidence(signed("a"), signed("b")))
+	assert.NoError(t, err)
+	tx := &types.TxBody{
+		Account:   sender.ID(),
+		Recipient: []byte(types.AergoSystem),
+		Payload:   []byte(`{"Name":"v1reportDoubleSign", "Args":["` + base58.Encode(raw) + `"]}`),
+	}
+	assert.NoError(t, types.ValidateSystemTx(tx))
+
+	blockInfo := &types.BlockHeaderInfo{No: 100, Version: 2}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.Error(t, err, "before v3")
+
+	blockInfo.Version = 3
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, types.OpreportDoubleSign.ID(), events[0].EventName)
+
+	// the next candidate takes the place of the penalized one
+	rankers, err = GetRankers(ar, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{bps[0].Pretty(), bps[2].Pretty(), bps[3].Pretty()}, rankers)
+	penalties, err := penaltyCountOf(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), penalties)
+	// but not before V3
+	rankers, err = GetRankers(ar, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{bps[0].Pretty(), bps[1].Pretty(), bps[2].Pretty()}, rankers)
+
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.Equal(t, ErrAlreadyPenalized, err)
+
+	// not conflicting
+	raw, _ = proto.Marshal(&types.DoubleSignEvidence{First: signed("c"), Second: signed("c")})
+	tx.Payload = []byte(`{"Name":"v1reportDoubleSign", "Args":["` + base58.Encode(raw) + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.Equal(t, types.ErrEvidenceNotConflict, err)
+}
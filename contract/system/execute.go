@@ -21,8 +21,11 @@ type SystemContext struct {
 	Call      *types.CallInfo
 	Args      []string
 	Staked    *types.Staking
-	Vote      *types.Vote // voting
-	Proposal  *Proposal   // voting
+	Vote      *types.Vote          // voting
+	Proposal  *Proposal            // voting
+	Offender  types.PeerID         // reporting double-sign
+	Rotation  *types.BPKeyRotation // rotating BP key
+	NewBPKey  types.PeerID         // rotating BP key
 	Sender    *state.V
 	Receiver  *state.V
 
@@ -62,10 +65,12 @@ func newSysCmd(account []byte, txBody *types.TxBody, sender, receiver *state.V,
 	scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (sysCmd, error) {
 
 	cmds := map[types.OpSysTx]sysCmdCtor{
-		types.OpvoteBP:    newVoteCmd,
-		types.OpvoteDAO: newVoteCmd,
-		types.Opstake:     newStakeCmd,
-		types.Opunstake:   newUnstakeCmd,
+		types.OpvoteBP:           newVoteCmd,
+		types.OpvoteDAO:          newVoteCmd,
+		types.Opstake:            newStakeCmd,
+		types.Opunstake:          newUnstakeCmd,
+		types.OpreportDoubleSign: newReportDoubleSignCmd,
+		types.OprotateBPKey:      newRotateBPKeyCmd,
 	}
 
 	context, err := newSystemContext(account, txBody, sender, receiver, scs, blockInfo)
@@ -47,6 +47,19 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Staked = staked
+	case types.OpreportDoubleSign:
+		offender, err := validateForDoubleSign(&ci, scs, blockInfo)
+		if err != nil {
+			return nil, err
+		}
+		context.Offender = offender
+	case types.OprotateBPKey:
+		rotation, newKey, err := validateForRotateBPKey(&ci, scs, blockInfo)
+		if err != nil {
+			return nil, err
+		}
+		context.Rotation = rotation
+		context.NewBPKey = newKey
 	case types.OpvoteDAO:
 		if blockInfo.Version < 2 {
 			return nil, fmt.Errorf("not supported operation")
@@ -360,17 +360,68 @@ func GetBpCount() int {
 	return int(GetParam(bpCount.ID()).Uint64())
 }
 
-// GetRankers returns the IDs of the top n rankers.
-func GetRankers(ar AccountStateReader) ([]string, error) {
+// BpCountOf returns the BP count in the storage of the system contract read
+// by g, or defaultCount if it has never been changed by voting.
+func BpCountOf(g dataGetter, defaultCount int) (int, error) {
+	data, err := g.GetData(genParamKey(bpCount.ID()))
+	if err != nil {
+		return 0, err
+	}
+	if data == nil {
+		return defaultCount, nil
+	}
+	return int(new(big.Int).SetBytes(data).Uint64()), nil
+}
+
+// GetRankers returns the IDs of the top n rankers. version is the block
+// version of the state read by ar.
+func GetRankers(ar AccountStateReader, version int32) ([]string, error) {
 	n := GetBpCount()
 
-	vl, err := GetVoteResult(ar, defaultVoteKey, n)
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return RankersOf(scs, n, version)
+}
+
+// RankersOf returns the IDs of the top n rankers in the storage of the system
+// contract read by g. Since V3, the penalized candidates are skipped, and only
+// as many candidates as may be skipped are loaded in addition.
+func RankersOf(g dataGetter, n int, version int32) ([]string, error) {
+	if version < 3 {
+		vl, err := getVoteResult(g, defaultVoteKey, n)
+		if err != nil {
+			return nil, err
+		}
+		bps := make([]string, 0, len(vl.Votes))
+		for _, v := range vl.Votes {
+			bps = append(bps, enc.ToString(v.Candidate))
+		}
+		return bps, nil
+	}
+
+	penalties, err := penaltyCountOf(g)
+	if err != nil {
+		return nil, err
+	}
+	vl, err := getVoteResult(g, defaultVoteKey, n+int(penalties))
 	if err != nil {
 		return nil, err
 	}
 
 	bps := make([]string, 0, n)
 	for _, v := range vl.Votes {
+		if len(bps) == n {
+			break
+		}
+		if penalties > 0 {
+			if penalized, err := isPenalized(g, types.PeerID(v.Candidate)); err != nil {
+				return nil, err
+			} else if penalized {
+				continue
+			}
+		}
 		bps = append(bps, enc.ToString(v.Candidate))
 	}
 	return bps, nil
@@ -436,9 +487,15 @@ func deserializeVoteEx(data []byte) *types.Vote {
 }
 
 func deserializeVoteList(data []byte, ex bool) *types.VoteList {
+	// every vote takes more than a byte.
+	return deserializeTopVotes(data, ex, len(data))
+}
+
+// deserializeTopVotes deserializes only the first n votes of the list.
+func deserializeTopVotes(data []byte, ex bool, n int) *types.VoteList {
 	vl := &types.VoteList{Votes: []*types.Vote{}}
 	var end int
-	for offset := 0; offset < len(data); offset = end {
+	for offset := 0; offset < len(data) && len(vl.Votes) < n; offset = end {
 		size := binary.LittleEndian.Uint64(data[offset : offset+8])
 		end = offset + 8 + int(size)
 		v := data[offset+8 : end]
@@ -95,6 +95,7 @@ func TestVoteResult(t *testing.T) {
 
 	result, err := getVoteResult(scs, defaultVoteKey, 23)
 	assert.NoError(t, err, "could not get vote result")
+	assert.Len(t, result.Votes, 23, "only the top votes are loaded")
 
 	oldAmount := new(big.Int).SetUint64((uint64)(math.MaxUint64))
 	for i, v := range result.Votes {
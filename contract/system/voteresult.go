@@ -180,7 +180,7 @@ func InitVoteResult(scs *state.ContractState, voteResult map[string]*big.Int) er
 	return res.Sync()
 }
 
-func getVoteResult(scs *state.ContractState, key []byte, n int) (*types.VoteList, error) {
+func getVoteResult(scs dataGetter, key []byte, n int) (*types.VoteList, error) {
 	data, err := scs.GetData(append(sortKey, key...))
 	if err != nil {
 		return nil, err
@@ -191,11 +191,7 @@ func getVoteResult(scs *state.ContractState, key []byte, n int) (*types.VoteList
 	} else {
 		ex = true
 	}
-	voteList := deserializeVoteList(data, ex)
-	if n < len(voteList.Votes) {
-		voteList.Votes = voteList.Votes[:n]
-	}
-	return voteList, nil
+	return deserializeTopVotes(data, ex, n), nil
 }
 
 func GetVoteResultEx(ar AccountStateReader, key []byte, n int) (*types.VoteList, error) {
@@ -1,8 +1,10 @@
 package merkle
 
 import (
-	"github.com/minio/sha256-simd"
+	"bytes"
 	"hash"
+
+	"github.com/minio/sha256-simd"
 )
 
 type MerkleEntry interface {
@@ -88,3 +90,47 @@ func CalculateMerkleTree(entries []MerkleEntry) [][]byte {
 
 	return merkles
 }
+
+// GetMerklePath returns the hashes of the siblings from the leaf of index up
+// to the root, in the tree built by CalculateMerkleTree.
+func GetMerklePath(merkles [][]byte, index int) [][]byte {
+	leafCount := (len(merkles) + 1) / 2
+	if index < 0 || index >= leafCount || merkles[index] == nil {
+		return nil
+	}
+	var path [][]byte
+	for start, width := 0, leafCount; width > 1; start, width = start+width, width/2 {
+		path = append(path, merkles[start+(index^1)])
+		index >>= 1
+	}
+	return path
+}
+
+// CalculateMerkleRootByPath returns the root computed from the leaf hash of
+// index and its merkle path. It returns nil if index is out of the tree of
+// the path, or if the path goes through a right child copied from its left
+// sibling by CalculateMerkleTree, since no leaf exists there. The leaves are
+// assumed to be distinct, as the hashes of txs and receipts are.
+func CalculateMerkleRootByPath(leaf []byte, index int, path [][]byte) []byte {
+	if index < 0 || index>>uint(len(path)) != 0 {
+		return nil
+	}
+	hasher := sha256.New()
+	node := leaf
+	for _, sibling := range path {
+		hasher.Reset()
+		if index&1 == 1 && bytes.Equal(node, sibling) {
+			return nil
+		}
+		if index&1 == 0 {
+			hasher.Write(node)
+			hasher.Write(sibling)
+		} else {
+			hasher.Write(sibling)
+			hasher.Write(node)
+		}
+		node = hasher.Sum(nil)
+		index >>= 1
+	}
+	return node
+}
@@ -52,7 +52,7 @@ func genMerkleEntry(h hash.Hash, i int) MerkleEntry {
 	tm := testME{}
 
 	h.Reset()
-	binary.Write(h, binary.LittleEndian, i)
+	binary.Write(h, binary.LittleEndian, int64(i))
 	tm.hash = h.Sum(nil)
 
 	return &tm
@@ -167,6 +167,32 @@ func TestMerkle32Tx(t *testing.T) {
 	assert.NotNil(t, merkleRoot)
 }
 
+func TestMerklePath(t *testing.T) {
+	for _, count := range []int{1, 2, 5, 11, 16} {
+		beforeTest(count)
+		merkles := CalculateMerkleTree(tms)
+		root := merkles[len(merkles)-1]
+
+		for i, tm := range tms {
+			path := GetMerklePath(merkles, i)
+			assert.True(t, bytes.Equal(root, CalculateMerkleRootByPath(tm.GetHash(), i, path)), "count %d, index %d", count, i)
+			// the other leaf isn't proved by the path
+			forged := sha256.Sum256(tm.GetHash())
+			assert.False(t, bytes.Equal(root, CalculateMerkleRootByPath(forged[:], i, path)), "count %d, index %d", count, i)
+		}
+		assert.Nil(t, GetMerklePath(merkles, len(merkles)))
+
+		// the copies of the last leaves aren't proved at the indexes out of
+		// the entries
+		last := len(tms) - 1
+		path := GetMerklePath(merkles, last)
+		for i := len(tms); i < 1<<uint(len(path)); i++ {
+			assert.False(t, bytes.Equal(root, CalculateMerkleRootByPath(tms[last].GetHash(), i, path)), "count %d, index %d", count, i)
+		}
+		assert.Nil(t, CalculateMerkleRootByPath(tms[last].GetHash(), last+1<<uint(len(path)), path), "count %d", count)
+	}
+}
+
 func BenchmarkMerkle10000Tx(b *testing.B) {
 	b.Log("BenchmarkMerkle10000Tx")
 	beforeTest(10000)
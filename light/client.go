@@ -0,0 +1,243 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/types"
+)
+
+var logger = log.NewLogger("light")
+
+var (
+	ErrNotSupported     = errors.New("not supported by light client")
+	ErrNameNotSupported = errors.New("light client can't resolve name, use the address of account")
+)
+
+const defaultMaxHeaders = 100
+
+// Backend is the source of headers and proofs, which is usually a full node.
+// Nothing returned from the backend is trusted: the headers are validated by
+// the header chain and the proofs are verified against them.
+type Backend interface {
+	// BestBlockNo returns the number of the best block of the backend.
+	BestBlockNo() (types.BlockNo, error)
+	// Headers returns at most size headers of the main chain from the block
+	// number from, in ascending order.
+	Headers(from types.BlockNo, size uint32) ([]*types.BlockHeader, error)
+	// StateProof returns merkle proofs for the query, which is based on the
+	// state root in query.Root.
+	StateProof(query *types.StateQuery) (*types.StateQueryProof, error)
+	// Receipts returns the binary form of all receipts of the block.
+	Receipts(blockHash []byte) ([]byte, error)
+}
+
+// Client is a light client, which follows the header chain of a full node
+// and answers queries by verifying merkle proofs fetched from it.
+type Client struct {
+	hc         *HeaderChain
+	backend    Backend
+	hardfork   types.BlockVersionner
+	maxHeaders uint32
+}
+
+// NewClient creates a light client. hardfork is needed to decode receipts,
+// whose binary form differs by the block version.
+func NewClient(hc *HeaderChain, backend Backend, hardfork types.BlockVersionner, maxHeaders uint32) *Client {
+	if maxHeaders == 0 {
+		maxHeaders = defaultMaxHeaders
+	}
+	return &Client{hc: hc, backend: backend, hardfork: hardfork, maxHeaders: maxHeaders}
+}
+
+// HeaderChain returns the header chain of the client.
+func (c *Client) HeaderChain() *HeaderChain {
+	return c.hc
+}
+
+// Sync fetches headers from the backend up to its best block, and returns the
+// number of inserted headers.
+func (c *Client) Sync() (int, error) {
+	target, err := c.backend.BestBlockNo()
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for {
+		best, _ := c.hc.Best()
+		from := best.GetBlockNo() + 1
+		if from > target {
+			return total, nil
+		}
+		size := c.maxHeaders
+		if target-from+1 < types.BlockNo(size) {
+			size = uint32(target - from + 1)
+		}
+		headers, err := c.backend.Headers(from, size)
+		if err != nil {
+			return total, err
+		}
+		if len(headers) == 0 {
+			return total, nil
+		}
+		inserted, err := c.hc.Insert(headers)
+		total += inserted
+		switch {
+		case err == ErrNotLinked:
+			// the backend is on the other fork. step back to find the common ancestor.
+			back := types.BlockNo(c.maxHeaders)
+			if best.GetBlockNo() < back {
+				back = best.GetBlockNo()
+			}
+			if c.hc.Rewind(best.GetBlockNo()-back) == best.GetBlockNo() {
+				return total, err
+			}
+			logger.Info().Uint64("from", best.GetBlockNo()).Msg("header chain rewound to find common ancestor")
+		case err != nil:
+			return total, err
+		}
+	}
+}
+
+// GetAccount returns the verified state of the account at the finalized block.
+func (c *Client) GetAccount(address []byte) (*types.State, error) {
+	h, _, err := c.hc.Finalized()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetAccountAt(h, address)
+}
+
+// GetAccountAt returns the verified state of the account at the block of
+// header. The state is empty if the account doesn't exist.
+func (c *Client) GetAccountAt(header *types.BlockHeader, address []byte) (*types.State, error) {
+	proof, err := c.QueryContractStateAt(header, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !proof.GetContractProof().GetInclusion() {
+		return &types.State{}, nil
+	}
+	return proof.GetContractProof().GetState(), nil
+}
+
+// QueryContractState returns the verified proof of the contract variables
+// of storageKeys at the finalized block. The storage keys are the keys of the
+// storage trie, as those of QueryContractState RPC.
+func (c *Client) QueryContractState(address []byte, storageKeys [][]byte) (*types.StateQueryProof, error) {
+	h, _, err := c.hc.Finalized()
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryContractStateAt(h, address, storageKeys)
+}
+
+// QueryContractStateAt returns the verified proof of the contract variables
+// of storageKeys at the block of header.
+func (c *Client) QueryContractStateAt(header *types.BlockHeader, address []byte, storageKeys [][]byte) (*types.StateQueryProof, error) {
+	if len(address) != types.AddressLength {
+		return nil, ErrNameNotSupported
+	}
+	root := header.GetBlocksRootHash()
+	proof, err := c.backend.StateProof(&types.StateQuery{
+		ContractAddress: address,
+		StorageKeys:     storageKeys,
+		Root:            root,
+		Compressed:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyStateQueryProof(root, address, storageKeys, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// GetReceipts returns the verified receipts of the block of blockHash, which
+// must be in the header chain.
+func (c *Client) GetReceipts(blockHash []byte) (*types.Receipts, error) {
+	header, err := c.hc.GetHeader(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.backend.Receipts(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	receipts := &types.Receipts{}
+	receipts.SetHardFork(c.hardfork, header.GetBlockNo())
+	if len(raw) != 0 {
+		if err := receipts.UnmarshalBinary(raw); err != nil {
+			return nil, err
+		}
+	}
+	if err := VerifyReceipts(header, receipts); err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+// GetReceipt returns the verified receipt of the transaction in the block of blockHash.
+func (c *Client) GetReceipt(blockHash []byte, txHash []byte) (*types.Receipt, error) {
+	receipts, err := c.GetReceipts(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range receipts.Get() {
+		if bytes.Equal(r.GetTxHash(), txHash) {
+			return r, nil
+		}
+	}
+	return nil, ErrReceiptNotFound
+}
+
+// rpcBackend is the backend using the grpc service of a full node.
+type rpcBackend struct {
+	client types.AergoRPCServiceClient
+}
+
+// NewRPCBackend returns a backend which connects to a full node by grpc.
+// It doesn't support receipts yet.
+func NewRPCBackend(client types.AergoRPCServiceClient) Backend {
+	return &rpcBackend{client: client}
+}
+
+func (b *rpcBackend) BestBlockNo() (types.BlockNo, error) {
+	status, err := b.client.Blockchain(context.Background(), &types.Empty{})
+	if err != nil {
+		return 0, err
+	}
+	return status.GetBestHeight(), nil
+}
+
+func (b *rpcBackend) Headers(from types.BlockNo, size uint32) ([]*types.BlockHeader, error) {
+	list, err := b.client.ListBlockHeaders(context.Background(), &types.ListParams{
+		Height: from + types.BlockNo(size) - 1,
+		Size:   size,
+		Asc:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	headers := make([]*types.BlockHeader, 0, len(list.GetBlocks()))
+	for _, b := range list.GetBlocks() {
+		headers = append(headers, b.GetHeader())
+	}
+	return headers, nil
+}
+
+func (b *rpcBackend) StateProof(query *types.StateQuery) (*types.StateQueryProof, error) {
+	return b.client.QueryContractState(context.Background(), query)
+}
+
+func (b *rpcBackend) Receipts(blockHash []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,96 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"github.com/aergoio/aergo/types"
+)
+
+// finalizer decides the last irreversible block of the header chain.
+type finalizer interface {
+	// add takes a new header of the best chain, which is signed by the
+	// member signer out of members, and returns the block number up to which
+	// the headers are final.
+	add(h *types.BlockHeader, signer types.PeerID, members int) types.BlockNo
+	// reset drops every header added after the block no.
+	reset(finalized types.BlockNo)
+}
+
+// quorumFinalizer regards a block as irreversible once 2/3+1 of the members
+// have signed blocks confirming it. Only the members verified by Membership
+// are counted, each one once.
+//
+// In DPoS, a block confirms its ancestors back to the previous block of the
+// same BP, which is recorded in the Confirms field of its header. In BFT, a
+// validator proposes a block only on top of the committed one, so a block
+// confirms all of its ancestors.
+type quorumFinalizer struct {
+	confirmsAll bool
+	finalized   types.BlockNo
+	confirmers  map[types.BlockNo]map[types.PeerID]struct{}
+}
+
+func newDposFinalizer() *quorumFinalizer {
+	return &quorumFinalizer{confirmers: make(map[types.BlockNo]map[types.PeerID]struct{})}
+}
+
+func newBFTFinalizer() *quorumFinalizer {
+	return &quorumFinalizer{confirmsAll: true, confirmers: make(map[types.BlockNo]map[types.PeerID]struct{})}
+}
+
+func (f *quorumFinalizer) add(h *types.BlockHeader, signer types.PeerID, members int) types.BlockNo {
+	no := h.GetBlockNo()
+
+	from := f.finalized + 1
+	if !f.confirmsAll && h.GetConfirms() < no && no-h.GetConfirms()+1 > from {
+		from = no - h.GetConfirms() + 1
+	}
+	for n := from; n <= no; n++ {
+		c, exist := f.confirmers[n]
+		if !exist {
+			c = make(map[types.PeerID]struct{})
+			f.confirmers[n] = c
+		}
+		c[signer] = struct{}{}
+	}
+
+	required := members*2/3 + 1
+	lib := f.finalized
+	for n := range f.confirmers {
+		if n > lib && len(f.confirmers[n]) >= required {
+			lib = n
+		}
+	}
+	if lib > f.finalized {
+		for n := range f.confirmers {
+			if n <= lib {
+				delete(f.confirmers, n)
+			}
+		}
+		f.finalized = lib
+	}
+	return f.finalized
+}
+
+func (f *quorumFinalizer) reset(finalized types.BlockNo) {
+	f.finalized = finalized
+	f.confirmers = make(map[types.BlockNo]map[types.PeerID]struct{})
+}
+
+// instantFinalizer regards every header signed by a member as final, which
+// is the case of raft: a block is connected to the chain only after it is
+// committed by the majority of the cluster.
+type instantFinalizer struct{}
+
+func newInstantFinalizer() *instantFinalizer {
+	return &instantFinalizer{}
+}
+
+func (f *instantFinalizer) add(h *types.BlockHeader, signer types.PeerID, members int) types.BlockNo {
+	return h.GetBlockNo()
+}
+
+func (f *instantFinalizer) reset(types.BlockNo) {}
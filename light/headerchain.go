@@ -0,0 +1,462 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	headerPrefix = "light.header."
+	noPrefix     = "light.no."
+	signerPrefix = "light.signer."
+)
+
+var (
+	bestKey      = []byte("light.best")
+	finalizedKey = []byte("light.finalized")
+	genesisKey   = []byte("light.genesisInfo")
+)
+
+var (
+	ErrNoGenesis         = errors.New("genesis block is not set")
+	ErrGenesisMismatch   = errors.New("genesis block is different from the stored one")
+	ErrWrongChainID      = errors.New("chain id of header doesn't match")
+	ErrBadSignature      = errors.New("bad signature of header")
+	ErrBadTimestamp      = errors.New("timestamp of header is earlier than its parent")
+	ErrNotLinked         = errors.New("header is not linked to the header chain")
+	ErrFinalizedConflict = errors.New("header conflicts with a finalized header")
+	ErrHeaderNotFound    = errors.New("header not found")
+	ErrSignerNotFound    = errors.New("signer of header not found")
+)
+
+// HeaderChain keeps the block headers of the best chain, which are verified
+// by their linkage, chain id and signature. The signer of every header must
+// be a member of the consensus at its height. It doesn't have any block body
+// nor state, so it can't serve them to the other peers.
+type HeaderChain struct {
+	mutex sync.RWMutex
+	store db.DB
+
+	genesis   *types.Genesis
+	members   Membership
+	finalizer finalizer
+
+	best      *types.BlockHeader
+	bestHash  []byte
+	finalized types.BlockNo
+}
+
+var _ types.ChainAccessor = (*HeaderChain)(nil)
+
+// NewHeaderChain loads the header chain from store, or initializes it with
+// the genesis block. The signers of headers are verified by members.
+func NewHeaderChain(store db.DB, genesis *types.Genesis, members Membership) (*HeaderChain, error) {
+	if genesis == nil || genesis.Block() == nil {
+		return nil, ErrNoGenesis
+	}
+	hc := &HeaderChain{
+		store:   store,
+		genesis: genesis,
+		members: members,
+	}
+	switch genesis.ConsensusType() {
+	case consensus.ConsensusName[consensus.ConsensusDPOS]:
+		hc.finalizer = newDposFinalizer()
+	case consensus.ConsensusName[consensus.ConsensusBFT]:
+		hc.finalizer = newBFTFinalizer()
+	default:
+		hc.finalizer = newInstantFinalizer()
+	}
+
+	genesisBlock := genesis.Block()
+	if stored := store.Get(genesisKey); len(stored) != 0 {
+		if !bytes.Equal(stored, genesisBlock.BlockHash()) {
+			return nil, ErrGenesisMismatch
+		}
+	} else {
+		tx := store.NewTx()
+		hc.putHeader(tx, genesisBlock.BlockHash(), genesisBlock.GetHeader())
+		tx.Set(genesisKey, genesisBlock.BlockHash())
+		tx.Set(bestKey, genesisBlock.BlockHash())
+		tx.Set(finalizedKey, types.BlockNoToBytes(0))
+		tx.Commit()
+	}
+
+	if err := hc.load(); err != nil {
+		return nil, err
+	}
+	return hc, nil
+}
+
+func (hc *HeaderChain) load() error {
+	hc.bestHash = hc.store.Get(bestKey)
+	best, err := hc.getHeader(hc.bestHash)
+	if err != nil {
+		return err
+	}
+	hc.best = best
+	hc.finalized = types.BlockNoFromBytes(hc.store.Get(finalizedKey))
+
+	// the votes for the blocks above the finalized one are not persisted.
+	hc.replay()
+
+	logger.Info().Uint64("best", hc.best.GetBlockNo()).Str("hash", enc.ToString(hc.bestHash)).
+		Uint64("finalized", hc.finalized).Msg("header chain loaded")
+	return nil
+}
+
+// replay feeds the headers above the finalized one to the finalizer again,
+// with their signers verified at insertion.
+func (hc *HeaderChain) replay() {
+	hc.finalizer.reset(hc.finalized)
+	for no := hc.finalized + 1; no <= hc.best.GetBlockNo(); no++ {
+		h, err := hc.getHeaderByNo(no)
+		if err != nil {
+			logger.Error().Err(err).Uint64("no", no).Msg("failed to replay header")
+			return
+		}
+		signer, members, err := hc.getSigner(headerHash(h))
+		if err != nil {
+			logger.Error().Err(err).Uint64("no", no).Msg("failed to replay header")
+			return
+		}
+		hc.finalizer.add(h, signer, members)
+	}
+}
+
+// Insert appends headers, which must be sorted in ascending order, to the
+// header chain. A header which conflicts with the best chain above the
+// finalized block replaces it. ErrNotLinked is returned if the first header
+// isn't linked to any header of the best chain.
+func (hc *HeaderChain) Insert(headers []*types.BlockHeader) (int, error) {
+	inserted := 0
+	for _, h := range headers {
+		prev, err := hc.linkedParent(h)
+		if err != nil {
+			return inserted, err
+		} else if prev == nil {
+			continue
+		}
+		// the membership may fetch proofs from the peers, so the header chain
+		// isn't locked meanwhile.
+		signer, members, err := hc.members.Signer(h, prev, hc.GetHeaderByNo)
+		if err != nil {
+			return inserted, err
+		}
+		if ok, err := hc.insert(h, prev, signer, members); err != nil {
+			return inserted, err
+		} else if ok {
+			inserted++
+		}
+	}
+	return inserted, nil
+}
+
+// linkedParent returns the header of the best chain which h is linked to. It
+// returns nil if h is already in the best chain.
+func (hc *HeaderChain) linkedParent(h *types.BlockHeader) (*types.BlockHeader, error) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	prev, err := hc.parentOf(h)
+	if err != nil || prev == nil {
+		return nil, err
+	}
+	if err := hc.validate(h, prev); err != nil {
+		return nil, err
+	}
+	return prev, nil
+}
+
+func (hc *HeaderChain) parentOf(h *types.BlockHeader) (*types.BlockHeader, error) {
+	no := h.GetBlockNo()
+	if no == 0 {
+		return nil, nil
+	}
+	if existing := hc.store.Get(noKey(no)); existing != nil && no <= hc.best.GetBlockNo() {
+		if bytes.Equal(existing, headerHash(h)) {
+			return nil, nil
+		}
+		if no <= hc.finalized {
+			return nil, ErrFinalizedConflict
+		}
+	}
+
+	prev, err := hc.getHeaderByNo(no - 1)
+	if err != nil || no-1 > hc.best.GetBlockNo() || !bytes.Equal(h.GetPrevBlockHash(), headerHash(prev)) {
+		return nil, ErrNotLinked
+	}
+	return prev, nil
+}
+
+// insert appends h, which is signed by signer, on top of prev. It reports
+// false if h has been inserted since its signer was resolved.
+func (hc *HeaderChain) insert(h, prev *types.BlockHeader, signer types.PeerID, members int) (bool, error) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	// the best chain may be changed while the signer is resolved.
+	if linked, err := hc.parentOf(h); err != nil || linked == nil {
+		return false, err
+	} else if !bytes.Equal(headerHash(linked), headerHash(prev)) {
+		return false, ErrNotLinked
+	}
+
+	hash := headerHash(h)
+	no := h.GetBlockNo()
+	rewound := no <= hc.best.GetBlockNo()
+	tx := hc.store.NewTx()
+	if rewound {
+		for n := no + 1; n <= hc.best.GetBlockNo(); n++ {
+			tx.Delete(noKey(n))
+		}
+		logger.Info().Uint64("from", no).Uint64("best", hc.best.GetBlockNo()).Msg("header chain reorganized")
+	}
+	hc.putHeader(tx, hash, h)
+	tx.Set(signerKey(hash), encodeSigner(signer, members))
+	tx.Set(noKey(no), hash)
+	tx.Set(bestKey, hash)
+	tx.Commit()
+
+	hc.best, hc.bestHash = h, hash
+	if rewound {
+		hc.replay()
+	} else if finalized := hc.finalizer.add(h, signer, members); finalized > hc.finalized {
+		hc.setFinalized(finalized)
+	}
+	return true, nil
+}
+
+// Rewind drops the headers above no, but not the finalized ones. It is used
+// to find a common ancestor with a peer on the other fork.
+func (hc *HeaderChain) Rewind(no types.BlockNo) types.BlockNo {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	if no < hc.finalized {
+		no = hc.finalized
+	}
+	if no >= hc.best.GetBlockNo() {
+		return hc.best.GetBlockNo()
+	}
+	h, err := hc.getHeaderByNo(no)
+	if err != nil {
+		return hc.best.GetBlockNo()
+	}
+	hash := headerHash(h)
+	tx := hc.store.NewTx()
+	for n := no + 1; n <= hc.best.GetBlockNo(); n++ {
+		tx.Delete(noKey(n))
+	}
+	tx.Set(bestKey, hash)
+	tx.Commit()
+
+	hc.best, hc.bestHash = h, hash
+	hc.replay()
+	return no
+}
+
+func (hc *HeaderChain) setFinalized(no types.BlockNo) {
+	hc.finalized = no
+	hc.store.Set(finalizedKey, types.BlockNoToBytes(no))
+	logger.Debug().Uint64("no", no).Msg("finalized header updated")
+}
+
+func (hc *HeaderChain) validate(h, prev *types.BlockHeader) error {
+	if !types.ChainIdEqualWithoutVersion(h.GetChainID(), hc.genesis.Block().GetHeader().GetChainID()) {
+		return ErrWrongChainID
+	}
+	if h.GetTimestamp() < prev.GetTimestamp() {
+		return ErrBadTimestamp
+	}
+	if valid, err := (&types.Block{Header: h}).VerifySign(); err != nil || !valid {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// Best returns the header of the best block and its hash.
+func (hc *HeaderChain) Best() (*types.BlockHeader, []byte) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.best, hc.bestHash
+}
+
+// Finalized returns the header of the last irreversible block and its hash.
+func (hc *HeaderChain) Finalized() (*types.BlockHeader, []byte, error) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	h, err := hc.getHeaderByNo(hc.finalized)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h, headerHash(h), nil
+}
+
+func (hc *HeaderChain) finalizedNo() types.BlockNo {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.finalized
+}
+
+// GetHeader returns the header of hash.
+func (hc *HeaderChain) GetHeader(hash []byte) (*types.BlockHeader, error) {
+	return hc.getHeader(hash)
+}
+
+// GetHeaderByNo returns the header of the best chain at no.
+func (hc *HeaderChain) GetHeaderByNo(no types.BlockNo) (*types.BlockHeader, error) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.getHeaderByNo(no)
+}
+
+// IsFinalized reports whether the header of hash is in the best chain and finalized.
+func (hc *HeaderChain) IsFinalized(hash []byte) bool {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	h, err := hc.getHeader(hash)
+	if err != nil || h.GetBlockNo() > hc.finalized {
+		return false
+	}
+	return bytes.Equal(hc.store.Get(noKey(h.GetBlockNo())), hash)
+}
+
+func (hc *HeaderChain) getHeaderByNo(no types.BlockNo) (*types.BlockHeader, error) {
+	hash := hc.store.Get(noKey(no))
+	if len(hash) == 0 {
+		if no == 0 {
+			return hc.genesis.Block().GetHeader(), nil
+		}
+		return nil, ErrHeaderNotFound
+	}
+	return hc.getHeader(hash)
+}
+
+func (hc *HeaderChain) getHeader(hash []byte) (*types.BlockHeader, error) {
+	raw := hc.store.Get(headerKey(hash))
+	if len(raw) == 0 {
+		return nil, ErrHeaderNotFound
+	}
+	var h types.BlockHeader
+	if err := proto.Unmarshal(raw, &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// getSigner returns the member which signed the header of hash, and the
+// number of members at its height.
+func (hc *HeaderChain) getSigner(hash []byte) (types.PeerID, int, error) {
+	raw := hc.store.Get(signerKey(hash))
+	if len(raw) < 8 {
+		return "", 0, ErrSignerNotFound
+	}
+	return types.PeerID(raw[8:]), int(types.BlockNoFromBytes(raw[:8])), nil
+}
+
+func encodeSigner(signer types.PeerID, members int) []byte {
+	return append(types.BlockNoToBytes(types.BlockNo(members)), signer...)
+}
+
+func (hc *HeaderChain) putHeader(tx db.Transaction, hash []byte, h *types.BlockHeader) {
+	raw, err := proto.Marshal(h)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to marshal header")
+		return
+	}
+	tx.Set(headerKey(hash), raw)
+	if h.GetBlockNo() == 0 {
+		tx.Set(noKey(0), hash)
+	}
+}
+
+// GetGenesisInfo implements types.ChainAccessor.
+func (hc *HeaderChain) GetGenesisInfo() *types.Genesis {
+	return hc.genesis
+}
+
+// GetConsensusInfo implements types.ChainAccessor.
+func (hc *HeaderChain) GetConsensusInfo() string {
+	return ""
+}
+
+// GetBestBlock implements types.ChainAccessor. The block has only its header.
+func (hc *HeaderChain) GetBestBlock() (*types.Block, error) {
+	best, hash := hc.Best()
+	return &types.Block{Hash: hash, Header: best}, nil
+}
+
+// GetBlock implements types.ChainAccessor. It always returns nil since the
+// header chain can't provide a whole block.
+func (hc *HeaderChain) GetBlock(blockHash []byte) (*types.Block, error) {
+	return nil, nil
+}
+
+// GetHashByNo implements types.ChainAccessor.
+func (hc *HeaderChain) GetHashByNo(blockNo types.BlockNo) ([]byte, error) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	hash := hc.store.Get(noKey(blockNo))
+	if len(hash) == 0 {
+		return nil, ErrHeaderNotFound
+	}
+	return hash, nil
+}
+
+// GetChainStats implements types.ChainAccessor.
+func (hc *HeaderChain) GetChainStats() string {
+	return ""
+}
+
+// GetSystemValue implements types.ChainAccessor. The header chain has no state.
+func (hc *HeaderChain) GetSystemValue(key types.SystemValue) (*big.Int, error) {
+	return nil, ErrNotSupported
+}
+
+// GetEnterpriseConfig implements types.ChainAccessor. The header chain has no state.
+func (hc *HeaderChain) GetEnterpriseConfig(key string) (*types.EnterpriseConfig, error) {
+	return nil, ErrNotSupported
+}
+
+// ChainID implements types.ChainAccessor.
+func (hc *HeaderChain) ChainID(bno types.BlockNo) *types.ChainID {
+	cid := types.NewChainID()
+	if h, err := hc.GetHeaderByNo(bno); err == nil {
+		if err := cid.Read(h.GetChainID()); err == nil {
+			return cid
+		}
+	}
+	if err := cid.Read(hc.genesis.Block().GetHeader().GetChainID()); err != nil {
+		return nil
+	}
+	return cid
+}
+
+func headerHash(h *types.BlockHeader) []byte {
+	return (&types.Block{Header: h}).BlockHash()
+}
+
+func headerKey(hash []byte) []byte {
+	return append([]byte(headerPrefix), hash...)
+}
+
+func signerKey(hash []byte) []byte {
+	return append([]byte(signerPrefix), hash...)
+}
+
+func noKey(no types.BlockNo) []byte {
+	return append([]byte(noPrefix), types.BlockNoToBytes(no)...)
+}
@@ -0,0 +1,367 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHeaderMaker struct {
+	t       *testing.T
+	genesis *types.Genesis
+	keys    []crypto.PrivKey
+	backend *testStateBackend
+}
+
+func newTestHeaderMaker(t *testing.T, consensus string, bpCount int) *testHeaderMaker {
+	genesis := &types.Genesis{
+		ID:        types.ChainID{Magic: "light.test", PublicNet: false, Consensus: consensus},
+		Timestamp: 1,
+	}
+	keys := make([]crypto.PrivKey, bpCount)
+	for i := range keys {
+		keys[i] = newTestKey(t)
+		id, err := types.IDFromPrivateKey(keys[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if consensus == "dpos" {
+			genesis.BPs = append(genesis.BPs, types.IDB58Encode(id))
+		} else {
+			genesis.EnterpriseBPs = append(genesis.EnterpriseBPs, types.EnterpriseBP{Name: id.Pretty(), PeerID: types.IDB58Encode(id)})
+		}
+	}
+	backend := newTestStateBackend(t)
+	genesis.Block().SetBlocksRootHash(backend.root)
+	return &testHeaderMaker{t: t, genesis: genesis, keys: keys, backend: backend}
+}
+
+func newTestKey(t *testing.T) crypto.PrivKey {
+	key, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// newHeaderChain creates a header chain whose signers are verified by the
+// BPs of genesis.
+func (m *testHeaderMaker) newHeaderChain(store db.DB) (*HeaderChain, error) {
+	members, err := NewMembership(m.genesis, m.backend, types.DummyBlockVersionner(3), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewHeaderChain(store, m.genesis, members)
+}
+
+// next makes a header on top of prev, which is signed by the bp of index.
+func (m *testHeaderMaker) next(prev *types.BlockHeader, bp int, confirms types.BlockNo) *types.BlockHeader {
+	return m.nextBy(prev, m.keys[bp], confirms)
+}
+
+// nextBy makes a header on top of prev, which is signed by key.
+func (m *testHeaderMaker) nextBy(prev *types.BlockHeader, key crypto.PrivKey, confirms types.BlockNo) *types.BlockHeader {
+	block := &types.Block{Header: &types.BlockHeader{
+		ChainID:        m.genesis.Block().GetHeader().GetChainID(),
+		PrevBlockHash:  headerHash(prev),
+		BlockNo:        prev.GetBlockNo() + 1,
+		Timestamp:      prev.GetTimestamp() + 1,
+		BlocksRootHash: m.backend.root,
+		Confirms:       confirms,
+	}}
+	if err := block.Sign(key); err != nil {
+		m.t.Fatal(err)
+	}
+	return block.Header
+}
+
+// chain makes n headers on top of prev, which are produced by the bps in turn.
+func (m *testHeaderMaker) chain(prev *types.BlockHeader, n int) []*types.BlockHeader {
+	headers := make([]*types.BlockHeader, n)
+	for i := range headers {
+		no := prev.GetBlockNo() + 1
+		confirms := types.BlockNo(len(m.keys))
+		if no < confirms {
+			confirms = no
+		}
+		headers[i] = m.next(prev, int(no)%len(m.keys), confirms)
+		prev = headers[i]
+	}
+	return headers
+}
+
+// testStateBackend proves the states of a state trie, which has no system
+// account. So the BPs are those of genesis and have never rotated their keys.
+type testStateBackend struct {
+	t    *testing.T
+	tr   *trie.Trie
+	root []byte
+
+	// proofs counts the state proofs served. StateProof sends to wait before
+	// serving unless it's nil.
+	proofs int
+	wait   chan struct{}
+}
+
+func newTestStateBackend(t *testing.T) *testStateBackend {
+	store := db.NewDB(db.MemoryImpl, "")
+	tr := newTestStateTrie(t, store, map[string]*types.State{
+		string(testAddress(1)): {Nonce: 1, Balance: []byte{100}},
+	})
+	return &testStateBackend{t: t, tr: tr, root: tr.Root}
+}
+
+func (b *testStateBackend) BestBlockNo() (types.BlockNo, error) {
+	return 0, nil
+}
+
+func (b *testStateBackend) Headers(from types.BlockNo, size uint32) ([]*types.BlockHeader, error) {
+	return nil, nil
+}
+
+func (b *testStateBackend) StateProof(query *types.StateQuery) (*types.StateQueryProof, error) {
+	if b.wait != nil {
+		b.wait <- struct{}{}
+	}
+	b.proofs++
+	return &types.StateQueryProof{ContractProof: accountProof(b.t, b.tr, query.ContractAddress, nil, query.Compressed)}, nil
+}
+
+func (b *testStateBackend) Receipts(blockHash []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func newTestStore(t *testing.T) (db.DB, func()) {
+	dir, err := ioutil.TempDir("", "light")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.NewDB(db.MemoryImpl, dir), func() { os.RemoveAll(dir) }
+}
+
+func TestHeaderChain_Insert(t *testing.T) {
+	m := newTestHeaderMaker(t, "raft", 1)
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	hc, err := m.newHeaderChain(store)
+	assert.NoError(t, err)
+	genesisHeader := m.genesis.Block().GetHeader()
+
+	headers := m.chain(genesisHeader, 5)
+	n, err := hc.Insert(headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	best, bestHash := hc.Best()
+	assert.Equal(t, types.BlockNo(5), best.GetBlockNo())
+	assert.Equal(t, headerHash(headers[4]), bestHash)
+	// every block of raft is final
+	assert.True(t, hc.IsFinalized(headerHash(headers[4])))
+
+	hash, err := hc.GetHashByNo(3)
+	assert.NoError(t, err)
+	assert.Equal(t, headerHash(headers[2]), hash)
+
+	// headers already in the chain are skipped
+	n, err = hc.Insert(headers[2:])
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	// the header chain is restored from the store
+	reloaded, err := m.newHeaderChain(store)
+	assert.NoError(t, err)
+	best, _ = reloaded.Best()
+	assert.Equal(t, types.BlockNo(5), best.GetBlockNo())
+	assert.Equal(t, types.BlockNo(5), reloaded.finalizedNo())
+}
+
+func TestHeaderChain_InsertInvalid(t *testing.T) {
+	m := newTestHeaderMaker(t, "raft", 1)
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	hc, err := m.newHeaderChain(store)
+	assert.NoError(t, err)
+	genesisHeader := m.genesis.Block().GetHeader()
+
+	tampered := m.next(genesisHeader, 0, 1)
+	tampered.Timestamp += 1
+	_, err = hc.Insert([]*types.BlockHeader{tampered})
+	assert.Equal(t, ErrBadSignature, err)
+
+	foreign := m.next(genesisHeader, 0, 1)
+	foreign.ChainID, _ = (&types.ChainID{Magic: "light.other", Consensus: "raft"}).Bytes()
+	_, err = hc.Insert([]*types.BlockHeader{foreign})
+	assert.Equal(t, ErrWrongChainID, err)
+
+	headers := m.chain(genesisHeader, 3)
+	_, err = hc.Insert(headers[1:])
+	assert.Equal(t, ErrNotLinked, err)
+
+	best, _ := hc.Best()
+	assert.Equal(t, types.BlockNo(0), best.GetBlockNo())
+}
+
+func TestHeaderChain_DposFinality(t *testing.T) {
+	m := newTestHeaderMaker(t, "dpos", 3)
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	hc, err := m.newHeaderChain(store)
+	assert.NoError(t, err)
+	genesisHeader := m.genesis.Block().GetHeader()
+
+	headers := m.chain(genesisHeader, 6)
+	tests := []struct {
+		no        int
+		finalized types.BlockNo
+	}{
+		// 3 of 3 bps are required to confirm a block
+		{1, 0},
+		{2, 0},
+		{3, 1},
+		{4, 2},
+		{5, 3},
+		{6, 4},
+	}
+	for _, tt := range tests {
+		_, err := hc.Insert(headers[tt.no-1 : tt.no])
+		assert.NoError(t, err)
+		assert.Equal(t, tt.finalized, hc.finalizedNo(), "block %d", tt.no)
+	}
+
+	// a fork above the finalized block replaces the best chain
+	fork := []*types.BlockHeader{m.next(headers[3], 0, 1)}
+	n, err := hc.Insert(fork)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	best, _ := hc.Best()
+	assert.Equal(t, headerHash(fork[0]), headerHash(best))
+	assert.Equal(t, types.BlockNo(4), hc.finalizedNo())
+
+	// but a fork of the finalized block is rejected
+	conflict := m.next(headers[1], 1, 1)
+	_, err = hc.Insert([]*types.BlockHeader{conflict})
+	assert.Equal(t, ErrFinalizedConflict, err)
+
+	// rewind doesn't drop the finalized headers
+	assert.Equal(t, types.BlockNo(4), hc.Rewind(1))
+}
+
+func TestHeaderChain_ForgedSigner(t *testing.T) {
+	for _, consensus := range []string{"raft", "dpos", "bft"} {
+		t.Run(consensus, func(t *testing.T) {
+			m := newTestHeaderMaker(t, consensus, 3)
+			store, cleanup := newTestStore(t)
+			defer cleanup()
+
+			hc, err := m.newHeaderChain(store)
+			assert.NoError(t, err)
+			genesisHeader := m.genesis.Block().GetHeader()
+
+			// a validly signed header by a key out of the members
+			forger := newTestKey(t)
+			forged := m.nextBy(genesisHeader, forger, 1)
+			n, err := hc.Insert([]*types.BlockHeader{forged})
+			assert.Equal(t, ErrNotMember, err)
+			assert.Equal(t, 0, n)
+
+			// the forged header isn't accepted after the headers of members either
+			headers := m.chain(genesisHeader, 2)
+			_, err = hc.Insert(headers)
+			assert.NoError(t, err)
+			_, err = hc.Insert([]*types.BlockHeader{m.nextBy(headers[1], forger, 1)})
+			assert.Equal(t, ErrNotMember, err)
+			best, _ := hc.Best()
+			assert.Equal(t, headerHash(headers[1]), headerHash(best))
+		})
+	}
+}
+
+func TestHeaderChain_QuorumCountsMembersOnce(t *testing.T) {
+	for _, consensus := range []string{"dpos", "bft"} {
+		t.Run(consensus, func(t *testing.T) {
+			m := newTestHeaderMaker(t, consensus, 4)
+			store, cleanup := newTestStore(t)
+			defer cleanup()
+
+			hc, err := m.newHeaderChain(store)
+			assert.NoError(t, err)
+
+			// 3 of 4 members are required, but a single member produces blocks.
+			prev := m.genesis.Block().GetHeader()
+			for i := 0; i < 8; i++ {
+				prev = m.next(prev, 0, 1)
+				_, err := hc.Insert([]*types.BlockHeader{prev})
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, types.BlockNo(0), hc.finalizedNo())
+
+			// the blocks are finalized as the other members build on them.
+			for bp := 1; bp <= 2; bp++ {
+				prev = m.next(prev, bp, prev.GetBlockNo())
+				_, err := hc.Insert([]*types.BlockHeader{prev})
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, types.BlockNo(8), hc.finalizedNo())
+
+			// the signers are restored with the header chain
+			reloaded, err := m.newHeaderChain(store)
+			assert.NoError(t, err)
+			assert.Equal(t, types.BlockNo(8), reloaded.finalizedNo())
+		})
+	}
+}
+
+func TestHeaderChain_DposSignerProofs(t *testing.T) {
+	m := newTestHeaderMaker(t, "dpos", 3)
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	hc, err := m.newHeaderChain(store)
+	assert.NoError(t, err)
+	genesisHeader := m.genesis.Block().GetHeader()
+
+	// the keys of the BPs are resolved once for the election
+	headers := m.chain(genesisHeader, 9)
+	_, err = hc.Insert(headers[:3])
+	assert.NoError(t, err)
+	proofs := m.backend.proofs
+	assert.NotZero(t, proofs)
+	_, err = hc.Insert(headers[3:])
+	assert.NoError(t, err)
+	assert.Equal(t, proofs, m.backend.proofs)
+
+	// the header chain isn't locked while the signer is resolved
+	m.backend.wait = make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		_, err := hc.Insert([]*types.BlockHeader{m.nextBy(headers[8], newTestKey(t), 1)})
+		assert.Equal(t, ErrNotMember, err)
+		close(done)
+	}()
+	<-m.backend.wait
+	best, _ := hc.Best()
+	assert.Equal(t, types.BlockNo(9), best.GetBlockNo())
+	_, err = hc.GetHeaderByNo(9)
+	assert.NoError(t, err)
+	for {
+		select {
+		case <-m.backend.wait:
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,327 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/contract/enterprise"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/types"
+)
+
+// maxCachedElections is the number of elections kept by dposMembership.
+const maxCachedElections = 4
+
+var (
+	ErrNotMember  = errors.New("signer of header is not a member of consensus")
+	ErrNoMember   = errors.New("no member of consensus is known")
+	ErrBadMembers = errors.New("invalid peer id of consensus member")
+)
+
+type dataGetter interface {
+	GetData(key []byte) ([]byte, error)
+}
+
+// headerLookup returns the header of the best chain at no.
+type headerLookup func(no types.BlockNo) (*types.BlockHeader, error)
+
+// Membership decides whether the signer of a header is in charge of
+// producing the block at its height.
+type Membership interface {
+	// Signer returns the ID of the member which signed h, and the number of
+	// members at the height of h. parent is the header which h is linked to.
+	// It returns ErrNotMember if the signer isn't a member.
+	Signer(h, parent *types.BlockHeader, lookup headerLookup) (types.PeerID, int, error)
+}
+
+// NewMembership returns the membership of the consensus of genesis. The
+// header chain is verified against the states of the chain, which are
+// proven by backend. hardfork decides the block versions, bpCount is the
+// default number of BPs of DPoS, and members are the IDs of the raft members
+// which have been added after the genesis block.
+func NewMembership(genesis *types.Genesis, backend Backend, hardfork types.BlockVersionner, bpCount int, members []string) (Membership, error) {
+	switch genesis.ConsensusType() {
+	case consensus.ConsensusName[consensus.ConsensusDPOS]:
+		return newDposMembership(genesis, backend, hardfork, bpCount)
+	case consensus.ConsensusName[consensus.ConsensusBFT]:
+		return newBFTMembership(genesis, backend)
+	default:
+		return newStaticMembership(append(enterpriseBPs(genesis), members...))
+	}
+}
+
+// dposMembership verifies that the signer is one of the BPs elected for the
+// height of header, taking the rotation of BP keys into account.
+type dposMembership struct {
+	backend  Backend
+	hardfork types.BlockVersionner
+	bpCount  int
+	genesis  map[types.PeerID]struct{}
+
+	// the proofs are fetched from the peers while mutex is locked, so that an
+	// election isn't fetched twice.
+	mutex     sync.Mutex
+	elections map[string]*election
+}
+
+// election is the BPs elected by the state of the block at ref. storage is
+// the system account in the state, whose values are fetched once for the
+// election.
+type election struct {
+	ref     types.BlockNo
+	bps     map[types.PeerID]struct{}
+	storage *cachedStorage
+}
+
+func newDposMembership(genesis *types.Genesis, backend Backend, hardfork types.BlockVersionner, bpCount int) (*dposMembership, error) {
+	bps, err := peerIDSet(genesis.BPs)
+	if err != nil {
+		return nil, err
+	}
+	if bpCount <= 0 {
+		bpCount = len(genesis.BPs)
+	}
+	return &dposMembership{
+		backend:   backend,
+		hardfork:  hardfork,
+		bpCount:   bpCount,
+		genesis:   bps,
+		elections: make(map[string]*election),
+	}, nil
+}
+
+func (m *dposMembership) Signer(h, parent *types.BlockHeader, lookup headerLookup) (types.PeerID, int, error) {
+	signer, err := (&types.Block{Header: h}).BPID()
+	if err != nil {
+		return "", 0, ErrBadSignature
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e, err := m.election(bp.ElectionRef(h.GetBlockNo()), lookup)
+	if err != nil {
+		return "", 0, err
+	}
+	// a rotated key signs blocks on behalf of the voted identity. The keys are
+	// resolved by the state of the election, so that a proof isn't fetched for
+	// every header. A key which the state doesn't know is resolved again by
+	// the state of the parent block, as the block validation of full nodes
+	// does, since it may be rotated after the election. But a key retired
+	// after the election is accepted until the next election.
+	id, err := resolveBP(e, e.storage, signer, h.GetBlockNo())
+	if err == ErrNotMember {
+		id, err = resolveBP(e, newProvenStorage(m.backend, parent, types.AergoSystem), signer, h.GetBlockNo())
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	return id, len(e.bps), nil
+}
+
+// resolveBP returns the elected BP which signs the block at no by the key of
+// signer.
+func resolveBP(e *election, st dataGetter, signer types.PeerID, no types.BlockNo) (types.PeerID, error) {
+	id, err := system.ResolveBlockProducer(st, signer, no)
+	if err == system.ErrRetiredBPKey {
+		return "", ErrNotMember
+	} else if err != nil {
+		return "", err
+	}
+	if _, exist := e.bps[id]; !exist {
+		return "", ErrNotMember
+	}
+	return id, nil
+}
+
+// election returns the election by the state of the block at ref.
+func (m *dposMembership) election(ref types.BlockNo, lookup headerLookup) (*election, error) {
+	h, err := lookup(ref)
+	if err != nil {
+		return nil, err
+	}
+	// the elections are cached by the hash, not by the number, since the
+	// block at ref may be replaced by reorganization.
+	hash := string(headerHash(h))
+	if e, exist := m.elections[hash]; exist {
+		return e, nil
+	}
+	e := &election{ref: ref, bps: m.genesis, storage: newCachedStorage(newProvenStorage(m.backend, h, types.AergoSystem))}
+	if ref != 0 {
+		n, err := system.BpCountOf(e.storage, m.bpCount)
+		if err != nil {
+			return nil, err
+		}
+		rankers, err := system.RankersOf(e.storage, n, m.hardfork.Version(ref))
+		if err != nil {
+			return nil, err
+		}
+		if e.bps, err = peerIDSet(rankers); err != nil {
+			return nil, err
+		}
+	}
+
+	// headers are inserted in ascending order mostly, so the oldest election
+	// is dropped.
+	m.elections[hash] = e
+	for len(m.elections) > maxCachedElections {
+		oldest, oldestRef := hash, ref
+		for k, e := range m.elections {
+			if e.ref < oldestRef {
+				oldest, oldestRef = k, e.ref
+			}
+		}
+		delete(m.elections, oldest)
+	}
+	return e, nil
+}
+
+// bftMembership verifies that the signer is one of the validators in the
+// state of the parent block.
+type bftMembership struct {
+	backend Backend
+	initial []string
+}
+
+func newBFTMembership(genesis *types.Genesis, backend Backend) (*bftMembership, error) {
+	initial := enterpriseBPs(genesis)
+	if len(initial) == 0 {
+		return nil, ErrNoMember
+	}
+	return &bftMembership{backend: backend, initial: initial}, nil
+}
+
+func (m *bftMembership) Signer(h, parent *types.BlockHeader, lookup headerLookup) (types.PeerID, int, error) {
+	signer, err := (&types.Block{Header: h}).BPID()
+	if err != nil {
+		return "", 0, ErrBadSignature
+	}
+	ids, err := enterprise.ValidatorsOf(newProvenStorage(m.backend, parent, types.AergoEnterprise), m.initial)
+	if err != nil {
+		return "", 0, err
+	}
+	validators, err := peerIDSet(ids)
+	if err != nil {
+		return "", 0, err
+	}
+	if _, exist := validators[signer]; !exist {
+		return "", 0, ErrNotMember
+	}
+	return signer, len(validators), nil
+}
+
+// staticMembership is the fixed set of members, which is used for raft. The
+// membership changes of raft are kept only in the logs of the cluster, so
+// the members added after the genesis block must be configured.
+type staticMembership struct {
+	members map[types.PeerID]struct{}
+}
+
+func newStaticMembership(ids []string) (*staticMembership, error) {
+	members, err := peerIDSet(ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, ErrNoMember
+	}
+	return &staticMembership{members: members}, nil
+}
+
+func (m *staticMembership) Signer(h, parent *types.BlockHeader, lookup headerLookup) (types.PeerID, int, error) {
+	signer, err := (&types.Block{Header: h}).BPID()
+	if err != nil {
+		return "", 0, ErrBadSignature
+	}
+	if _, exist := m.members[signer]; !exist {
+		return "", 0, ErrNotMember
+	}
+	return signer, len(m.members), nil
+}
+
+// provenStorage reads the storage of a system account in the state of a
+// block. Every value is verified by its merkle proof against the state root
+// of the header.
+type provenStorage struct {
+	backend Backend
+	root    []byte
+	account []byte
+}
+
+func newProvenStorage(backend Backend, h *types.BlockHeader, account string) *provenStorage {
+	return &provenStorage{backend: backend, root: h.GetBlocksRootHash(), account: []byte(account)}
+}
+
+// GetData returns the value of key in the storage, as
+// state.ContractState.GetData does.
+func (s *provenStorage) GetData(key []byte) ([]byte, error) {
+	id := types.GetHashID(key)
+	storageKeys := [][]byte{id[:]}
+	proof, err := s.backend.StateProof(&types.StateQuery{
+		ContractAddress: s.account,
+		StorageKeys:     storageKeys,
+		Root:            s.root,
+		Compressed:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyStateQueryProof(s.root, s.account, storageKeys, proof); err != nil {
+		return nil, err
+	}
+	if !proof.GetContractProof().GetInclusion() || !proof.GetVarProofs()[0].GetInclusion() {
+		return nil, nil
+	}
+	return proof.GetVarProofs()[0].GetValue(), nil
+}
+
+// cachedStorage keeps the values read from a storage of a fixed state.
+type cachedStorage struct {
+	storage *provenStorage
+	values  map[string][]byte
+}
+
+func newCachedStorage(storage *provenStorage) *cachedStorage {
+	return &cachedStorage{storage: storage, values: make(map[string][]byte)}
+}
+
+// GetData returns the value of key in the storage, which is fetched only at
+// the first time.
+func (s *cachedStorage) GetData(key []byte) ([]byte, error) {
+	if value, exist := s.values[string(key)]; exist {
+		return value, nil
+	}
+	value, err := s.storage.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	s.values[string(key)] = value
+	return value, nil
+}
+
+func enterpriseBPs(genesis *types.Genesis) []string {
+	ids := make([]string, len(genesis.EnterpriseBPs))
+	for i, bp := range genesis.EnterpriseBPs {
+		ids[i] = bp.PeerID
+	}
+	return ids
+}
+
+func peerIDSet(ids []string) (map[types.PeerID]struct{}, error) {
+	set := make(map[types.PeerID]struct{}, len(ids))
+	for _, s := range ids {
+		id, err := types.IDB58Decode(s)
+		if err != nil {
+			logger.Warn().Str("id", s).Msg("invalid peer id of consensus member")
+			return nil, ErrBadMembers
+		}
+		set[id] = struct{}{}
+	}
+	return set, nil
+}
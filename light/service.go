@@ -0,0 +1,353 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-actor/actor"
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	defaultRequestTimeout = time.Second * 10
+	defaultSyncInterval   = time.Second * 10
+)
+
+var (
+	ErrNoPeer  = errors.New("no peer to get data from")
+	ErrStopped = errors.New("light service is stopped")
+)
+
+// LightService is the actor component of light node. It syncs the header
+// chain from the peers, and serves the state queries of RPC by verifying
+// merkle proofs which are fetched from the peers.
+type LightService struct {
+	*component.BaseComponent
+
+	store  db.DB
+	hc     *HeaderChain
+	client *Client
+
+	syncInterval time.Duration
+	syncC        chan struct{}
+	quitC        chan struct{}
+
+	// the goroutines using store are tracked by wg, so that store is closed
+	// after all of them finish. No goroutine starts once stopped is set.
+	mutex   sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+var (
+	_ consensus.ConsensusAccessor = (*LightService)(nil)
+	_ Backend                     = (*p2pBackend)(nil)
+)
+
+// NewLightService creates the light service. genesis is the genesis block of
+// the chain to follow.
+func NewLightService(cfg *config.Config, genesis *types.Genesis) (*LightService, error) {
+	hardfork := cfg.Hardfork
+	if genesis.IsMainNet() {
+		hardfork = config.MainNetHardforkConfig
+	} else if genesis.IsTestNet() {
+		hardfork = config.TestNetHardforkConfig
+	}
+
+	ls := &LightService{
+		syncInterval: time.Duration(cfg.Light.SyncInterval) * time.Second,
+		syncC:        make(chan struct{}, 1),
+		quitC:        make(chan struct{}),
+	}
+	if ls.syncInterval <= 0 {
+		ls.syncInterval = defaultSyncInterval
+	}
+	backend := &p2pBackend{ls: ls}
+	members, err := NewMembership(genesis, backend, hardfork, cfg.Light.BPCount, cfg.Light.Members)
+	if err != nil {
+		return nil, err
+	}
+	ls.store = db.NewDB(db.ImplType(cfg.DbType), common.PathMkdirAll(cfg.DataDir, "light"))
+	if ls.hc, err = NewHeaderChain(ls.store, genesis, members); err != nil {
+		ls.store.Close()
+		return nil, err
+	}
+	ls.BaseComponent = component.NewBaseComponent(message.LightSvc, ls, log.NewLogger("light"))
+	ls.client = NewClient(ls.hc, backend, hardfork, cfg.Light.MaxHeaders)
+	return ls, nil
+}
+
+// HeaderChain returns the header chain, which is used as the chain accessor
+// of the other services in light mode.
+func (ls *LightService) HeaderChain() *HeaderChain {
+	return ls.hc
+}
+
+func (ls *LightService) BeforeStart() {}
+
+func (ls *LightService) AfterStart() {
+	ls.spawn(ls.syncLoop)
+}
+
+func (ls *LightService) BeforeStop() {
+	ls.mutex.Lock()
+	ls.stopped = true
+	close(ls.quitC)
+	ls.mutex.Unlock()
+	ls.wg.Wait()
+	ls.store.Close()
+}
+
+// spawn runs f in a new goroutine, unless the service is stopped.
+func (ls *LightService) spawn(f func()) bool {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+	if ls.stopped {
+		return false
+	}
+	ls.wg.Add(1)
+	go func() {
+		defer ls.wg.Done()
+		f()
+	}()
+	return true
+}
+
+func (ls *LightService) Statistics() *map[string]interface{} {
+	best, _ := ls.hc.Best()
+	return &map[string]interface{}{
+		"best":      best.GetBlockNo(),
+		"finalized": ls.hc.finalizedNo(),
+	}
+}
+
+func (ls *LightService) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *message.NewBlockNoticed:
+		if best, _ := ls.hc.Best(); msg.BlockNo > best.GetBlockNo() {
+			ls.triggerSync()
+		}
+	case *message.GetLightStatus:
+		best, bestHash := ls.hc.Best()
+		context.Respond(&message.GetLightStatusRsp{Best: best, BestHash: bestHash, Finalized: ls.hc.finalizedNo()})
+	case *message.GetStateAndProof:
+		// proofs are fetched from the remote peer, so don't block the actor
+		sender := context.Sender()
+		if !ls.spawn(func() {
+			proof, err := ls.getStateAndProof(msg)
+			sender.Tell(message.GetStateAndProofRsp{StateProof: proof, Err: err})
+		}) {
+			sender.Tell(message.GetStateAndProofRsp{Err: ErrStopped})
+		}
+	case *message.GetStateQuery:
+		sender := context.Sender()
+		if !ls.spawn(func() {
+			proof, err := ls.queryContractState(msg)
+			sender.Tell(message.GetStateQueryRsp{Result: proof, Err: err})
+		}) {
+			sender.Tell(message.GetStateQueryRsp{Err: ErrStopped})
+		}
+	}
+}
+
+func (ls *LightService) triggerSync() {
+	select {
+	case ls.syncC <- struct{}{}:
+	default:
+	}
+}
+
+func (ls *LightService) syncLoop() {
+	ticker := time.NewTicker(ls.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ls.syncC:
+		case <-ls.quitC:
+			return
+		}
+		if n, err := ls.client.Sync(); err != nil {
+			ls.Warn().Err(err).Int("inserted", n).Msg("failed to sync headers")
+		} else if n > 0 {
+			best, _ := ls.hc.Best()
+			ls.Debug().Int("inserted", n).Uint64("best", best.GetBlockNo()).Uint64("finalized", ls.hc.finalizedNo()).Msg("headers synced")
+		}
+	}
+}
+
+// stateHeader returns the header whose state root is root. An empty root
+// means the finalized block.
+func (ls *LightService) stateHeader(root []byte) (*types.BlockHeader, error) {
+	finalized, _, err := ls.hc.Finalized()
+	if err != nil || len(root) == 0 {
+		return finalized, err
+	}
+	// the state root isn't indexed, so search it among the recent headers
+	best, _ := ls.hc.Best()
+	for no := best.GetBlockNo(); ; no-- {
+		h, err := ls.hc.GetHeaderByNo(no)
+		if err != nil {
+			return nil, err
+		}
+		if types.ToHashID(h.GetBlocksRootHash()) == types.ToHashID(root) {
+			return h, nil
+		}
+		if no == 0 || best.GetBlockNo()-no >= types.BlockNo(ls.client.maxHeaders) {
+			return nil, ErrHeaderNotFound
+		}
+	}
+}
+
+func (ls *LightService) getStateAndProof(msg *message.GetStateAndProof) (*types.AccountProof, error) {
+	header, err := ls.stateHeader(msg.Root)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := ls.client.QueryContractStateAt(header, msg.Account, nil)
+	if err != nil {
+		return nil, err
+	}
+	return proof.GetContractProof(), nil
+}
+
+func (ls *LightService) queryContractState(msg *message.GetStateQuery) (*types.StateQueryProof, error) {
+	header, err := ls.stateHeader(msg.Root)
+	if err != nil {
+		return nil, err
+	}
+	return ls.client.QueryContractStateAt(header, msg.ContractAddress, msg.StorageKeys)
+}
+
+// ConsensusInfo returns the consensus type of the chain. A light node
+// doesn't take part in the consensus.
+func (ls *LightService) ConsensusInfo() *types.ConsensusInfo {
+	return &types.ConsensusInfo{Type: ls.hc.GetGenesisInfo().ConsensusType(), Info: "light client"}
+}
+
+func (ls *LightService) ClusterInfo([]byte) *types.GetClusterInfoResponse {
+	return &types.GetClusterInfoResponse{Error: ErrNotSupported.Error()}
+}
+
+func (ls *LightService) ConfChange(req *types.MembershipChange) (*consensus.Member, error) {
+	return nil, ErrNotSupported
+}
+
+func (ls *LightService) ConfChangeInfo(requestID uint64) (*types.ConfChangeProgress, error) {
+	return nil, ErrNotSupported
+}
+
+func (ls *LightService) TransferLeadership(name string) (*consensus.Member, error) {
+	return nil, ErrNotSupported
+}
+
+func (ls *LightService) SetMaintenance(enable bool) (*types.MaintenanceMode, error) {
+	return nil, ErrNotSupported
+}
+
+func (ls *LightService) RaftAccessor() consensus.AergoRaftAccessor {
+	return nil
+}
+
+// p2pBackend fetches headers and proofs from the peer which has the highest
+// block.
+type p2pBackend struct {
+	ls *LightService
+}
+
+func (b *p2pBackend) bestPeer() (types.PeerID, types.BlockNo, error) {
+	result, err := b.ls.RequestToFuture(message.P2PSvc, &message.GetPeers{}, defaultRequestTimeout).Result()
+	if err != nil {
+		return "", 0, err
+	}
+	rsp, ok := result.(*message.GetPeersRsp)
+	if !ok {
+		return "", 0, ErrNoPeer
+	}
+	var bestID types.PeerID
+	var bestNo uint64
+	for _, p := range rsp.Peers {
+		if p.Self || p.State != types.RUNNING || p.Addr == nil {
+			continue
+		}
+		if bestID == "" || p.LastBlockNumber > bestNo {
+			bestID, bestNo = types.PeerID(p.Addr.PeerID), p.LastBlockNumber
+		}
+	}
+	if bestID == "" {
+		return "", 0, ErrNoPeer
+	}
+	return bestID, bestNo, nil
+}
+
+func (b *p2pBackend) BestBlockNo() (types.BlockNo, error) {
+	_, no, err := b.bestPeer()
+	return no, err
+}
+
+func (b *p2pBackend) Headers(from types.BlockNo, size uint32) ([]*types.BlockHeader, error) {
+	peerID, _, err := b.bestPeer()
+	if err != nil {
+		return nil, err
+	}
+	replyC := make(chan *message.BlockHeadersResponse, 1)
+	b.ls.TellTo(message.P2PSvc, &message.GetBlockHeaders{ToWhom: peerID,
+		Height: from + types.BlockNo(size) - 1, MaxSize: size, ReplyC: replyC})
+	select {
+	case rsp := <-replyC:
+		if rsp.Err != nil {
+			return nil, rsp.Err
+		}
+		// headers are returned in descending order
+		headers := make([]*types.BlockHeader, len(rsp.Headers))
+		for i, h := range rsp.Headers {
+			headers[len(headers)-1-i] = h
+		}
+		return headers, nil
+	case <-time.After(defaultRequestTimeout):
+		return nil, message.RemotePeerFailError
+	}
+}
+
+func (b *p2pBackend) StateProof(query *types.StateQuery) (*types.StateQueryProof, error) {
+	peerID, _, err := b.bestPeer()
+	if err != nil {
+		return nil, err
+	}
+	replyC := make(chan *message.GetStateProofRsp, 1)
+	b.ls.TellTo(message.P2PSvc, &message.GetStateProof{ToWhom: peerID, Query: query, ReplyC: replyC})
+	select {
+	case rsp := <-replyC:
+		return rsp.Proof, rsp.Err
+	case <-time.After(defaultRequestTimeout):
+		return nil, message.RemotePeerFailError
+	}
+}
+
+func (b *p2pBackend) Receipts(blockHash []byte) ([]byte, error) {
+	peerID, _, err := b.bestPeer()
+	if err != nil {
+		return nil, err
+	}
+	replyC := make(chan *message.GetBlockReceiptsRsp, 1)
+	b.ls.TellTo(message.P2PSvc, &message.GetBlockReceipts{ToWhom: peerID, BlockHash: blockHash, ReplyC: replyC})
+	select {
+	case rsp := <-replyC:
+		return rsp.Receipts, rsp.Err
+	case <-time.After(defaultRequestTimeout):
+		return nil, message.RemotePeerFailError
+	}
+}
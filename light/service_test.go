@@ -0,0 +1,57 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/db"
+)
+
+func TestLightService_BeforeStop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "light")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ls := &LightService{store: db.NewDB(db.MemoryImpl, dir), quitC: make(chan struct{})}
+
+	release := make(chan struct{})
+	written := make(chan struct{})
+	if !ls.spawn(func() {
+		<-release
+		// the store is still open, since stopping waits for this goroutine
+		ls.store.Set([]byte("key"), []byte("value"))
+		close(written)
+	}) {
+		t.Fatal("spawn() = false before stop, want true")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		ls.BeforeStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("BeforeStop() returned before the running goroutine finished")
+	case <-time.After(time.Millisecond * 100):
+	}
+	close(release)
+	<-written
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("BeforeStop() didn't return after the running goroutine finished")
+	}
+
+	if ls.spawn(func() { t.Error("goroutine started after stop") }) {
+		t.Error("spawn() = true after stop, want false")
+	}
+}
@@ -0,0 +1,122 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	ErrInvalidProof     = errors.New("merkle proof doesn't match the root")
+	ErrUnexpectedKey    = errors.New("proof is for another key")
+	ErrMissingProof     = errors.New("proof is missing")
+	ErrReceiptsMismatch = errors.New("receipts don't match the receipts root of header")
+	ErrReceiptNotFound  = errors.New("receipt not found in the block")
+)
+
+// VerifyAccountProof checks that proof proves the state (or the absence) of
+// the account of address in the state trie of root.
+func VerifyAccountProof(root []byte, address []byte, proof *types.AccountProof) error {
+	if proof == nil {
+		return ErrMissingProof
+	}
+	if len(proof.GetKey()) != 0 && !bytes.Equal(proof.GetKey(), address) {
+		return ErrUnexpectedKey
+	}
+	id := types.ToAccountID(address)
+	var value []byte
+	if proof.GetInclusion() {
+		if proof.GetState() == nil {
+			return ErrMissingProof
+		}
+		raw, err := proto.Marshal(proof.GetState())
+		if err != nil {
+			return err
+		}
+		value = common.Hasher(raw)
+	}
+	if !verifyTrieProof(root, id[:], value, proof.GetInclusion(), proof.GetBitmap(), proof.GetAuditPath(),
+		int(proof.GetHeight()), proof.GetProofKey(), proof.GetProofVal()) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyContractVarProof checks that proof proves the value (or the absence)
+// of storageKey in the storage trie of a contract. storageKey is the hashed
+// key of the trie, i.e. the hash of "_sv_" prefixed variable name.
+func VerifyContractVarProof(storageRoot []byte, storageKey []byte, proof *types.ContractVarProof) error {
+	if proof == nil {
+		return ErrMissingProof
+	}
+	if len(proof.GetKey()) != 0 && !bytes.Equal(proof.GetKey(), storageKey) {
+		return ErrUnexpectedKey
+	}
+	var value []byte
+	if proof.GetInclusion() {
+		value = common.Hasher(proof.GetValue())
+	}
+	if !verifyTrieProof(storageRoot, storageKey, value, proof.GetInclusion(), proof.GetBitmap(), proof.GetAuditPath(),
+		int(proof.GetHeight()), proof.GetProofKey(), proof.GetProofVal()) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyStateQueryProof checks the proof of a contract account and of its
+// storage variables against the state root of a block header.
+func VerifyStateQueryProof(root []byte, address []byte, storageKeys [][]byte, proof *types.StateQueryProof) error {
+	if proof == nil {
+		return ErrMissingProof
+	}
+	contractProof := proof.GetContractProof()
+	if err := VerifyAccountProof(root, address, contractProof); err != nil {
+		return err
+	}
+	if !contractProof.GetInclusion() || len(storageKeys) == 0 {
+		return nil
+	}
+	if len(proof.GetVarProofs()) != len(storageKeys) {
+		return ErrMissingProof
+	}
+	storageRoot := contractProof.GetState().GetStorageRoot()
+	for i, key := range storageKeys {
+		if err := VerifyContractVarProof(storageRoot, key, proof.GetVarProofs()[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyReceipts checks that receipts are exactly the receipts committed to
+// the header.
+func VerifyReceipts(header *types.BlockHeader, receipts *types.Receipts) error {
+	if !bytes.Equal(receipts.MerkleRoot(), header.GetReceiptsRootHash()) {
+		return ErrReceiptsMismatch
+	}
+	return nil
+}
+
+func verifyTrieProof(root, key, value []byte, inclusion bool, bitmap []byte, ap [][]byte, height int, proofKey, proofVal []byte) bool {
+	tr := trie.NewTrie(root, common.Hasher, nil)
+	compressed := len(bitmap) != 0
+	switch {
+	case inclusion && compressed:
+		return tr.VerifyInclusionC(bitmap, key, value, ap, height)
+	case inclusion:
+		return tr.VerifyInclusion(ap, key, value)
+	case compressed:
+		return tr.VerifyNonInclusionC(ap, height, bitmap, key, proofVal, proofKey)
+	default:
+		return tr.VerifyNonInclusion(ap, key, proofVal, proofKey)
+	}
+}
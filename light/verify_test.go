@@ -0,0 +1,136 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAddress(b byte) []byte {
+	address := make([]byte, types.AddressLength)
+	address[0] = 0x02
+	address[1] = b
+	return address
+}
+
+// newTestStateTrie builds a state trie of accounts, which stores the hash of
+// marshaled state as the state db does.
+func newTestStateTrie(t *testing.T, store db.DB, states map[string]*types.State) *trie.Trie {
+	keys := make([][]byte, 0, len(states))
+	values := make(map[string][]byte)
+	for address, state := range states {
+		id := types.ToAccountID([]byte(address))
+		raw, err := proto.Marshal(state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, id[:])
+		values[string(id[:])] = common.Hasher(raw)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	vals := make([][]byte, len(keys))
+	for i, k := range keys {
+		vals[i] = values[string(k)]
+	}
+	tr := trie.NewTrie(nil, common.Hasher, store)
+	if _, err := tr.Update(keys, vals); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	return tr
+}
+
+func accountProof(t *testing.T, tr *trie.Trie, address []byte, state *types.State, compressed bool) *types.AccountProof {
+	id := types.ToAccountID(address)
+	proof := &types.AccountProof{Key: address}
+	var err error
+	if compressed {
+		var height int
+		proof.Bitmap, proof.AuditPath, height, proof.Inclusion, proof.ProofKey, proof.ProofVal, err = tr.MerkleProofCompressed(id[:])
+		proof.Height = uint32(height)
+	} else {
+		proof.AuditPath, proof.Inclusion, proof.ProofKey, proof.ProofVal, err = tr.MerkleProof(id[:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Inclusion {
+		proof.State = state
+		proof.ProofKey, proof.ProofVal = nil, nil
+	}
+	return proof
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	alice, bob, carol := testAddress(1), testAddress(2), testAddress(3)
+	states := map[string]*types.State{
+		string(alice): {Nonce: 1, Balance: []byte{100}},
+		string(bob):   {Nonce: 2, Balance: []byte{200}},
+	}
+	tr := newTestStateTrie(t, store, states)
+	root := tr.Root
+
+	for _, compressed := range []bool{false, true} {
+		proof := accountProof(t, tr, alice, states[string(alice)], compressed)
+		assert.True(t, proof.Inclusion)
+		assert.NoError(t, VerifyAccountProof(root, alice, proof))
+
+		// the state which is different from the committed one
+		forged := proto.Clone(proof).(*types.AccountProof)
+		forged.State = &types.State{Nonce: 1, Balance: []byte{255}}
+		assert.Equal(t, ErrInvalidProof, VerifyAccountProof(root, alice, forged))
+
+		// the proof of another account
+		assert.Equal(t, ErrUnexpectedKey, VerifyAccountProof(root, bob, proof))
+
+		// the absence of account
+		proof = accountProof(t, tr, carol, nil, compressed)
+		assert.False(t, proof.Inclusion)
+		assert.NoError(t, VerifyAccountProof(root, carol, proof))
+
+		// the absence can't be claimed for an existing account
+		absent := accountProof(t, tr, carol, nil, compressed)
+		absent.Key = alice
+		assert.Equal(t, ErrInvalidProof, VerifyAccountProof(root, alice, absent))
+	}
+	assert.Equal(t, ErrMissingProof, VerifyAccountProof(root, alice, nil))
+}
+
+func TestVerifyReceipts(t *testing.T) {
+	bv := types.DummyBlockVersionner(0)
+	receipts := &types.Receipts{}
+	receipts.SetHardFork(bv, 1)
+	receipts.Set([]*types.Receipt{
+		{TxHash: common.Hasher([]byte("tx1")), Status: "SUCCESS", ContractAddress: testAddress(1), Ret: "1"},
+		{TxHash: common.Hasher([]byte("tx2")), Status: "ERROR", ContractAddress: testAddress(2), Ret: "failed"},
+	})
+	header := &types.BlockHeader{BlockNo: 1, ReceiptsRootHash: receipts.MerkleRoot()}
+	assert.NoError(t, VerifyReceipts(header, receipts))
+
+	raw, err := receipts.MarshalBinary()
+	assert.NoError(t, err)
+	decoded := &types.Receipts{}
+	decoded.SetHardFork(bv, 1)
+	assert.NoError(t, decoded.UnmarshalBinary(raw))
+	assert.NoError(t, VerifyReceipts(header, decoded))
+
+	decoded.Get()[1].Status = "SUCCESS"
+	assert.Equal(t, ErrReceiptsMismatch, VerifyReceipts(header, decoded))
+}
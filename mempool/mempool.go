@@ -53,6 +53,8 @@ type MemPool struct {
 
 	sync.RWMutex
 	cfg *cfg.Config
+	// hardfork is the hardfork config rescheduled by the chain service.
+	hardfork types.BlockVersionner
 
 	sdb           *state.ChainStateDB
 	bestBlockID   types.BlockID
@@ -83,15 +85,18 @@ type MemPool struct {
 func NewMemPoolService(cfg *cfg.Config, cs *chain.ChainService) *MemPool {
 
 	var sdb *state.ChainStateDB
+	var hardfork types.BlockVersionner = cfg.Hardfork
 	if cs != nil {
 		sdb = cs.SDB()
+		hardfork = cs.SharedHardforkConfig()
 	} else { // Test
 		fee.EnableZeroFee()
 	}
 
 	actor := &MemPool{
-		cfg: cfg,
-		sdb: sdb,
+		cfg:      cfg,
+		hardfork: hardfork,
+		sdb:      sdb,
 		//cache:    map[types.TxID]types.Transaction{},
 		cache:    sync.Map{},
 		pool:     map[types.AccountID]*txList{},
@@ -218,7 +223,7 @@ func (mp *MemPool) Receive(context actor.Context) {
 
 	switch msg := context.Message().(type) {
 	case *message.MemPoolPut:
-		mp.verifier.Request(msg.Tx, context.Sender())
+		mp.verifier.Request(msg, context.Sender())
 	case *message.MemPoolGet:
 		txs, err := mp.get(msg.MaxBlockBodySize)
 		context.Respond(&message.MemPoolGetRsp{
@@ -571,7 +576,7 @@ func (mp *MemPool) getNameDest(account []byte, owner bool) []byte {
 }
 
 func (mp *MemPool) nextBlockVersion() int32 {
-	return mp.cfg.Hardfork.Version(mp.bestBlockInfo.No+1)
+	return mp.hardfork.Version(mp.bestBlockInfo.No+1)
 }
 
 // check tx sanity
@@ -669,7 +674,11 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			if err != nil {
 				return err
 			}
-			if _, err := enterprise.ValidateEnterpriseTx(tx.GetBody(), sender, enterprisecs, mp.bestBlockInfo.No+1); err != nil {
+			nextBlockInfo := types.BlockHeaderInfo{
+				No:            mp.bestBlockInfo.No+1,
+				Version:       mp.nextBlockVersion(),
+			}
+			if _, err := enterprise.ValidateEnterpriseTx(tx.GetBody(), sender, enterprisecs, &nextBlockInfo); err != nil {
 				return err
 			}
 		}
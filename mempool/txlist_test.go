@@ -18,10 +18,8 @@ import (
 )
 
 var dummyMempool = &MemPool{
-	cfg: &config.Config{
-		Hardfork: &config.HardforkConfig{
-			V2: 0,
-		},
+	hardfork: &config.HardforkConfig{
+		V2: 0,
 	},
 	bestBlockInfo: getCurrentBestBlockInfoMock(),
 }
@@ -19,21 +19,28 @@ func NewTxVerifier(p *MemPool) *TxVerifier {
 func (s *TxVerifier) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *types.Tx:
-		var err error
-		if s.mp.exist(msg.GetHash()) != nil {
-			// it's very common cases.
-			err = types.ErrTxAlreadyInMempool
-			s.mp.Logger.Trace().Object("tx",types.LogTxHash{msg}).Msg("tx already exist")
-		} else {
-			tx := types.NewTransaction(msg)
-			err = s.mp.verifyTx(tx)
-			if err == nil {
-				err = s.mp.put(tx)
-			}
-			if err != nil {
-				s.mp.Logger.Info().Err(err).Str("txID",enc.ToString(msg.GetHash())).Msg("tx verification failed")
-			}
+		context.Respond(&message.MemPoolPutRsp{Err: s.verify(msg)})
+	case *message.MemPoolPut:
+		// the sender of tx is echoed back, so that p2p can penalize the peer sending invalid tx
+		context.Respond(&message.MemPoolPutRsp{Err: s.verify(msg.Tx), From: msg.From})
+	}
+}
+
+func (s *TxVerifier) verify(msg *types.Tx) error {
+	var err error
+	if s.mp.exist(msg.GetHash()) != nil {
+		// it's very common cases.
+		err = types.ErrTxAlreadyInMempool
+		s.mp.Logger.Trace().Object("tx",types.LogTxHash{msg}).Msg("tx already exist")
+	} else {
+		tx := types.NewTransaction(msg)
+		err = s.mp.verifyTx(tx)
+		if err == nil {
+			err = s.mp.put(tx)
+		}
+		if err != nil {
+			s.mp.Logger.Info().Err(err).Str("txID",enc.ToString(msg.GetHash())).Msg("tx verification failed")
 		}
-		context.Respond(&message.MemPoolPutRsp{Err: err})
 	}
+	return err
 }
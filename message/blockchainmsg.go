@@ -45,6 +45,10 @@ type AddBlockRsp struct {
 	BlockNo   types.BlockNo
 	BlockHash []byte
 	Err       error
+	// PeerID is the peer which sent the block, copied from AddBlock
+	PeerID types.PeerID
+	// Invalid is set if the block fails the verification, not by the state of local chain.
+	Invalid bool
 }
 type GetState struct {
 	Account []byte
@@ -80,6 +84,25 @@ type GetReceiptRsp struct {
 	Err     error
 }
 
+// GetReceiptWithProof requests the receipt of the transaction with the merkle
+// path which proves its inclusion in the receipts root of the block.
+type GetReceiptWithProof struct {
+	TxHash []byte
+}
+type GetReceiptWithProofRsp struct {
+	Proof *types.ReceiptProof
+	Err   error
+}
+
+// GetReceipts requests all receipts of the block.
+type GetReceipts struct {
+	BlockHash []byte
+}
+type GetReceiptsRsp struct {
+	Receipts *types.Receipts
+	Err      error
+}
+
 type GetABI struct {
 	Contract []byte
 }
@@ -192,9 +215,48 @@ type ListEvents struct {
 // response to p2p for GetAncestor message
 type ListEventsRsp struct {
 	Events []*types.Event
+	Cursor []byte
 	Err    error
 }
 
+// AddDoubleSignEvidence requests chain service to verify and keep the
+// double-sign evidence received from a peer.
+type AddDoubleSignEvidence struct {
+	Evidence *types.DoubleSignEvidence
+	From     types.PeerID
+}
+
+// ListDoubleSignEvidence requests the kept double-sign evidences. Those of
+// all block producers are listed if BPID is empty.
+type ListDoubleSignEvidence struct {
+	BPID types.PeerID
+}
+
+type ListDoubleSignEvidenceRsp struct {
+	Evidences []*types.DoubleSignEvidence
+	Err       error
+}
+
+// GetStakingHistory requests the staking records of the account in the block
+// range. The best block is used if BlockTo is 0.
+type GetStakingHistory struct {
+	Account   []byte
+	BlockFrom types.BlockNo
+	BlockTo   types.BlockNo
+}
+
+type GetStakingHistoryRsp struct {
+	Records []*types.StakingRecord
+	Err     error
+}
+
+// GetHardforkSchedule requests the block numbers of hardfork versions.
+type GetHardforkSchedule struct{}
+
+type GetHardforkScheduleRsp struct {
+	Schedule *types.HardforkSchedule
+}
+
 type VerifyStart struct{}
 
 type GetParams struct{}
@@ -0,0 +1,29 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package message
+
+import (
+	"github.com/aergoio/aergo/types"
+)
+
+const LightSvc = "LightSvc"
+
+// NewBlockNoticed is sent from p2p to light service when a remote peer announces a new block.
+type NewBlockNoticed struct {
+	PeerID    types.PeerID
+	BlockHash BlockHash
+	BlockNo   types.BlockNo
+}
+
+// GetLightStatus requests the status of header chain of light service.
+// The actor returns *GetLightStatusRsp
+type GetLightStatus struct{}
+
+type GetLightStatusRsp struct {
+	Best      *types.BlockHeader
+	BestHash  BlockHash
+	Finalized types.BlockNo
+}
@@ -15,11 +15,14 @@ const MemPoolSvc = "MemPoolSvc"
 // MemPoolPut is interface of MemPool service for inserting transactions
 type MemPoolPut struct {
 	Tx *types.Tx
+	// From is the remote peer which sent the tx, or empty if it is not from p2p
+	From types.PeerID
 }
 
 // MemPoolPutRsp defines struct of result for MemPoolPut
 type MemPoolPutRsp struct {
-	Err error
+	Err  error
+	From types.PeerID
 }
 
 // MemPoolGet is interface of MemPool service for retrieving transactions
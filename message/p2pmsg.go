@@ -57,6 +57,36 @@ type NotifyNewTransactions struct {
 	Txs []*types.Tx
 }
 
+// NotifyFinalityVote send types.FinalityVote to other peers except the peer
+// which the vote is received from. From is empty for the vote of this node.
+type NotifyFinalityVote struct {
+	Vote *types.FinalityVote
+	From types.PeerID
+}
+
+// NotifyVoteEquivocation send types.VoteEquivocation to other peers except
+// the peer which the evidence is received from. From is empty for the
+// evidence detected by this node.
+type NotifyVoteEquivocation struct {
+	Evidence *types.VoteEquivocation
+	From     types.PeerID
+}
+
+// NotifyDoubleSignEvidence send types.DoubleSignEvidence to other peers
+// except the peer which the evidence is received from. From is empty for the
+// evidence detected by this node.
+type NotifyDoubleSignEvidence struct {
+	Evidence *types.DoubleSignEvidence
+	From     types.PeerID
+}
+
+// NotifyBFTMessage send types.BFTMessage to other peers except the peer which
+// the message is received from. From is empty for the message of this node.
+type NotifyBFTMessage struct {
+	Message *types.BFTMessage
+	From    types.PeerID
+}
+
 // GetTransactions send types.GetTransactionsRequest to dest peer. The receiving peer will send types.GetTransactionsResponse
 // The actor returns true if sending is successful.
 type GetTransactions struct {
@@ -80,13 +110,45 @@ type GetBlockHeaders struct {
 	Asc     bool
 	Offset  uint64
 	MaxSize uint32
+	// ReplyC receives the response of remote peer if it is not nil. It must be buffered channel.
+	ReplyC chan *BlockHeadersResponse
 }
 
 // BlockHeadersResponse is data from other peer, as a response of types.GetBlockRequest
 // p2p module will send this to chainservice actor.
 type BlockHeadersResponse struct {
-	Hashes  []BlockHash
-	Headers []*types.BlockHeader
+	FromWhom types.PeerID
+	Hashes   []BlockHash
+	Headers  []*types.BlockHeader
+	Err      error
+}
+
+// GetStateProof send types.GetStateProofRequest to dest peer, and the response is sent to ReplyC.
+type GetStateProof struct {
+	ToWhom types.PeerID
+	// Query.Root is the state root of block which the proof is based on
+	Query  *types.StateQuery
+	ReplyC chan *GetStateProofRsp
+}
+
+type GetStateProofRsp struct {
+	FromWhom types.PeerID
+	Proof    *types.StateQueryProof
+	Err      error
+}
+
+// GetBlockReceipts send types.GetReceiptsRequest to dest peer, and the response is sent to ReplyC.
+type GetBlockReceipts struct {
+	ToWhom    types.PeerID
+	BlockHash BlockHash
+	ReplyC    chan *GetBlockReceiptsRsp
+}
+
+type GetBlockReceiptsRsp struct {
+	FromWhom types.PeerID
+	// Receipts is the binary form of types.Receipts
+	Receipts []byte
+	Err      error
 }
 
 // GetBlockInfos send types.GetBlockRequest to dest peer.
@@ -121,6 +183,8 @@ type GetBlockChunksRsp struct {
 type GetPeers struct {
 	NoHidden bool
 	ShowSelf bool
+	// ShowBanned adds the peers banned by bad reputation, which are not connected
+	ShowBanned bool
 }
 
 type PeerInfo struct {
@@ -134,6 +198,10 @@ type PeerInfo struct {
 	LastBlockNumber uint64
 	State           types.PeerState
 	Self            bool
+	// Score is the reputation score of peer, and LastPenalty is the reason of the last penalty
+	Score       int
+	LastPenalty string
+	BannedUntil time.Time
 }
 
 // GetPeersRsp contains peer meta information and current states.
@@ -141,6 +209,15 @@ type GetPeersRsp struct {
 	Peers []*PeerInfo
 }
 
+// GetAddressBook requests p2p actor to get the peers in the address book.
+// The actor returns *GetAddressBookRsp
+type GetAddressBook struct {
+}
+
+type GetAddressBookRsp struct {
+	Entries []*types.AddressBookEntry
+}
+
 type GetMetrics struct {
 }
 
@@ -242,6 +319,48 @@ type NotifyCertRenewed struct {
 	Cert *types.AgentCertificate
 }
 
+// NotifyCertRevoked requests p2p actor to remove the certificates revoked by CRL from connected peers, and to
+// gossip CRL to peers except From. CRL is sent only to the peer if To is set.
+type NotifyCertRevoked struct {
+	CRL  *types.CertificateRevocationList
+	From types.PeerID
+	To   types.PeerID
+}
+
+// GetAgentCertificates requests p2p actor to get the certificates of local peer and the known revocation lists.
+// The certificates are issued ones for block producer, or my own ones for agent. The actor returns
+// *GetAgentCertificatesRsp
+type GetAgentCertificates struct {
+}
+
+type GetAgentCertificatesRsp struct {
+	Certificates    []*types.AgentCertificate
+	RevocationLists []*types.CertificateRevocationList
+}
+
+// RenewAgentCertificates requests p2p actor to renew certificates without waiting for expiration. PeerID is the agent
+// if local peer is block producer, or the block producer if local peer is agent. The actor returns
+// *RenewAgentCertificatesRsp
+type RenewAgentCertificates struct {
+	PeerID types.PeerID
+}
+
+type RenewAgentCertificatesRsp struct {
+	Err error
+}
+
+// RevokeAgentCertificates requests p2p actor of block producer to revoke the certificates issued to the agent.
+// The actor returns *RevokeAgentCertificatesRsp
+type RevokeAgentCertificates struct {
+	AgentID   types.PeerID
+	Renewable bool
+}
+
+type RevokeAgentCertificatesRsp struct {
+	CRL *types.CertificateRevocationList
+	Err error
+}
+
 type TossDirection bool
 
 type TossBPNotice struct {
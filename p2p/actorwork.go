@@ -8,6 +8,7 @@ package p2p
 import (
 	"fmt"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"math/rand"
 	"github.com/aergoio/etcd/raft/raftpb"
 	"reflect"
 	"time"
@@ -44,8 +45,16 @@ func (p2ps *P2P) GetBlockHeaders(msg *message.GetBlockHeaders) bool {
 	remotePeer, exists := p2ps.pm.GetPeer(msg.ToWhom)
 	if !exists {
 		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(msg.ToWhom)).Msg("Request to invalid peer")
+		if msg.ReplyC != nil {
+			msg.ReplyC <- &message.BlockHeadersResponse{FromWhom: msg.ToWhom, Err: message.PeerNotFoundError}
+		}
 		return false
 	}
+	if msg.ReplyC != nil {
+		receiver := NewBlockHeadersReceiver(remotePeer, msg, fetchTimeOut)
+		receiver.StartGet()
+		return true
+	}
 
 	p2ps.Debug().Str(p2putil.LogPeerName, remotePeer.Name()).Interface("msg", msg).Msg("Sending Get block Header request")
 	// create message data
@@ -56,6 +65,30 @@ func (p2ps *P2P) GetBlockHeaders(msg *message.GetBlockHeaders) bool {
 	return true
 }
 
+// GetStateProof send request message of state proof to peer. The response is sent to ReplyC of msg.
+func (p2ps *P2P) GetStateProof(msg *message.GetStateProof) {
+	remotePeer, exists := p2ps.pm.GetPeer(msg.ToWhom)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(msg.ToWhom)).Str(p2putil.LogProtoID, p2pcommon.GetStateProofRequest.String()).Msg("Invalid peerID")
+		msg.ReplyC <- &message.GetStateProofRsp{FromWhom: msg.ToWhom, Err: message.PeerNotFoundError}
+		return
+	}
+	receiver := NewStateProofReceiver(remotePeer, msg, fetchTimeOut)
+	receiver.StartGet()
+}
+
+// GetBlockReceipts send request message of receipts of a block to peer. The response is sent to ReplyC of msg.
+func (p2ps *P2P) GetBlockReceipts(msg *message.GetBlockReceipts) {
+	remotePeer, exists := p2ps.pm.GetPeer(msg.ToWhom)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(msg.ToWhom)).Str(p2putil.LogProtoID, p2pcommon.GetReceiptsRequest.String()).Msg("Invalid peerID")
+		msg.ReplyC <- &message.GetBlockReceiptsRsp{FromWhom: msg.ToWhom, Err: message.PeerNotFoundError}
+		return
+	}
+	receiver := NewReceiptsReceiver(remotePeer, msg, fetchTimeOut)
+	receiver.StartGet()
+}
+
 // GetBlocks send request message to peer and
 func (p2ps *P2P) GetBlocks(peerID types.PeerID, blockHashes []message.BlockHash) bool {
 	remotePeer, exists := p2ps.pm.GetPeer(peerID)
@@ -90,7 +123,7 @@ func (p2ps *P2P) GetBlocksChunk(context actor.Context, msg *message.GetBlockChun
 		context.Respond(&message.GetBlockChunksRsp{Seq:msg.Seq, ToWhom: peerID, Err: fmt.Errorf("invalid peer")})
 		return
 	}
-	receiver := NewBlockReceiver(p2ps, remotePeer, msg.Seq, blockHashes, msg.TTL)
+	receiver := NewBlockReceiver(p2ps, remotePeer, p2ps.rm, msg.Seq, blockHashes, msg.TTL)
 	receiver.StartGet()
 }
 
@@ -128,6 +161,9 @@ func (p2ps *P2P) NotifyNewBlock(blockNotice message.NotifyNewBlock) bool {
 		BlockHash: blockNotice.Block.BlockHash(),
 		BlockNo:   blockNotice.BlockNo}
 	mo := p2ps.mf.NewMsgBlkBroadcastOrder(req)
+	// peers supporting compact block relay receive the header and tx hashes instead,
+	// so that they don't need to download the full body.
+	var cmo p2pcommon.MsgOrder
 
 	// sending new block notice (relay inv message is not need to every nodes)
 	peers := p2ps.prm.FilterNewBlockNoticeReceiver(blockNotice.Block, p2ps.pm )
@@ -135,7 +171,16 @@ func (p2ps *P2P) NotifyNewBlock(blockNotice message.NotifyNewBlock) bool {
 	for _, neighbor := range peers {
 		if neighbor != nil && neighbor.State() == types.RUNNING {
 			sent++
-			neighbor.SendMessage(mo)
+			if neighbor.RemoteInfo().SupportCompactBlock() {
+				if cmo == nil {
+					cmo = p2ps.mf.NewMsgCompactBlkBroadcastOrder(newCompactBlockNotice(blockNotice.Block))
+					// txs of the block are already removed from mempool, so keep them to serve requests of missing txs
+					p2ps.sm.RegisterTxNotice(blockNotice.Block.GetBody().GetTxs())
+				}
+				neighbor.SendMessage(cmo)
+			} else {
+				neighbor.SendMessage(mo)
+			}
 		} else {
 			skipped++
 		}
@@ -193,26 +238,87 @@ func (p2ps *P2P) GetTXs(peerID types.PeerID, txHashes []message.TXHash) bool {
 	return true
 }
 
+// NotifyFinalityVote broadcasts the finality vote to the peers, except the one which the vote came from.
+func (p2ps *P2P) NotifyFinalityVote(msg *message.NotifyFinalityVote) bool {
+	mo := p2ps.mf.NewMsgRequestOrder(false, p2pcommon.FinalityVoteNotice, msg.Vote)
+	sent, skipped := 0, 0
+	for _, neighbor := range p2ps.pm.GetPeers() {
+		if neighbor.State() == types.RUNNING && neighbor.ID() != msg.From {
+			sent++
+			neighbor.SendMessage(mo)
+		} else {
+			skipped++
+		}
+	}
+
+	p2ps.Debug().Int("skipped_cnt", skipped).Int("sent_cnt", sent).Uint32("type", msg.Vote.Type).Uint64("block_no", msg.Vote.BlockNo).Msg("Notifying finality vote")
+	return true
+}
+
+// NotifyVoteEquivocation broadcasts the evidence of conflicting finality votes to the peers, except the one which the evidence came from.
+func (p2ps *P2P) NotifyVoteEquivocation(msg *message.NotifyVoteEquivocation) bool {
+	mo := p2ps.mf.NewMsgRequestOrder(false, p2pcommon.VoteEquivocationNotice, msg.Evidence)
+	sent, skipped := 0, 0
+	for _, neighbor := range p2ps.pm.GetPeers() {
+		if neighbor.State() == types.RUNNING && neighbor.ID() != msg.From {
+			sent++
+			neighbor.SendMessage(mo)
+		} else {
+			skipped++
+		}
+	}
+
+	p2ps.Debug().Int("skipped_cnt", skipped).Int("sent_cnt", sent).Uint64("block_no", msg.Evidence.GetFirst().GetBlockNo()).Msg("Notifying finality vote equivocation")
+	return true
+}
+
+// NotifyDoubleSignEvidence broadcasts the double-sign evidence to the peers, except the one which the evidence came from.
+func (p2ps *P2P) NotifyDoubleSignEvidence(msg *message.NotifyDoubleSignEvidence) bool {
+	mo := p2ps.mf.NewMsgRequestOrder(false, p2pcommon.DoubleSignEvidenceNotice, msg.Evidence)
+	sent, skipped := 0, 0
+	for _, neighbor := range p2ps.pm.GetPeers() {
+		if neighbor.State() == types.RUNNING && neighbor.ID() != msg.From {
+			sent++
+			neighbor.SendMessage(mo)
+		} else {
+			skipped++
+		}
+	}
+
+	p2ps.Debug().Int("skipped_cnt", skipped).Int("sent_cnt", sent).Uint64("block_no", msg.Evidence.GetFirst().GetBlockNo()).Msg("Notifying double-sign evidence")
+	return true
+}
+
+// NotifyBFTMessage broadcasts the proposal or vote of BFT validator to the peers, except the one which the message came from.
+func (p2ps *P2P) NotifyBFTMessage(msg *message.NotifyBFTMessage) bool {
+	mo := p2ps.mf.NewMsgRequestOrder(false, p2pcommon.BFTMessageNotice, msg.Message)
+	sent, skipped := 0, 0
+	for _, neighbor := range p2ps.pm.GetPeers() {
+		if neighbor.State() == types.RUNNING && neighbor.ID() != msg.From {
+			sent++
+			neighbor.SendMessage(mo)
+		} else {
+			skipped++
+		}
+	}
+
+	p2ps.Debug().Int("skipped_cnt", skipped).Int("sent_cnt", sent).Uint64("height", msg.Message.Height()).Uint32("round", msg.Message.Round()).Msg("Notifying BFT message")
+	return true
+}
+
 // NotifyNewTX notice tx(s) id created
 func (p2ps *P2P) NotifyNewTX(msg *message.NotifyNewTransactions) bool {
 	hashes := make([]types.TxID, len(msg.Txs))
 	for i, tx := range msg.Txs {
 		hashes[i] = types.ToTxID(tx.Hash)
 	}
-	// create message data
-	skipped, sent := 0, 0
 	// send to peers
-	peers := p2ps.pm.GetPeers()
+	peers, skipped := txRelayPeers(p2ps.pm.GetPeers(), txRelayFanout)
 	p2ps.sm.RegisterTxNotice(msg.Txs)
 	for _, rPeer := range peers {
-		if rPeer != nil && rPeer.State() == types.RUNNING {
-			sent++
-			rPeer.PushTxsNotice(hashes)
-		} else {
-			skipped++
-		}
+		rPeer.PushTxsNotice(hashes)
 	}
-	//p2ps.Debug().Int("skippeer_cnt", skipped).Int("sendpeer_cnt", sent).Int("hash_cnt", len(hashes)).Msg("Notifying newTXs to peers")
+	p2ps.Trace().Int("skippeer_cnt", skipped).Int("sendpeer_cnt", len(peers)).Int("hash_cnt", len(hashes)).Msg("Notifying newTXs to peers")
 
 	return true
 }
@@ -289,6 +395,38 @@ func (p2ps *P2P) NotifyCertRenewed(context actor.Context, renewed message.Notify
 
 }
 
+// NotifyCertRevoked removes revoked certificates from connected peers and gossips the revocation list to other peers.
+func (p2ps *P2P) NotifyCertRevoked(context actor.Context, revoked message.NotifyCertRevoked) {
+	body := &types.CertificateRevokedNotice{RevocationList: revoked.CRL}
+	if len(revoked.To) > 0 {
+		if remotePeer, exists := p2ps.pm.GetPeer(revoked.To); exists {
+			remotePeer.SendMessage(p2ps.mf.NewMsgRequestOrder(false, p2pcommon.CertificateRevokedNotice, body))
+		}
+		return
+	}
+	crl, err := p2putil.CheckAndGetCRLV1(revoked.CRL)
+	if err != nil {
+		p2ps.Warn().Err(err).Msg("invalid certificate revocation list to notify")
+		return
+	}
+	msg := p2ps.mf.NewMsgRequestOrder(false, p2pcommon.CertificateRevokedNotice, body)
+
+	skipped, sent := 0, 0
+	for _, neighbor := range p2ps.pm.GetPeers() {
+		if neighbor == nil {
+			continue
+		}
+		neighbor.RemoveRevokedCertificates(crl)
+		if neighbor.State() == types.RUNNING && !types.IsSamePeerID(neighbor.ID(), revoked.From) {
+			sent++
+			neighbor.SendMessage(msg)
+		} else {
+			skipped++
+		}
+	}
+	p2ps.Debug().Int("skipped_cnt", skipped).Int("sent_cnt", sent).Str("bpID", p2putil.ShortForm(crl.BPID)).Msg("Notifying certificate revoked")
+}
+
 func (p2ps *P2P) TossBPNotice(msg message.TossBPNotice) bool {
 	orgMsg := msg.OriginalMsg.(p2pcommon.Message)
 	mo := p2ps.mf.NewTossMsgOrder(orgMsg)
@@ -308,3 +446,29 @@ func (p2ps *P2P) TossBPNotice(msg message.TossBPNotice) bool {
 	p2ps.Debug().Int("skipCnt", skipped).Int("sendCnt", sent).Str("Target", targetZone.String()).Str(p2putil.LogMsgID, orgMsg.ID().String()).Msg("Tossing block produced notice")
 	return true
 }
+
+// txRelayPeers selects running peers to which new txs are announced. Block producers always get notices since they
+// make blocks with txs, and the others are chosen randomly up to fanout, so that txs are gossiped through the network
+// without flooding every link.
+func txRelayPeers(peers []p2pcommon.RemotePeer, fanout int) ([]p2pcommon.RemotePeer, int) {
+	targets := make([]p2pcommon.RemotePeer, 0, len(peers))
+	others := make([]p2pcommon.RemotePeer, 0, len(peers))
+	skipped := 0
+	for _, rPeer := range peers {
+		if rPeer == nil || rPeer.State() != types.RUNNING {
+			skipped++
+		} else if rPeer.AcceptedRole() == types.PeerRole_Producer {
+			targets = append(targets, rPeer)
+		} else {
+			others = append(others, rPeer)
+		}
+	}
+	if len(others) > fanout {
+		rand.Shuffle(len(others), func(i, j int) {
+			others[i], others[j] = others[j], others[i]
+		})
+		skipped += len(others) - fanout
+		others = others[:fanout]
+	}
+	return append(targets, others...), skipped
+}
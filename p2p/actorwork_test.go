@@ -250,4 +250,46 @@ func TestP2P_NotifyBlockProduced(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func Test_txRelayPeers(t *testing.T) {
+	rp, rw := types.PeerRole_Producer, types.PeerRole_Watcher
+	sr, ss := types.RUNNING, types.STOPPING
+
+	tests := []struct {
+		name    string
+		argPeer []rs
+		fanout  int
+
+		wantCnt  int
+		wantSkip int
+	}{
+		{"TUnderFanout", []rs{{rw, sr}, {rw, sr}, {rw, ss}}, 3, 2, 1},
+		{"TOverFanout", []rs{{rw, sr}, {rw, sr}, {rw, sr}, {rw, sr}}, 2, 2, 2},
+		// block producers are always selected
+		{"TProducers", []rs{{rp, sr}, {rp, sr}, {rw, sr}, {rw, sr}, {rp, ss}}, 1, 3, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPeers := make([]p2pcommon.RemotePeer, 0, len(tt.argPeer))
+			for _, ap := range tt.argPeer {
+				mPeer := p2pmock.NewMockRemotePeer(ctrl)
+				mPeer.EXPECT().AcceptedRole().Return(ap.r).AnyTimes()
+				mPeer.EXPECT().State().Return(ap.s).AnyTimes()
+				mockPeers = append(mockPeers, mPeer)
+			}
+
+			got, skipped := txRelayPeers(mockPeers, tt.fanout)
+			if len(got) != tt.wantCnt || skipped != tt.wantSkip {
+				t.Errorf("txRelayPeers() = %v, %v, want %v, %v", len(got), skipped, tt.wantCnt, tt.wantSkip)
+			}
+			for _, p := range got {
+				if p.State() != types.RUNNING {
+					t.Errorf("txRelayPeers() selected not running peer")
+				}
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ type BlocksChunkReceiver struct {
 
 	peer  p2pcommon.RemotePeer
 	actor p2pcommon.ActorService
+	rm    p2pcommon.ReputationManager
 
 	blockHashes []message.BlockHash
 	timeout     time.Time
@@ -44,9 +45,9 @@ const (
 	receiverStatusFinished
 )
 
-func NewBlockReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, seq uint64, blockHashes []message.BlockHash, ttl time.Duration) *BlocksChunkReceiver {
+func NewBlockReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, rm p2pcommon.ReputationManager, seq uint64, blockHashes []message.BlockHash, ttl time.Duration) *BlocksChunkReceiver {
 	timeout := time.Now().Add(ttl)
-	return &BlocksChunkReceiver{syncerSeq: seq, actor: actor, peer: peer, blockHashes: blockHashes, timeout: timeout, got: make([]*types.Block, len(blockHashes))}
+	return &BlocksChunkReceiver{syncerSeq: seq, actor: actor, peer: peer, rm: rm, blockHashes: blockHashes, timeout: timeout, got: make([]*types.Block, len(blockHashes))}
 }
 
 func (br *BlocksChunkReceiver) StartGet() {
@@ -89,6 +90,7 @@ func (br *BlocksChunkReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2
 	// timeout
 	if br.timeout.Before(time.Now()) {
 		// silently ignore already status job
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ResponseTimeout, "getBlocks response")
 		br.finishReceiver()
 		return
 	}
@@ -131,6 +133,7 @@ func (br *BlocksChunkReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2
 			br.cancelReceiving(message.TooFewBlocksError, body.HasNext)
 		} else {
 			br.actor.TellRequest(message.SyncerSvc, &message.GetBlockChunksRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Blocks: br.got, Err: nil})
+			reportPeer(br.rm, br.peer.ID(), p2pcommon.UsefulResponse, "getBlocks response")
 			br.finishReceiver()
 		}
 	}
@@ -141,6 +144,10 @@ func (br *BlocksChunkReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2
 // not all part of response is received, it wait remaining (and useless) response. It is assumed cancelling is not frequently occur
 func (br *BlocksChunkReceiver) cancelReceiving(err error, hasNext bool) {
 	br.status = receiverStatusCanceled
+	// remote peer can fail by its own state, but the other errors are caused by the malformed response
+	if err != message.RemotePeerFailError {
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "getBlocks response: "+err.Error())
+	}
 	br.actor.TellRequest(message.SyncerSvc,
 		&message.GetBlockChunksRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Err: err})
 
@@ -48,7 +48,7 @@ func TestBlocksChunkReceiver_StartGet(t *testing.T) {
 			mockPeer.EXPECT().SendMessage(mockMo).Times(1)
 
 			expire := time.Now().Add(test.ttl)
-			br := NewBlockReceiver(mockActor, mockPeer, 0, test.input, test.ttl)
+			br := NewBlockReceiver(mockActor, mockPeer, nil, 0, test.input, test.ttl)
 
 			br.StartGet()
 
@@ -124,7 +124,7 @@ func TestBlocksChunkReceiver_ReceiveResp(t *testing.T) {
 			}
 
 			//expire := time.Now().Add(test.ttl)
-			br := NewBlockReceiver(mockActor, mockPeer, seqNo, test.input, time.Minute>>1)
+			br := NewBlockReceiver(mockActor, mockPeer, nil, seqNo, test.input, time.Minute>>1)
 			br.StartGet()
 
 			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetBlocksResponse, sampleMsgID)
@@ -200,7 +200,7 @@ func TestBlocksChunkReceiver_ReceiveRespTimeout(t *testing.T) {
 			}
 
 			//expire := time.Now().Add(test.ttl)
-			br := NewBlockReceiver(mockActor, mockPeer, seqNo, test.input, test.ttl)
+			br := NewBlockReceiver(mockActor, mockPeer, nil, seqNo, test.input, test.ttl)
 			br.StartGet()
 
 			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetBlocksResponse, sampleMsgID)
@@ -0,0 +1,70 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/aergoio/etcd/raft/raftpb"
+)
+
+// bodyFactories is the type of payload for each subprotocol, which must be same as the handlers in subproto
+var bodyFactories = map[p2pcommon.SubProtocol]func() p2pcommon.MessageBody{
+	p2pcommon.StatusRequest:            func() p2pcommon.MessageBody { return &types.Status{} },
+	p2pcommon.PingRequest:              func() p2pcommon.MessageBody { return &types.Ping{} },
+	p2pcommon.PingResponse:             func() p2pcommon.MessageBody { return &types.Pong{} },
+	p2pcommon.GoAway:                   func() p2pcommon.MessageBody { return &types.GoAwayNotice{} },
+	p2pcommon.AddressesRequest:         func() p2pcommon.MessageBody { return &types.AddressesRequest{} },
+	p2pcommon.AddressesResponse:        func() p2pcommon.MessageBody { return &types.AddressesResponse{} },
+	p2pcommon.IssueCertificateRequest:  func() p2pcommon.MessageBody { return &types.IssueCertificateRequest{} },
+	p2pcommon.IssueCertificateResponse: func() p2pcommon.MessageBody { return &types.IssueCertificateResponse{} },
+	p2pcommon.CertificateRenewedNotice: func() p2pcommon.MessageBody { return &types.CertificateRenewedNotice{} },
+	p2pcommon.CertificateRevokedNotice: func() p2pcommon.MessageBody { return &types.CertificateRevokedNotice{} },
+
+	p2pcommon.GetBlocksRequest:        func() p2pcommon.MessageBody { return &types.GetBlockRequest{} },
+	p2pcommon.GetBlocksResponse:       func() p2pcommon.MessageBody { return &types.GetBlockResponse{} },
+	p2pcommon.GetBlockHeadersRequest:  func() p2pcommon.MessageBody { return &types.GetBlockHeadersRequest{} },
+	p2pcommon.GetBlockHeadersResponse: func() p2pcommon.MessageBody { return &types.GetBlockHeadersResponse{} },
+	p2pcommon.NewBlockNotice:          func() p2pcommon.MessageBody { return &types.NewBlockNotice{} },
+	p2pcommon.GetAncestorRequest:      func() p2pcommon.MessageBody { return &types.GetAncestorRequest{} },
+	p2pcommon.GetAncestorResponse:     func() p2pcommon.MessageBody { return &types.GetAncestorResponse{} },
+	p2pcommon.GetHashesRequest:        func() p2pcommon.MessageBody { return &types.GetHashesRequest{} },
+	p2pcommon.GetHashesResponse:       func() p2pcommon.MessageBody { return &types.GetHashesResponse{} },
+	p2pcommon.GetHashByNoRequest:      func() p2pcommon.MessageBody { return &types.GetHashByNo{} },
+	p2pcommon.GetHashByNoResponse:     func() p2pcommon.MessageBody { return &types.GetHashByNoResponse{} },
+	p2pcommon.CompactBlockNotice:      func() p2pcommon.MessageBody { return &types.CompactBlockNotice{} },
+
+	p2pcommon.GetTXsRequest:  func() p2pcommon.MessageBody { return &types.GetTransactionsRequest{} },
+	p2pcommon.GetTXsResponse: func() p2pcommon.MessageBody { return &types.GetTransactionsResponse{} },
+	p2pcommon.NewTxNotice:    func() p2pcommon.MessageBody { return &types.NewTransactionsNotice{} },
+
+	p2pcommon.BlockProducedNotice:      func() p2pcommon.MessageBody { return &types.BlockProducedNotice{} },
+	p2pcommon.FinalityVoteNotice:       func() p2pcommon.MessageBody { return &types.FinalityVote{} },
+	p2pcommon.VoteEquivocationNotice:   func() p2pcommon.MessageBody { return &types.VoteEquivocation{} },
+	p2pcommon.DoubleSignEvidenceNotice: func() p2pcommon.MessageBody { return &types.DoubleSignEvidence{} },
+	p2pcommon.BFTMessageNotice:         func() p2pcommon.MessageBody { return &types.BFTMessage{} },
+
+	p2pcommon.GetStateProofRequest:  func() p2pcommon.MessageBody { return &types.GetStateProofRequest{} },
+	p2pcommon.GetStateProofResponse: func() p2pcommon.MessageBody { return &types.GetStateProofResponse{} },
+	p2pcommon.GetReceiptsRequest:    func() p2pcommon.MessageBody { return &types.GetReceiptsRequest{} },
+	p2pcommon.GetReceiptsResponse:   func() p2pcommon.MessageBody { return &types.GetReceiptsResponse{} },
+
+	p2pcommon.GetClusterRequest:  func() p2pcommon.MessageBody { return &types.GetClusterInfoRequest{} },
+	p2pcommon.GetClusterResponse: func() p2pcommon.MessageBody { return &types.GetClusterInfoResponse{} },
+	p2pcommon.RaftWrapperMessage: func() p2pcommon.MessageBody { return &raftpb.Message{} },
+}
+
+// DecodeBody unmarshals the payload of message to the type of its subprotocol.
+func DecodeBody(msg p2pcommon.Message) (p2pcommon.MessageBody, error) {
+	factory, exist := bodyFactories[msg.Subprotocol()]
+	if !exist {
+		return nil, fmt.Errorf("unknown subprotocol %s", msg.Subprotocol())
+	}
+	return p2putil.UnmarshalAndReturn(msg.Payload(), factory())
+}
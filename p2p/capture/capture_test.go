@@ -0,0 +1,223 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	v030 "github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/types"
+)
+
+var sampleHeader = Header{SelfID: types.RandomPeerID(), GenesisChainID: []byte("chainid"), GenesisHash: []byte("genesishash")}
+
+func newMsg(t *testing.T, protocol p2pcommon.SubProtocol, body p2pcommon.MessageBody) p2pcommon.Message {
+	payload, err := p2putil.MarshalMessageBody(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p2pcommon.NewMessageValue(protocol, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano(), payload)
+}
+
+type dummyRW struct {
+	in []p2pcommon.Message
+}
+
+func (rw *dummyRW) ReadMsg() (p2pcommon.Message, error) {
+	if len(rw.in) == 0 {
+		return nil, io.EOF
+	}
+	msg := rw.in[0]
+	rw.in = rw.in[1:]
+	return msg, nil
+}
+func (rw *dummyRW) WriteMsg(msg p2pcommon.Message) error    { return nil }
+func (rw *dummyRW) Close() error                            { return nil }
+func (rw *dummyRW) AddIOListener(l p2pcommon.MsgIOListener) {}
+
+func readAll(t *testing.T, path string) (Header, []*Record) {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := NewReader(f)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	var records []*Record
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		records = append(records, rec)
+	}
+	return r.Header(), records
+}
+
+func TestRecorder_WrapReadWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "p2pcapture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	recorder, err := NewRecorder(log.NewLogger("test.capture"), dir, 1<<20, 3, sampleHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := types.RandomPeerID()
+	ping := newMsg(t, p2pcommon.PingRequest, &types.Ping{BestHeight: 10})
+	notice := newMsg(t, p2pcommon.NewTxNotice, &types.NewTransactionsNotice{TxHashes: [][]byte{[]byte("txhash")}})
+	rw := recorder.WrapReadWriter(pid, &dummyRW{in: []p2pcommon.Message{ping}})
+	rw.ReadMsg()
+	rw.WriteMsg(notice)
+	// failed read is not recorded
+	rw.ReadMsg()
+	recorder.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"+fileSuffix))
+	if len(files) != 1 {
+		t.Fatalf("capture files = %v, want 1", files)
+	}
+	header, records := readAll(t, files[0])
+	if header.SelfID != sampleHeader.SelfID || !bytes.Equal(header.GenesisHash, sampleHeader.GenesisHash) || !bytes.Equal(header.GenesisChainID, sampleHeader.GenesisChainID) {
+		t.Errorf("Header() = %v, want %v", header, sampleHeader)
+	}
+	if len(records) != 2 {
+		t.Fatalf("records = %v, want 2", len(records))
+	}
+	for i, want := range []struct {
+		outbound bool
+		msg      p2pcommon.Message
+	}{{false, ping}, {true, notice}} {
+		got := records[i]
+		if got.Outbound != want.outbound || got.PeerID != pid || got.Msg.ID() != want.msg.ID() ||
+			got.Msg.Subprotocol() != want.msg.Subprotocol() || !bytes.Equal(got.Msg.Payload(), want.msg.Payload()) {
+			t.Errorf("record[%d] = %v %v %v, want %v %v", i, got.Direction(), got.Msg.Subprotocol(), got.Msg.ID(), want.outbound, want.msg.Subprotocol())
+		}
+	}
+	body, err := DecodeBody(records[0].Msg)
+	if err != nil || body.(*types.Ping).BestHeight != 10 {
+		t.Errorf("DecodeBody() = %v, %v", body, err)
+	}
+}
+
+func TestRecorder_rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "p2pcapture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	recorder, err := NewRecorder(log.NewLogger("test.capture"), dir, 100, 2, sampleHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	recorder.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+	pid := types.RandomPeerID()
+	for i := 0; i < 10; i++ {
+		recorder.Record(true, pid, newMsg(t, p2pcommon.PingRequest, &types.Ping{BestHeight: uint64(i)}))
+	}
+	recorder.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"+fileSuffix))
+	if len(files) != 2 {
+		t.Fatalf("capture files = %v, want 2", files)
+	}
+	// the last file has the last message
+	_, records := readAll(t, files[1])
+	if len(records) == 0 {
+		t.Fatalf("no record in the last file")
+	}
+	body, _ := DecodeBody(records[len(records)-1].Msg)
+	if body.(*types.Ping).BestHeight != 9 {
+		t.Errorf("last message = %v, want height 9", body)
+	}
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	self := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "127.0.0.1", 7846, "")
+	pid := types.RandomPeerID()
+	records := []*Record{
+		{Outbound: false, PeerID: pid, Msg: newMsg(t, p2pcommon.GetBlocksRequest, &types.GetBlockRequest{})},
+		{Outbound: true, PeerID: pid, Msg: newMsg(t, p2pcommon.GetBlocksResponse, &types.GetBlockResponse{})},
+		{Outbound: false, PeerID: pid, Msg: newMsg(t, p2pcommon.NewTxNotice, &types.NewTransactionsNotice{})},
+	}
+	client, node := net.Pipe()
+
+	// node side answers handshake and then echoes back the subprotocols of received messages
+	nodeErr := make(chan error, 1)
+	go func() {
+		hs := make([]byte, p2pcommon.HSMagicLength+p2pcommon.HSVerCntLength+p2pcommon.HSVersionLength*len(replayVersions))
+		if _, err := io.ReadFull(node, hs); err != nil {
+			nodeErr <- err
+			return
+		}
+		resp := p2pcommon.HSHeadResp{Magic: binary.BigEndian.Uint32(hs), RespCode: p2pcommon.P2PVersion200.Uint32()}
+		node.Write(resp.Marshal())
+		rw := v030.NewV030MsgPipe(node)
+		msg, err := rw.ReadMsg()
+		if err != nil {
+			nodeErr <- err
+			return
+		}
+		status := &types.Status{}
+		p2putil.UnmarshalMessageBody(msg.Payload(), status)
+		if !types.IsSamePeerID(types.PeerID(status.Sender.PeerID), self.ID) || !bytes.Equal(status.Genesis, sampleHeader.GenesisHash) || !status.NoExpose {
+			t.Errorf("status = %v", status)
+		}
+		rw.WriteMsg(msg)
+		for i := 0; i < 2; i++ {
+			msg, err := rw.ReadMsg()
+			if err != nil {
+				nodeErr <- err
+				return
+			}
+			rw.WriteMsg(msg)
+		}
+		nodeErr <- nil
+	}()
+
+	rp := NewReplayer(log.NewLogger("test.capture"), sampleHeader, self)
+	rp.Linger = time.Second
+	var responses []p2pcommon.SubProtocol
+	rp.OnRead = func(msg p2pcommon.Message) {
+		responses = append(responses, msg.Subprotocol())
+	}
+	sent, err := rp.Replay(context.Background(), client, records)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if err := <-nodeErr; err != nil {
+		t.Fatalf("node error = %v", err)
+	}
+	if sent != 2 {
+		t.Errorf("Replay() sent %v, want 2", sent)
+	}
+	if len(responses) != 2 || responses[0] != p2pcommon.GetBlocksRequest || responses[1] != p2pcommon.NewTxNotice {
+		t.Errorf("responses = %v", responses)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package capture records the p2p messages exchanged with remote peers to files, and reads and replays them
+// for debugging and regression tests of message handlers.
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	v030 "github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/types"
+)
+
+// A capture file starts with the magic and the header, and records follow them. A record consists of the
+// captured time, the direction, the peer id and the message in wire format of p2p v0.3.
+const (
+	fileMagic     = "AGP2PCAP"
+	formatVersion = uint32(1)
+
+	// maxFieldLength is the limit of variable length fields except the message
+	maxFieldLength = 1024
+)
+
+var ErrInvalidFormat = errors.New("invalid capture file format")
+
+// Header identifies the capturing node and its chain. The replayer handshakes with the genesis information in it.
+type Header struct {
+	SelfID         types.PeerID
+	GenesisChainID []byte
+	GenesisHash    []byte
+	Started        time.Time
+}
+
+// Record is a message read from or written to a remote peer.
+type Record struct {
+	Time     time.Time
+	Outbound bool
+	PeerID   types.PeerID
+	Msg      p2pcommon.Message
+}
+
+// Direction returns "in" for the message received from peer, or "out" for the message sent to peer.
+func (r *Record) Direction() string {
+	if r.Outbound {
+		return "out"
+	}
+	return "in"
+}
+
+type encoder struct {
+	w  *bufio.Writer
+	rw *v030.V030ReadWriter
+}
+
+func newEncoder(w io.Writer) *encoder {
+	bw := bufio.NewWriter(w)
+	return &encoder{w: bw, rw: v030.NewV030ReadWriter(nil, bw, nil)}
+}
+
+func (e *encoder) writeHeader(h Header) error {
+	e.w.WriteString(fileMagic)
+	e.writeUint32(formatVersion)
+	e.writeBytes([]byte(h.SelfID))
+	e.writeBytes(h.GenesisChainID)
+	e.writeBytes(h.GenesisHash)
+	e.writeUint64(uint64(h.Started.UnixNano()))
+	return e.w.Flush()
+}
+
+// writeRecord writes record and flushes it.
+func (e *encoder) writeRecord(r Record) error {
+	e.writeUint64(uint64(r.Time.UnixNano()))
+	if r.Outbound {
+		e.w.WriteByte(1)
+	} else {
+		e.w.WriteByte(0)
+	}
+	e.writeBytes([]byte(r.PeerID))
+	return e.rw.WriteMsg(r.Msg)
+}
+
+func (e *encoder) writeUint32(v uint32) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	e.w.Write(buf[:])
+}
+
+func (e *encoder) writeUint64(v uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	e.w.Write(buf[:])
+}
+
+func (e *encoder) writeBytes(b []byte) {
+	e.writeUint32(uint32(len(b)))
+	e.w.Write(b)
+}
+
+// Reader reads the records of a capture file in order.
+type Reader struct {
+	r      *bufio.Reader
+	rw     *v030.V030ReadWriter
+	header Header
+}
+
+// NewReader reads the header of capture and returns the reader of records.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	cr := &Reader{r: br, rw: v030.NewV030ReadWriter(br, nil, nil)}
+	magic := make([]byte, len(fileMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != fileMagic {
+		return nil, ErrInvalidFormat
+	}
+	version, err := cr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if version != formatVersion {
+		return nil, fmt.Errorf("unsupported capture format version %d", version)
+	}
+	selfID, err := cr.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	cr.header.SelfID = types.PeerID(selfID)
+	if cr.header.GenesisChainID, err = cr.readBytes(); err != nil {
+		return nil, err
+	}
+	if cr.header.GenesisHash, err = cr.readBytes(); err != nil {
+		return nil, err
+	}
+	started, err := cr.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	cr.header.Started = time.Unix(0, int64(started))
+	return cr, nil
+}
+
+func (cr *Reader) Header() Header {
+	return cr.header
+}
+
+// Next returns the next record, or io.EOF at the end of capture. It returns io.ErrUnexpectedEOF if the last record
+// is truncated, which is the case when the node stopped abruptly.
+func (cr *Reader) Next() (*Record, error) {
+	ts, err := cr.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := cr.r.ReadByte()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	pid, err := cr.readBytes()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	msg, err := cr.rw.ReadMsg()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return &Record{Time: time.Unix(0, int64(ts)), Outbound: dir == 1, PeerID: types.PeerID(pid), Msg: msg}, nil
+}
+
+func (cr *Reader) readUint32() (uint32, error) {
+	var buf [4]byte
+	if _, err := io.ReadFull(cr.r, buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(buf[:]), nil
+}
+
+func (cr *Reader) readUint64() (uint64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(cr.r, buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+func (cr *Reader) readBytes() ([]byte, error) {
+	size, err := cr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if size > maxFieldLength {
+		return nil, ErrInvalidFormat
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(cr.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	filePrefix = "p2p-"
+	fileSuffix = ".cap"
+	// fileTimeFormat makes the names of capture files sorted in the order of creation
+	fileTimeFormat = "20060102-150405.000000"
+)
+
+// Recorder writes the captured messages to the files in a directory. It starts a new file if the current file
+// exceeds the size limit, and removes the oldest files to keep the number of files.
+type Recorder struct {
+	logger   *log.Logger
+	dir      string
+	maxSize  int64
+	maxFiles int
+	header   Header
+
+	mutex sync.Mutex
+	file  *os.File
+	enc   *encoder
+	size  int64
+	// failed is set if writing is failed, to stop logging the same error for every message
+	failed bool
+
+	now func() time.Time
+}
+
+// NewRecorder create a recorder and opens the first capture file in dir. The header is written at the start of
+// every file, with the start time of the file.
+func NewRecorder(logger *log.Logger, dir string, maxSize int64, maxFiles int, header Header) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if maxFiles < 1 {
+		maxFiles = 1
+	}
+	r := &Recorder{logger: logger, dir: dir, maxSize: maxSize, maxFiles: maxFiles, header: header, now: time.Now}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record writes a message read from or written to the peer.
+func (r *Recorder) Record(outbound bool, pid types.PeerID, msg p2pcommon.Message) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.file == nil {
+		return
+	}
+	if r.size >= r.maxSize {
+		if err := r.rotate(); err != nil {
+			r.logger.Warn().Err(err).Str("dir", r.dir).Msg("failed to start new p2p capture file")
+			r.file = nil
+			return
+		}
+	}
+	if err := r.enc.writeRecord(Record{Time: r.now(), Outbound: outbound, PeerID: pid, Msg: msg}); err != nil {
+		if !r.failed {
+			r.logger.Warn().Err(err).Str("file", r.file.Name()).Msg("failed to write p2p capture")
+		}
+		r.failed = true
+		return
+	}
+	r.failed = false
+}
+
+// Close closes the current capture file. The messages are not recorded after closing.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// WrapReadWriter returns MsgReadWriter which records the messages read and written successfully.
+func (r *Recorder) WrapReadWriter(pid types.PeerID, rw p2pcommon.MsgReadWriter) p2pcommon.MsgReadWriter {
+	return &capturingReadWriter{MsgReadWriter: rw, r: r, pid: pid}
+}
+
+// rotate closes the current file, removes old files and creates a new file.
+func (r *Recorder) rotate() error {
+	if r.file != nil {
+		if err := r.file.Close(); err != nil {
+			r.logger.Warn().Err(err).Str("file", r.file.Name()).Msg("failed to close p2p capture file")
+		}
+		r.file = nil
+	}
+	r.removeOldFiles(r.maxFiles - 1)
+
+	now := r.now()
+	f, err := os.Create(filepath.Join(r.dir, filePrefix+now.Format(fileTimeFormat)+fileSuffix))
+	if err != nil {
+		return err
+	}
+	r.file, r.size = f, 0
+	r.enc = newEncoder(&countingWriter{w: f, n: &r.size})
+	header := r.header
+	header.Started = now
+	if err := r.enc.writeHeader(header); err != nil {
+		f.Close()
+		r.file = nil
+		return err
+	}
+	r.logger.Info().Str("file", f.Name()).Msg("started new p2p capture file")
+	return nil
+}
+
+// removeOldFiles removes the oldest capture files in dir, so that at most keep files remain.
+func (r *Recorder) removeOldFiles(keep int) {
+	infos, err := ioutil.ReadDir(r.dir)
+	if err != nil {
+		return
+	}
+	var names []string
+	for _, fi := range infos {
+		if !fi.IsDir() && strings.HasPrefix(fi.Name(), filePrefix) && strings.HasSuffix(fi.Name(), fileSuffix) {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	for len(names) > keep && len(names) > 0 {
+		if err := os.Remove(filepath.Join(r.dir, names[0])); err != nil {
+			r.logger.Warn().Err(err).Str("file", names[0]).Msg("failed to remove old p2p capture file")
+		}
+		names = names[1:]
+	}
+}
+
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	*cw.n += int64(n)
+	return n, err
+}
+
+type capturingReadWriter struct {
+	p2pcommon.MsgReadWriter
+	r   *Recorder
+	pid types.PeerID
+}
+
+func (rw *capturingReadWriter) ReadMsg() (p2pcommon.Message, error) {
+	msg, err := rw.MsgReadWriter.ReadMsg()
+	if err == nil {
+		rw.r.Record(false, rw.pid, msg)
+	}
+	return msg, err
+}
+
+func (rw *capturingReadWriter) WriteMsg(msg p2pcommon.Message) error {
+	err := rw.MsgReadWriter.WriteMsg(msg)
+	if err == nil {
+		rw.r.Record(true, rw.pid, msg)
+	}
+	return err
+}
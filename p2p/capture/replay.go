@@ -0,0 +1,153 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	v030 "github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/types"
+)
+
+// replayVersions are the p2p versions which the replayer offers. The versions before 2.0.0 have the different
+// handshake.
+var replayVersions = []p2pcommon.P2PVersion{p2pcommon.P2PVersion210, p2pcommon.P2PVersion200}
+
+// Replayer sends the messages which the capturing node received, to a node as if it is the remote peer. It
+// handshakes as a hidden peer at the genesis block of the chain in header, so the node doesn't sync from it.
+type Replayer struct {
+	logger *log.Logger
+	header Header
+	self   p2pcommon.PeerMeta
+
+	// Speed is the ratio to the captured intervals of messages. The messages are sent without waiting if it is 0.
+	Speed float64
+	// Linger is the duration to wait for the responses after sending all messages.
+	Linger time.Duration
+	// OnRead is called for each message received from the node, if set.
+	OnRead func(msg p2pcommon.Message)
+}
+
+// NewReplayer create a replayer of the capture, which introduces itself to the node with self.
+func NewReplayer(logger *log.Logger, header Header, self p2pcommon.PeerMeta) *Replayer {
+	self.Hidden = true
+	if self.Version == "" {
+		self.Version = p2pcommon.MinimumAergoVersion
+	}
+	return &Replayer{logger: logger, header: header, self: self}
+}
+
+// Replay handshakes over the stream to the node, and sends the inbound messages among records in order. It
+// returns the number of sent messages, and the stream is closed at return.
+func (rp *Replayer) Replay(ctx context.Context, s io.ReadWriteCloser, records []*Record) (int, error) {
+	rw, err := rp.handshake(s)
+	if err != nil {
+		s.Close()
+		return 0, err
+	}
+
+	readDone := make(chan struct{})
+	// OnRead is not called after returning
+	defer func() {
+		s.Close()
+		<-readDone
+	}()
+	go func() {
+		defer close(readDone)
+		for {
+			msg, err := rw.ReadMsg()
+			if err != nil {
+				return
+			}
+			if rp.OnRead != nil {
+				rp.OnRead(msg)
+			}
+		}
+	}()
+
+	sent := 0
+	var prev time.Time
+	for _, r := range records {
+		if r.Outbound {
+			continue
+		}
+		if rp.Speed > 0 && !prev.IsZero() && r.Time.After(prev) {
+			select {
+			case <-time.After(time.Duration(float64(r.Time.Sub(prev)) / rp.Speed)):
+			case <-ctx.Done():
+				return sent, ctx.Err()
+			}
+		}
+		prev = r.Time
+		if err := rw.WriteMsg(r.Msg); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	select {
+	case <-time.After(rp.Linger):
+	case <-readDone:
+	case <-ctx.Done():
+	}
+	return sent, nil
+}
+
+func (rp *Replayer) handshake(s io.ReadWriteCloser) (p2pcommon.MsgReadWriter, error) {
+	req := p2pcommon.HSHeadReq{Magic: p2pcommon.MAGICMain, Versions: replayVersions}
+	if _, err := s.Write(req.Marshal()); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, p2pcommon.V030HSHeaderLength)
+	if _, err := p2putil.ReadToLen(s, buf); err != nil {
+		return nil, err
+	}
+	resp := p2pcommon.HSHeadResp{}
+	resp.Unmarshal(buf)
+	if resp.Magic != req.Magic {
+		return nil, fmt.Errorf("node refused handshake: %v", resp.RespCode)
+	}
+	rp.logger.Debug().Str("version", p2pcommon.P2PVersion(resp.RespCode).String()).Msg("p2p version is agreed")
+
+	rw := v030.NewV030MsgPipe(s)
+	selfAddr := rp.self.ToPeerAddress()
+	status := &types.Status{
+		Sender:        &selfAddr,
+		ChainID:       rp.header.GenesisChainID,
+		BestBlockHash: rp.header.GenesisHash,
+		BestHeight:    0,
+		NoExpose:      true,
+		Version:       rp.self.Version,
+		Genesis:       rp.header.GenesisHash,
+	}
+	payload, err := p2putil.MarshalMessageBody(status)
+	if err != nil {
+		return nil, err
+	}
+	if err := rw.WriteMsg(p2pcommon.NewMessageValue(p2pcommon.StatusRequest, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano(), payload)); err != nil {
+		return nil, err
+	}
+	msg, err := rw.ReadMsg()
+	if err != nil {
+		return nil, err
+	}
+	switch msg.Subprotocol() {
+	case p2pcommon.StatusRequest:
+		return rw, nil
+	case p2pcommon.GoAway:
+		goAway := &types.GoAwayNotice{}
+		p2putil.UnmarshalMessageBody(msg.Payload(), goAway)
+		return nil, fmt.Errorf("node refused handshake: %s", goAway.Message)
+	default:
+		return nil, fmt.Errorf("unexpected message %s in handshake", msg.Subprotocol())
+	}
+}
@@ -2,6 +2,11 @@ package p2p
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
@@ -9,6 +14,7 @@ import (
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/types"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
 
 	"sync"
 	"time"
@@ -17,29 +23,48 @@ import (
 var emptyIDArr []types.PeerID
 var emptyCertArr []*p2pcommon.AgentCertificateV1
 
-func newCertificateManager(actor p2pcommon.ActorService, is p2pcommon.InternalService, logger *log.Logger) p2pcommon.CertificateManager {
-	d := baseCertManager{actor: actor, self: is.SelfMeta(), settings:is.LocalSettings(), logger: logger}
-	switch d.self.Role {
+// crlFileName is the file in data directory, where block producer keeps its own revocation list
+const crlFileName = "agentcrl"
+
+// newCertificateManager create certificate manager for the role of local peer. The revocation list of
+// block producer is stored in dataDir, or it is not stored if dataDir is empty.
+func newCertificateManager(actor p2pcommon.ActorService, is p2pcommon.InternalService, logger *log.Logger, dataDir string) p2pcommon.CertificateManager {
+	self := is.SelfMeta()
+	switch self.Role {
 	case types.PeerRole_Producer:
 		pk := p2putil.ConvertPKToBTCEC(p2pkey.NodePrivKey())
 		if pk == nil {
 			panic(fmt.Sprintf("invalid pk %v", p2pkey.NodePrivKey()))
 		}
-		return &bpCertificateManager{baseCertManager: d, key: pk}
+		cm := &bpCertificateManager{baseCertManager: newBaseCertManager(actor, is, self, logger), key: pk}
+		if len(dataDir) > 0 {
+			cm.crlPath = filepath.Join(dataDir, crlFileName)
+			cm.loadCRL()
+		}
+		return cm
 	case types.PeerRole_Agent:
-		return &agentCertificateManager{baseCertManager: d, certMap: make(map[types.PeerID]*p2pcommon.AgentCertificateV1)}
+		return &agentCertificateManager{baseCertManager: newBaseCertManager(actor, is, self, logger), certMap: make(map[types.PeerID]*p2pcommon.AgentCertificateV1)}
 	case types.PeerRole_Watcher:
-		return &watcherCertificateManager{baseCertManager: d}
+		return &watcherCertificateManager{baseCertManager: newBaseCertManager(actor, is, self, logger)}
 	default:
 		return nil
 	}
 }
 
+func newBaseCertManager(actor p2pcommon.ActorService, is p2pcommon.InternalService, self p2pcommon.PeerMeta, logger *log.Logger) baseCertManager {
+	return baseCertManager{actor: actor, is: is, self: self, settings: is.LocalSettings(), logger: logger}
+}
+
 type baseCertManager struct {
 	actor    p2pcommon.ActorService
+	is       p2pcommon.InternalService
 	self     p2pcommon.PeerMeta
 	settings p2pcommon.LocalSettings
 	logger   *log.Logger
+
+	crlMutex sync.Mutex
+	// crls is the latest revocation list of each block producer
+	crls map[types.PeerID]*p2pcommon.CertRevocationListV1
 }
 
 func (cm *baseCertManager) Start() {
@@ -52,6 +77,18 @@ func (cm *baseCertManager) CreateCertificate(remoteMeta p2pcommon.PeerMeta) (*p2
 	return nil, p2pcommon.ErrInvalidRole
 }
 
+func (cm *baseCertManager) GetIssuedCertificates() []*p2pcommon.AgentCertificateV1 {
+	return emptyCertArr
+}
+
+func (cm *baseCertManager) RevokeCertificates(agentID types.PeerID, renewable bool) (*p2pcommon.CertRevocationListV1, error) {
+	return nil, p2pcommon.ErrInvalidRole
+}
+
+func (cm *baseCertManager) RenewCertificates(bpID types.PeerID) error {
+	return p2pcommon.ErrInvalidRole
+}
+
 func (cm *baseCertManager) GetProducers() []types.PeerID {
 	return emptyIDArr
 }
@@ -63,7 +100,72 @@ func (cm *baseCertManager) GetCertificates() []*p2pcommon.AgentCertificateV1 {
 func (cm *baseCertManager) AddCertificate(cert *p2pcommon.AgentCertificateV1) {
 }
 
+func (cm *baseCertManager) AddRevocationList(crl *p2pcommon.CertRevocationListV1) error {
+	if !cm.isKnownProducer(crl.BPID) {
+		return p2pcommon.ErrUnknownIssuer
+	}
+	cm.crlMutex.Lock()
+	defer cm.crlMutex.Unlock()
+	if old, exist := cm.crls[crl.BPID]; exist && !crl.CreateTime.After(old.CreateTime) {
+		return p2pcommon.ErrOldRevocationList
+	}
+	if cm.crls == nil {
+		cm.crls = make(map[types.PeerID]*p2pcommon.CertRevocationListV1)
+	}
+	cm.crls[crl.BPID] = crl
+	cm.logger.Info().Str("bpID", p2putil.ShortForm(crl.BPID)).Time("cTime", crl.CreateTime).Int("revocations", len(crl.Revocations)).Msg("certificate revocation list is updated")
+	return nil
+}
+
+func (cm *baseCertManager) GetRevocationLists() []*p2pcommon.CertRevocationListV1 {
+	cm.crlMutex.Lock()
+	defer cm.crlMutex.Unlock()
+	ret := make([]*p2pcommon.CertRevocationListV1, 0, len(cm.crls))
+	for _, crl := range cm.crls {
+		ret = append(ret, crl)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].BPID < ret[j].BPID
+	})
+	return ret
+}
+
+func (cm *baseCertManager) IsRevoked(cert *p2pcommon.AgentCertificateV1) bool {
+	cm.crlMutex.Lock()
+	defer cm.crlMutex.Unlock()
+	crl, exist := cm.crls[cert.BPID]
+	return exist && crl.IsRevoked(cert)
+}
+
+func (cm *baseCertManager) getRevocationList(bpID types.PeerID) *p2pcommon.CertRevocationListV1 {
+	cm.crlMutex.Lock()
+	defer cm.crlMutex.Unlock()
+	return cm.crls[bpID]
+}
+
+// OnPeerConnect sends the known revocation lists to the newly connected peer, since the lists are gossiped
+// only when they are changed.
 func (cm *baseCertManager) OnPeerConnect(pid types.PeerID) {
+	for _, crl := range cm.GetRevocationLists() {
+		// the issuer may have left the block producers after the list is received
+		if !cm.isKnownProducer(crl.BPID) {
+			continue
+		}
+		cm.actor.TellRequest(message.P2PSvc, message.NotifyCertRevoked{CRL: p2putil.ConvertCRLToProto(crl), To: pid})
+	}
+}
+
+// isKnownProducer returns whether bpID is local node itself, one of the producers which local agent is in charge
+// of, or a block producer in current consensus.
+func (cm *baseCertManager) isKnownProducer(bpID types.PeerID) bool {
+	if types.IsSamePeerID(cm.self.ID, bpID) || p2putil.ContainsID(cm.self.ProducerIDs, bpID) {
+		return true
+	}
+	if cm.is == nil {
+		return false
+	}
+	prm := cm.is.RoleManager()
+	return prm != nil && prm.GetRole(bpID) == types.PeerRole_Producer
 }
 
 func (cm *baseCertManager) OnPeerDisconnect(peer p2pcommon.RemotePeer) {
@@ -76,6 +178,11 @@ func (cm *baseCertManager) CanHandle(bpID types.PeerID) bool {
 type bpCertificateManager struct {
 	baseCertManager
 	key *btcec.PrivateKey
+
+	mutex sync.Mutex
+	// issued is the latest certificate issued to each agent
+	issued  map[types.PeerID]*p2pcommon.AgentCertificateV1
+	crlPath string
 }
 
 func (cm *bpCertificateManager) CreateCertificate(remoteMeta p2pcommon.PeerMeta) (*p2pcommon.AgentCertificateV1, error) {
@@ -84,12 +191,120 @@ func (cm *bpCertificateManager) CreateCertificate(remoteMeta p2pcommon.PeerMeta)
 		cm.logger.Info().Str("agentID", p2putil.ShortForm(remoteMeta.ID)).Msg("failed to issue certificate, since peer is not registered agent")
 		return nil, p2pcommon.ErrInvalidRole
 	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	if crl := cm.getRevocationList(cm.self.ID); crl != nil {
+		if r := crl.FindRevocation(remoteMeta.ID); r != nil && !r.Renewable {
+			cm.logger.Info().Str("agentID", p2putil.ShortForm(remoteMeta.ID)).Msg("failed to issue certificate, since certificates of agent are revoked")
+			return nil, p2pcommon.ErrCertRevoked
+		}
+	}
 
 	addrs := make([]string, len(remoteMeta.Addresses))
 	for i, ad := range remoteMeta.Addresses {
 		addrs[i] = types.AddressFromMultiAddr(ad)
 	}
-	return p2putil.NewAgentCertV1(cm.self.ID, remoteMeta.ID, cm.key, addrs, p2pcommon.DefaultCertTTL)
+	cert, err := p2putil.NewAgentCertV1(cm.self.ID, remoteMeta.ID, cm.key, addrs, p2pcommon.DefaultCertTTL)
+	if err == nil {
+		if cm.issued == nil {
+			cm.issued = make(map[types.PeerID]*p2pcommon.AgentCertificateV1)
+		}
+		cm.issued[cert.AgentID] = cert
+	}
+	return cert, err
+}
+
+func (cm *bpCertificateManager) GetIssuedCertificates() []*p2pcommon.AgentCertificateV1 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	now := time.Now()
+	certs := make([]*p2pcommon.AgentCertificateV1, 0, len(cm.issued))
+	for agentID, cert := range cm.issued {
+		if cert.IsValidInTime(now, p2pcommon.TimeErrorTolerance) {
+			certs = append(certs, cert)
+		} else {
+			delete(cm.issued, agentID)
+		}
+	}
+	return certs
+}
+
+func (cm *bpCertificateManager) RevokeCertificates(agentID types.PeerID, renewable bool) (*p2pcommon.CertRevocationListV1, error) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	now := time.Now().Truncate(0)
+	revocations := make([]p2pcommon.CertRevocation, 0, 1)
+	if old := cm.getRevocationList(cm.self.ID); old != nil {
+		for _, r := range old.Revocations {
+			// the renewable revocation is useless after all certificates created before it are expired.
+			if types.IsSamePeerID(r.AgentID, agentID) ||
+				(r.Renewable && now.Sub(r.RevokeTime) > p2pcommon.DefaultCertTTL+p2pcommon.TimeErrorTolerance) {
+				continue
+			}
+			revocations = append(revocations, r)
+		}
+	}
+	revocations = append(revocations, p2pcommon.CertRevocation{AgentID: agentID, RevokeTime: now, Renewable: renewable})
+	crl, err := p2putil.NewCRLV1(cm.self.ID, cm.key, revocations)
+	if err != nil {
+		return nil, err
+	}
+	if err = cm.AddRevocationList(crl); err != nil {
+		return nil, err
+	}
+	delete(cm.issued, agentID)
+	cm.saveCRL(crl)
+	cm.logger.Info().Str("agentID", p2putil.ShortForm(agentID)).Bool("renewable", renewable).Msg("revoked certificates of agent")
+	cm.actor.TellRequest(message.P2PSvc, message.NotifyCertRevoked{CRL: p2putil.ConvertCRLToProto(crl)})
+	return crl, nil
+}
+
+// RenewCertificates revokes the certificates issued to the agent, so that the agent requests new certificate
+// right after. The registered agent is used if agentID is empty.
+func (cm *bpCertificateManager) RenewCertificates(agentID types.PeerID) error {
+	if len(agentID) == 0 {
+		agentID = cm.settings.AgentID
+	}
+	if len(agentID) == 0 {
+		return p2pcommon.ErrInvalidPeerID
+	}
+	_, err := cm.RevokeCertificates(agentID, true)
+	return err
+}
+
+// loadCRL loads its own revocation list, so that the revoked agents are not issued certificates after restart.
+func (cm *bpCertificateManager) loadCRL() {
+	b, err := ioutil.ReadFile(cm.crlPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			cm.logger.Warn().Err(err).Str("path", cm.crlPath).Msg("failed to read certificate revocation list")
+		}
+		return
+	}
+	pCrl := &types.CertificateRevocationList{}
+	if err = proto.Unmarshal(b, pCrl); err != nil {
+		cm.logger.Warn().Err(err).Str("path", cm.crlPath).Msg("failed to read certificate revocation list")
+		return
+	}
+	crl, err := p2putil.CheckAndGetCRLV1(pCrl)
+	if err != nil || !types.IsSamePeerID(crl.BPID, cm.self.ID) {
+		cm.logger.Warn().Err(err).Str("path", cm.crlPath).Msg("dropping invalid certificate revocation list")
+		return
+	}
+	cm.AddRevocationList(crl)
+}
+
+func (cm *bpCertificateManager) saveCRL(crl *p2pcommon.CertRevocationListV1) {
+	if len(cm.crlPath) == 0 {
+		return
+	}
+	b, err := proto.Marshal(p2putil.ConvertCRLToProto(crl))
+	if err == nil {
+		err = ioutil.WriteFile(cm.crlPath, b, 0600)
+	}
+	if err != nil {
+		cm.logger.Warn().Err(err).Str("path", cm.crlPath).Msg("failed to save certificate revocation list")
+	}
 }
 
 type agentCertificateManager struct {
@@ -159,6 +374,10 @@ func (cm *agentCertificateManager) GetCertificates() []*p2pcommon.AgentCertifica
 func (cm *agentCertificateManager) AddCertificate(cert *p2pcommon.AgentCertificateV1) {
 	cm.mutex.Lock()
 	defer cm.mutex.Unlock()
+	if cm.IsRevoked(cert) {
+		cm.logger.Info().Str("bpID", p2putil.ShortForm(cert.BPID)).Msg("drop issued certificate, since it is revoked")
+		return
+	}
 	if !p2putil.ContainsID(cm.self.ProducerIDs, cert.BPID) {
 		// this agent is not in charge of that bp id.
 		cm.logger.Info().Str("bpID", p2putil.ShortForm(cert.BPID)).Msg("drop issued certificate, since issuer is not my managed producer")
@@ -188,7 +407,51 @@ func (cm *agentCertificateManager) AddCertificate(cert *p2pcommon.AgentCertifica
 	cm.actor.TellRequest(message.P2PSvc, message.NotifyCertRenewed{pCert})
 }
 
+// RenewCertificates requests new certificate to the producer, or all managed producers if bpID is empty.
+func (cm *agentCertificateManager) RenewCertificates(bpID types.PeerID) error {
+	if len(bpID) == 0 {
+		for _, pid := range cm.self.ProducerIDs {
+			cm.actor.TellRequest(message.P2PSvc, message.IssueAgentCertificate{ProducerID: pid})
+		}
+		return nil
+	}
+	if !p2putil.ContainsID(cm.self.ProducerIDs, bpID) {
+		return p2pcommon.ErrInvalidRole
+	}
+	cm.actor.TellRequest(message.P2PSvc, message.IssueAgentCertificate{ProducerID: bpID})
+	return nil
+}
+
+// AddRevocationList removes my certificate if it is revoked, and requests new one if the revocation is renewable.
+func (cm *agentCertificateManager) AddRevocationList(crl *p2pcommon.CertRevocationListV1) error {
+	if err := cm.baseCertManager.AddRevocationList(crl); err != nil {
+		return err
+	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cert, exist := cm.certMap[crl.BPID]
+	if !exist || !crl.IsRevoked(cert) {
+		return nil
+	}
+	newCerts := make([]*p2pcommon.AgentCertificateV1, 0, len(cm.certs))
+	for _, c := range cm.certs {
+		if c != cert {
+			newCerts = append(newCerts, c)
+		}
+	}
+	cm.certs = newCerts
+	delete(cm.certMap, crl.BPID)
+	if r := crl.FindRevocation(cm.self.ID); r.Renewable {
+		cm.logger.Info().Str("bpID", p2putil.ShortForm(crl.BPID)).Msg("my certificate is revoked. requesting new certificate")
+		cm.actor.TellRequest(message.P2PSvc, message.IssueAgentCertificate{ProducerID: crl.BPID})
+	} else {
+		cm.logger.Warn().Str("bpID", p2putil.ShortForm(crl.BPID)).Msg("my certificate is revoked by producer")
+	}
+	return nil
+}
+
 func (cm *agentCertificateManager) OnPeerConnect(pid types.PeerID) {
+	cm.baseCertManager.OnPeerConnect(pid)
 	// check if peer is producer which is managed
 	if !p2putil.ContainsID(cm.self.ProducerIDs, pid) {
 		return
@@ -43,7 +43,7 @@ func Test_newCertificateManager(t *testing.T) {
 			is.EXPECT().SelfMeta().Return(meta)
 			is.EXPECT().LocalSettings().Return(p2pcommon.LocalSettings{}).MaxTimes(1)
 
-			got := newCertificateManager(nil, is, logger)
+			got := newCertificateManager(nil, is, logger, "")
 			if (got == nil) != tt.wantNil {
 				t.Errorf("newCertificateManager() = %v, want nil %v", got, tt.wantNil)
 			}
@@ -78,7 +78,7 @@ func Test_newCertificateManagerAgent(t *testing.T) {
 			is.EXPECT().SelfMeta().Return(meta)
 			is.EXPECT().LocalSettings().Return(p2pcommon.LocalSettings{}).MaxTimes(1)
 
-			got := newCertificateManager(nil, is, logger)
+			got := newCertificateManager(nil, is, logger, "")
 			if (got == nil) != tt.wantNil {
 				t.Errorf("newCertificateManager() = %v, want nil %v", got, tt.wantNil)
 			}
@@ -282,4 +282,192 @@ func Test_agentCertificateManager_checkCertificates(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func Test_bpCertificateManager_RevokeCertificates(t *testing.T) {
+	logger := log.NewLogger("p2p.test")
+	sampleKey, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 356)
+	selfID, _ := types.IDFromPrivateKey(sampleKey)
+	agentID := types.RandomPeerID()
+	sampleAddrs := []types.Multiaddr{test.Multiaddr("/ip4/192.168.1.2/tcp/7846")}
+
+	tests := []struct {
+		name      string
+		renewable bool
+
+		wantCreateErr error
+	}{
+		{"TRenewable", true, nil},
+		{"TBlocked", false, p2pcommon.ErrCertRevoked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sampleMeta := p2pcommon.NewMetaWith1Addr(selfID, "172.12.1.1", 7846, "v2.0.0")
+			sampleMeta.Role = types.PeerRole_Producer
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.AssignableToTypeOf(message.NotifyCertRevoked{})).Times(1)
+			inMeta := p2pcommon.PeerMeta{ID: agentID, Addresses: sampleAddrs, Role: types.PeerRole_Agent, ProducerIDs: []types.PeerID{selfID}, Version: "v2.0.0"}
+			cm := &bpCertificateManager{
+				baseCertManager: baseCertManager{actor: mockActor, self: sampleMeta, settings: p2pcommon.LocalSettings{AgentID: agentID}, logger: logger},
+				key:             p2putil.ConvertPKToBTCEC(sampleKey),
+			}
+			prevCert, err := cm.CreateCertificate(inMeta)
+			if err != nil {
+				t.Fatalf("CreateCertificate() error = %v", err)
+			}
+			if len(cm.GetIssuedCertificates()) != 1 {
+				t.Fatalf("GetIssuedCertificates() size = %v, want 1", len(cm.GetIssuedCertificates()))
+			}
+
+			crl, err := cm.RevokeCertificates(agentID, tt.renewable)
+			if err != nil {
+				t.Fatalf("RevokeCertificates() error = %v", err)
+			}
+			if _, err = p2putil.CheckAndGetCRLV1(p2putil.ConvertCRLToProto(crl)); err != nil {
+				t.Errorf("RevokeCertificates() returned invalid list: %v", err)
+			}
+			if !cm.IsRevoked(prevCert) {
+				t.Errorf("IsRevoked() = false, want true")
+			}
+			if len(cm.GetIssuedCertificates()) != 0 {
+				t.Errorf("GetIssuedCertificates() size = %v, want 0", len(cm.GetIssuedCertificates()))
+			}
+
+			newCert, err := cm.CreateCertificate(inMeta)
+			if err != tt.wantCreateErr {
+				t.Fatalf("CreateCertificate() after revocation error = %v, want %v", err, tt.wantCreateErr)
+			}
+			if err == nil && cm.IsRevoked(newCert) {
+				t.Errorf("IsRevoked() of renewed certificate = true, want false")
+			}
+		})
+	}
+}
+
+func Test_agentCertificateManager_AddRevocationList(t *testing.T) {
+	logger := log.NewLogger("p2p.test")
+	agentID := types.RandomPeerID()
+
+	addrs := []string{"192.168.1.2"}
+	bpSize := 2
+	bpKeys := make([]crypto.PrivKey, bpSize)
+	bpIds := make([]types.PeerID, bpSize)
+	bpCerts := make([]*p2pcommon.AgentCertificateV1, bpSize)
+	for i := 0; i < bpSize; i++ {
+		bpKeys[i], _, _ = crypto.GenerateKeyPair(crypto.Secp256k1, 11)
+		bpIds[i], _ = types.IDFromPrivateKey(bpKeys[i])
+		bpCerts[i], _ = p2putil.NewAgentCertV1(bpIds[i], agentID, p2putil.ConvertPKToBTCEC(bpKeys[i]), addrs, time.Hour)
+	}
+	revokeTime := time.Now().Add(time.Second)
+
+	tests := []struct {
+		name        string
+		revocations []p2pcommon.CertRevocation
+
+		wantRequest  int
+		wantRemained int
+	}{
+		{"TRenew", []p2pcommon.CertRevocation{{AgentID: agentID, RevokeTime: revokeTime, Renewable: true}}, 1, 1},
+		{"TBlocked", []p2pcommon.CertRevocation{{AgentID: agentID, RevokeTime: revokeTime}}, 0, 1},
+		{"TOtherAgent", []p2pcommon.CertRevocation{{AgentID: types.RandomPeerID(), RevokeTime: revokeTime}}, 0, 2},
+		{"TOldRevocation", []p2pcommon.CertRevocation{{AgentID: agentID, RevokeTime: bpCerts[0].CreateTime.Add(-time.Second)}}, 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sampleMeta := p2pcommon.NewMetaWith1Addr(agentID, addrs[0], 7846, "v2.0.0")
+			sampleMeta.Role = types.PeerRole_Agent
+			sampleMeta.ProducerIDs = bpIds
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockActor.EXPECT().TellRequest(message.P2PSvc, message.IssueAgentCertificate{ProducerID: bpIds[0]}).Times(tt.wantRequest)
+			cm := &agentCertificateManager{
+				baseCertManager: baseCertManager{actor: mockActor, self: sampleMeta, logger: logger},
+				certs:           bpCerts,
+				certMap:         make(map[types.PeerID]*p2pcommon.AgentCertificateV1),
+			}
+			for _, c := range cm.certs {
+				cm.certMap[c.BPID] = c
+			}
+
+			crl, _ := p2putil.NewCRLV1(bpIds[0], p2putil.ConvertPKToBTCEC(bpKeys[0]), tt.revocations)
+			if err := cm.AddRevocationList(crl); err != nil {
+				t.Fatalf("AddRevocationList() error = %v", err)
+			}
+			if len(cm.GetCertificates()) != tt.wantRemained {
+				t.Errorf("AddRevocationList() remained certs = %v, want %v", len(cm.GetCertificates()), tt.wantRemained)
+			}
+			if len(cm.GetRevocationLists()) != 1 {
+				t.Errorf("GetRevocationLists() size = %v, want 1", len(cm.GetRevocationLists()))
+			}
+			// the same list is not accepted again
+			if err := cm.AddRevocationList(crl); err != p2pcommon.ErrOldRevocationList {
+				t.Errorf("AddRevocationList() of same list error = %v, want %v", err, p2pcommon.ErrOldRevocationList)
+			}
+		})
+	}
+}
+
+func Test_baseCertManager_AddRevocationListIssuer(t *testing.T) {
+	logger := log.NewLogger("p2p.test")
+	selfID := types.RandomPeerID()
+	managedID := types.RandomPeerID()
+	bpID := types.RandomPeerID()
+	issuerKey, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+
+	tests := []struct {
+		name   string
+		issuer types.PeerID
+
+		wantErr error
+	}{
+		{"TSelf", selfID, nil},
+		{"TManaged", managedID, nil},
+		{"TProducer", bpID, nil},
+		{"TUnknown", types.RandomPeerID(), p2pcommon.ErrUnknownIssuer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			self := p2pcommon.NewMetaWith1Addr(selfID, "192.168.0.2", 7846, "v2.0.0")
+			self.ProducerIDs = []types.PeerID{managedID}
+			producers := map[types.PeerID]bool{bpID: true}
+			prm := p2pmock.NewMockPeerRoleManager(ctrl)
+			prm.EXPECT().GetRole(gomock.Any()).DoAndReturn(func(pid types.PeerID) types.PeerRole {
+				if producers[pid] {
+					return types.PeerRole_Producer
+				}
+				return types.PeerRole_Watcher
+			}).AnyTimes()
+			is := p2pmock.NewMockInternalService(ctrl)
+			is.EXPECT().RoleManager().Return(prm).AnyTimes()
+			actor := p2pmock.NewMockActorService(ctrl)
+			cm := &baseCertManager{actor: actor, is: is, self: self, logger: logger}
+
+			crl, _ := p2putil.NewCRLV1(tt.issuer, p2putil.ConvertPKToBTCEC(issuerKey), nil)
+			if err := cm.AddRevocationList(crl); err != tt.wantErr {
+				t.Fatalf("AddRevocationList() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(cm.GetRevocationLists()) != 0 {
+					t.Errorf("GetRevocationLists() size = %v, want 0", len(cm.GetRevocationLists()))
+				}
+				return
+			}
+
+			// the list is not sent to new peer after the issuer leaves the block producers
+			wantSent := 1
+			if tt.issuer == bpID {
+				producers[bpID] = false
+				wantSent = 0
+			}
+			actor.EXPECT().TellRequest(message.P2PSvc, gomock.Any()).Times(wantSent)
+			cm.OnPeerConnect(types.RandomPeerID())
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// newCompactBlockNotice makes the compact form of block, which has only the header and the hashes of txs.
+func newCompactBlockNotice(block *types.Block) *types.CompactBlockNotice {
+	txs := block.GetBody().GetTxs()
+	hashes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.GetHash()
+	}
+	return &types.CompactBlockNotice{Header: block.GetHeader(), TxHashes: hashes}
+}
+
+// CompactBlockReceiver rebuilds the block announced by compact block notice. It fills txs from the caches and the
+// mempool of local node first, and requests only the missing txs to the notifier. It falls back to download the full
+// block from the notifier if the block can't be rebuilt.
+type CompactBlockReceiver struct {
+	requestID p2pcommon.MsgID
+	logger    *log.Logger
+
+	peer  p2pcommon.RemotePeer
+	actor p2pcommon.ActorService
+	rm    p2pcommon.ReputationManager
+
+	header  *types.BlockHeader
+	hash    []byte
+	txs     []*types.Tx
+	missing map[types.TxID]int
+
+	ttl    time.Duration
+	timer  *time.Timer
+	mutex  sync.Mutex
+	status receiverStatus
+}
+
+func NewCompactBlockReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, rm p2pcommon.ReputationManager, logger *log.Logger, data *types.CompactBlockNotice, ttl time.Duration) *CompactBlockReceiver {
+	hash := (&types.Block{Header: data.Header}).BlockHash()
+	return &CompactBlockReceiver{actor: actor, peer: peer, rm: rm, logger: logger, header: data.Header, hash: hash,
+		txs: make([]*types.Tx, len(data.TxHashes)), missing: make(map[types.TxID]int), ttl: ttl}
+}
+
+// StartGet fills txs of block and requests missing txs to remote peer if needed. It can block while querying mempool,
+// so it should not be called in the read goroutine of peer.
+func (br *CompactBlockReceiver) StartGet(hashes [][]byte, txCache *lru.Cache) {
+	var mpReqs []types.TxHash
+	var mpIdxs []int
+	for i, h := range hashes {
+		txID := types.ToTxID(h)
+		if _, exist := br.missing[txID]; exist {
+			// block with duplicated tx is invalid
+			reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "duplicated tx in compact block")
+			br.fallback()
+			return
+		}
+		br.missing[txID] = i
+		if tx, ok := txCache.Get(txID); ok {
+			br.txs[i] = tx.(*types.Tx)
+			delete(br.missing, txID)
+		} else {
+			mpReqs = append(mpReqs, h)
+			mpIdxs = append(mpIdxs, i)
+		}
+	}
+
+	// then find remaining txs in mempool. the response has nil element for the tx not in mempool.
+	bucket := message.MaxReqestHashes
+	for start := 0; start < len(mpReqs); start += bucket {
+		end := start + bucket
+		if end > len(mpReqs) {
+			end = len(mpReqs)
+		}
+		f, err := br.actor.CallRequestDefaultTimeout(message.MemPoolSvc, &message.MemPoolExistEx{Hashes: mpReqs[start:end]})
+		if err != nil {
+			continue
+		}
+		found, err := message.GetHelper().ExtractTxsFromResponseAndError(f, nil)
+		if err != nil {
+			br.logger.Debug().Err(err).Msg("failed to get txs of compact block from mempool")
+			continue
+		}
+		for j, tx := range found {
+			if tx == nil || start+j >= end {
+				continue
+			}
+			idx := mpIdxs[start+j]
+			br.txs[idx] = tx
+			delete(br.missing, types.ToTxID(hashes[idx]))
+		}
+	}
+
+	if len(br.missing) == 0 {
+		br.mutex.Lock()
+		defer br.mutex.Unlock()
+		br.complete()
+		return
+	}
+
+	req := &types.GetTransactionsRequest{Hashes: make([][]byte, 0, len(br.missing))}
+	for i, tx := range br.txs {
+		if tx == nil {
+			req.Hashes = append(req.Hashes, hashes[i])
+		}
+	}
+	br.logger.Debug().Str(p2putil.LogBlkHash, types.ToBlockID(br.hash).String()).Int("tx_cnt", len(br.txs)).Int("missing", len(req.Hashes)).Str(p2putil.LogPeerName, br.peer.Name()).Msg("requesting missing txs of compact block")
+
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	mo := br.peer.MF().NewMsgRequestOrderWithReceiver(br.ReceiveResp, p2pcommon.GetTXsRequest, req)
+	br.requestID = mo.GetMsgID()
+	br.timer = time.AfterFunc(br.ttl, br.onTimeout)
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *CompactBlockReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.status != receiverStatusWaiting {
+		return
+	}
+
+	respBody, ok := msgBody.(types.ResponseMessage)
+	if !ok || respBody.GetStatus() != types.ResultStatus_OK {
+		br.fallback()
+		return
+	}
+	body, ok := msgBody.(*types.GetTransactionsResponse)
+	if !ok {
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "getTXs response: "+message.MissingHashError.Error())
+		br.fallback()
+		return
+	}
+	for _, tx := range body.Txs {
+		txID := types.ToTxID(tx.GetHash())
+		idx, ok := br.missing[txID]
+		if !ok || !bytes.Equal(tx.CalculateTxHash(), tx.GetHash()) {
+			reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "getTXs response: unexpected tx for compact block")
+			br.fallback()
+			return
+		}
+		br.txs[idx] = tx
+		delete(br.missing, txID)
+	}
+	if body.HasNext {
+		return
+	}
+	if len(br.missing) > 0 {
+		// the notifier discarded some txs already. it is not fault of remote peer.
+		br.fallback()
+		return
+	}
+	reportPeer(br.rm, br.peer.ID(), p2pcommon.UsefulResponse, "getTXs response")
+	br.complete()
+	return
+}
+
+func (br *CompactBlockReceiver) onTimeout() {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.status != receiverStatusWaiting {
+		return
+	}
+	reportPeer(br.rm, br.peer.ID(), p2pcommon.ResponseTimeout, "getTXs response")
+	br.fallback()
+}
+
+// complete assembles block and sends it to chainservice. It must be called with holding mutex.
+func (br *CompactBlockReceiver) complete() {
+	br.finishReceiver()
+	if !bytes.Equal(types.CalculateTxsRootHash(br.txs), br.header.GetTxsRootHash()) {
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "txs root of compact block mismatch")
+		br.fallback()
+		return
+	}
+	block := &types.Block{Hash: br.hash, Header: br.header, Body: &types.BlockBody{Txs: br.txs}}
+	if block.Size() > int(chain.MaxBlockSize()) {
+		br.logger.Info().Str(p2putil.LogPeerName, br.peer.Name()).Str(p2putil.LogBlkHash, block.BlockID().String()).Int("size", block.Size()).Msg("cancel to add compact block. block size exceed limit")
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "too big compact block")
+		return
+	}
+	br.actor.SendRequest(message.ChainSvc, &message.AddBlock{PeerID: br.peer.ID(), Block: block, Bstate: nil})
+}
+
+// fallback gives up rebuilding block and requests the full block to the notifier, as the legacy new block notice does.
+// It must be called with holding mutex, except before sending request.
+func (br *CompactBlockReceiver) fallback() {
+	br.finishReceiver()
+	br.logger.Debug().Str(p2putil.LogBlkHash, types.ToBlockID(br.hash).String()).Str(p2putil.LogPeerName, br.peer.Name()).Msg("failed to rebuild compact block. request full block to notifier")
+	br.actor.SendRequest(message.P2PSvc, &message.GetBlockInfos{ToWhom: br.peer.ID(),
+		Hashes: []message.BlockHash{message.BlockHash(br.hash)}})
+}
+
+// finishReceiver stops waiting response. It is ok to be called more than once.
+func (br *CompactBlockReceiver) finishReceiver() {
+	if br.status == receiverStatusFinished {
+		return
+	}
+	br.status = receiverStatusFinished
+	if br.timer != nil {
+		br.timer.Stop()
+	}
+	if br.requestID != p2pcommon.EmptyID {
+		br.peer.ConsumeRequest(br.requestID)
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+)
+
+func Test_newCompactBlockNotice(t *testing.T) {
+	txs := make([]*types.Tx, 3)
+	for i := range txs {
+		txs[i] = types.NewTx()
+		txs[i].Body.Nonce = uint64(i + 1)
+		txs[i].Hash = txs[i].CalculateTxHash()
+	}
+	tests := []struct {
+		name string
+		txs  []*types.Tx
+	}{
+		{"TEmpty", nil},
+		{"TTxs", txs},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := &types.Block{Header: &types.BlockHeader{BlockNo: 100, TxsRootHash: types.CalculateTxsRootHash(tt.txs)}, Body: &types.BlockBody{Txs: tt.txs}}
+			got := newCompactBlockNotice(block)
+
+			// block hash must be recalculated only from header
+			if !bytes.Equal((&types.Block{Header: got.Header}).BlockHash(), block.BlockHash()) {
+				t.Errorf("newCompactBlockNotice() hash differs from original block")
+			}
+			if len(got.TxHashes) != len(tt.txs) {
+				t.Fatalf("newCompactBlockNotice() tx hashes %v, want %v", len(got.TxHashes), len(tt.txs))
+			}
+			for i, tx := range tt.txs {
+				if !bytes.Equal(got.TxHashes[i], tx.Hash) {
+					t.Errorf("newCompactBlockNotice() tx hash[%d] differs", i)
+				}
+			}
+		})
+	}
+}
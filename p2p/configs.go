@@ -25,6 +25,10 @@ const (
 
 	MaxAddrListSizePolaris = 200
 	MaxAddrListSizePeer    = 50
+
+	// selfAddrCheckInterval is the interval to check the reachable addresses of local node, which can be changed
+	// by NAT port mapping or the observation of remote peers.
+	selfAddrCheckInterval = time.Minute
 )
 
 // constants for peer internal operations
@@ -48,6 +52,17 @@ const (
 	cachePlaceHolder = true
 )
 
+// constants for tx relay
+const (
+	// txRelayFanout is max number of peers, except block producers, to which new txs are announced at once.
+	// the other peers will get notices from the receivers.
+	txRelayFanout = 8
+	// getTxQueryRate is number of getTXs requests per second which a remote peer can send, and getTxQueryBurst is
+	// max number of requests at once. requests over the limit are rejected as server is busy.
+	getTxQueryRate  = 10
+	getTxQueryBurst = 30
+)
+
 // constants for block notice tuning
 const (
 	GapToSkipAll    = 86400
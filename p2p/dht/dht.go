@@ -0,0 +1,329 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package dht
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/network"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/types"
+	core "github.com/libp2p/go-libp2p-core"
+)
+
+// subprotocol for DHT
+const (
+	DHTProtocolID core.ProtocolID = "/aergo/dht/0.1"
+)
+const (
+	FindNodeRequest p2pcommon.SubProtocol = 0x0200 + iota
+	FindNodeResponse
+)
+
+const (
+	// Alpha is the number of concurrent queries in a lookup.
+	Alpha = 3
+	// maxLookupRounds limits the rounds of queries in a lookup, in case that closer nodes keep appearing.
+	maxLookupRounds = 10
+	// QueryTTL is the deadline of a single query, including connecting to remote node.
+	QueryTTL = time.Second * 10
+
+	differentChainMsg = "different chain"
+)
+
+// kadDHT discovers nodes of the same chain by kademlia lookup. Every node answers the nodes closest to the
+// requested key from its routing table, which contains only the nodes whose chain is confirmed to be same.
+type kadDHT struct {
+	logger *log.Logger
+	ntc    p2pcommon.NTContainer
+	nt     p2pcommon.NetworkTransport
+
+	rt         *RoutingTable
+	bootstraps []p2pcommon.PeerMeta
+	exposeSelf bool
+}
+
+var _ p2pcommon.DHTDiscoverer = (*kadDHT)(nil)
+
+// NewDHT creates DHT discoverer with the bootstrap nodes in configuration.
+func NewDHT(cfg *config.P2PConfig, ntc p2pcommon.NTContainer, logger *log.Logger) p2pcommon.DHTDiscoverer {
+	d := &kadDHT{logger: logger, ntc: ntc, rt: NewRoutingTable(ntc.SelfMeta().ID), exposeSelf: cfg.NPExposeSelf}
+	for _, addrStr := range cfg.NPDHTBootstraps {
+		meta, err := p2putil.FromMultiAddrString(addrStr)
+		if err != nil {
+			logger.Info().Str("addr_str", addrStr).Msg("invalid DHT bootstrap address in config file")
+			continue
+		}
+		d.bootstraps = append(d.bootstraps, meta)
+	}
+	if len(d.bootstraps) == 0 {
+		logger.Info().Msg("no DHT bootstrap node is set. DHT will be joined through connected peers")
+	} else {
+		logger.Info().Array("bootstraps", p2putil.NewLogPeerMetasMarshaller(d.bootstraps, 10)).Msg("using DHT peer discovery")
+	}
+	return d
+}
+
+func (d *kadDHT) Start() {
+	d.nt = d.ntc.GetNetworkTransport()
+	d.nt.AddStreamHandler(DHTProtocolID, d.onFindNode)
+}
+
+func (d *kadDHT) Stop() {
+	d.nt.RemoveStreamHandler(DHTProtocolID)
+}
+
+func (d *kadDHT) AddPeer(meta p2pcommon.PeerMeta) {
+	if meta.Hidden || len(meta.Addresses) == 0 {
+		return
+	}
+	d.rt.Update(meta)
+}
+
+func (d *kadDHT) FindPeers() []p2pcommon.PeerMeta {
+	// a random key spreads lookups over key space, while the key of local node is looked up to fill the nearby
+	// buckets at the first time.
+	var target Key
+	if d.rt.Size() == 0 {
+		target = KeyOf(d.ntc.SelfMeta().ID)
+	} else if _, err := rand.Read(target[:]); err != nil {
+		return nil
+	}
+	return d.lookup(target)
+}
+
+// lookup queries iteratively to the nodes closer to target, and returns the nodes which answered during the lookup.
+// The nodes reported by the others are only candidates until they answer to the query themselves.
+func (d *kadDHT) lookup(target Key) []p2pcommon.PeerMeta {
+	selfID := d.ntc.SelfMeta().ID
+	candidates := d.rt.Nearest(target, BucketSize)
+	if len(candidates) == 0 {
+		candidates = d.bootstraps
+	}
+	shortlist := make([]*nodeEntry, 0, len(candidates))
+	known := make(map[types.PeerID]bool)
+	queried := make(map[types.PeerID]bool)
+	for _, meta := range candidates {
+		if meta.ID == selfID {
+			continue
+		}
+		shortlist = append(shortlist, &nodeEntry{meta: meta, key: KeyOf(meta.ID)})
+		known[meta.ID] = true
+	}
+
+	found := make([]p2pcommon.PeerMeta, 0, BucketSize)
+	// exhaustive is set when a round finds no closer node; then all of the closest nodes
+	// not yet queried are queried at once, and the lookup finishes when none is left.
+	exhaustive := false
+	for round := 0; round < maxLookupRounds; round++ {
+		sortByDistance(shortlist, target)
+		toQuery := make([]*nodeEntry, 0, Alpha)
+		for i, e := range shortlist {
+			if i >= BucketSize || (!exhaustive && len(toQuery) >= Alpha) {
+				break
+			}
+			if !queried[e.meta.ID] {
+				toQuery = append(toQuery, e)
+				queried[e.meta.ID] = true
+			}
+		}
+		if len(toQuery) == 0 {
+			break
+		}
+		var closest Key
+		if len(shortlist) > 0 {
+			closest = shortlist[0].key.Distance(target)
+		}
+
+		results := make([][]p2pcommon.PeerMeta, len(toQuery))
+		answered := make([]bool, len(toQuery))
+		wg := sync.WaitGroup{}
+		for i, e := range toQuery {
+			wg.Add(1)
+			go func(i int, meta p2pcommon.PeerMeta) {
+				defer wg.Done()
+				metas, err := d.query(meta, target)
+				if err != nil {
+					d.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(meta.ID)).Msg("DHT query failed")
+					d.rt.Remove(meta.ID)
+					return
+				}
+				// the node answered to the query of local chain, so it is on the same chain.
+				d.rt.Update(meta)
+				results[i], answered[i] = metas, true
+			}(i, e.meta)
+		}
+		wg.Wait()
+
+		failed := make(map[types.PeerID]bool)
+		for i, e := range toQuery {
+			if answered[i] {
+				found = append(found, e.meta)
+			} else {
+				failed[e.meta.ID] = true
+			}
+		}
+		if len(failed) > 0 {
+			alive := shortlist[:0]
+			for _, e := range shortlist {
+				if !failed[e.meta.ID] {
+					alive = append(alive, e)
+				}
+			}
+			shortlist = alive
+		}
+		for _, metas := range results {
+			for _, meta := range metas {
+				if meta.ID == selfID || known[meta.ID] {
+					continue
+				}
+				known[meta.ID] = true
+				shortlist = append(shortlist, &nodeEntry{meta: meta, key: KeyOf(meta.ID)})
+			}
+		}
+		if len(shortlist) == 0 {
+			break
+		}
+		sortByDistance(shortlist, target)
+		dist := shortlist[0].key.Distance(target)
+		exhaustive = bytes.Compare(dist[:], closest[:]) >= 0
+	}
+	d.logger.Debug().Int("found", len(found)).Int("queried", len(queried)).Int("table_size", d.rt.Size()).Msg("DHT lookup finished")
+	return found
+}
+
+// query sends findNode request to remote node and returns the nodes in response.
+func (d *kadDHT) query(meta p2pcommon.PeerMeta, target Key) ([]p2pcommon.PeerMeta, error) {
+	if len(meta.Addresses) == 0 {
+		return nil, fmt.Errorf("no address")
+	}
+	s, err := d.nt.GetOrCreateStreamWithTTL(meta, QueryTTL, DHTProtocolID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(QueryTTL))
+	if s.Conn().RemotePeer() != meta.ID {
+		return nil, fmt.Errorf("peerid mismatch, exp %s, actual %s", meta.ID.Pretty(), s.Conn().RemotePeer().Pretty())
+	}
+
+	rw := v030.NewV030ReadWriter(bufio.NewReader(s), bufio.NewWriter(s), nil)
+	req := &types.FindNodeRequest{Status: d.selfStatus(), Target: target[:], Size: BucketSize}
+	payload, err := p2putil.MarshalMessageBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if err = rw.WriteMsg(p2pcommon.NewMessageValue(FindNodeRequest, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano(), payload)); err != nil {
+		return nil, err
+	}
+	msg, err := rw.ReadMsg()
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.FindNodeResponse{}
+	if err = p2putil.UnmarshalMessageBody(msg.Payload(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != types.ResultStatus_OK {
+		return nil, fmt.Errorf("remote error %s %s", resp.Status.String(), resp.Message)
+	}
+	metas := make([]p2pcommon.PeerMeta, 0, len(resp.Peers))
+	for _, addr := range resp.Peers {
+		if len(addr.Addresses) == 0 || network.CheckAddressType(addr.Address) == network.AddressTypeError {
+			continue
+		}
+		metas = append(metas, p2pcommon.FromPeerAddress(addr))
+	}
+	return metas, nil
+}
+
+func (d *kadDHT) onFindNode(s core.Stream) {
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(QueryTTL))
+	peerID := s.Conn().RemotePeer()
+	rw := v030.NewV030ReadWriter(bufio.NewReader(s), bufio.NewWriter(s), nil)
+
+	msg, err := rw.ReadMsg()
+	if err != nil {
+		return
+	}
+	req := &types.FindNodeRequest{}
+	if err = p2putil.UnmarshalMessageBody(msg.Payload(), req); err != nil || len(req.Target) != len(Key{}) {
+		d.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("malformed DHT query")
+		return
+	}
+
+	resp := &types.FindNodeResponse{Status: types.ResultStatus_OK}
+	if same, err := d.checkChain(req.Status.GetChainID()); err != nil || !same {
+		resp.Status, resp.Message = types.ResultStatus_FAILED_PRECONDITION, differentChainMsg
+	} else {
+		if !req.Status.NoExpose {
+			d.AddPeer(observedMeta(s.Conn(), req.Status.GetSender()))
+		}
+		size := int(req.Size)
+		if size <= 0 || size > BucketSize {
+			size = BucketSize
+		}
+		var target Key
+		copy(target[:], req.Target)
+		for _, meta := range d.rt.Nearest(target, size+1) {
+			if meta.ID == peerID || len(resp.Peers) >= size {
+				continue
+			}
+			addr := meta.ToPeerAddress()
+			resp.Peers = append(resp.Peers, &addr)
+		}
+	}
+
+	payload, err := p2putil.MarshalMessageBody(resp)
+	if err != nil {
+		return
+	}
+	if err = rw.WriteMsg(p2pcommon.NewMessageValue(FindNodeResponse, p2pcommon.NewMsgID(), msg.ID(), time.Now().UnixNano(), payload)); err != nil {
+		d.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("failed to write DHT response")
+	}
+}
+
+// observedMeta returns the meta of the remote node of conn, whose address is the one observed by this node. The
+// addresses advertised by the node are not trusted, since a node could make others connect to arbitrary hosts.
+func observedMeta(conn core.Conn, sender *types.PeerAddress) p2pcommon.PeerMeta {
+	meta := p2pcommon.PeerMeta{ID: conn.RemotePeer()}
+	if sender != nil && types.PeerID(sender.PeerID) == meta.ID {
+		meta = p2pcommon.FromPeerAddress(sender)
+	}
+	meta.Addresses = nil
+	if addr := conn.RemoteMultiaddr(); addr != nil {
+		if _, _, err := types.GetIPPortFromMultiaddr(addr); err == nil {
+			meta.Addresses = []types.Multiaddr{addr}
+		}
+	}
+	return meta
+}
+
+func (d *kadDHT) selfStatus() *types.Status {
+	selfAddr := d.ntc.SelfMeta().ToPeerAddress()
+	chainBytes, _ := d.ntc.GenesisChainID().Bytes()
+	return &types.Status{Sender: &selfAddr, ChainID: chainBytes, NoExpose: !d.exposeSelf, Version: p2pkey.NodeVersion()}
+}
+
+// checkChain is same as that of polaris.
+func (d *kadDHT) checkChain(chainIDBytes []byte) (bool, error) {
+	remoteChainID := types.NewChainID()
+	if err := remoteChainID.Read(chainIDBytes); err != nil {
+		return false, err
+	}
+	return d.ntc.GenesisChainID().Equals(remoteChainID), nil
+}
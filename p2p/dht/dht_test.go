@@ -0,0 +1,288 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package dht
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	core "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+var (
+	testLogger = log.NewLogger("dht.test")
+	testChain  = &types.ChainID{Magic: "dht.test", Consensus: "sbp"}
+	otherChain = &types.ChainID{Magic: "dht.other", Consensus: "sbp"}
+)
+
+func init() {
+	baseCfg := &config.BaseConfig{AuthDir: "test"}
+	p2pCfg := &config.P2PConfig{NPKey: "../../test/sample.key"}
+	p2pkey.InitNodeInfo(baseCfg, p2pCfg, "0.0.1-test", testLogger)
+}
+
+// pipeStream is a stream over an in-memory connection.
+type pipeStream struct {
+	c    net.Conn
+	conn core.Conn
+}
+
+func (s *pipeStream) Read(p []byte) (int, error)         { return s.c.Read(p) }
+func (s *pipeStream) Write(p []byte) (int, error)        { return s.c.Write(p) }
+func (s *pipeStream) Close() error                       { return s.c.Close() }
+func (s *pipeStream) Reset() error                       { return s.c.Close() }
+func (s *pipeStream) SetDeadline(t time.Time) error      { return s.c.SetDeadline(t) }
+func (s *pipeStream) SetReadDeadline(t time.Time) error  { return s.c.SetReadDeadline(t) }
+func (s *pipeStream) SetWriteDeadline(t time.Time) error { return s.c.SetWriteDeadline(t) }
+func (s *pipeStream) Protocol() protocol.ID              { return DHTProtocolID }
+func (s *pipeStream) SetProtocol(protocol.ID)            {}
+func (s *pipeStream) Stat() network.Stat                 { return network.Stat{} }
+func (s *pipeStream) Conn() core.Conn                    { return s.conn }
+
+// testNet connects the DHT nodes in process. A query to the node which is
+// not in the network fails.
+type testNet struct {
+	ctrl *gomock.Controller
+
+	sync.Mutex
+	nodes map[types.PeerID]*kadDHT
+}
+
+func newTestNet(ctrl *gomock.Controller) *testNet {
+	return &testNet{ctrl: ctrl, nodes: make(map[types.PeerID]*kadDHT)}
+}
+
+func (tn *testNet) newNode(chainID *types.ChainID) *kadDHT {
+	tn.Lock()
+	defer tn.Unlock()
+
+	addr, _ := types.ParseMultiaddr(fmt.Sprintf("/ip4/10.0.%d.%d/tcp/7846", len(tn.nodes)/250, len(tn.nodes)%250+1))
+	meta := p2pcommon.PeerMeta{ID: types.RandomPeerID(), Addresses: []types.Multiaddr{addr}}
+
+	ntc := p2pmock.NewMockNTContainer(tn.ctrl)
+	ntc.EXPECT().SelfMeta().Return(meta).AnyTimes()
+	ntc.EXPECT().GenesisChainID().Return(chainID).AnyTimes()
+	nt := p2pmock.NewMockNetworkTransport(tn.ctrl)
+	nt.EXPECT().GetOrCreateStreamWithTTL(gomock.Any(), gomock.Any(), DHTProtocolID).DoAndReturn(
+		func(to p2pcommon.PeerMeta, ttl time.Duration, pids ...core.ProtocolID) (core.Stream, error) {
+			return tn.connect(meta, to)
+		}).AnyTimes()
+
+	d := &kadDHT{logger: testLogger, ntc: ntc, nt: nt, rt: NewRoutingTable(meta.ID), exposeSelf: true}
+	tn.nodes[meta.ID] = d
+	return d
+}
+
+func (tn *testNet) connect(from, to p2pcommon.PeerMeta) (core.Stream, error) {
+	tn.Lock()
+	remote, exist := tn.nodes[to.ID]
+	tn.Unlock()
+	if !exist {
+		return nil, fmt.Errorf("unreachable node %s", to.ID.Pretty())
+	}
+	c, s := net.Pipe()
+	go remote.onFindNode(tn.stream(s, from))
+	return tn.stream(c, to), nil
+}
+
+func (tn *testNet) stream(c net.Conn, remote p2pcommon.PeerMeta) *pipeStream {
+	conn := p2pmock.NewMockConn(tn.ctrl)
+	conn.EXPECT().RemotePeer().Return(remote.ID).AnyTimes()
+	conn.EXPECT().RemoteMultiaddr().Return(remote.Addresses[0]).AnyTimes()
+	return &pipeStream{c: c, conn: conn}
+}
+
+func selfMeta(d *kadDHT) p2pcommon.PeerMeta {
+	return d.ntc.SelfMeta()
+}
+
+func contains(metas []p2pcommon.PeerMeta, id types.PeerID) bool {
+	for _, m := range metas {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestKadDHT_lookupConverges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tn := newTestNet(ctrl)
+
+	// every node knows the others as many as its buckets can keep
+	nodes := make([]*kadDHT, 100)
+	for i := range nodes {
+		nodes[i] = tn.newNode(testChain)
+	}
+	for _, n := range nodes {
+		for _, o := range nodes {
+			n.AddPeer(selfMeta(o))
+		}
+	}
+
+	// a new node knowing only a bootstrap node finds the node of target, which
+	// becomes the nearest one in its routing table unless the bucket of target is
+	// already full. The bootstrap node doesn't know the target, so that it's found
+	// through the other nodes.
+	for _, target := range nodes[1:10] {
+		nodes[0].rt.Remove(selfMeta(target).ID)
+		joining := tn.newNode(testChain)
+		joining.bootstraps = []p2pcommon.PeerMeta{selfMeta(nodes[0])}
+		key := KeyOf(selfMeta(target).ID)
+		if found := joining.lookup(key); !contains(found, selfMeta(target).ID) {
+			t.Errorf("lookup() didn't find the target node %s", p2putil.ShortForm(selfMeta(target).ID))
+		}
+		nearest := joining.rt.Nearest(key, 1)
+		bucket := joining.rt.buckets[commonPrefixLen(joining.rt.self, key)]
+		if (len(nearest) == 0 || nearest[0].ID != selfMeta(target).ID) && len(bucket) < BucketSize {
+			t.Errorf("the target node is not the nearest in routing table")
+		}
+	}
+}
+
+func TestKadDHT_lookupUnreachable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tn := newTestNet(ctrl)
+
+	// the bootstrap node reports a reachable node and a record of the node not in network
+	bootstrap := tn.newNode(testChain)
+	reachable := tn.newNode(testChain)
+	addr, _ := types.ParseMultiaddr("/ip4/10.1.0.1/tcp/7846")
+	unreachable := p2pcommon.PeerMeta{ID: types.RandomPeerID(), Addresses: []types.Multiaddr{addr}}
+	bootstrap.AddPeer(selfMeta(reachable))
+	bootstrap.AddPeer(unreachable)
+
+	local := tn.newNode(testChain)
+	local.bootstraps = []p2pcommon.PeerMeta{selfMeta(bootstrap)}
+	found := local.lookup(KeyOf(unreachable.ID))
+	if !contains(found, selfMeta(bootstrap).ID) || !contains(found, selfMeta(reachable).ID) {
+		t.Errorf("lookup() = %v, want the nodes answered", found)
+	}
+	if contains(found, unreachable.ID) {
+		t.Errorf("lookup() returned the node which didn't answer")
+	}
+	if contains(local.rt.Nearest(KeyOf(unreachable.ID), BucketSize), unreachable.ID) {
+		t.Errorf("the node which didn't answer is added to routing table")
+	}
+}
+
+func TestKadDHT_lookupOtherChain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tn := newTestNet(ctrl)
+
+	other := tn.newNode(otherChain)
+	otherPeer := tn.newNode(otherChain)
+	other.AddPeer(selfMeta(otherPeer))
+
+	local := tn.newNode(testChain)
+	local.bootstraps = []p2pcommon.PeerMeta{selfMeta(other)}
+	if found := local.lookup(KeyOf(selfMeta(local).ID)); len(found) != 0 {
+		t.Errorf("lookup() = %v, want no node of other chain", found)
+	}
+	if local.rt.Size() != 0 {
+		t.Errorf("the node of other chain is added to routing table")
+	}
+	if other.rt.Nearest(KeyOf(selfMeta(local).ID), BucketSize)[0].ID == selfMeta(local).ID {
+		t.Errorf("the querier of other chain is added to routing table")
+	}
+}
+
+func TestKadDHT_onFindNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tn := newTestNet(ctrl)
+
+	server := tn.newNode(testChain)
+	known := tn.newNode(testChain)
+	server.AddPeer(selfMeta(known))
+
+	observed, _ := types.ParseMultiaddr("/ip4/192.168.0.7/tcp/7846")
+	advertised, _ := types.ParseMultiaddr("/ip4/8.8.8.8/tcp/53")
+	client := p2pcommon.PeerMeta{ID: types.RandomPeerID(), Addresses: []types.Multiaddr{observed}}
+	tests := []struct {
+		name     string
+		chainID  *types.ChainID
+		noExpose bool
+
+		wantStatus types.ResultStatus
+		wantAdded  bool
+	}{
+		{"TSameChain", testChain, false, types.ResultStatus_OK, true},
+		{"TNoExpose", testChain, true, types.ResultStatus_OK, false},
+		{"TOtherChain", otherChain, false, types.ResultStatus_FAILED_PRECONDITION, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server.rt.Remove(client.ID)
+
+			c, s := net.Pipe()
+			go server.onFindNode(tn.stream(s, client))
+			defer c.Close()
+
+			// the sender advertises an address other than the observed one
+			sender := p2pcommon.PeerMeta{ID: client.ID, Addresses: []types.Multiaddr{advertised}}.ToPeerAddress()
+			chainBytes, _ := test.chainID.Bytes()
+			target := KeyOf(selfMeta(known).ID)
+			req := &types.FindNodeRequest{Status: &types.Status{Sender: &sender, ChainID: chainBytes, NoExpose: test.noExpose}, Target: target[:], Size: BucketSize}
+			payload, _ := p2putil.MarshalMessageBody(req)
+			rw := v030.NewV030ReadWriter(bufio.NewReader(c), bufio.NewWriter(c), nil)
+			if err := rw.WriteMsg(p2pcommon.NewMessageValue(FindNodeRequest, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano(), payload)); err != nil {
+				t.Fatal(err)
+			}
+			msg, err := rw.ReadMsg()
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp := &types.FindNodeResponse{}
+			if err := p2putil.UnmarshalMessageBody(msg.Payload(), resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.Status != test.wantStatus {
+				t.Errorf("status = %v, want %v", resp.Status, test.wantStatus)
+			}
+			if test.wantStatus == types.ResultStatus_OK {
+				if len(resp.Peers) != 1 || types.PeerID(resp.Peers[0].PeerID) != selfMeta(known).ID {
+					t.Errorf("peers = %v, want the known node", resp.Peers)
+				}
+			} else if len(resp.Peers) != 0 {
+				t.Errorf("peers = %v, want none for other chain", resp.Peers)
+			}
+
+			var added *p2pcommon.PeerMeta
+			for _, m := range server.rt.Nearest(KeyOf(client.ID), BucketSize) {
+				if m.ID == client.ID {
+					meta := m
+					added = &meta
+				}
+			}
+			if (added != nil) != test.wantAdded {
+				t.Fatalf("added = %v, want %v", added != nil, test.wantAdded)
+			}
+			if added != nil && (len(added.Addresses) != 1 || !added.Addresses[0].Equal(observed)) {
+				t.Errorf("addresses = %v, want only the observed one %v", added.Addresses, observed)
+			}
+		})
+	}
+}
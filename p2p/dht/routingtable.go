@@ -0,0 +1,165 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package dht
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/bits"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	// BucketSize is the max number of nodes in a k-bucket, and also the number of nodes returned by a lookup.
+	BucketSize = 20
+	// KeyBits is the bit length of key space, which is the number of k-buckets.
+	KeyBits = sha256.Size * 8
+
+	// NodeStaleTTL is the duration after the last contact, from which the node can be replaced by a new one.
+	NodeStaleTTL = time.Minute * 30
+)
+
+// Key is the position of node in kademlia key space. It is the sha256 hash of peer id, so that
+// the nodes are distributed evenly regardless of the form of peer id.
+type Key [sha256.Size]byte
+
+// KeyOf returns the key of peer.
+func KeyOf(id types.PeerID) Key {
+	return sha256.Sum256([]byte(id))
+}
+
+// Distance returns the XOR distance between two keys.
+func (k Key) Distance(o Key) Key {
+	var d Key
+	for i := range k {
+		d[i] = k[i] ^ o[i]
+	}
+	return d
+}
+
+// commonPrefixLen returns the number of leading bits which two keys share.
+func commonPrefixLen(a, b Key) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			return i*8 + bits.LeadingZeros8(x)
+		}
+	}
+	return KeyBits
+}
+
+type nodeEntry struct {
+	meta     p2pcommon.PeerMeta
+	key      Key
+	lastSeen time.Time
+}
+
+// RoutingTable is the kademlia routing table of local node. The nodes are grouped into k-buckets
+// by the length of common prefix with the key of local node. It is thread-safe.
+type RoutingTable struct {
+	self    Key
+	mutex   sync.RWMutex
+	buckets [KeyBits][]*nodeEntry
+
+	now func() time.Time
+}
+
+func NewRoutingTable(selfID types.PeerID) *RoutingTable {
+	return &RoutingTable{self: KeyOf(selfID), now: time.Now}
+}
+
+// Update adds the node or marks it recently seen. If the bucket is full, the least recently seen node is
+// replaced only if it is stale, since the long-lived nodes are likely to stay longer. It returns true if the
+// node is in the table after update.
+func (rt *RoutingTable) Update(meta p2pcommon.PeerMeta) bool {
+	key := KeyOf(meta.ID)
+	idx := commonPrefixLen(rt.self, key)
+	if idx == KeyBits {
+		// local node itself
+		return false
+	}
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	now := rt.now()
+	bucket := rt.buckets[idx]
+	for i, e := range bucket {
+		if e.meta.ID == meta.ID {
+			// move to tail
+			e.meta, e.lastSeen = meta, now
+			rt.buckets[idx] = append(append(bucket[:i:i], bucket[i+1:]...), e)
+			return true
+		}
+	}
+	entry := &nodeEntry{meta: meta, key: key, lastSeen: now}
+	if len(bucket) < BucketSize {
+		rt.buckets[idx] = append(bucket, entry)
+		return true
+	}
+	if now.Sub(bucket[0].lastSeen) > NodeStaleTTL {
+		rt.buckets[idx] = append(bucket[1:len(bucket):len(bucket)], entry)
+		return true
+	}
+	return false
+}
+
+// Remove deletes the node from table, usually when the node is not responding.
+func (rt *RoutingTable) Remove(id types.PeerID) {
+	idx := commonPrefixLen(rt.self, KeyOf(id))
+	if idx == KeyBits {
+		return
+	}
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	bucket := rt.buckets[idx]
+	for i, e := range bucket {
+		if e.meta.ID == id {
+			rt.buckets[idx] = append(bucket[:i:i], bucket[i+1:]...)
+			return
+		}
+	}
+}
+
+// Nearest returns at most count nodes which are closest to the target, in the order of distance.
+func (rt *RoutingTable) Nearest(target Key, count int) []p2pcommon.PeerMeta {
+	rt.mutex.RLock()
+	entries := make([]*nodeEntry, 0, count)
+	for _, bucket := range rt.buckets {
+		entries = append(entries, bucket...)
+	}
+	rt.mutex.RUnlock()
+
+	sortByDistance(entries, target)
+	if len(entries) > count {
+		entries = entries[:count]
+	}
+	metas := make([]p2pcommon.PeerMeta, len(entries))
+	for i, e := range entries {
+		metas[i] = e.meta
+	}
+	return metas
+}
+
+// Size returns the number of nodes in table.
+func (rt *RoutingTable) Size() int {
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	size := 0
+	for _, bucket := range rt.buckets {
+		size += len(bucket)
+	}
+	return size
+}
+
+func sortByDistance(entries []*nodeEntry, target Key) {
+	sort.Slice(entries, func(i, j int) bool {
+		di, dj := entries[i].key.Distance(target), entries[j].key.Distance(target)
+		return bytes.Compare(di[:], dj[:]) < 0
+	})
+}
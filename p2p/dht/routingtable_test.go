@@ -0,0 +1,115 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package dht
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func TestRoutingTable_Update(t *testing.T) {
+	self := types.RandomPeerID()
+	rt := NewRoutingTable(self)
+	now := time.Now()
+	rt.now = func() time.Time { return now }
+
+	if rt.Update(p2pcommon.PeerMeta{ID: self}) {
+		t.Errorf("local node is added to table")
+	}
+
+	// fill a bucket by the nodes which share the same prefix with local node
+	var first p2pcommon.PeerMeta
+	var idx int
+	added := 0
+	for added < BucketSize {
+		meta := p2pcommon.PeerMeta{ID: types.RandomPeerID()}
+		if commonPrefixLen(rt.self, KeyOf(meta.ID)) != 0 {
+			continue
+		}
+		if added == 0 {
+			first = meta
+		}
+		if !rt.Update(meta) {
+			t.Fatalf("failed to add node to not full bucket")
+		}
+		added++
+	}
+	if rt.Size() != BucketSize || len(rt.buckets[idx]) != BucketSize {
+		t.Fatalf("Size() = %v, want %v", rt.Size(), BucketSize)
+	}
+
+	var newcomer p2pcommon.PeerMeta
+	for {
+		newcomer = p2pcommon.PeerMeta{ID: types.RandomPeerID()}
+		if commonPrefixLen(rt.self, KeyOf(newcomer.ID)) == 0 {
+			break
+		}
+	}
+	// full bucket keeps the old nodes
+	if rt.Update(newcomer) {
+		t.Errorf("newcomer is added to full bucket")
+	}
+	// seen node is moved to tail, so the next node become the least recently seen.
+	now = now.Add(time.Minute)
+	rt.Update(first)
+	if tail := rt.buckets[idx][BucketSize-1]; tail.meta.ID != first.ID || !tail.lastSeen.Equal(now) {
+		t.Errorf("updated node is not moved to tail")
+	}
+	second := rt.buckets[idx][0].meta.ID
+	// stale node is replaced
+	now = now.Add(NodeStaleTTL)
+	if !rt.Update(newcomer) {
+		t.Errorf("newcomer is not added instead of stale node")
+	}
+	if rt.Size() != BucketSize {
+		t.Errorf("Size() = %v, want %v", rt.Size(), BucketSize)
+	}
+	for _, e := range rt.buckets[idx] {
+		if e.meta.ID == second {
+			t.Errorf("stale node %v is not removed", second)
+		}
+	}
+
+	rt.Remove(newcomer.ID)
+	if rt.Size() != BucketSize-1 {
+		t.Errorf("Size() after Remove = %v, want %v", rt.Size(), BucketSize-1)
+	}
+}
+
+func TestRoutingTable_Nearest(t *testing.T) {
+	rt := NewRoutingTable(types.RandomPeerID())
+	for i := 0; i < 100; i++ {
+		rt.Update(p2pcommon.PeerMeta{ID: types.RandomPeerID()})
+	}
+	target := KeyOf(types.RandomPeerID())
+	size := rt.Size()
+
+	got := rt.Nearest(target, 10)
+	if len(got) != 10 {
+		t.Fatalf("Nearest() returns %v nodes, want 10", len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		prev, cur := KeyOf(got[i-1].ID).Distance(target), KeyOf(got[i].ID).Distance(target)
+		if bytes.Compare(prev[:], cur[:]) > 0 {
+			t.Errorf("Nearest() is not sorted by distance at %v", i)
+		}
+	}
+	// no node out of result is closer than the farthest one in result
+	farthest := KeyOf(got[len(got)-1].ID).Distance(target)
+	for _, meta := range rt.Nearest(target, size)[10:] {
+		d := KeyOf(meta.ID).Distance(target)
+		if bytes.Compare(d[:], farthest[:]) < 0 {
+			t.Errorf("closer node %v is not in result", meta.ID)
+		}
+	}
+	if all := rt.Nearest(target, size+10); len(all) != size {
+		t.Errorf("Nearest() returns %v nodes, want all %v nodes", len(all), size)
+	}
+}
@@ -88,7 +88,12 @@ func (h *InboundWireHandshaker) handleInboundPeer(ctx context.Context, rwc io.Re
 	if err != nil {
 		return nil, err
 	}
-	return innerHS.DoForInbound(ctx)
+	result, err := innerHS.DoForInbound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Version = bestVer
+	return result, nil
 }
 
 type OutboundWireHandshaker struct {
@@ -143,7 +148,12 @@ func (h *OutboundWireHandshaker) handleOutboundPeer(ctx context.Context, rwc io.
 	if err != nil {
 		return nil, err
 	}
-	return innerHS.DoForOutbound(ctx)
+	result, err := innerHS.DoForOutbound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Version = bestVersion
+	return result, nil
 }
 
 func (h *baseWireHandshaker) writeWireHSRequest(hsHeader p2pcommon.HSHeadReq, wr io.Writer) (err error) {
@@ -0,0 +1,148 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+// BlockHeadersReceiver sends p2p GetBlockHeadersRequest to target peer and delivers the response to ReplyC of the actor message.
+// The reply channel must be buffered, since the receiver doesn't wait for the channel to be read.
+type BlockHeadersReceiver struct {
+	requestID p2pcommon.MsgID
+
+	peer    p2pcommon.RemotePeer
+	msg     *message.GetBlockHeaders
+	timeout time.Time
+
+	finished bool
+}
+
+func NewBlockHeadersReceiver(peer p2pcommon.RemotePeer, msg *message.GetBlockHeaders, ttl time.Duration) *BlockHeadersReceiver {
+	return &BlockHeadersReceiver{peer: peer, msg: msg, timeout: time.Now().Add(ttl)}
+}
+
+func (br *BlockHeadersReceiver) StartGet() {
+	req := &types.GetBlockHeadersRequest{Hash: br.msg.Hash,
+		Height: br.msg.Height, Offset: br.msg.Offset, Size: br.msg.MaxSize, Asc: br.msg.Asc,
+	}
+	mo := br.peer.MF().NewMsgRequestOrderWithReceiver(br.ReceiveResp, p2pcommon.GetBlockHeadersRequest, req)
+	br.requestID = mo.GetMsgID()
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *BlockHeadersReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	defer br.peer.ConsumeRequest(br.requestID)
+	if br.finished || br.timeout.Before(time.Now()) {
+		// silently ignore already finished job
+		br.finished = true
+		return
+	}
+	br.finished = true
+	body := msgBody.(*types.GetBlockHeadersResponse)
+	rsp := &message.BlockHeadersResponse{FromWhom: br.peer.ID()}
+	if body.Status != types.ResultStatus_OK || len(body.Hashes) != len(body.Headers) {
+		rsp.Err = message.RemotePeerFailError
+	} else {
+		rsp.Hashes = make([]message.BlockHash, len(body.Hashes))
+		for i, hash := range body.Hashes {
+			rsp.Hashes[i] = hash
+		}
+		rsp.Headers = body.Headers
+	}
+	br.msg.ReplyC <- rsp
+	return
+}
+
+// StateProofReceiver sends p2p GetStateProofRequest to target peer and delivers the response to ReplyC of the actor message.
+type StateProofReceiver struct {
+	requestID p2pcommon.MsgID
+
+	peer    p2pcommon.RemotePeer
+	msg     *message.GetStateProof
+	timeout time.Time
+
+	finished bool
+}
+
+func NewStateProofReceiver(peer p2pcommon.RemotePeer, msg *message.GetStateProof, ttl time.Duration) *StateProofReceiver {
+	return &StateProofReceiver{peer: peer, msg: msg, timeout: time.Now().Add(ttl)}
+}
+
+func (sr *StateProofReceiver) StartGet() {
+	req := &types.GetStateProofRequest{Query: sr.msg.Query}
+	mo := sr.peer.MF().NewMsgRequestOrderWithReceiver(sr.ReceiveResp, p2pcommon.GetStateProofRequest, req)
+	sr.requestID = mo.GetMsgID()
+	sr.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (sr *StateProofReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	defer sr.peer.ConsumeRequest(sr.requestID)
+	if sr.finished || sr.timeout.Before(time.Now()) {
+		sr.finished = true
+		return
+	}
+	sr.finished = true
+	body := msgBody.(*types.GetStateProofResponse)
+	rsp := &message.GetStateProofRsp{FromWhom: sr.peer.ID()}
+	if body.Status != types.ResultStatus_OK {
+		rsp.Err = message.RemotePeerFailError
+	} else {
+		rsp.Proof = body.Proof
+	}
+	sr.msg.ReplyC <- rsp
+	return
+}
+
+// ReceiptsReceiver sends p2p GetReceiptsRequest to target peer and delivers the response to ReplyC of the actor message.
+type ReceiptsReceiver struct {
+	requestID p2pcommon.MsgID
+
+	peer    p2pcommon.RemotePeer
+	msg     *message.GetBlockReceipts
+	timeout time.Time
+
+	finished bool
+}
+
+func NewReceiptsReceiver(peer p2pcommon.RemotePeer, msg *message.GetBlockReceipts, ttl time.Duration) *ReceiptsReceiver {
+	return &ReceiptsReceiver{peer: peer, msg: msg, timeout: time.Now().Add(ttl)}
+}
+
+func (rr *ReceiptsReceiver) StartGet() {
+	req := &types.GetReceiptsRequest{BlockHash: rr.msg.BlockHash}
+	mo := rr.peer.MF().NewMsgRequestOrderWithReceiver(rr.ReceiveResp, p2pcommon.GetReceiptsRequest, req)
+	rr.requestID = mo.GetMsgID()
+	rr.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (rr *ReceiptsReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	defer rr.peer.ConsumeRequest(rr.requestID)
+	if rr.finished || rr.timeout.Before(time.Now()) {
+		rr.finished = true
+		return
+	}
+	rr.finished = true
+	body := msgBody.(*types.GetReceiptsResponse)
+	rsp := &message.GetBlockReceiptsRsp{FromWhom: rr.peer.ID()}
+	if body.Status != types.ResultStatus_OK {
+		rsp.Err = message.RemotePeerFailError
+	} else {
+		rsp.Receipts = body.Receipts
+	}
+	rr.msg.ReplyC <- rsp
+	return
+}
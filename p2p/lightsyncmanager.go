@@ -0,0 +1,57 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+// lightSyncManager is the sync manager of light node. It neither keeps blocks nor transactions,
+// and just forwards notices of new blocks to the light service, which then fetches the headers.
+type lightSyncManager struct {
+	logger *log.Logger
+	actor  p2pcommon.ActorService
+}
+
+func newLightSyncManager(actor p2pcommon.ActorService, logger *log.Logger) p2pcommon.SyncManager {
+	return &lightSyncManager{actor: actor, logger: logger}
+}
+
+func (sm *lightSyncManager) Start() {}
+
+func (sm *lightSyncManager) Stop() {}
+
+func (sm *lightSyncManager) HandleBlockProducedNotice(peer p2pcommon.RemotePeer, block *types.Block) {
+	sm.actor.TellRequest(message.LightSvc, &message.NewBlockNoticed{PeerID: peer.ID(), BlockHash: block.BlockHash(), BlockNo: block.BlockNo()})
+}
+
+func (sm *lightSyncManager) HandleNewBlockNotice(peer p2pcommon.RemotePeer, data *types.NewBlockNotice) {
+	sm.actor.TellRequest(message.LightSvc, &message.NewBlockNoticed{PeerID: peer.ID(), BlockHash: data.BlockHash, BlockNo: data.BlockNo})
+}
+
+func (sm *lightSyncManager) HandleCompactBlockNotice(peer p2pcommon.RemotePeer, data *types.CompactBlockNotice) {
+	header := data.GetHeader()
+	sm.actor.TellRequest(message.LightSvc, &message.NewBlockNoticed{PeerID: peer.ID(), BlockHash: (&types.Block{Header: header}).BlockHash(), BlockNo: header.GetBlockNo()})
+}
+
+func (sm *lightSyncManager) HandleGetBlockResponse(peer p2pcommon.RemotePeer, msg p2pcommon.Message, resp *types.GetBlockResponse) {
+	// light node never requests blocks
+}
+
+func (sm *lightSyncManager) RegisterTxNotice(txs []*types.Tx) {}
+
+func (sm *lightSyncManager) HandleNewTxNotice(peer p2pcommon.RemotePeer, hashes []types.TxID, data *types.NewTransactionsNotice) {
+	// light node has no mempool
+}
+
+func (sm *lightSyncManager) HandleGetTxReq(peer p2pcommon.RemotePeer, msgID p2pcommon.MsgID, data *types.GetTransactionsRequest) error {
+	return p2pcommon.SyncManagerBusyError
+}
+
+func (sm *lightSyncManager) RetryGetTx(peer p2pcommon.RemotePeer, hashes [][]byte) {}
@@ -0,0 +1,245 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package list
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	addrBookDBName = "addrbook"
+
+	// MaxAddrBookSize is the number of peers in the address book. The newly discovered peers
+	// are not added if the address book is full.
+	MaxAddrBookSize = 1000
+	// AddrBookEntryTTL is the duration after the last seen time, from which the peer is pruned.
+	AddrBookEntryTTL = time.Hour * 24 * 7
+	// MaxAddrBookFailures is the number of failures from which the peer that has never been
+	// connected is pruned.
+	MaxAddrBookFailures = 3
+
+	addrBookFlushInterval = time.Minute
+	addrBookPruneInterval = time.Hour
+)
+
+// addressBook keeps the entries in memory and writes the changed ones to the db periodically.
+type addressBook struct {
+	logger *log.Logger
+	selfID types.PeerID
+	store  db.DB
+
+	mutex   sync.Mutex
+	entries map[types.PeerID]*types.AddressBookEntry
+	// dirty is the set of peers changed or deleted since the last flush
+	dirty map[types.PeerID]bool
+	now   func() time.Time
+
+	quitC chan struct{}
+	wg    sync.WaitGroup
+}
+
+var _ p2pcommon.AddressBook = (*addressBook)(nil)
+
+// NewAddressBook opens the address book in the data directory and loads the peers in it.
+func NewAddressBook(logger *log.Logger, dbType string, dataDir string, selfID types.PeerID) p2pcommon.AddressBook {
+	dbPath := common.PathMkdirAll(dataDir, addrBookDBName)
+	ab := newAddressBook(logger, db.NewDB(db.ImplType(dbType), dbPath), selfID)
+	logger.Info().Str("datadir", dbPath).Int("peers", len(ab.entries)).Msg("address book loaded")
+	return ab
+}
+
+func newAddressBook(logger *log.Logger, store db.DB, selfID types.PeerID) *addressBook {
+	ab := &addressBook{logger: logger, selfID: selfID, store: store, entries: make(map[types.PeerID]*types.AddressBookEntry),
+		dirty: make(map[types.PeerID]bool), now: time.Now, quitC: make(chan struct{})}
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		entry := &types.AddressBookEntry{}
+		if err := proto.Unmarshal(iter.Value(), entry); err != nil || entry.Address == nil {
+			logger.Warn().Err(err).Msg("dropping broken entry of address book")
+			ab.dirty[types.PeerID(iter.Key())] = true
+			continue
+		}
+		ab.entries[types.PeerID(iter.Key())] = entry
+	}
+	ab.prune()
+	return ab
+}
+
+func (ab *addressBook) Start() {
+	ab.wg.Add(1)
+	go func() {
+		defer ab.wg.Done()
+		flushTicker := time.NewTicker(addrBookFlushInterval)
+		defer flushTicker.Stop()
+		pruneTicker := time.NewTicker(addrBookPruneInterval)
+		defer pruneTicker.Stop()
+		for {
+			select {
+			case <-flushTicker.C:
+				ab.flush()
+			case <-pruneTicker.C:
+				ab.prune()
+			case <-ab.quitC:
+				return
+			}
+		}
+	}()
+}
+
+func (ab *addressBook) Stop() {
+	close(ab.quitC)
+	ab.wg.Wait()
+	ab.flush()
+	ab.store.Close()
+}
+
+func (ab *addressBook) KnownPeers(size int) []p2pcommon.PeerMeta {
+	ab.mutex.Lock()
+	entries := make([]*types.AddressBookEntry, 0, len(ab.entries))
+	for _, e := range ab.entries {
+		entries = append(entries, e)
+	}
+	ab.mutex.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		si, sj := entries[i].SuccessCount > 0, entries[j].SuccessCount > 0
+		if si != sj {
+			return si
+		}
+		return entries[i].LastSeen > entries[j].LastSeen
+	})
+	if len(entries) > size {
+		entries = entries[:size]
+	}
+	metas := make([]p2pcommon.PeerMeta, 0, len(entries))
+	for _, e := range entries {
+		if meta := p2pcommon.FromPeerAddress(e.Address); len(meta.Addresses) > 0 {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+func (ab *addressBook) AddPeers(metas []p2pcommon.PeerMeta) {
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	now := ab.now().UnixNano()
+	for _, meta := range metas {
+		if len(ab.entries) >= MaxAddrBookSize {
+			return
+		}
+		if meta.ID == ab.selfID || len(meta.Addresses) == 0 {
+			continue
+		}
+		if _, exist := ab.entries[meta.ID]; exist {
+			continue
+		}
+		addr := meta.ToPeerAddress()
+		ab.entries[meta.ID] = &types.AddressBookEntry{Address: &addr, LastSeen: now}
+		ab.dirty[meta.ID] = true
+	}
+}
+
+func (ab *addressBook) OnConnected(meta p2pcommon.PeerMeta, role types.PeerRole) {
+	if meta.ID == ab.selfID || len(meta.Addresses) == 0 {
+		return
+	}
+	addr := meta.ToPeerAddress()
+	addr.Role = role
+
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	entry, exist := ab.entries[meta.ID]
+	if !exist {
+		// connected peer is always added, even if the address book is full. it will be adjusted at the next pruning.
+		entry = &types.AddressBookEntry{}
+		ab.entries[meta.ID] = entry
+	}
+	entry.Address = &addr
+	entry.LastSeen = ab.now().UnixNano()
+	entry.SuccessCount++
+	ab.dirty[meta.ID] = true
+}
+
+func (ab *addressBook) OnConnectFailed(pid types.PeerID) {
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	if entry, exist := ab.entries[pid]; exist {
+		entry.FailCount++
+		ab.dirty[pid] = true
+	}
+}
+
+func (ab *addressBook) Entries() []*types.AddressBookEntry {
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	entries := make([]*types.AddressBookEntry, 0, len(ab.entries))
+	for _, e := range ab.entries {
+		entries = append(entries, proto.Clone(e).(*types.AddressBookEntry))
+	}
+	return entries
+}
+
+// prune removes the peers not seen for long time and the peers failed to connect repeatedly. If
+// the address book is still too large, the peers seen earlier are removed.
+func (ab *addressBook) prune() {
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	expire := ab.now().Add(-AddrBookEntryTTL).UnixNano()
+	for pid, e := range ab.entries {
+		if e.LastSeen < expire || (e.SuccessCount == 0 && e.FailCount >= MaxAddrBookFailures) {
+			delete(ab.entries, pid)
+			ab.dirty[pid] = true
+		}
+	}
+	if over := len(ab.entries) - MaxAddrBookSize; over > 0 {
+		pids := make([]types.PeerID, 0, len(ab.entries))
+		for pid := range ab.entries {
+			pids = append(pids, pid)
+		}
+		sort.Slice(pids, func(i, j int) bool {
+			return ab.entries[pids[i]].LastSeen < ab.entries[pids[j]].LastSeen
+		})
+		for _, pid := range pids[:over] {
+			delete(ab.entries, pid)
+			ab.dirty[pid] = true
+		}
+	}
+}
+
+// flush writes the changed entries to the db.
+func (ab *addressBook) flush() {
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+	if len(ab.dirty) == 0 {
+		return
+	}
+	tx := ab.store.NewTx()
+	for pid := range ab.dirty {
+		entry, exist := ab.entries[pid]
+		if !exist {
+			tx.Delete([]byte(pid))
+			continue
+		}
+		data, err := proto.Marshal(entry)
+		if err != nil {
+			ab.logger.Warn().Err(err).Msg("failed to marshal entry of address book")
+			continue
+		}
+		tx.Set([]byte(pid), data)
+	}
+	tx.Commit()
+	ab.dirty = make(map[types.PeerID]bool)
+}
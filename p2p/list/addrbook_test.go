@@ -0,0 +1,84 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package list
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func TestAddressBook_Persist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "addrbook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logger := log.NewLogger("p2p.list.test")
+	self := types.RandomPeerID()
+
+	ab := newAddressBook(logger, db.NewDB(db.LevelImpl, dir), self)
+	good := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.1", 7846, "v2.0.0")
+	discovered := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.2", 7846, "v2.0.0")
+	ab.AddPeers([]p2pcommon.PeerMeta{discovered, p2pcommon.NewMetaWith1Addr(self, "192.168.0.3", 7846, "v2.0.0")})
+	ab.OnConnected(good, types.PeerRole_Producer)
+	ab.OnConnectFailed(discovered.ID)
+	ab.Start()
+	ab.Stop()
+
+	ab = newAddressBook(logger, db.NewDB(db.LevelImpl, dir), self)
+	defer ab.store.Close()
+	if len(ab.entries) != 2 {
+		t.Fatalf("loaded %v entries, want 2", len(ab.entries))
+	}
+	entry := ab.entries[good.ID]
+	if entry.SuccessCount != 1 || entry.FailCount != 0 || entry.Address.Role != types.PeerRole_Producer {
+		t.Errorf("entry of connected peer = %v", entry)
+	}
+	if entry := ab.entries[discovered.ID]; entry.SuccessCount != 0 || entry.FailCount != 1 {
+		t.Errorf("entry of discovered peer = %v", entry)
+	}
+	// connected peer comes first
+	known := ab.KnownPeers(10)
+	if len(known) != 2 || known[0].ID != good.ID || known[1].ID != discovered.ID {
+		t.Errorf("KnownPeers() = %v", known)
+	}
+	if known := ab.KnownPeers(1); len(known) != 1 {
+		t.Errorf("KnownPeers(1) returns %v peers", len(known))
+	}
+}
+
+func TestAddressBook_prune(t *testing.T) {
+	logger := log.NewLogger("p2p.list.test")
+	ab := newAddressBook(logger, db.NewDB(db.MemoryImpl, ""), types.RandomPeerID())
+	now := time.Now()
+	ab.now = func() time.Time { return now }
+
+	failing := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.1", 7846, "v2.0.0")
+	old := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.2", 7846, "v2.0.0")
+	good := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.3", 7846, "v2.0.0")
+	ab.AddPeers([]p2pcommon.PeerMeta{failing})
+	ab.OnConnected(old, types.PeerRole_Watcher)
+	for i := 0; i < MaxAddrBookFailures; i++ {
+		ab.OnConnectFailed(failing.ID)
+		ab.OnConnectFailed(old.ID)
+	}
+	now = now.Add(AddrBookEntryTTL)
+	ab.OnConnected(good, types.PeerRole_Watcher)
+	now = now.Add(time.Minute)
+
+	ab.prune()
+	entries := ab.Entries()
+	if len(entries) != 1 || types.PeerID(entries[0].Address.PeerID) != good.ID {
+		t.Errorf("Entries() after prune = %v, want only %v", entries, good.ID)
+	}
+}
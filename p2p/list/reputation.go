@@ -0,0 +1,188 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package list
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+// score changes by the behaviors of remote peer, indexed by p2pcommon.PeerBehavior
+var behaviorScores = []int{
+	p2pcommon.InvalidBlock:      -50,
+	p2pcommon.InvalidTx:         -10,
+	p2pcommon.ResponseTimeout:   -5,
+	p2pcommon.ProtocolViolation: -25,
+	p2pcommon.Spam:              -10,
+	p2pcommon.UsefulResponse:    1,
+}
+
+const (
+	// MaxReputationScore limits the score earned by the useful responses, so that the
+	// peer can't save up the score to misbehave later.
+	MaxReputationScore = 100
+	// BanScore is the score at or below which the peer is banned.
+	BanScore = -100
+
+	// ScoreRecoveryInterval is the interval in which a negative score recovers by one point.
+	ScoreRecoveryInterval = time.Minute
+	// BaseBanDuration is the duration of the first ban, and it doubles for each following ban.
+	BaseBanDuration = time.Minute * 5
+	MaxBanDuration  = time.Hour * 24
+	// BanCountResetDuration is the duration after the last ban to forget the ban count.
+	BanCountResetDuration = time.Hour * 24
+
+	// maxReputationEntries is the number of entries over which the neutral ones are pruned.
+	maxReputationEntries = 1024
+)
+
+type reputation struct {
+	p2pcommon.PeerReputation
+	updated time.Time
+}
+
+// recover restores the negative score as the time passes since the last update.
+func (r *reputation) recover(now time.Time) {
+	if r.Score < 0 {
+		if recovered := int(now.Sub(r.updated) / ScoreRecoveryInterval); recovered > 0 {
+			r.Score += recovered
+			if r.Score > 0 {
+				r.Score = 0
+			}
+			r.updated = r.updated.Add(ScoreRecoveryInterval * time.Duration(recovered))
+		}
+	} else {
+		r.updated = now
+	}
+	if r.BanCount > 0 && !r.Banned(now) && now.Sub(r.BannedUntil) > BanCountResetDuration {
+		r.BanCount = 0
+	}
+}
+
+func (r *reputation) neutral(now time.Time) bool {
+	return r.Score >= 0 && r.BanCount == 0 && !r.Banned(now)
+}
+
+type reputationManager struct {
+	logger *log.Logger
+	onBan  func(pid types.PeerID)
+
+	mutex   sync.Mutex
+	entries map[types.PeerID]*reputation
+	now     func() time.Time
+}
+
+// NewReputationManager returns a new ReputationManager. onBan is called with the peer
+// which becomes banned, to disconnect it.
+func NewReputationManager(logger *log.Logger, onBan func(pid types.PeerID)) p2pcommon.ReputationManager {
+	return &reputationManager{logger: logger, onBan: onBan, entries: make(map[types.PeerID]*reputation), now: time.Now}
+}
+
+func (rm *reputationManager) Report(pid types.PeerID, behavior p2pcommon.PeerBehavior, reason string) bool {
+	if behavior < 0 || int(behavior) >= len(behaviorScores) {
+		return false
+	}
+	rm.mutex.Lock()
+	now := rm.now()
+	r := rm.get(pid, now)
+	delta := behaviorScores[behavior]
+	if delta < 0 {
+		r.LastPenalty = behavior.String() + ": " + reason
+	}
+	r.Score += delta
+	if r.Score > MaxReputationScore {
+		r.Score = MaxReputationScore
+	}
+	banned := false
+	if r.Score <= BanScore && !r.Banned(now) {
+		duration := MaxBanDuration
+		if r.BanCount < 16 {
+			if d := BaseBanDuration << uint(r.BanCount); d < MaxBanDuration {
+				duration = d
+			}
+		}
+		r.BanCount++
+		r.BannedUntil = now.Add(duration)
+		// the peer starts over with the neutral score after the ban
+		r.Score = 0
+		banned = true
+	}
+	status := r.PeerReputation
+	rm.mutex.Unlock()
+
+	if banned {
+		rm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Int("banCount", status.BanCount).Time("until", status.BannedUntil).Str("reason", status.LastPenalty).Msg("peer is banned by bad reputation")
+		if rm.onBan != nil {
+			rm.onBan(pid)
+		}
+	} else if delta < 0 {
+		rm.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Int("score", status.Score).Str("reason", status.LastPenalty).Msg("peer is penalized")
+	}
+	return banned
+}
+
+func (rm *reputationManager) IsBanned(pid types.PeerID) (bool, time.Time) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	if r, exist := rm.entries[pid]; exist && r.Banned(rm.now()) {
+		return true, r.BannedUntil
+	}
+	return false, UndefinedTime
+}
+
+func (rm *reputationManager) Reputation(pid types.PeerID) p2pcommon.PeerReputation {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	now := rm.now()
+	if r, exist := rm.entries[pid]; exist {
+		r.recover(now)
+		return r.PeerReputation
+	}
+	return p2pcommon.PeerReputation{ID: pid}
+}
+
+func (rm *reputationManager) BannedPeers() []p2pcommon.PeerReputation {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	now := rm.now()
+	var banned []p2pcommon.PeerReputation
+	for _, r := range rm.entries {
+		if r.Banned(now) {
+			banned = append(banned, r.PeerReputation)
+		}
+	}
+	return banned
+}
+
+// get returns the entry of pid, creating it if not exists. It must be called in mutex.
+func (rm *reputationManager) get(pid types.PeerID, now time.Time) *reputation {
+	r, exist := rm.entries[pid]
+	if exist {
+		r.recover(now)
+		return r
+	}
+	if len(rm.entries) >= maxReputationEntries {
+		rm.prune(now)
+	}
+	r = &reputation{PeerReputation: p2pcommon.PeerReputation{ID: pid}, updated: now}
+	rm.entries[pid] = r
+	return r
+}
+
+// prune removes the entries which are same as the new ones. It must be called in mutex.
+func (rm *reputationManager) prune(now time.Time) {
+	for pid, r := range rm.entries {
+		r.recover(now)
+		if r.neutral(now) {
+			delete(rm.entries, pid)
+		}
+	}
+}
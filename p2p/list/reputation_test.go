@@ -0,0 +1,102 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func TestReputationManager_Report(t *testing.T) {
+	logger := log.NewLogger("p2p.list.test")
+	pid := types.RandomPeerID()
+	other := types.RandomPeerID()
+
+	var banCalled []types.PeerID
+	rm := NewReputationManager(logger, func(pid types.PeerID) {
+		banCalled = append(banCalled, pid)
+	}).(*reputationManager)
+	now := time.Now()
+	rm.now = func() time.Time { return now }
+
+	// score is capped by max score
+	for i := 0; i < MaxReputationScore*2; i++ {
+		rm.Report(other, p2pcommon.UsefulResponse, "")
+	}
+	if got := rm.Reputation(other).Score; got != MaxReputationScore {
+		t.Errorf("score of good peer = %v, want %v", got, MaxReputationScore)
+	}
+
+	// invalid blocks make peer banned
+	if rm.Report(pid, p2pcommon.InvalidBlock, "first") {
+		t.Fatalf("peer is banned by a single penalty")
+	}
+	if !rm.Report(pid, p2pcommon.InvalidBlock, "second") {
+		t.Fatalf("peer is not banned")
+	}
+	banned, until := rm.IsBanned(pid)
+	if !banned || !until.Equal(now.Add(BaseBanDuration)) {
+		t.Errorf("IsBanned() = %v, %v, want true, %v", banned, until, now.Add(BaseBanDuration))
+	}
+	if len(banCalled) != 1 || banCalled[0] != pid {
+		t.Errorf("onBan is called with %v, want %v", banCalled, pid)
+	}
+	reputation := rm.Reputation(pid)
+	if reputation.Score != 0 || reputation.BanCount != 1 || reputation.LastPenalty != "InvalidBlock: second" {
+		t.Errorf("Reputation() = %+v", reputation)
+	}
+	if list := rm.BannedPeers(); len(list) != 1 || list[0].ID != pid {
+		t.Errorf("BannedPeers() = %v, want only %v", list, pid)
+	}
+
+	// ban duration doubles at the next ban
+	now = until.Add(time.Second)
+	if banned, _ := rm.IsBanned(pid); banned {
+		t.Errorf("ban is not released after %v", until)
+	}
+	rm.Report(pid, p2pcommon.InvalidBlock, "third")
+	rm.Report(pid, p2pcommon.InvalidBlock, "fourth")
+	if _, until := rm.IsBanned(pid); !until.Equal(now.Add(BaseBanDuration * 2)) {
+		t.Errorf("second ban until %v, want %v", until, now.Add(BaseBanDuration*2))
+	}
+	if list := rm.BannedPeers(); len(list) != 1 {
+		t.Errorf("BannedPeers() = %v, want 1 peer", list)
+	}
+}
+
+func TestReputationManager_Recover(t *testing.T) {
+	logger := log.NewLogger("p2p.list.test")
+	pid := types.RandomPeerID()
+
+	rm := NewReputationManager(logger, nil).(*reputationManager)
+	now := time.Now()
+	rm.now = func() time.Time { return now }
+
+	rm.Report(pid, p2pcommon.ProtocolViolation, "malformed")
+	if got := rm.Reputation(pid).Score; got != -25 {
+		t.Fatalf("score = %v, want -25", got)
+	}
+	now = now.Add(ScoreRecoveryInterval*10 + ScoreRecoveryInterval/2)
+	if got := rm.Reputation(pid).Score; got != -15 {
+		t.Errorf("score after 10 intervals = %v, want -15", got)
+	}
+	now = now.Add(ScoreRecoveryInterval * 100)
+	if got := rm.Reputation(pid).Score; got != 0 {
+		t.Errorf("recovered score = %v, want 0", got)
+	}
+
+	// spread penalties are not enough to ban peer
+	for i := 0; i < 20; i++ {
+		now = now.Add(ScoreRecoveryInterval * 10)
+		if rm.Report(pid, p2pcommon.Spam, "duplicated") {
+			t.Fatalf("peer is banned by spread penalties")
+		}
+	}
+}
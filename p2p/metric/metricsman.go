@@ -43,6 +43,7 @@ type metricsManager struct {
 
 	deadTotalIn  int64
 	deadTotalOut int64
+	deadTxRelay  TxRelayStat
 }
 
 var _ MetricsManager = (*metricsManager)(nil)
@@ -97,6 +98,7 @@ func (mm *metricsManager) Remove(pid types.PeerID, manNum uint32) *PeerMetric {
 		}
 		atomic.AddInt64(&mm.deadTotalIn, metric.totalIn)
 		atomic.AddInt64(&mm.deadTotalOut, metric.totalOut)
+		mm.deadTxRelay.add(metric.TxRelay())
 		delete(mm.metricsMap, pid)
 		return metric
 	}
@@ -125,6 +127,7 @@ func (mm *metricsManager) Summary() map[string]interface{} {
 	sum := make(map[string]interface{})
 	sum["since"] = mm.startTime
 	var totalIn, totalOut int64
+	txRelay := mm.deadTxRelay.load()
 	if len(mm.Metrics()) > 0 {
 		var cnt = 0
 		//var inAps, inLoad, outAps, outLoad int64
@@ -132,12 +135,14 @@ func (mm *metricsManager) Summary() map[string]interface{} {
 			cnt++
 			totalIn += met.totalIn
 			totalOut += met.totalOut
+			txRelay.add(met.TxRelay())
 		}
 	}
 	totalIn += atomic.LoadInt64(&mm.deadTotalIn)
 	totalOut += atomic.LoadInt64(&mm.deadTotalOut)
 	sum["in"] = totalIn
 	sum["out"] = totalOut
+	sum["txrelay"] = txRelay
 	return sum
 }
 
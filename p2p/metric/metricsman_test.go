@@ -79,3 +79,22 @@ func TestMetricsManager_Size(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricsManager_TxRelay(t *testing.T) {
+	pid1, pid2 := types.RandomPeerID(), types.RandomPeerID()
+	mm := NewMetricManager(1)
+	m1 := mm.NewMetric(pid1, 1)
+	m2 := mm.NewMetric(pid2, 2)
+
+	m1.OnTxNoticeIn(10, 3)
+	m1.OnTxNoticeOut(5)
+	m1.OnTxQuery(false)
+	m2.OnTxNoticeIn(4, 4)
+	m2.OnTxQuery(true)
+	assert.Equal(t, TxRelayStat{NoticeIn: 10, NoticeDup: 3, NoticeOut: 5, QueryIn: 1}, m1.TxRelay())
+
+	// stats of removed peer are kept in summary
+	mm.Remove(pid2, 2)
+	summary := mm.Summary()
+	assert.Equal(t, TxRelayStat{NoticeIn: 14, NoticeDup: 7, NoticeOut: 5, QueryIn: 2, QueryLimited: 1}, summary["txrelay"].(TxRelayStat))
+}
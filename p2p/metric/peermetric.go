@@ -23,6 +23,33 @@ type PeerMetric struct {
 
 	InMetric  DataMetric
 	OutMetric DataMetric
+
+	txRelay TxRelayStat
+}
+
+// TxRelayStat is the statistics of tx relay with a remote peer.
+type TxRelayStat struct {
+	// NoticeIn is the number of tx hashes announced by remote peer, and NoticeDup is the number of those already known.
+	NoticeIn  int64
+	NoticeDup int64
+	// NoticeOut is the number of tx hashes announced to remote peer.
+	NoticeOut int64
+	// QueryIn is the number of getTXs requests from remote peer, and QueryLimited is the number of those rejected by rate limit.
+	QueryIn      int64
+	QueryLimited int64
+}
+
+func (s *TxRelayStat) add(o TxRelayStat) {
+	atomic.AddInt64(&s.NoticeIn, o.NoticeIn)
+	atomic.AddInt64(&s.NoticeDup, o.NoticeDup)
+	atomic.AddInt64(&s.NoticeOut, o.NoticeOut)
+	atomic.AddInt64(&s.QueryIn, o.QueryIn)
+	atomic.AddInt64(&s.QueryLimited, o.QueryLimited)
+}
+
+func (s *TxRelayStat) load() TxRelayStat {
+	return TxRelayStat{NoticeIn: atomic.LoadInt64(&s.NoticeIn), NoticeDup: atomic.LoadInt64(&s.NoticeDup),
+		NoticeOut: atomic.LoadInt64(&s.NoticeOut), QueryIn: atomic.LoadInt64(&s.QueryIn), QueryLimited: atomic.LoadInt64(&s.QueryLimited)}
 }
 
 var _ p2pcommon.MsgIOListener = (*PeerMetric)(nil)
@@ -37,6 +64,30 @@ func (m *PeerMetric) OnWrite(protocol p2pcommon.SubProtocol, write int) {
 	m.OutMetric.AddBytes(write)
 }
 
+// OnTxNoticeIn is called when remote peer announced tx hashes, dup of which were already known to it.
+func (m *PeerMetric) OnTxNoticeIn(hashes int, dup int) {
+	atomic.AddInt64(&m.txRelay.NoticeIn, int64(hashes))
+	atomic.AddInt64(&m.txRelay.NoticeDup, int64(dup))
+}
+
+// OnTxNoticeOut is called when tx hashes are announced to remote peer.
+func (m *PeerMetric) OnTxNoticeOut(hashes int) {
+	atomic.AddInt64(&m.txRelay.NoticeOut, int64(hashes))
+}
+
+// OnTxQuery is called when remote peer requested txs.
+func (m *PeerMetric) OnTxQuery(limited bool) {
+	atomic.AddInt64(&m.txRelay.QueryIn, 1)
+	if limited {
+		atomic.AddInt64(&m.txRelay.QueryLimited, 1)
+	}
+}
+
+// TxRelay returns the snapshot of tx relay statistics.
+func (m *PeerMetric) TxRelay() TxRelayStat {
+	return m.txRelay.load()
+}
+
 func (m *PeerMetric) TotalIn() int64 {
 	return atomic.LoadInt64(&m.totalIn)
 }
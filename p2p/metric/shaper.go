@@ -0,0 +1,290 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package metric
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+// bandwidth is a pair of limiters for inbound and outbound. The limiter is nil if unlimited.
+type bandwidth struct {
+	in  *p2putil.BandwidthLimiter
+	out *p2putil.BandwidthLimiter
+}
+
+func newBandwidth(kbPerSec int) bandwidth {
+	if kbPerSec <= 0 {
+		return bandwidth{}
+	}
+	return bandwidth{in: p2putil.NewBandwidthLimiter(kbPerSec * 1024), out: p2putil.NewBandwidthLimiter(kbPerSec * 1024)}
+}
+
+func (b bandwidth) limited() bool {
+	return b.in != nil
+}
+
+func (b bandwidth) limiter(outbound bool) *p2putil.BandwidthLimiter {
+	if outbound {
+		return b.out
+	}
+	return b.in
+}
+
+// classQueueSize is the number of messages of a throttled class which wait for the bandwidth, in each direction
+// of a peer.
+const classQueueSize = 40
+
+var errShaperClosed = errors.New("read writer is closed")
+
+type trafficShaper struct {
+	global  bandwidth
+	classes map[p2pcommon.TrafficClass]bandwidth
+	// perPeer is the bandwidth of each peer in KB/s
+	perPeer int
+
+	sleep  func(d time.Duration, cancel <-chan struct{})
+	logger *log.Logger
+}
+
+var _ p2pcommon.TrafficShaper = (*trafficShaper)(nil)
+
+// NewTrafficShaper create a shaper with the bandwidth caps in configuration.
+func NewTrafficShaper(conf *config.P2PConfig) *trafficShaper {
+	s := &trafficShaper{global: newBandwidth(conf.NPMaxBandwidth), classes: make(map[p2pcommon.TrafficClass]bandwidth),
+		perPeer: conf.NPMaxPeerBandwidth, sleep: sleepOrCancel, logger: log.NewLogger("p2p")}
+	for class, kbPerSec := range map[p2pcommon.TrafficClass]int{
+		p2pcommon.TrafficBlockSync: conf.NPMaxSyncBandwidth,
+		p2pcommon.TrafficTxRelay:   conf.NPMaxTxBandwidth,
+		p2pcommon.TrafficRaft:      conf.NPMaxRaftBandwidth,
+	} {
+		if b := newBandwidth(kbPerSec); b.limited() {
+			s.classes[class] = b
+		}
+	}
+	return s
+}
+
+func (s *trafficShaper) WrapReadWriter(pid types.PeerID, rw p2pcommon.MsgReadWriter) p2pcommon.MsgReadWriter {
+	if !s.global.limited() && len(s.classes) == 0 && s.perPeer <= 0 {
+		return rw
+	}
+	lrw := &limitedReadWriter{MsgReadWriter: rw, s: s, pid: pid, peer: newBandwidth(s.perPeer),
+		readC: make(chan readResult), readQs: make(map[p2pcommon.TrafficClass]chan p2pcommon.Message),
+		writeQs: make(map[p2pcommon.TrafficClass]chan p2pcommon.Message), closeC: make(chan struct{})}
+	for class := p2pcommon.TrafficControl; class <= p2pcommon.TrafficRaft; class++ {
+		if !s.throttled(class) {
+			continue
+		}
+		lrw.readQs[class] = make(chan p2pcommon.Message, classQueueSize)
+		lrw.writeQs[class] = make(chan p2pcommon.Message, classQueueSize)
+		go lrw.runReadQueue(lrw.readQs[class])
+		go lrw.runWriteQueue(lrw.writeQs[class])
+	}
+	return lrw
+}
+
+// throttled returns whether the messages of class can be delayed. They wait in the queue of their class, so
+// that the other classes are not delayed behind them.
+func (s *trafficShaper) throttled(class p2pcommon.TrafficClass) bool {
+	_, capped := s.classes[class]
+	return capped || !class.Prioritized()
+}
+
+// delay consumes the bandwidth of message and returns the duration to wait. The prioritized traffic consumes the
+// global and per-peer bandwidth, but is delayed only by the cap of its own class.
+func (s *trafficShaper) delay(peer bandwidth, outbound bool, msg p2pcommon.Message) time.Duration {
+	size := int(msg.Length())
+	class := p2pcommon.TrafficClassOf(msg.Subprotocol())
+	var d time.Duration
+	if b, exist := s.classes[class]; exist {
+		d = b.limiter(outbound).Reserve(size)
+	}
+	for _, b := range []bandwidth{s.global, peer} {
+		if !b.limited() {
+			continue
+		}
+		if bd := b.limiter(outbound).Reserve(size); bd > d && !class.Prioritized() {
+			d = bd
+		}
+	}
+	return d
+}
+
+func sleepOrCancel(d time.Duration, cancel <-chan struct{}) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-cancel:
+	}
+}
+
+// limitedReadWriter delays the messages of throttled classes in the queue of each class. A message of the other
+// classes is read or written without waiting for the throttled ones. The queue of a throttled class stops reading
+// while it's full, so that the remote peer is throttled by the flow control of underlying connection.
+type limitedReadWriter struct {
+	p2pcommon.MsgReadWriter
+	s    *trafficShaper
+	pid  types.PeerID
+	peer bandwidth
+
+	readOnce sync.Once
+	readC    chan readResult
+	readQs   map[p2pcommon.TrafficClass]chan p2pcommon.Message
+
+	writeMutex sync.Mutex
+	writeQs    map[p2pcommon.TrafficClass]chan p2pcommon.Message
+	// writeErr is the error of the last delayed write, which is returned by the next WriteMsg.
+	writeErr error
+
+	closeC    chan struct{}
+	closeOnce sync.Once
+}
+
+type readResult struct {
+	msg p2pcommon.Message
+	err error
+}
+
+func (rw *limitedReadWriter) ReadMsg() (p2pcommon.Message, error) {
+	rw.readOnce.Do(func() {
+		go rw.runRead()
+	})
+	select {
+	case r := <-rw.readC:
+		return r.msg, r.err
+	case <-rw.closeC:
+		return nil, errShaperClosed
+	}
+}
+
+// runRead reads messages ahead and passes each to the queue of its class, or to the reader directly if its class
+// isn't throttled.
+func (rw *limitedReadWriter) runRead() {
+	for {
+		msg, err := rw.MsgReadWriter.ReadMsg()
+		if err != nil {
+			rw.passRead(readResult{err: err})
+			return
+		}
+		q, throttled := rw.readQs[p2pcommon.TrafficClassOf(msg.Subprotocol())]
+		if !throttled {
+			// it only consumes the bandwidth
+			rw.s.delay(rw.peer, false, msg)
+			if !rw.passRead(readResult{msg: msg}) {
+				return
+			}
+			continue
+		}
+		select {
+		case q <- msg:
+		case <-rw.closeC:
+			return
+		}
+	}
+}
+
+func (rw *limitedReadWriter) runReadQueue(q <-chan p2pcommon.Message) {
+	for {
+		select {
+		case msg := <-q:
+			if d := rw.s.delay(rw.peer, false, msg); d > 0 {
+				rw.s.sleep(d, rw.closeC)
+			}
+			if !rw.passRead(readResult{msg: msg}) {
+				return
+			}
+		case <-rw.closeC:
+			return
+		}
+	}
+}
+
+func (rw *limitedReadWriter) passRead(r readResult) bool {
+	select {
+	case rw.readC <- r:
+		return true
+	case <-rw.closeC:
+		return false
+	}
+}
+
+// WriteMsg writes a message of the class which isn't throttled immediately, and queues the other. The message is
+// dropped if the queue is full, since an error disconnects the peer and waiting for the queue delays the classes
+// behind it. It returns an error if the delayed write has failed.
+func (rw *limitedReadWriter) WriteMsg(msg p2pcommon.Message) error {
+	select {
+	case <-rw.closeC:
+		return errShaperClosed
+	default:
+	}
+	if err := rw.lastWriteErr(); err != nil {
+		return err
+	}
+	q, throttled := rw.writeQs[p2pcommon.TrafficClassOf(msg.Subprotocol())]
+	if !throttled {
+		// it only consumes the bandwidth
+		rw.s.delay(rw.peer, true, msg)
+		return rw.write(msg)
+	}
+	select {
+	case q <- msg:
+	default:
+		rw.s.logger.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(rw.pid)).Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogProtoID, msg.Subprotocol().String()).Msg("dropped message since too many messages are waiting for bandwidth")
+	}
+	return nil
+}
+
+func (rw *limitedReadWriter) runWriteQueue(q <-chan p2pcommon.Message) {
+	for {
+		select {
+		case msg := <-q:
+			if d := rw.s.delay(rw.peer, true, msg); d > 0 {
+				rw.s.sleep(d, rw.closeC)
+			}
+			select {
+			case <-rw.closeC:
+				return
+			default:
+			}
+			if err := rw.write(msg); err != nil {
+				rw.writeMutex.Lock()
+				rw.writeErr = err
+				rw.writeMutex.Unlock()
+				return
+			}
+		case <-rw.closeC:
+			return
+		}
+	}
+}
+
+func (rw *limitedReadWriter) write(msg p2pcommon.Message) error {
+	rw.writeMutex.Lock()
+	defer rw.writeMutex.Unlock()
+	return rw.MsgReadWriter.WriteMsg(msg)
+}
+
+func (rw *limitedReadWriter) lastWriteErr() error {
+	rw.writeMutex.Lock()
+	defer rw.writeMutex.Unlock()
+	return rw.writeErr
+}
+
+func (rw *limitedReadWriter) Close() error {
+	rw.closeOnce.Do(func() {
+		close(rw.closeC)
+	})
+	return rw.MsgReadWriter.Close()
+}
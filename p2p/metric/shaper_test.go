@@ -0,0 +1,263 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package metric
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+type dummyRW struct {
+	readC     chan p2pcommon.Message
+	written   chan p2pcommon.Message
+	closeC    chan struct{}
+	closeOnce sync.Once
+}
+
+func newDummyRW() *dummyRW {
+	return &dummyRW{readC: make(chan p2pcommon.Message, 10), written: make(chan p2pcommon.Message, 10), closeC: make(chan struct{})}
+}
+
+func (rw *dummyRW) ReadMsg() (p2pcommon.Message, error) {
+	select {
+	case msg := <-rw.readC:
+		return msg, nil
+	case <-rw.closeC:
+		return nil, io.EOF
+	}
+}
+func (rw *dummyRW) WriteMsg(msg p2pcommon.Message) error {
+	rw.written <- msg
+	return nil
+}
+func (rw *dummyRW) Close() error {
+	rw.closeOnce.Do(func() {
+		close(rw.closeC)
+	})
+	return nil
+}
+func (rw *dummyRW) AddIOListener(l p2pcommon.MsgIOListener) {}
+
+// heldSleep reports the duration to sleep, and holds the sleeper until released.
+type heldSleep struct {
+	slept   chan time.Duration
+	release chan struct{}
+}
+
+func newHeldSleep() *heldSleep {
+	return &heldSleep{slept: make(chan time.Duration, 10), release: make(chan struct{})}
+}
+
+func (h *heldSleep) sleep(d time.Duration, cancel <-chan struct{}) {
+	h.slept <- d
+	select {
+	case <-h.release:
+	case <-cancel:
+	}
+}
+
+func sizedMsg(protocol p2pcommon.SubProtocol, size int) p2pcommon.Message {
+	msg := p2pcommon.NewLiteMessageValue(protocol, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano())
+	msg.SetPayload(make([]byte, size))
+	return msg
+}
+
+func TestTrafficShaper_WrapReadWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *config.P2PConfig
+
+		wantWrapped bool
+	}{
+		{"TUnlimited", &config.P2PConfig{}, false},
+		{"TGlobal", &config.P2PConfig{NPMaxBandwidth: 100}, true},
+		{"TPeer", &config.P2PConfig{NPMaxPeerBandwidth: 100}, true},
+		{"TClass", &config.P2PConfig{NPMaxTxBandwidth: 100}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rw := newDummyRW()
+			got := NewTrafficShaper(tt.conf).WrapReadWriter(types.RandomPeerID(), rw)
+			if (got != p2pcommon.MsgReadWriter(rw)) != tt.wantWrapped {
+				t.Errorf("WrapReadWriter() wrapped = %v, want %v", got != p2pcommon.MsgReadWriter(rw), tt.wantWrapped)
+			}
+		})
+	}
+}
+
+func TestTrafficShaper_delay(t *testing.T) {
+	// 1 KB/s globally and 2 KB/s for tx relay
+	s := NewTrafficShaper(&config.P2PConfig{NPMaxBandwidth: 1, NPMaxTxBandwidth: 2})
+	peer := newBandwidth(s.perPeer)
+
+	// sync serving exhausts the global bandwidth
+	if d := s.delay(peer, true, sizedMsg(p2pcommon.GetBlocksResponse, 1024)); d != 0 {
+		t.Fatalf("delay() in burst = %v", d)
+	}
+	if d := s.delay(peer, true, sizedMsg(p2pcommon.GetBlocksResponse, 512)); d < time.Second/3 {
+		t.Fatalf("delay() over global bandwidth = %v, want about 0.5s", d)
+	}
+	// consensus messages are not delayed by the global bandwidth
+	if d := s.delay(peer, true, sizedMsg(p2pcommon.BlockProducedNotice, 2048)); d != 0 {
+		t.Errorf("delay() of prioritized message = %v, want none", d)
+	}
+	if d := s.delay(peer, true, sizedMsg(p2pcommon.RaftWrapperMessage, 2048)); d != 0 {
+		t.Errorf("delay() of prioritized message = %v, want none", d)
+	}
+	// but the bandwidth is consumed, so the other messages wait more
+	if d := s.delay(peer, true, sizedMsg(p2pcommon.NewTxNotice, 10)); d < 4*time.Second {
+		t.Errorf("delay() after prioritized messages = %v, want over 4s", d)
+	}
+
+	// inbound bandwidth is separated from outbound
+	if d := s.delay(peer, false, sizedMsg(p2pcommon.GetTXsResponse, 1024)); d != 0 {
+		t.Errorf("delay() of inbound in burst = %v", d)
+	}
+	if d := s.delay(peer, false, sizedMsg(p2pcommon.GetTXsResponse, 1024)); d == 0 {
+		t.Errorf("delay() of inbound over bandwidth = %v, want some", d)
+	}
+}
+
+func TestLimitedReadWriter_WriteMsg(t *testing.T) {
+	// 1 KB/s globally
+	s := NewTrafficShaper(&config.P2PConfig{NPMaxBandwidth: 1})
+	held := newHeldSleep()
+	s.sleep = held.sleep
+	inner := newDummyRW()
+	rw := s.WrapReadWriter(types.RandomPeerID(), inner)
+	defer rw.Close()
+
+	// the second response waits for the bandwidth
+	bulk := []p2pcommon.Message{sizedMsg(p2pcommon.GetBlocksResponse, 1024), sizedMsg(p2pcommon.GetBlocksResponse, 1024)}
+	for _, msg := range bulk {
+		if err := rw.WriteMsg(msg); err != nil {
+			t.Fatalf("WriteMsg() error = %v", err)
+		}
+	}
+	if got := <-inner.written; got != bulk[0] {
+		t.Fatalf("written %v, want the first response", got.Subprotocol())
+	}
+	<-held.slept
+
+	// consensus message is not delayed behind the throttled one
+	notice := sizedMsg(p2pcommon.BlockProducedNotice, 100)
+	if err := rw.WriteMsg(notice); err != nil {
+		t.Fatalf("WriteMsg() error = %v", err)
+	}
+	select {
+	case got := <-inner.written:
+		if got != notice {
+			t.Fatalf("written %v, want the notice", got.Subprotocol())
+		}
+	default:
+		t.Fatalf("the notice is delayed behind the throttled response")
+	}
+
+	close(held.release)
+	if got := <-inner.written; got != bulk[1] {
+		t.Errorf("written %v, want the second response", got.Subprotocol())
+	}
+}
+
+func TestLimitedReadWriter_WriteMsgQueueFull(t *testing.T) {
+	s := NewTrafficShaper(&config.P2PConfig{NPMaxTxBandwidth: 1})
+	held := newHeldSleep()
+	s.sleep = held.sleep
+	inner := newDummyRW()
+	inner.written = make(chan p2pcommon.Message, classQueueSize+3)
+	rw := s.WrapReadWriter(types.RandomPeerID(), inner)
+	defer rw.Close()
+
+	// the first is written and the second is held, so the queue keeps the others
+	for i := 0; i < classQueueSize+2; i++ {
+		if err := rw.WriteMsg(sizedMsg(p2pcommon.NewTxNotice, 1024)); err != nil {
+			t.Fatalf("WriteMsg() #%d error = %v", i, err)
+		}
+		if i == 1 {
+			<-held.slept
+		}
+	}
+	// the messages over the queue are dropped without the error, which disconnects the peer
+	for i := 0; i < classQueueSize; i++ {
+		if err := rw.WriteMsg(sizedMsg(p2pcommon.NewTxNotice, 1024)); err != nil {
+			t.Fatalf("WriteMsg() over queue error = %v, want nil", err)
+		}
+	}
+	select {
+	case <-inner.closeC:
+		t.Fatalf("the peer is disconnected by the full queue")
+	default:
+	}
+	// the other class is not affected
+	if err := rw.WriteMsg(sizedMsg(p2pcommon.GetBlocksResponse, 1024)); err != nil {
+		t.Errorf("WriteMsg() of other class error = %v", err)
+	}
+
+	// the queued messages are written later, and the dropped ones are not
+	close(held.release)
+	go func() {
+		for range held.slept {
+		}
+	}()
+	for i := 0; i < classQueueSize+3; i++ {
+		select {
+		case <-inner.written:
+		case <-time.After(time.Second):
+			t.Fatalf("written %d messages, want %d", i, classQueueSize+3)
+		}
+	}
+	select {
+	case got := <-inner.written:
+		t.Errorf("written %v over the queue", got.Subprotocol())
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestLimitedReadWriter_ReadMsg(t *testing.T) {
+	// 1 KB/s per peer
+	s := NewTrafficShaper(&config.P2PConfig{NPMaxPeerBandwidth: 1})
+	held := newHeldSleep()
+	s.sleep = held.sleep
+	inner := newDummyRW()
+	rw := s.WrapReadWriter(types.RandomPeerID(), inner)
+
+	bulk := []p2pcommon.Message{sizedMsg(p2pcommon.GetBlocksResponse, 1024), sizedMsg(p2pcommon.GetBlocksResponse, 1024)}
+	inner.readC <- bulk[0]
+	inner.readC <- bulk[1]
+	if got, err := rw.ReadMsg(); err != nil || got != bulk[0] {
+		t.Fatalf("ReadMsg() = %v, %v, want the first response", got, err)
+	}
+	<-held.slept
+
+	// consensus message is passed before the throttled one
+	notice := sizedMsg(p2pcommon.BlockProducedNotice, 100)
+	inner.readC <- notice
+	if got, err := rw.ReadMsg(); err != nil || got != notice {
+		t.Fatalf("ReadMsg() = %v, %v, want the notice", got, err)
+	}
+
+	close(held.release)
+	if got, err := rw.ReadMsg(); err != nil || got != bulk[1] {
+		t.Errorf("ReadMsg() = %v, %v, want the second response", got, err)
+	}
+
+	// the error of underlying reader is passed, and close is delegated
+	rw.Close()
+	if _, err := rw.ReadMsg(); err == nil {
+		t.Errorf("ReadMsg() after Close() error = nil, want error")
+	}
+	select {
+	case <-inner.closeC:
+	default:
+		t.Errorf("Close() is not delegated")
+	}
+}
@@ -59,6 +59,17 @@ func (mf *baseMOFactory) NewMsgBlkBroadcastOrder(noticeMsg *types.NewBlockNotice
 	return nil
 }
 
+func (mf *baseMOFactory) NewMsgCompactBlkBroadcastOrder(noticeMsg *types.CompactBlockNotice) p2pcommon.MsgOrder {
+	rmo := &pbBlkNoticeOrder{}
+	msgID := uuid.Must(uuid.NewV4())
+	if mf.fillUpMsgOrder(&rmo.pbMessageOrder, msgID, uuid.Nil, p2pcommon.CompactBlockNotice, noticeMsg) {
+		rmo.blkHash = (&types.Block{Header: noticeMsg.Header}).BlockHash()
+		rmo.blkNo = noticeMsg.Header.GetBlockNo()
+		return rmo
+	}
+	return nil
+}
+
 func (mf *baseMOFactory) NewMsgTxBroadcastOrder(message *types.NewTransactionsNotice) p2pcommon.MsgOrder {
 	rmo := &pbTxNoticeOrder{}
 	reqID := uuid.Must(uuid.NewV4())
@@ -7,6 +7,8 @@ package p2p
 
 import (
 	"fmt"
+	"github.com/aergoio/aergo/p2p/capture"
+	"github.com/aergoio/aergo/p2p/dht"
 	"github.com/aergoio/aergo/p2p/list"
 	"net"
 	"sync"
@@ -18,6 +20,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/internal/network"
 	"github.com/aergoio/aergo/p2p/metric"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
@@ -26,7 +29,6 @@ import (
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-lib/log"
-	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
@@ -42,6 +44,8 @@ type P2P struct {
 	// inited during construction
 	useRaft  bool
 	selfMeta p2pcommon.PeerMeta
+	// metaMutex guards the addresses of selfMeta, which are updated by NAT traversal
+	metaMutex sync.RWMutex
 	// caching data from genesis block
 	genesisChainID *types.ChainID
 	localSettings  p2pcommon.LocalSettings
@@ -51,16 +55,23 @@ type P2P struct {
 	vm     p2pcommon.VersionedManager
 	sm     p2pcommon.SyncManager
 	mm     metric.MetricsManager
+	ts     p2pcommon.TrafficShaper
+	cr     *capture.Recorder
 	mf     p2pcommon.MoFactory
 	signer p2pcommon.MsgSigner
 	ca     types.ChainAccessor
 	prm    p2pcommon.PeerRoleManager
 	lm     p2pcommon.ListManager
 	cm     p2pcommon.CertificateManager
+	rm     p2pcommon.ReputationManager
+	dht    p2pcommon.DHTDiscoverer
+	ab     p2pcommon.AddressBook
 	mutex sync.Mutex
 
 	// inited between construction and start
 	consacc consensus.ConsensusAccessor
+
+	stopC chan struct{}
 }
 
 var (
@@ -68,20 +79,20 @@ var (
 	_ p2pcommon.HSHandlerFactory = (*P2P)(nil)
 )
 
-// NewP2P create a new ActorService for p2p
-func NewP2P(cfg *config.Config, chainSvc *chain.ChainService) *P2P {
-	p2psvc := &P2P{cfg: cfg}
+// NewP2P create a new ActorService for p2p. ca is the chain service of full node, or the header chain of light node.
+func NewP2P(cfg *config.Config, ca types.ChainAccessor) *P2P {
+	p2psvc := &P2P{cfg: cfg, stopC: make(chan struct{})}
 	p2psvc.BaseComponent = component.NewBaseComponent(message.P2PSvc, p2psvc, log.NewLogger("p2p"))
-	p2psvc.initP2P(chainSvc)
+	p2psvc.initP2P(ca)
 	return p2psvc
 }
 
-func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
+func (p2ps *P2P) initP2P(ca types.ChainAccessor) {
 	cfg := p2ps.cfg
-	p2ps.ca = chainSvc
+	p2ps.ca = ca
 
 	// check genesis block and get meta information from it
-	genesis := chainSvc.CDB().GetGenesisInfo()
+	genesis := ca.GetGenesisInfo()
 	chainIdBytes, err := genesis.ChainID()
 	if err != nil {
 		panic("genesis block is not set properly: " + err.Error())
@@ -97,8 +108,20 @@ func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
 	p2ps.selfMeta = SetupSelfMeta(p2pkey.NodeID(), cfg.P2P, cfg.Consensus.EnableBp)
 	p2ps.initLocalSettings(cfg.P2P)
 	// set selfMeta.AcceptedRole and init role manager
-	p2ps.cm = newCertificateManager(p2ps, p2ps, p2ps.Logger)
+	p2ps.cm = newCertificateManager(p2ps, p2ps, p2ps.Logger, cfg.DataDir)
 	p2ps.prm = p2ps.initRoleManager(p2ps.useRaft, p2ps.selfMeta.Role, p2ps.cm)
+	p2ps.rm = list.NewReputationManager(p2ps.Logger, p2ps.disconnectBanned)
+	p2ps.ab = list.NewAddressBook(p2ps.Logger, cfg.DbType, cfg.DataDir, p2ps.selfMeta.ID)
+	if cfg.P2P.NPUseDHT {
+		p2ps.dht = dht.NewDHT(cfg.P2P, p2ps, p2ps.Logger)
+	}
+	if len(cfg.P2P.NPCaptureDir) > 0 {
+		header := capture.Header{SelfID: p2ps.selfMeta.ID, GenesisChainID: chainIdBytes, GenesisHash: genesis.Block().Hash}
+		p2ps.cr, err = capture.NewRecorder(p2ps.Logger, cfg.P2P.NPCaptureDir, int64(cfg.P2P.NPCaptureFileSize)<<20, cfg.P2P.NPCaptureFiles, header)
+		if err != nil {
+			panic("failed to open p2p capture directory: " + err.Error())
+		}
+	}
 
 	netTransport := transport.NewNetworkTransport(cfg.P2P, p2ps.Logger, p2ps)
 	signer := newDefaultMsgSigner(p2pkey.NodePrivKey(), p2pkey.NodePubKey(), p2pkey.NodeID())
@@ -108,8 +131,14 @@ func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
 	// public network is always disabled white/blacklist in chain
 	lm := list.NewListManager(cfg.Auth, cfg.AuthDir, p2ps.ca, p2ps.prm, p2ps.Logger, genesis.PublicNet())
 	metricMan := metric.NewMetricManager(10)
+	trafficShaper := metric.NewTrafficShaper(cfg.P2P)
 	peerMan := NewPeerManager(p2ps, p2ps, p2ps, p2ps, netTransport, metricMan, lm, p2ps.Logger, cfg, p2ps.useRaft)
-	syncMan := newSyncManager(p2ps, peerMan, p2ps.Logger)
+	var syncMan p2pcommon.SyncManager
+	if cfg.Light != nil && cfg.Light.Enable {
+		syncMan = newLightSyncManager(p2ps, p2ps.Logger)
+	} else {
+		syncMan = newSyncManager(p2ps, peerMan, p2ps.rm, p2ps.Logger)
+	}
 	versionMan := newDefaultVersionManager(p2ps, p2ps, peerMan, p2ps.ca, p2ps.Logger, p2ps.genesisChainID)
 
 	// connect managers each other
@@ -124,6 +153,7 @@ func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
 	p2ps.sm = syncMan
 	//p2ps.rm = reconMan
 	p2ps.mm = metricMan
+	p2ps.ts = trafficShaper
 	p2ps.lm = lm
 
 	p2ps.mutex.Unlock()
@@ -170,6 +200,11 @@ func (p2ps *P2P) AfterStart() {
 	nt := p2ps.nt
 	nt.Start()
 	p2ps.mutex.Unlock()
+	if p2ps.dht != nil {
+		p2ps.dht.Start()
+	}
+	p2ps.ab.Start()
+	go p2ps.watchSelfAddresses()
 
 	if err := p2ps.pm.Start(); err != nil {
 		panic("Failed to start p2p component")
@@ -193,9 +228,13 @@ func (p2ps *P2P) checkConsensus() {
 // BeforeStop is called before actor hub stops. it finishes underlying peer manager
 func (p2ps *P2P) BeforeStop() {
 	p2ps.Logger.Debug().Msg("stopping p2p actor.")
+	close(p2ps.stopC)
 	p2ps.prm.Stop()
 	p2ps.cm.Stop()
 	p2ps.mm.Stop()
+	if p2ps.dht != nil {
+		p2ps.dht.Stop()
+	}
 	if err := p2ps.pm.Stop(); err != nil {
 		p2ps.Logger.Warn().Err(err).Msg("Error on stopping peerManager")
 	}
@@ -203,7 +242,11 @@ func (p2ps *P2P) BeforeStop() {
 	nt := p2ps.nt
 	p2ps.mutex.Unlock()
 	nt.Stop()
+	p2ps.ab.Stop()
 	p2ps.lm.Stop()
+	if p2ps.cr != nil {
+		p2ps.cr.Close()
+	}
 }
 
 // Statistics show statistic information of p2p module. NOTE: It it not implemented yet
@@ -211,7 +254,7 @@ func (p2ps *P2P) Statistics() *map[string]interface{} {
 	stmap := make(map[string]interface{})
 	stmap["netstat"] = p2ps.mm.Summary()
 	stmap["config"] = p2ps.cfg.P2P
-	stmap["status"] = p2ps.selfMeta
+	stmap["status"] = p2ps.SelfMeta()
 	wlSummary := p2ps.lm.Summary()
 	stmap["whitelist"] = wlSummary["whitelist"]
 	stmap["whitelist_on"] = wlSummary["whitelist_on"]
@@ -247,6 +290,10 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		context.Respond(p2ps.mm.Metrics())
 	case *message.GetBlockHeaders:
 		p2ps.GetBlockHeaders(msg)
+	case *message.GetStateProof:
+		p2ps.GetStateProof(msg)
+	case *message.GetBlockReceipts:
+		p2ps.GetBlockReceipts(msg)
 	case *message.GetBlockChunks:
 		p2ps.GetBlocksChunk(context, msg)
 	case *message.GetBlockInfos:
@@ -265,14 +312,45 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		p2ps.GetTXs(msg.ToWhom, msg.Hashes)
 	case *message.NotifyNewTransactions:
 		p2ps.NotifyNewTX(msg)
+	case *message.NotifyFinalityVote:
+		p2ps.NotifyFinalityVote(msg)
+	case *message.NotifyVoteEquivocation:
+		p2ps.NotifyVoteEquivocation(msg)
+	case *message.NotifyDoubleSignEvidence:
+		p2ps.NotifyDoubleSignEvidence(msg)
+	case *message.NotifyBFTMessage:
+		p2ps.NotifyBFTMessage(msg)
 	case *message.AddBlockRsp:
-		// do nothing for now. just for prevent deadletter
+		if msg.Invalid && len(msg.PeerID) > 0 {
+			p2ps.rm.Report(msg.PeerID, p2pcommon.InvalidBlock, "block "+enc.ToString(msg.BlockHash)+": "+msg.Err.Error())
+		}
+	case *message.MemPoolPutRsp:
+		if len(msg.From) > 0 && isInvalidTxErr(msg.Err) {
+			p2ps.rm.Report(msg.From, p2pcommon.InvalidTx, msg.Err.Error())
+		}
 
 	case *message.GetSelf:
-		context.Respond(p2ps.selfMeta)
+		context.Respond(p2ps.SelfMeta())
 	case *message.GetPeers:
 		peers := p2ps.pm.GetPeerAddresses(msg.NoHidden, msg.ShowSelf)
+		if msg.ShowBanned {
+			peers = append(peers, p2ps.bannedPeerInfos()...)
+		}
 		context.Respond(&message.GetPeersRsp{Peers: peers})
+	case *message.GetAddressBook:
+		context.Respond(&message.GetAddressBookRsp{Entries: p2ps.ab.Entries()})
+	case *message.GetAgentCertificates:
+		context.Respond(p2ps.getAgentCertificates())
+	case *message.RenewAgentCertificates:
+		context.Respond(&message.RenewAgentCertificatesRsp{Err: p2ps.cm.RenewCertificates(msg.PeerID)})
+	case *message.RevokeAgentCertificates:
+		rsp := &message.RevokeAgentCertificatesRsp{}
+		if crl, err := p2ps.cm.RevokeCertificates(msg.AgentID, msg.Renewable); err != nil {
+			rsp.Err = err
+		} else {
+			rsp.CRL = p2putil.ConvertCRLToProto(crl)
+		}
+		context.Respond(rsp)
 	case *message.GetSyncAncestor:
 		p2ps.GetSyncAncestor(context, msg)
 	case *message.MapQueryMsg:
@@ -317,6 +395,8 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		p2ps.SendIssueCertMessage(context, msg)
 	case message.NotifyCertRenewed:
 		p2ps.NotifyCertRenewed(context, msg)
+	case message.NotifyCertRevoked:
+		p2ps.NotifyCertRevoked(context, msg)
 	case message.TossBPNotice:
 		p2ps.TossBPNotice(msg)
 	}
@@ -431,14 +511,34 @@ func (p2ps *P2P) insertHandlers(peer p2pcommon.RemotePeer) {
 	if p2ps.useRaft && p2ps.selfMeta.Role == types.PeerRole_Producer {
 		peer.AddMessageHandler(p2pcommon.BlockProducedNotice, subproto.NewBPNoticeDiscardHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
 		peer.AddMessageHandler(p2pcommon.NewBlockNotice, subproto.NewBlkNoticeDiscardHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
+		peer.AddMessageHandler(p2pcommon.CompactBlockNotice, subproto.NewCompactBlkNoticeDiscardHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
 	} else if p2ps.selfMeta.Role == types.PeerRole_Agent {
 		peer.AddMessageHandler(p2pcommon.BlockProducedNotice, subproto.WithTimeLog(subproto.NewAgentBlockProducedNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm, p2ps.cm), p2ps.Logger, zerolog.DebugLevel))
 		peer.AddMessageHandler(p2pcommon.NewBlockNotice, subproto.NewNewBlockNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
+		peer.AddMessageHandler(p2pcommon.CompactBlockNotice, subproto.NewCompactBlockNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
 	} else {
 		peer.AddMessageHandler(p2pcommon.BlockProducedNotice, subproto.WithTimeLog(subproto.NewBlockProducedNoticeHandler(p2ps, p2ps.pm, peer, logger, p2ps, p2ps.sm), p2ps.Logger, zerolog.DebugLevel))
 		peer.AddMessageHandler(p2pcommon.NewBlockNotice, subproto.NewNewBlockNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
+		peer.AddMessageHandler(p2pcommon.CompactBlockNotice, subproto.NewCompactBlockNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
 	}
 
+	// finality votes of block producers
+	if fa, ok := p2ps.consacc.(consensus.FinalityAccessor); ok {
+		peer.AddMessageHandler(p2pcommon.FinalityVoteNotice, subproto.NewFinalityVoteNoticeHandler(p2ps.pm, peer, logger, p2ps, fa, p2ps.rm))
+		peer.AddMessageHandler(p2pcommon.VoteEquivocationNotice, subproto.NewVoteEquivocationNoticeHandler(p2ps.pm, peer, logger, p2ps, fa, p2ps.rm))
+	}
+	// proposals and votes of BFT validators
+	if ba, ok := p2ps.consacc.(consensus.BFTAccessor); ok {
+		peer.AddMessageHandler(p2pcommon.BFTMessageNotice, subproto.NewBFTMessageNoticeHandler(p2ps.pm, peer, logger, p2ps, ba, p2ps.rm))
+	}
+	peer.AddMessageHandler(p2pcommon.DoubleSignEvidenceNotice, subproto.NewDoubleSignEvidenceNoticeHandler(p2ps.pm, peer, logger, p2ps))
+
+	// light client support
+	peer.AddMessageHandler(p2pcommon.GetStateProofRequest, subproto.NewGetStateProofReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateProofResponse, subproto.NewGetStateProofRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetReceiptsRequest, subproto.NewGetReceiptsReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetReceiptsResponse, subproto.NewGetReceiptsRespHandler(p2ps.pm, peer, logger, p2ps))
+
 	// Raft support
 	peer.AddMessageHandler(p2pcommon.GetClusterRequest, subproto.NewGetClusterReqHandler(p2ps.pm, peer, logger, p2ps, p2ps.consacc))
 	peer.AddMessageHandler(p2pcommon.GetClusterResponse, subproto.NewGetClusterRespHandler(p2ps.pm, peer, logger, p2ps))
@@ -448,6 +548,7 @@ func (p2ps *P2P) insertHandlers(peer p2pcommon.RemotePeer) {
 	peer.AddMessageHandler(p2pcommon.IssueCertificateRequest, subproto.NewIssueCertReqHandler(p2ps.pm, p2ps.cm, peer, logger, p2ps))
 	peer.AddMessageHandler(p2pcommon.IssueCertificateResponse, subproto.NewIssueCertRespHandler(p2ps.pm, p2ps.cm, peer, logger, p2ps))
 	peer.AddMessageHandler(p2pcommon.CertificateRenewedNotice, subproto.NewCertRenewedNoticeHandler(p2ps.pm, p2ps.cm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.CertificateRevokedNotice, subproto.NewCertRevokedNoticeHandler(p2ps.pm, p2ps.cm, peer, logger, p2ps, p2ps.rm))
 }
 
 func (p2ps *P2P) CreateHSHandler(outbound bool, pid types.PeerID) p2pcommon.HSHandler {
@@ -466,8 +567,15 @@ func (p2ps *P2P) CreateRemotePeer(remoteInfo p2pcommon.RemoteInfo, seq uint32, r
 		remoteInfo.AcceptedRole = types.PeerRole_Watcher
 	}
 
+	// messages are captured inside the traffic shaper, at the time of actual read and write
+	if p2ps.cr != nil {
+		rw = p2ps.cr.WrapReadWriter(remoteInfo.Meta.ID, rw)
+	}
+	rw = p2ps.ts.WrapReadWriter(remoteInfo.Meta.ID, rw)
 	newPeer := newRemotePeer(remoteInfo, seq, p2ps.pm, p2ps, p2ps.Logger, p2ps.mf, p2ps.signer, rw)
-	rw.AddIOListener(p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber()))
+	newPeer.rm = p2ps.rm
+	newPeer.metric = p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber())
+	rw.AddIOListener(newPeer.metric)
 
 	// insert Handlers
 	p2ps.insertHandlers(newPeer)
@@ -481,9 +589,53 @@ type notifyNewTXs struct {
 }
 
 func (p2ps *P2P) SelfMeta() p2pcommon.PeerMeta {
+	p2ps.metaMutex.RLock()
+	defer p2ps.metaMutex.RUnlock()
 	return p2ps.selfMeta
 }
 
+// watchSelfAddresses updates the addresses of local node periodically, so that the handshakes and
+// the queries to polaris or DHT carry the addresses which remote peers can dial.
+func (p2ps *P2P) watchSelfAddresses() {
+	ticker := time.NewTicker(selfAddrCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p2ps.updateSelfAddresses(p2ps.nt.ReachableAddrs())
+		case <-p2ps.stopC:
+			return
+		}
+	}
+}
+
+func (p2ps *P2P) updateSelfAddresses(addrs []types.Multiaddr) {
+	if len(addrs) == 0 {
+		return
+	}
+	p2ps.metaMutex.Lock()
+	defer p2ps.metaMutex.Unlock()
+	prev := p2ps.selfMeta.Addresses
+	if len(prev) == len(addrs) {
+		same := true
+		for i, a := range prev {
+			if !a.Equal(addrs[i]) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+	p2ps.selfMeta.Addresses = addrs
+	strs := make([]fmt.Stringer, len(addrs))
+	for i, a := range addrs {
+		strs[i] = a
+	}
+	p2ps.Logger.Info().Array("addrs", p2putil.NewLogStringersMarshaller(strs, 10)).Msg("advertised addresses of local node are changed")
+}
+
 func (p2ps *P2P) SelfNodeID() types.PeerID {
 	return p2ps.selfMeta.ID
 }
@@ -512,6 +664,60 @@ func (p2ps *P2P) RoleManager() p2pcommon.PeerRoleManager {
 	return p2ps.prm
 }
 
+// getAgentCertificates returns the certificates issued by local peer if it is block producer, or its own certificates
+// if it is agent.
+func (p2ps *P2P) getAgentCertificates() *message.GetAgentCertificatesRsp {
+	certs := p2ps.cm.GetCertificates()
+	if p2ps.selfMeta.Role == types.PeerRole_Producer {
+		certs = p2ps.cm.GetIssuedCertificates()
+	}
+	rsp := &message.GetAgentCertificatesRsp{}
+	rsp.Certificates, _ = p2putil.ConvertCertsToProto(certs)
+	for _, crl := range p2ps.cm.GetRevocationLists() {
+		rsp.RevocationLists = append(rsp.RevocationLists, p2putil.ConvertCRLToProto(crl))
+	}
+	return rsp
+}
+
+func (p2ps *P2P) ReputationManager() p2pcommon.ReputationManager {
+	return p2ps.rm
+}
+
+// DHTDiscoverer returns nil if DHT discovery is not enabled.
+func (p2ps *P2P) DHTDiscoverer() p2pcommon.DHTDiscoverer {
+	return p2ps.dht
+}
+
+func (p2ps *P2P) AddressBook() p2pcommon.AddressBook {
+	return p2ps.ab
+}
+
+// bannedPeerInfos returns the peers banned by bad reputation. The banned peers are not
+// connected, so only ID of peer is known.
+func (p2ps *P2P) bannedPeerInfos() []*message.PeerInfo {
+	banned := p2ps.rm.BannedPeers()
+	infos := make([]*message.PeerInfo, 0, len(banned))
+	for _, r := range banned {
+		if _, connected := p2ps.pm.GetPeer(r.ID); connected {
+			continue
+		}
+		infos = append(infos, &message.PeerInfo{Addr: &types.PeerAddress{PeerID: []byte(r.ID)}, State: types.DOWN,
+			Score: r.Score, LastPenalty: r.LastPenalty, BannedUntil: r.BannedUntil})
+	}
+	return infos
+}
+
+// disconnectBanned closes the connection to the peer banned by its reputation. It is
+// called by the reputation manager which can be run in the goroutine of peer manager,
+// so closing is done asynchronously.
+func (p2ps *P2P) disconnectBanned(pid types.PeerID) {
+	go func() {
+		if peer, found := p2ps.pm.GetPeer(pid); found {
+			peer.Stop()
+		}
+	}()
+}
+
 func (p2ps *P2P) initLocalSettings(conf *config.P2PConfig) {
 	meta := p2ps.selfMeta
 	switch meta.Role {
@@ -548,3 +754,23 @@ func (p2ps *P2P) initLocalSettings(conf *config.P2PConfig) {
 	}
 
 }
+
+// reportPeer reports the behavior of remote peer to rm, if rm exists.
+func reportPeer(rm p2pcommon.ReputationManager, pid types.PeerID, behavior p2pcommon.PeerBehavior, reason string) {
+	if rm != nil {
+		rm.Report(pid, behavior, reason)
+	}
+}
+
+// isInvalidTxErr reports whether the tx is rejected by mempool for its own defect, and not
+// by the state of local node such as duplication or nonce.
+func isInvalidTxErr(err error) bool {
+	switch err {
+	case types.ErrTxHasInvalidHash, types.ErrTxFormatInvalid, types.ErrTxInvalidType,
+		types.ErrTxInvalidAccount, types.ErrTxInvalidRecipient, types.ErrTxInvalidPayload,
+		types.ErrTxInvalidSize, types.ErrSignNotMatch, types.ErrCouldNotRecoverPubKey:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,33 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+import (
+	"github.com/aergoio/aergo/types"
+)
+
+// AddressBook keeps the peers known to local node on disk, so that the node can find peers
+// to connect after restart without depending on polaris or designated peers.
+type AddressBook interface {
+	Start()
+	Stop()
+
+	// KnownPeers returns at most size peers to connect at startup. The peers connected
+	// successfully and seen recently come first.
+	KnownPeers(size int) []PeerMeta
+	// AddPeers adds the discovered peers which are not in the address book yet.
+	AddPeers(metas []PeerMeta)
+	// OnConnected updates the address, role and last seen time of the peer, after it is
+	// connected and handshaked.
+	OnConnected(meta PeerMeta, role types.PeerRole)
+	// OnConnectFailed counts a failure to connect to the peer.
+	OnConnectFailed(pid types.PeerID)
+
+	// Entries returns all peers in the address book.
+	Entries() []*types.AddressBookEntry
+}
+
+//go:generate mockgen -source=addrbook.go -package=p2pmock -destination=../p2pmock/mock_addrbook.go
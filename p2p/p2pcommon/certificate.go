@@ -15,6 +15,9 @@ var (
 	ErrVerificationFailed = errors.New("signature verification failed")
 	ErrMalformedCert    = errors.New("malformed certificate data")
 	ErrInvalidCertField = errors.New("invalid field in certificate ")
+	ErrCertRevoked      = errors.New("certificate is revoked")
+	ErrOldRevocationList = errors.New("revocation list is older than known one")
+	ErrUnknownIssuer     = errors.New("issuer of revocation list is not a known block producer")
 )
 
 const (
@@ -51,6 +54,45 @@ func (c *AgentCertificateV1) IsNeedUpdate(t time.Time, bufTerm time.Duration) bo
 	return c.ExpireTime.Sub(t) < bufTerm
 }
 
+// CertRevocation revokes all certificates issued to the agent, which are created at or before RevokeTime.
+type CertRevocation struct {
+	AgentID    types.PeerID
+	RevokeTime time.Time
+	// Renewable means that the agent can get new certificate from the block producer right after the revocation.
+	// Otherwise, block producer refuses to issue certificate to the agent until it is renewed explicitly.
+	Renewable bool
+}
+
+// CertRevocationListV1 is the list of revocations signed by a block producer. The newer list of a block producer
+// replaces the older one, so a list contains all revocations which are still effective.
+type CertRevocationListV1 struct {
+	Version     uint32
+	BPID        types.PeerID
+	BPPubKey    *btcec.PublicKey
+	CreateTime  time.Time
+	Revocations []CertRevocation
+	Signature   *btcec.Signature
+}
+
+// IsRevoked check if the certificate is revoked by this list.
+func (l *CertRevocationListV1) IsRevoked(c *AgentCertificateV1) bool {
+	if !types.IsSamePeerID(l.BPID, c.BPID) {
+		return false
+	}
+	r := l.FindRevocation(c.AgentID)
+	return r != nil && !c.CreateTime.After(r.RevokeTime)
+}
+
+// FindRevocation returns the revocation of agent, or nil if the agent is not in this list.
+func (l *CertRevocationListV1) FindRevocation(agentID types.PeerID) *CertRevocation {
+	for i, r := range l.Revocations {
+		if types.IsSamePeerID(r.AgentID, agentID) {
+			return &l.Revocations[i]
+		}
+	}
+	return nil
+}
+
 // CertificateManager manages local peer's certificates and related information
 type CertificateManager interface {
 	PeerEventListener
@@ -61,6 +103,12 @@ type CertificateManager interface {
 	// methods for bp
 	// CreateCertificate create certificate for the agent. It will return ErrInvalidRole error if local peer is not block producer
 	CreateCertificate(remoteMeta PeerMeta) (*AgentCertificateV1, error)
+	// GetIssuedCertificates returns the latest certificates issued by local peer.
+	GetIssuedCertificates() []*AgentCertificateV1
+	// RevokeCertificates revokes all certificates issued to the agent until now and returns the new revocation list
+	// of local peer. The agent can get new certificate right after if renewable is true. It will return ErrInvalidRole
+	// error if local peer is not block producer
+	RevokeCertificates(agentID types.PeerID, renewable bool) (*CertRevocationListV1, error)
 
 	// methods for agents
 	// GetProducers return list of peer id of which this agent is charge.
@@ -69,7 +117,19 @@ type CertificateManager interface {
 	GetCertificates() []*AgentCertificateV1
 	// AddCertificate add to my certificate list
 	AddCertificate(cert *AgentCertificateV1)
+	// RenewCertificates requests new certificate to the producer without waiting for expiration. All managed
+	// producers are requested if bpID is empty.
+	RenewCertificates(bpID types.PeerID) error
 
 	CanHandle(bpID types.PeerID) bool
+
+	// methods for all roles
+	// AddRevocationList keeps the revocation list if it is newer than the known list of the same block producer.
+	// It returns ErrOldRevocationList error if the list is not newer.
+	AddRevocationList(crl *CertRevocationListV1) error
+	// GetRevocationLists returns the latest revocation lists of all block producers that local peer knows.
+	GetRevocationLists() []*CertRevocationListV1
+	// IsRevoked check if the certificate is revoked by the known revocation list of its issuer
+	IsRevoked(cert *AgentCertificateV1) bool
 }
 //go:generate sh -c "mockgen github.com/aergoio/aergo/p2p/p2pcommon CertificateManager | sed -e 's/^package mock_p2pcommon/package p2pmock/g' > ../p2pmock/mock_certificate.go"
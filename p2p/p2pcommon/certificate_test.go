@@ -3,6 +3,8 @@ package p2pcommon
 import (
 	"testing"
 	"time"
+
+	"github.com/aergoio/aergo/types"
 )
 
 func TestAgentCertificateV1_IsValidInTime(t *testing.T) {
@@ -71,3 +73,29 @@ func TestAgentCertificateV1_IsNeedUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestCertRevocationListV1_IsRevoked(t *testing.T) {
+	bpID, otherBP := types.RandomPeerID(), types.RandomPeerID()
+	agentID, otherAgent := types.RandomPeerID(), types.RandomPeerID()
+	rt := time.Now()
+	l := &CertRevocationListV1{BPID: bpID, CreateTime: rt, Revocations: []CertRevocation{{AgentID: agentID, RevokeTime: rt}}}
+
+	tests := []struct {
+		name string
+		cert *AgentCertificateV1
+		want bool
+	}{
+		{"TOld", &AgentCertificateV1{BPID: bpID, AgentID: agentID, CreateTime: rt.Add(-time.Hour)}, true},
+		{"TSameTime", &AgentCertificateV1{BPID: bpID, AgentID: agentID, CreateTime: rt}, true},
+		{"TNewer", &AgentCertificateV1{BPID: bpID, AgentID: agentID, CreateTime: rt.Add(time.Second)}, false},
+		{"TOtherAgent", &AgentCertificateV1{BPID: bpID, AgentID: otherAgent, CreateTime: rt.Add(-time.Hour)}, false},
+		{"TOtherIssuer", &AgentCertificateV1{BPID: otherBP, AgentID: agentID, CreateTime: rt.Add(-time.Hour)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.IsRevoked(tt.cert); got != tt.want {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ type HandshakeResult struct {
 	BestBlockNo   types.BlockNo
 	Hidden        bool
 	Certificates []*AgentCertificateV1
+	// Version is the p2p version agreed in wire handshake
+	Version P2PVersion
 }
 
 // HSHandlerFactory is creator of HSHandler
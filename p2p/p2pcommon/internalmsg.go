@@ -32,6 +32,8 @@ type MoFactory interface {
 	NewMsgRequestOrderWithReceiver(respReceiver ResponseReceiver, protocolID SubProtocol, message MessageBody) MsgOrder
 	NewMsgResponseOrder(reqID MsgID, protocolID SubProtocol, message MessageBody) MsgOrder
 	NewMsgBlkBroadcastOrder(noticeMsg *types.NewBlockNotice) MsgOrder
+	// NewMsgCompactBlkBroadcastOrder creates the order of block notice, which is sent in the same condition of NewBlockNotice
+	NewMsgCompactBlkBroadcastOrder(noticeMsg *types.CompactBlockNotice) MsgOrder
 	NewMsgTxBroadcastOrder(noticeMsg *types.NewTransactionsNotice) MsgOrder
 	NewMsgBPBroadcastOrder(noticeMsg *types.BlockProducedNotice) MsgOrder
 	NewRaftMsgOrder(msgType raftpb.MessageType, raftMsg *raftpb.Message) MsgOrder
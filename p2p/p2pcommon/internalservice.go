@@ -28,6 +28,13 @@ type InternalService interface {
 	CertificateManager() CertificateManager
 
 	RoleManager() PeerRoleManager
+
+	ReputationManager() ReputationManager
+
+	// DHTDiscoverer returns nil if DHT discovery is disabled
+	DHTDiscoverer() DHTDiscoverer
+
+	AddressBook() AddressBook
 }
 
 //go:generate mockgen -source=internalservice.go  -package=p2pmock -destination=../p2pmock/mock_internalservice.go
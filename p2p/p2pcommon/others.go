@@ -23,6 +23,8 @@ type SyncManager interface {
 	HandleBlockProducedNotice(peer RemotePeer, block *types.Block)
 	// handle notice from other node
 	HandleNewBlockNotice(peer RemotePeer, data *types.NewBlockNotice)
+	// HandleCompactBlockNotice rebuilds the new block from the local txs and fetches the missing ones from remote peer.
+	HandleCompactBlockNotice(peer RemotePeer, data *types.CompactBlockNotice)
 	HandleGetBlockResponse(peer RemotePeer, msg Message, resp *types.GetBlockResponse)
 
 	// RegisterTxNotice caching ids of tx that was added to local node.
@@ -8,7 +8,6 @@ package p2pcommon
 import (
 	"github.com/aergoio/aergo/types"
 	"github.com/multiformats/go-multiaddr"
-	"strconv"
 )
 
 // PeerMeta contains non changeable information of peer node during connected state
@@ -78,9 +77,11 @@ func (m PeerMeta) ToPeerAddress() types.PeerAddress {
 // PrimaryAddress is first advertised port of peer
 func (m PeerMeta) PrimaryPort() uint32 {
 	if len(m.Addresses) > 0 {
-		portVal, _ := m.Addresses[0].ValueForProtocol(multiaddr.P_TCP)
-		port, _ := strconv.Atoi(portVal)
-		return uint32(port)
+		port := types.GetPortFromMultiaddr(m.Addresses[0])
+		if port == types.PortUndefined {
+			return 0
+		}
+		return port
 	} else {
 		return 0
 	}
@@ -16,6 +16,7 @@ const (
 	WaitingPeerManagerInterval = time.Minute >> 2
 
 	PolarisQueryInterval = time.Minute * 10
+	DHTQueryInterval     = time.Minute * 5
 	PeerQueryInterval    = time.Hour
 	PeerFirstInterval    = time.Second * 4
 
@@ -42,6 +43,17 @@ type PeerFinder interface {
 	CheckAndFill()
 }
 
+// DHTDiscoverer finds peers of same chain by kademlia DHT, without depending on polaris.
+type DHTDiscoverer interface {
+	Start()
+	Stop()
+
+	// AddPeer adds the peer, which is known to be in same chain, to routing table.
+	AddPeer(meta PeerMeta)
+	// FindPeers looks up the network and returns the peers found. It blocks until lookup is finished.
+	FindPeers() []PeerMeta
+}
+
 // WaitingPeerManager manage waiting peer pool and role to connect and handshaking of remote peer.
 type WaitingPeerManager interface {
 	PeerEventListener
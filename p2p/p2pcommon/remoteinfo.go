@@ -39,4 +39,11 @@ type RemoteInfo struct {
 	AcceptedRole types.PeerRole
 	Certificates []*AgentCertificateV1
 	Zone         PeerZone
+	// P2PVersion is the p2p version used in the connection to the remote peer
+	P2PVersion P2PVersion
+}
+
+// SupportCompactBlock reports whether the remote peer can receive CompactBlockNotice.
+func (ri RemoteInfo) SupportCompactBlock() bool {
+	return ri.P2PVersion >= P2PVersion210
 }
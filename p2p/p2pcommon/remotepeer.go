@@ -44,6 +44,8 @@ type RemotePeer interface {
 	SendAndWaitMessage(msg MsgOrder, ttl time.Duration) error
 
 	PushTxsNotice(txHashes []types.TxID)
+	// AllowTxQuery returns false if remote peer sent getTXs requests too frequently.
+	AllowTxQuery() bool
 	// utility method
 
 	ConsumeRequest(msgID MsgID) MsgOrder
@@ -60,6 +62,8 @@ type RemotePeer interface {
 
 	// AddCertificate add to my certificate list
 	AddCertificate(cert *AgentCertificateV1)
+	// RemoveRevokedCertificates removes the certificates of remote peer, which are revoked by crl
+	RemoveRevokedCertificates(crl *CertRevocationListV1)
 
 	// DoTask execute task in remote peer's own goroutine, it should not consume lots of time to process.
 	DoTask(task PeerTask) bool
@@ -0,0 +1,72 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// PeerBehavior is a behavior of remote peer which changes its reputation score.
+type PeerBehavior int
+
+const (
+	// InvalidBlock is sending a block which fails the verification of chain
+	InvalidBlock PeerBehavior = iota
+	// InvalidTx is sending a transaction which is malformed or wrongly signed
+	InvalidTx
+	// ResponseTimeout is not responding to the request in time
+	ResponseTimeout
+	// ProtocolViolation is sending a malformed or unexpected message
+	ProtocolViolation
+	// Spam is sending useless messages repeatedly
+	Spam
+	// UsefulResponse is responding to the request properly
+	UsefulResponse
+)
+
+var peerBehaviorNames = []string{"InvalidBlock", "InvalidTx", "ResponseTimeout", "ProtocolViolation", "Spam", "UsefulResponse"}
+
+func (b PeerBehavior) String() string {
+	if b < 0 || int(b) >= len(peerBehaviorNames) {
+		return "UnknownBehavior"
+	}
+	return peerBehaviorNames[b]
+}
+
+// PeerReputation is the reputation score and ban status of a remote peer.
+type PeerReputation struct {
+	ID    types.PeerID
+	Score int
+	// LastPenalty is the reason of the last penalty, which also explains the ban.
+	LastPenalty string
+	BanCount    int
+	BannedUntil time.Time
+}
+
+// Banned reports whether the peer is banned at t.
+func (r PeerReputation) Banned(t time.Time) bool {
+	return t.Before(r.BannedUntil)
+}
+
+// ReputationManager scores the behaviors of remote peers, and bans a peer for a while
+// if its score falls below the threshold. The ban duration grows exponentially as the
+// peer is banned repeatedly.
+type ReputationManager interface {
+	// Report applies the behavior of the peer to its score. It returns true if the peer
+	// becomes banned by the behavior.
+	Report(pid types.PeerID, behavior PeerBehavior, reason string) bool
+
+	// IsBanned returns whether the peer is banned, and when the ban is released.
+	IsBanned(pid types.PeerID) (bool, time.Time)
+	// Reputation returns the current reputation of the peer.
+	Reputation(pid types.PeerID) PeerReputation
+	// BannedPeers returns the reputations of the peers banned currently.
+	BannedPeers() []PeerReputation
+}
+
+//go:generate mockgen -source=reputation.go -package=p2pmock -destination=../p2pmock/mock_reputation.go
@@ -17,6 +17,8 @@ const (
 	IssueCertificateRequest
 	IssueCertificateResponse
 	CertificateRenewedNotice
+	// CertificateRevokedNotice gossips the revocation list signed by block producer
+	CertificateRevokedNotice
 )
 
 const (
@@ -33,6 +35,8 @@ const (
 	GetHashesResponse
 	GetHashByNoRequest
 	GetHashByNoResponse
+	// CompactBlockNotice announces new block with the hashes of txs instead of the full body, since p2p version 2.1.0
+	CompactBlockNotice
 )
 const (
 	GetTXsRequest SubProtocol = 0x020 + iota
@@ -44,6 +48,22 @@ const (
 const (
 	// BlockProducedNotice from block producer to trusted nodes and other bp nodes
 	BlockProducedNotice SubProtocol = 0x030 + iota
+	// FinalityVoteNotice gossips the signed finality vote of block producer
+	FinalityVoteNotice
+	// DoubleSignEvidenceNotice gossips the evidence of block producer which signed two blocks for the same slot
+	DoubleSignEvidenceNotice
+	// BFTMessageNotice gossips the proposals and votes of BFT validators
+	BFTMessageNotice
+	// VoteEquivocationNotice gossips the evidence of block producer which signed conflicting finality votes
+	VoteEquivocationNotice
+)
+
+// subprotocols for light clients, which query proofs of the state and receipts of a block to full nodes
+const (
+	GetStateProofRequest SubProtocol = 0x040 + iota
+	GetStateProofResponse
+	GetReceiptsRequest
+	GetReceiptsResponse
 )
 
 const (
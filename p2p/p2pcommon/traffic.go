@@ -0,0 +1,75 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+import (
+	"github.com/aergoio/aergo/types"
+)
+
+// TrafficClass is the group of subprotocols which shares a bandwidth cap.
+type TrafficClass int
+
+const (
+	// TrafficControl is handshake, ping, addresses and certificates
+	TrafficControl TrafficClass = iota
+	// TrafficConsensus is the notices of new blocks and the messages among block producers
+	TrafficConsensus
+	// TrafficBlockSync is the requests and responses of blocks, hashes and proofs
+	TrafficBlockSync
+	// TrafficTxRelay is the notices and requests of txs
+	TrafficTxRelay
+	// TrafficRaft is the messages of raft cluster
+	TrafficRaft
+)
+
+var trafficClassNames = []string{"control", "consensus", "blocksync", "txrelay", "raft"}
+
+func (c TrafficClass) String() string {
+	if c < 0 || int(c) >= len(trafficClassNames) {
+		return "unknown"
+	}
+	return trafficClassNames[c]
+}
+
+// Prioritized returns whether the class is exempt from the global and per-peer bandwidth caps, so that block
+// production is not starved by serving sync or relaying txs.
+func (c TrafficClass) Prioritized() bool {
+	switch c {
+	case TrafficBlockSync, TrafficTxRelay:
+		return false
+	default:
+		return true
+	}
+}
+
+// TrafficClassOf returns the traffic class of subprotocol.
+func TrafficClassOf(protocol SubProtocol) TrafficClass {
+	switch {
+	case protocol == NewBlockNotice || protocol == CompactBlockNotice:
+		return TrafficConsensus
+	case protocol >= GetBlocksRequest && protocol < GetTXsRequest:
+		return TrafficBlockSync
+	case protocol >= GetTXsRequest && protocol < BlockProducedNotice:
+		return TrafficTxRelay
+	case protocol >= BlockProducedNotice && protocol < GetStateProofRequest:
+		return TrafficConsensus
+	case protocol >= GetStateProofRequest && protocol <= GetReceiptsResponse:
+		return TrafficBlockSync
+	case protocol >= GetClusterRequest && protocol <= RaftWrapperMessage:
+		return TrafficRaft
+	default:
+		return TrafficControl
+	}
+}
+
+// TrafficShaper limits the bandwidth of p2p messages globally, per peer and per traffic class.
+type TrafficShaper interface {
+	// WrapReadWriter returns MsgReadWriter which waits before writing or after reading a message while the
+	// bandwidth is exceeded. It returns rw itself if no bandwidth is limited.
+	WrapReadWriter(pid types.PeerID, rw MsgReadWriter) MsgReadWriter
+}
+
+//go:generate mockgen -source=traffic.go -package=p2pmock -destination=../p2pmock/mock_traffic.go
@@ -0,0 +1,45 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+import "testing"
+
+func TestTrafficClassOf(t *testing.T) {
+	tests := []struct {
+		protocol SubProtocol
+
+		want            TrafficClass
+		wantPrioritized bool
+	}{
+		{StatusRequest, TrafficControl, true},
+		{PingRequest, TrafficControl, true},
+		{CertificateRenewedNotice, TrafficControl, true},
+		{GetBlocksRequest, TrafficBlockSync, false},
+		{GetBlocksResponse, TrafficBlockSync, false},
+		{GetHashByNoResponse, TrafficBlockSync, false},
+		{NewBlockNotice, TrafficConsensus, true},
+		{CompactBlockNotice, TrafficConsensus, true},
+		{GetTXsRequest, TrafficTxRelay, false},
+		{NewTxNotice, TrafficTxRelay, false},
+		{BlockProducedNotice, TrafficConsensus, true},
+		{BFTMessageNotice, TrafficConsensus, true},
+		{GetStateProofRequest, TrafficBlockSync, false},
+		{GetReceiptsResponse, TrafficBlockSync, false},
+		{GetClusterRequest, TrafficRaft, true},
+		{RaftWrapperMessage, TrafficRaft, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.protocol.String(), func(t *testing.T) {
+			got := TrafficClassOf(tt.protocol)
+			if got != tt.want {
+				t.Errorf("TrafficClassOf() = %v, want %v", got, tt.want)
+			}
+			if got.Prioritized() != tt.wantPrioritized {
+				t.Errorf("Prioritized() = %v, want %v", got.Prioritized(), tt.wantPrioritized)
+			}
+		})
+	}
+}
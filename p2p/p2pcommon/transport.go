@@ -6,12 +6,44 @@
 package p2pcommon
 
 import (
+	"fmt"
 	"github.com/aergoio/aergo/types"
 	"github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
 	"time"
 )
 
+// names of transports in configuration
+const (
+	TransportTCP  = "tcp"
+	TransportQUIC = "quic"
+)
+
+// TransportAddrs returns the multiaddrs of address and port for each transport, in the order of transports.
+// tcp is used if no transport is given. The QUIC address uses the udp port of the same number.
+func TransportAddrs(transports []string, address string, port uint32) ([]types.Multiaddr, error) {
+	if len(transports) == 0 {
+		transports = []string{TransportTCP}
+	}
+	addrs := make([]types.Multiaddr, 0, len(transports))
+	for _, t := range transports {
+		var ma types.Multiaddr
+		var err error
+		switch t {
+		case TransportTCP:
+			ma, err = types.ToMultiAddr(address, port)
+		case TransportQUIC:
+			ma, err = types.ToQUICMultiAddr(address, port)
+		default:
+			return nil, fmt.Errorf("unknown transport %s", t)
+		}
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, ma)
+	}
+	return addrs, nil
+}
 
 //go:generate sh -c "mockgen github.com/aergoio/aergo/p2p/p2pcommon NTContainer,NetworkTransport | sed -e 's/^package mock_p2pcommon/package p2pmock/g' > ../p2pmock/mock_networktransport.go"
 // NTContainer can provide NetworkTransport interface.
@@ -32,6 +64,9 @@ type NetworkTransport interface {
 	SelfMeta() PeerMeta
 
 	GetAddressesOfPeer(peerID types.PeerID) []string
+	// ReachableAddrs returns the addresses of local node to be advertised, which can change by NAT
+	// port mapping or the observation of remote peers.
+	ReachableAddrs() []types.Multiaddr
 
 	// AddStreamHandler wrapper function which call host.SetStreamHandler after transport is initialized, this method is for preventing nil error.
 	AddStreamHandler(pid core.ProtocolID, handler network.StreamHandler)
@@ -0,0 +1,51 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+)
+
+func TestTransportAddrs(t *testing.T) {
+	tests := []struct {
+		name       string
+		transports []string
+
+		want     []string
+		wantPort uint32
+		wantErr  bool
+	}{
+		{"TEmpty", nil, []string{"/ip4/192.168.1.2/tcp/7846"}, 7846, false},
+		{"TTCP", []string{"tcp"}, []string{"/ip4/192.168.1.2/tcp/7846"}, 7846, false},
+		{"TQUICOnly", []string{"quic"}, []string{"/ip4/192.168.1.2/udp/7846/aergo-quic"}, 7846, false},
+		{"TBoth", []string{"quic", "tcp"}, []string{"/ip4/192.168.1.2/udp/7846/aergo-quic", "/ip4/192.168.1.2/tcp/7846"}, 7846, false},
+		{"TUnknown", []string{"tcp", "ws"}, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TransportAddrs(tt.transports, "192.168.1.2", 7846)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TransportAddrs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("TransportAddrs() = %v, want %v", got, tt.want)
+			}
+			for i, a := range got {
+				if a.String() != tt.want[i] {
+					t.Errorf("TransportAddrs()[%d] = %v, want %v", i, a, tt.want[i])
+				}
+			}
+			if !tt.wantErr {
+				meta := PeerMeta{ID: types.RandomPeerID(), Addresses: got}
+				if meta.PrimaryPort() != tt.wantPort {
+					t.Errorf("PrimaryPort() = %v, want %v", meta.PrimaryPort(), tt.wantPort)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: addrbook.go
+
+// Package p2pmock is a generated GoMock package.
+package p2pmock
+
+import (
+	p2pcommon "github.com/aergoio/aergo/p2p/p2pcommon"
+	types "github.com/aergoio/aergo/types"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAddressBook is a mock of AddressBook interface
+type MockAddressBook struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressBookMockRecorder
+}
+
+// MockAddressBookMockRecorder is the mock recorder for MockAddressBook
+type MockAddressBookMockRecorder struct {
+	mock *MockAddressBook
+}
+
+// NewMockAddressBook creates a new mock instance
+func NewMockAddressBook(ctrl *gomock.Controller) *MockAddressBook {
+	mock := &MockAddressBook{ctrl: ctrl}
+	mock.recorder = &MockAddressBookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAddressBook) EXPECT() *MockAddressBookMockRecorder {
+	return m.recorder
+}
+
+// Start mocks base method
+func (m *MockAddressBook) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start
+func (mr *MockAddressBookMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAddressBook)(nil).Start))
+}
+
+// Stop mocks base method
+func (m *MockAddressBook) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockAddressBookMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockAddressBook)(nil).Stop))
+}
+
+// KnownPeers mocks base method
+func (m *MockAddressBook) KnownPeers(size int) []p2pcommon.PeerMeta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KnownPeers", size)
+	ret0, _ := ret[0].([]p2pcommon.PeerMeta)
+	return ret0
+}
+
+// KnownPeers indicates an expected call of KnownPeers
+func (mr *MockAddressBookMockRecorder) KnownPeers(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnownPeers", reflect.TypeOf((*MockAddressBook)(nil).KnownPeers), size)
+}
+
+// AddPeers mocks base method
+func (m *MockAddressBook) AddPeers(metas []p2pcommon.PeerMeta) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddPeers", metas)
+}
+
+// AddPeers indicates an expected call of AddPeers
+func (mr *MockAddressBookMockRecorder) AddPeers(metas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeers", reflect.TypeOf((*MockAddressBook)(nil).AddPeers), metas)
+}
+
+// OnConnected mocks base method
+func (m *MockAddressBook) OnConnected(meta p2pcommon.PeerMeta, role types.PeerRole) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConnected", meta, role)
+}
+
+// OnConnected indicates an expected call of OnConnected
+func (mr *MockAddressBookMockRecorder) OnConnected(meta, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConnected", reflect.TypeOf((*MockAddressBook)(nil).OnConnected), meta, role)
+}
+
+// OnConnectFailed mocks base method
+func (m *MockAddressBook) OnConnectFailed(pid types.PeerID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConnectFailed", pid)
+}
+
+// OnConnectFailed indicates an expected call of OnConnectFailed
+func (mr *MockAddressBookMockRecorder) OnConnectFailed(pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConnectFailed", reflect.TypeOf((*MockAddressBook)(nil).OnConnectFailed), pid)
+}
+
+// Entries mocks base method
+func (m *MockAddressBook) Entries() []*types.AddressBookEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Entries")
+	ret0, _ := ret[0].([]*types.AddressBookEntry)
+	return ret0
+}
+
+// Entries indicates an expected call of Entries
+func (mr *MockAddressBookMockRecorder) Entries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Entries", reflect.TypeOf((*MockAddressBook)(nil).Entries))
+}
@@ -46,6 +46,20 @@ func (mr *MockCertificateManagerMockRecorder) AddCertificate(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockCertificateManager)(nil).AddCertificate), arg0)
 }
 
+// AddRevocationList mocks base method
+func (m *MockCertificateManager) AddRevocationList(arg0 *p2pcommon.CertRevocationListV1) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRevocationList", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRevocationList indicates an expected call of AddRevocationList
+func (mr *MockCertificateManagerMockRecorder) AddRevocationList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRevocationList", reflect.TypeOf((*MockCertificateManager)(nil).AddRevocationList), arg0)
+}
+
 // CanHandle mocks base method
 func (m *MockCertificateManager) CanHandle(arg0 peer.ID) bool {
 	m.ctrl.T.Helper()
@@ -89,6 +103,20 @@ func (mr *MockCertificateManagerMockRecorder) GetCertificates() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificates", reflect.TypeOf((*MockCertificateManager)(nil).GetCertificates))
 }
 
+// GetIssuedCertificates mocks base method
+func (m *MockCertificateManager) GetIssuedCertificates() []*p2pcommon.AgentCertificateV1 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssuedCertificates")
+	ret0, _ := ret[0].([]*p2pcommon.AgentCertificateV1)
+	return ret0
+}
+
+// GetIssuedCertificates indicates an expected call of GetIssuedCertificates
+func (mr *MockCertificateManagerMockRecorder) GetIssuedCertificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuedCertificates", reflect.TypeOf((*MockCertificateManager)(nil).GetIssuedCertificates))
+}
+
 // GetProducers mocks base method
 func (m *MockCertificateManager) GetProducers() []peer.ID {
 	m.ctrl.T.Helper()
@@ -103,6 +131,34 @@ func (mr *MockCertificateManagerMockRecorder) GetProducers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducers", reflect.TypeOf((*MockCertificateManager)(nil).GetProducers))
 }
 
+// GetRevocationLists mocks base method
+func (m *MockCertificateManager) GetRevocationLists() []*p2pcommon.CertRevocationListV1 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevocationLists")
+	ret0, _ := ret[0].([]*p2pcommon.CertRevocationListV1)
+	return ret0
+}
+
+// GetRevocationLists indicates an expected call of GetRevocationLists
+func (mr *MockCertificateManagerMockRecorder) GetRevocationLists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevocationLists", reflect.TypeOf((*MockCertificateManager)(nil).GetRevocationLists))
+}
+
+// IsRevoked mocks base method
+func (m *MockCertificateManager) IsRevoked(arg0 *p2pcommon.AgentCertificateV1) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRevoked indicates an expected call of IsRevoked
+func (mr *MockCertificateManagerMockRecorder) IsRevoked(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockCertificateManager)(nil).IsRevoked), arg0)
+}
+
 // OnPeerConnect mocks base method
 func (m *MockCertificateManager) OnPeerConnect(arg0 peer.ID) {
 	m.ctrl.T.Helper()
@@ -127,6 +183,35 @@ func (mr *MockCertificateManagerMockRecorder) OnPeerDisconnect(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPeerDisconnect", reflect.TypeOf((*MockCertificateManager)(nil).OnPeerDisconnect), arg0)
 }
 
+// RenewCertificates mocks base method
+func (m *MockCertificateManager) RenewCertificates(arg0 peer.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewCertificates", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewCertificates indicates an expected call of RenewCertificates
+func (mr *MockCertificateManagerMockRecorder) RenewCertificates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewCertificates", reflect.TypeOf((*MockCertificateManager)(nil).RenewCertificates), arg0)
+}
+
+// RevokeCertificates mocks base method
+func (m *MockCertificateManager) RevokeCertificates(arg0 peer.ID, arg1 bool) (*p2pcommon.CertRevocationListV1, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificates", arg0, arg1)
+	ret0, _ := ret[0].(*p2pcommon.CertRevocationListV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificates indicates an expected call of RevokeCertificates
+func (mr *MockCertificateManagerMockRecorder) RevokeCertificates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificates", reflect.TypeOf((*MockCertificateManager)(nil).RevokeCertificates), arg0, arg1)
+}
+
 // Start mocks base method
 func (m *MockCertificateManager) Start() {
 	m.ctrl.T.Helper()
@@ -83,6 +83,36 @@ func (mr *MockConsensusAccessorMockRecorder) ConfChangeInfo(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfChangeInfo", reflect.TypeOf((*MockConsensusAccessor)(nil).ConfChangeInfo), arg0)
 }
 
+// TransferLeadership mocks base method
+func (m *MockConsensusAccessor) TransferLeadership(arg0 string) (*consensus.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", arg0)
+	ret0, _ := ret[0].(*consensus.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership
+func (mr *MockConsensusAccessorMockRecorder) TransferLeadership(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockConsensusAccessor)(nil).TransferLeadership), arg0)
+}
+
+// SetMaintenance mocks base method
+func (m *MockConsensusAccessor) SetMaintenance(arg0 bool) (*types.MaintenanceMode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenance", arg0)
+	ret0, _ := ret[0].(*types.MaintenanceMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaintenance indicates an expected call of SetMaintenance
+func (mr *MockConsensusAccessorMockRecorder) SetMaintenance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenance", reflect.TypeOf((*MockConsensusAccessor)(nil).SetMaintenance), arg0)
+}
+
 // ConsensusInfo mocks base method
 func (m *MockConsensusAccessor) ConsensusInfo() *types.ConsensusInfo {
 	m.ctrl.T.Helper()
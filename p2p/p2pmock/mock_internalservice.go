@@ -146,3 +146,45 @@ func (mr *MockInternalServiceMockRecorder) RoleManager() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleManager", reflect.TypeOf((*MockInternalService)(nil).RoleManager))
 }
+
+// ReputationManager mocks base method
+func (m *MockInternalService) ReputationManager() p2pcommon.ReputationManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReputationManager")
+	ret0, _ := ret[0].(p2pcommon.ReputationManager)
+	return ret0
+}
+
+// ReputationManager indicates an expected call of ReputationManager
+func (mr *MockInternalServiceMockRecorder) ReputationManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReputationManager", reflect.TypeOf((*MockInternalService)(nil).ReputationManager))
+}
+
+// DHTDiscoverer mocks base method
+func (m *MockInternalService) DHTDiscoverer() p2pcommon.DHTDiscoverer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DHTDiscoverer")
+	ret0, _ := ret[0].(p2pcommon.DHTDiscoverer)
+	return ret0
+}
+
+// DHTDiscoverer indicates an expected call of DHTDiscoverer
+func (mr *MockInternalServiceMockRecorder) DHTDiscoverer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DHTDiscoverer", reflect.TypeOf((*MockInternalService)(nil).DHTDiscoverer))
+}
+
+// AddressBook mocks base method
+func (m *MockInternalService) AddressBook() p2pcommon.AddressBook {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressBook")
+	ret0, _ := ret[0].(p2pcommon.AddressBook)
+	return ret0
+}
+
+// AddressBook indicates an expected call of AddressBook
+func (mr *MockInternalServiceMockRecorder) AddressBook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressBook", reflect.TypeOf((*MockInternalService)(nil).AddressBook))
+}
@@ -210,6 +210,20 @@ func (mr *MockMoFactoryMockRecorder) NewMsgBlkBroadcastOrder(noticeMsg interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMsgBlkBroadcastOrder", reflect.TypeOf((*MockMoFactory)(nil).NewMsgBlkBroadcastOrder), noticeMsg)
 }
 
+// NewMsgCompactBlkBroadcastOrder mocks base method
+func (m *MockMoFactory) NewMsgCompactBlkBroadcastOrder(noticeMsg *types.CompactBlockNotice) p2pcommon.MsgOrder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMsgCompactBlkBroadcastOrder", noticeMsg)
+	ret0, _ := ret[0].(p2pcommon.MsgOrder)
+	return ret0
+}
+
+// NewMsgCompactBlkBroadcastOrder indicates an expected call of NewMsgCompactBlkBroadcastOrder
+func (mr *MockMoFactoryMockRecorder) NewMsgCompactBlkBroadcastOrder(noticeMsg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMsgCompactBlkBroadcastOrder", reflect.TypeOf((*MockMoFactory)(nil).NewMsgCompactBlkBroadcastOrder), noticeMsg)
+}
+
 // NewMsgTxBroadcastOrder mocks base method
 func (m *MockMoFactory) NewMsgTxBroadcastOrder(noticeMsg *types.NewTransactionsNotice) p2pcommon.MsgOrder {
 	m.ctrl.T.Helper()
@@ -348,6 +348,20 @@ func (mr *MockNetworkTransportMockRecorder) Peerstore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peerstore", reflect.TypeOf((*MockNetworkTransport)(nil).Peerstore))
 }
 
+// ReachableAddrs mocks base method
+func (m *MockNetworkTransport) ReachableAddrs() []go_multiaddr.Multiaddr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReachableAddrs")
+	ret0, _ := ret[0].([]go_multiaddr.Multiaddr)
+	return ret0
+}
+
+// ReachableAddrs indicates an expected call of ReachableAddrs
+func (mr *MockNetworkTransportMockRecorder) ReachableAddrs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReachableAddrs", reflect.TypeOf((*MockNetworkTransport)(nil).ReachableAddrs))
+}
+
 // RemoveStreamHandler mocks base method
 func (m *MockNetworkTransport) RemoveStreamHandler(arg0 protocol.ID) {
 	m.ctrl.T.Helper()
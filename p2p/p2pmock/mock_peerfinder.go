@@ -118,6 +118,79 @@ func (mr *MockPeerFinderMockRecorder) CheckAndFill() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAndFill", reflect.TypeOf((*MockPeerFinder)(nil).CheckAndFill))
 }
 
+// MockDHTDiscoverer is a mock of DHTDiscoverer interface
+type MockDHTDiscoverer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDHTDiscovererMockRecorder
+}
+
+// MockDHTDiscovererMockRecorder is the mock recorder for MockDHTDiscoverer
+type MockDHTDiscovererMockRecorder struct {
+	mock *MockDHTDiscoverer
+}
+
+// NewMockDHTDiscoverer creates a new mock instance
+func NewMockDHTDiscoverer(ctrl *gomock.Controller) *MockDHTDiscoverer {
+	mock := &MockDHTDiscoverer{ctrl: ctrl}
+	mock.recorder = &MockDHTDiscovererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDHTDiscoverer) EXPECT() *MockDHTDiscovererMockRecorder {
+	return m.recorder
+}
+
+// Start mocks base method
+func (m *MockDHTDiscoverer) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start
+func (mr *MockDHTDiscovererMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDHTDiscoverer)(nil).Start))
+}
+
+// Stop mocks base method
+func (m *MockDHTDiscoverer) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockDHTDiscovererMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockDHTDiscoverer)(nil).Stop))
+}
+
+// AddPeer mocks base method
+func (m *MockDHTDiscoverer) AddPeer(meta p2pcommon.PeerMeta) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddPeer", meta)
+}
+
+// AddPeer indicates an expected call of AddPeer
+func (mr *MockDHTDiscovererMockRecorder) AddPeer(meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockDHTDiscoverer)(nil).AddPeer), meta)
+}
+
+// FindPeers mocks base method
+func (m *MockDHTDiscoverer) FindPeers() []p2pcommon.PeerMeta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPeers")
+	ret0, _ := ret[0].([]p2pcommon.PeerMeta)
+	return ret0
+}
+
+// FindPeers indicates an expected call of FindPeers
+func (mr *MockDHTDiscovererMockRecorder) FindPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPeers", reflect.TypeOf((*MockDHTDiscoverer)(nil).FindPeers))
+}
+
 // MockWaitingPeerManager is a mock of WaitingPeerManager interface
 type MockWaitingPeerManager struct {
 	ctrl     *gomock.Controller
@@ -298,6 +298,20 @@ func (mr *MockRemotePeerMockRecorder) PushTxsNotice(txHashes interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushTxsNotice", reflect.TypeOf((*MockRemotePeer)(nil).PushTxsNotice), txHashes)
 }
 
+// AllowTxQuery mocks base method
+func (m *MockRemotePeer) AllowTxQuery() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowTxQuery")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AllowTxQuery indicates an expected call of AllowTxQuery
+func (mr *MockRemotePeerMockRecorder) AllowTxQuery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowTxQuery", reflect.TypeOf((*MockRemotePeer)(nil).AllowTxQuery))
+}
+
 // ConsumeRequest mocks base method
 func (m *MockRemotePeer) ConsumeRequest(msgID p2pcommon.MsgID) p2pcommon.MsgOrder {
 	m.ctrl.T.Helper()
@@ -391,6 +405,18 @@ func (mr *MockRemotePeerMockRecorder) AddCertificate(cert interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockRemotePeer)(nil).AddCertificate), cert)
 }
 
+// RemoveRevokedCertificates mocks base method
+func (m *MockRemotePeer) RemoveRevokedCertificates(crl *p2pcommon.CertRevocationListV1) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveRevokedCertificates", crl)
+}
+
+// RemoveRevokedCertificates indicates an expected call of RemoveRevokedCertificates
+func (mr *MockRemotePeerMockRecorder) RemoveRevokedCertificates(crl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRevokedCertificates", reflect.TypeOf((*MockRemotePeer)(nil).RemoveRevokedCertificates), crl)
+}
+
 // DoTask mocks base method
 func (m *MockRemotePeer) DoTask(task p2pcommon.PeerTask) bool {
 	m.ctrl.T.Helper()
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reputation.go
+
+// Package p2pmock is a generated GoMock package.
+package p2pmock
+
+import (
+	p2pcommon "github.com/aergoio/aergo/p2p/p2pcommon"
+	types "github.com/aergoio/aergo/types"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockReputationManager is a mock of ReputationManager interface
+type MockReputationManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockReputationManagerMockRecorder
+}
+
+// MockReputationManagerMockRecorder is the mock recorder for MockReputationManager
+type MockReputationManagerMockRecorder struct {
+	mock *MockReputationManager
+}
+
+// NewMockReputationManager creates a new mock instance
+func NewMockReputationManager(ctrl *gomock.Controller) *MockReputationManager {
+	mock := &MockReputationManager{ctrl: ctrl}
+	mock.recorder = &MockReputationManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReputationManager) EXPECT() *MockReputationManagerMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method
+func (m *MockReputationManager) Report(pid types.PeerID, behavior p2pcommon.PeerBehavior, reason string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", pid, behavior, reason)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Report indicates an expected call of Report
+func (mr *MockReputationManagerMockRecorder) Report(pid, behavior, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockReputationManager)(nil).Report), pid, behavior, reason)
+}
+
+// IsBanned mocks base method
+func (m *MockReputationManager) IsBanned(pid types.PeerID) (bool, time.Time) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBanned", pid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Time)
+	return ret0, ret1
+}
+
+// IsBanned indicates an expected call of IsBanned
+func (mr *MockReputationManagerMockRecorder) IsBanned(pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBanned", reflect.TypeOf((*MockReputationManager)(nil).IsBanned), pid)
+}
+
+// Reputation mocks base method
+func (m *MockReputationManager) Reputation(pid types.PeerID) p2pcommon.PeerReputation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reputation", pid)
+	ret0, _ := ret[0].(p2pcommon.PeerReputation)
+	return ret0
+}
+
+// Reputation indicates an expected call of Reputation
+func (mr *MockReputationManagerMockRecorder) Reputation(pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reputation", reflect.TypeOf((*MockReputationManager)(nil).Reputation), pid)
+}
+
+// BannedPeers mocks base method
+func (m *MockReputationManager) BannedPeers() []p2pcommon.PeerReputation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BannedPeers")
+	ret0, _ := ret[0].([]p2pcommon.PeerReputation)
+	return ret0
+}
+
+// BannedPeers indicates an expected call of BannedPeers
+func (mr *MockReputationManagerMockRecorder) BannedPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BannedPeers", reflect.TypeOf((*MockReputationManager)(nil).BannedPeers))
+}
@@ -73,6 +73,18 @@ func (mr *MockSyncManagerMockRecorder) HandleGetTxReq(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleGetTxReq", reflect.TypeOf((*MockSyncManager)(nil).HandleGetTxReq), arg0, arg1, arg2)
 }
 
+// HandleCompactBlockNotice mocks base method
+func (m *MockSyncManager) HandleCompactBlockNotice(arg0 p2pcommon.RemotePeer, arg1 *types.CompactBlockNotice) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCompactBlockNotice", arg0, arg1)
+}
+
+// HandleCompactBlockNotice indicates an expected call of HandleCompactBlockNotice
+func (mr *MockSyncManagerMockRecorder) HandleCompactBlockNotice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCompactBlockNotice", reflect.TypeOf((*MockSyncManager)(nil).HandleCompactBlockNotice), arg0, arg1)
+}
+
 // HandleNewBlockNotice mocks base method
 func (m *MockSyncManager) HandleNewBlockNotice(arg0 p2pcommon.RemotePeer, arg1 *types.NewBlockNotice) {
 	m.ctrl.T.Helper()
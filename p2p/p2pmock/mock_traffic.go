@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: traffic.go
+
+// Package p2pmock is a generated GoMock package.
+package p2pmock
+
+import (
+	p2pcommon "github.com/aergoio/aergo/p2p/p2pcommon"
+	types "github.com/aergoio/aergo/types"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockTrafficShaper is a mock of TrafficShaper interface
+type MockTrafficShaper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrafficShaperMockRecorder
+}
+
+// MockTrafficShaperMockRecorder is the mock recorder for MockTrafficShaper
+type MockTrafficShaperMockRecorder struct {
+	mock *MockTrafficShaper
+}
+
+// NewMockTrafficShaper creates a new mock instance
+func NewMockTrafficShaper(ctrl *gomock.Controller) *MockTrafficShaper {
+	mock := &MockTrafficShaper{ctrl: ctrl}
+	mock.recorder = &MockTrafficShaperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTrafficShaper) EXPECT() *MockTrafficShaperMockRecorder {
+	return m.recorder
+}
+
+// WrapReadWriter mocks base method
+func (m *MockTrafficShaper) WrapReadWriter(pid types.PeerID, rw p2pcommon.MsgReadWriter) p2pcommon.MsgReadWriter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WrapReadWriter", pid, rw)
+	ret0, _ := ret[0].(p2pcommon.MsgReadWriter)
+	return ret0
+}
+
+// WrapReadWriter indicates an expected call of WrapReadWriter
+func (mr *MockTrafficShaperMockRecorder) WrapReadWriter(pid, rw interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WrapReadWriter", reflect.TypeOf((*MockTrafficShaper)(nil).WrapReadWriter), pid, rw)
+}
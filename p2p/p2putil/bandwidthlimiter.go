@@ -0,0 +1,48 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"sync"
+	"time"
+)
+
+// BandwidthLimiter is a thread-safe token bucket of bytes. Unlike RateLimiter, the bucket can run into debt so that
+// a message larger than the bucket passes, and the debt delays the bytes transferred after it.
+type BandwidthLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now func() time.Time
+}
+
+// NewBandwidthLimiter create a new limiter of bytesPerSec. The bucket holds bytes of one second, and is full at the start.
+func NewBandwidthLimiter(bytesPerSec int) *BandwidthLimiter {
+	return &BandwidthLimiter{rate: float64(bytesPerSec), burst: float64(bytesPerSec), tokens: float64(bytesPerSec), last: time.Now(), now: time.Now}
+}
+
+// Reserve consumes n bytes and returns the duration to wait before transferring them. It returns zero if the
+// bucket has enough bytes.
+func (l *BandwidthLimiter) Reserve(n int) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
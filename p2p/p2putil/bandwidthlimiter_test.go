@@ -0,0 +1,43 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBandwidthLimiter_Reserve(t *testing.T) {
+	now := time.Now()
+	l := NewBandwidthLimiter(1000)
+	l.now = func() time.Time { return now }
+
+	// bytes of one second are available at once
+	if d := l.Reserve(600); d != 0 {
+		t.Fatalf("Reserve() = %v in burst, want 0", d)
+	}
+	if d := l.Reserve(400); d != 0 {
+		t.Fatalf("Reserve() = %v in burst, want 0", d)
+	}
+	// bytes over the bucket wait for refill
+	if d := l.Reserve(500); d != time.Second/2 {
+		t.Errorf("Reserve() = %v, want %v", d, time.Second/2)
+	}
+	// the debt delays the next bytes too
+	now = now.Add(time.Second / 4)
+	if d := l.Reserve(2000); d != time.Second/4+2*time.Second {
+		t.Errorf("Reserve() = %v, want %v", d, time.Second/4+2*time.Second)
+	}
+
+	// bytes are not refilled over the bucket
+	now = now.Add(time.Hour)
+	if d := l.Reserve(1000); d != 0 {
+		t.Errorf("Reserve() = %v after refill, want 0", d)
+	}
+	if d := l.Reserve(100); d != time.Second/10 {
+		t.Errorf("Reserve() = %v, want %v", d, time.Second/10)
+	}
+}
@@ -179,3 +179,116 @@ func calculateCertificateHash(cert *p2pcommon.AgentCertificateV1) ([]byte, error
 	}
 	return h.Sum(nil), nil
 }
+
+func ConvertCRLToProto(l *p2pcommon.CertRevocationListV1) *types.CertificateRevocationList {
+	protoL := &types.CertificateRevocationList{CrlVersion: l.Version, BPID: []byte(l.BPID),
+		BPPubKey: l.BPPubKey.SerializeCompressed(), CreateTime: l.CreateTime.UnixNano()}
+	protoL.Revocations = make([]*types.CertRevocation, len(l.Revocations))
+	for i, r := range l.Revocations {
+		protoL.Revocations[i] = &types.CertRevocation{AgentID: []byte(r.AgentID), RevokeTime: r.RevokeTime.UnixNano(), Renewable: r.Renewable}
+	}
+	protoL.Signature = l.Signature.Serialize()
+	return protoL
+}
+
+// NewCRLV1 create revocation list signed by block producer
+func NewCRLV1(bpID types.PeerID, bpKey *btcec.PrivateKey, revocations []p2pcommon.CertRevocation) (*p2pcommon.CertRevocationListV1, error) {
+	l := &p2pcommon.CertRevocationListV1{Version: p2pcommon.CertVersion0001, BPID: bpID, BPPubKey: bpKey.PubKey(),
+		CreateTime: time.Now().Truncate(0), Revocations: revocations}
+	err := SignCRL(bpKey, l)
+	if err != nil {
+		return nil, p2pcommon.ErrInvalidCertField
+	}
+	return l, nil
+}
+
+// CheckAndGetCRLV1 converts protobuf revocation list and verifies that it is signed by the block producer in it.
+func CheckAndGetCRLV1(crl *types.CertificateRevocationList) (*p2pcommon.CertRevocationListV1, error) {
+	var err error
+	if crl.CrlVersion != p2pcommon.CertVersion0001 {
+		return nil, p2pcommon.ErrInvalidCertVersion
+	}
+	wrap := &p2pcommon.CertRevocationListV1{Version: crl.CrlVersion}
+	wrap.BPID, err = peer.IDFromBytes(crl.BPID)
+	if err != nil {
+		return nil, p2pcommon.ErrInvalidPeerID
+	}
+	wrap.BPPubKey, err = btcec.ParsePubKey(crl.BPPubKey, btcec.S256())
+	if err != nil {
+		return nil, p2pcommon.ErrInvalidKey
+	}
+	generatedID, err := peer.IDFromPublicKey(ConvertPubToLibP2P(wrap.BPPubKey))
+	if err != nil || !types.IsSamePeerID(wrap.BPID, generatedID) {
+		return nil, p2pcommon.ErrInvalidKey
+	}
+	wrap.CreateTime = time.Unix(0, crl.CreateTime)
+	if wrap.CreateTime.Sub(time.Now()) > p2pcommon.TimeErrorTolerance {
+		return nil, p2pcommon.ErrInvalidCertField
+	}
+	wrap.Revocations = make([]p2pcommon.CertRevocation, len(crl.Revocations))
+	for i, r := range crl.Revocations {
+		if r == nil {
+			return nil, p2pcommon.ErrMalformedCert
+		}
+		wrap.Revocations[i].AgentID, err = peer.IDFromBytes(r.AgentID)
+		if err != nil {
+			return nil, p2pcommon.ErrInvalidPeerID
+		}
+		wrap.Revocations[i].RevokeTime = time.Unix(0, r.RevokeTime)
+		wrap.Revocations[i].Renewable = r.Renewable
+	}
+	wrap.Signature, err = btcec.ParseSignature(crl.Signature, btcec.S256())
+	if err != nil {
+		return nil, p2pcommon.ErrInvalidCertField
+	}
+
+	if !VerifyCRL(wrap) {
+		return nil, p2pcommon.ErrVerificationFailed
+	}
+	return wrap, nil
+}
+
+func SignCRL(key *btcec.PrivateKey, wrap *p2pcommon.CertRevocationListV1) error {
+	hash, err := calculateCRLHash(wrap)
+	if err != nil {
+		return err
+	}
+	sign, err := key.Sign(hash)
+	if err != nil {
+		return err
+	}
+	wrap.BPPubKey = key.PubKey()
+	wrap.Signature = sign
+	return nil
+}
+
+func VerifyCRL(wrap *p2pcommon.CertRevocationListV1) bool {
+	hash, err := calculateCRLHash(wrap)
+	if err != nil {
+		return false
+	}
+	return wrap.Signature.Verify(hash, wrap.BPPubKey)
+}
+
+// version, bpid, bppubkey, create time, and agent id, revoke time and renewable of each revocation
+func calculateCRLHash(crl *p2pcommon.CertRevocationListV1) ([]byte, error) {
+	h := sha256.New()
+	binary.Write(h, binary.LittleEndian, crl.Version)
+	bArr, err := crl.BPID.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	h.Write(bArr)
+	h.Write(crl.BPPubKey.SerializeCompressed())
+	binary.Write(h, binary.LittleEndian, crl.CreateTime.UnixNano())
+	for _, r := range crl.Revocations {
+		bArr, err = r.AgentID.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		h.Write(bArr)
+		binary.Write(h, binary.LittleEndian, r.RevokeTime.UnixNano())
+		binary.Write(h, binary.LittleEndian, r.Renewable)
+	}
+	return h.Sum(nil), nil
+}
@@ -266,4 +266,58 @@ func Test_calculateCertificateHash(t *testing.T) {
 		t.Fatalf("calculated hash is same! %v , want different ", enc.ToString(h2))
 	}
 
-}
\ No newline at end of file
+}
+func TestCheckAndGetCRLV1(t *testing.T) {
+	pk1, _ := btcec.NewPrivateKey(btcec.S256())
+	pk2, _ := btcec.NewPrivateKey(btcec.S256())
+	pid1, _ := types.IDFromPrivateKey(ConvertPKToLibP2P(pk1))
+	agent1, agent2 := types.RandomPeerID(), types.RandomPeerID()
+	now := time.Now().Truncate(0)
+	revocations := []p2pcommon.CertRevocation{{AgentID: agent1, RevokeTime: now, Renewable: true}, {AgentID: agent2, RevokeTime: now.Add(-time.Hour)}}
+
+	l, err := NewCRLV1(pid1, pk1, revocations)
+	if err != nil {
+		t.Fatalf("Failed to create test input. %s ", err.Error())
+	}
+	tmpl := ConvertCRLToProto(l)
+	otherKey := proto.Clone(tmpl).(*types.CertificateRevocationList)
+	otherKey.BPPubKey = pk2.PubKey().SerializeCompressed()
+	tampered := proto.Clone(tmpl).(*types.CertificateRevocationList)
+	tampered.Revocations[0].RevokeTime = now.Add(time.Hour).UnixNano()
+	removed := proto.Clone(tmpl).(*types.CertificateRevocationList)
+	removed.Revocations = removed.Revocations[:1]
+	badVersion := proto.Clone(tmpl).(*types.CertificateRevocationList)
+	badVersion.CrlVersion = 0
+	fl, _ := NewCRLV1(pid1, pk1, revocations)
+	fl.CreateTime = now.Add(time.Hour)
+	SignCRL(pk1, fl)
+
+	tests := []struct {
+		name    string
+		crl     *types.CertificateRevocationList
+		wantErr error
+	}{
+		{"TSucc", tmpl, nil},
+		{"TDiffKeyAndID", otherKey, p2pcommon.ErrInvalidKey},
+		{"TTampered", tampered, p2pcommon.ErrVerificationFailed},
+		{"TRemoved", removed, p2pcommon.ErrVerificationFailed},
+		{"TWrongVersion", badVersion, p2pcommon.ErrInvalidCertVersion},
+		{"TFutureList", ConvertCRLToProto(fl), p2pcommon.ErrInvalidCertField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckAndGetCRLV1(tt.crl)
+			if err != tt.wantErr {
+				t.Fatalf("CheckAndGetCRLV1() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if !reflect.DeepEqual(got, l) {
+					t.Errorf("CheckAndGetCRLV1() = %v, want %v", got, l)
+				}
+				if !proto.Equal(ConvertCRLToProto(got), tt.crl) {
+					t.Errorf("ConvertCRLToProto() = %v, want %v", ConvertCRLToProto(got), tt.crl)
+				}
+			}
+		})
+	}
+}
@@ -50,15 +50,14 @@ func FromMultiAddrString(str string) (p2pcommon.PeerMeta, error) {
 	return FromMultiAddr(ma)
 }
 
-// FromMultiAddrToPeerInfo create PeerStaticInfo from qualified multiaddr which contains if and only if address, port and peerID
+// FromMultiAddrToPeerInfo create PeerStaticInfo from qualified multiaddr which contains if and only if address, port and peerID.
+// The port is tcp port, or udp port followed by quic.
 func FromMultiAddrToPeerInfo(ma types.Multiaddr) (p2pcommon.PeerMeta, error) {
 	meta := p2pcommon.PeerMeta{}
 	// check if ma contains all required information (address, port, peerID)
 	protos := ma.Protocols()
 	// check address
-	if len(protos) != 3 || !types.IsAddress(protos[0]) ||
-		protos[1].Code != multiaddr.P_TCP ||
-		protos[2].Code != multiaddr.P_P2P {
+	if !isTCPPeerAddr(protos) && !isQUICPeerAddr(protos) {
 		return meta, fmt.Errorf("invalid Peer addr format %s", ma.String())
 	}
 	addrPortPart, idPart := multiaddr.SplitLast(ma)
@@ -72,6 +71,19 @@ func FromMultiAddrToPeerInfo(ma types.Multiaddr) (p2pcommon.PeerMeta, error) {
 	return meta, nil
 }
 
+func isTCPPeerAddr(protos []multiaddr.Protocol) bool {
+	return len(protos) == 3 && types.IsAddress(protos[0]) &&
+		protos[1].Code == multiaddr.P_TCP &&
+		protos[2].Code == multiaddr.P_P2P
+}
+
+func isQUICPeerAddr(protos []multiaddr.Protocol) bool {
+	return len(protos) == 4 && types.IsAddress(protos[0]) &&
+		protos[1].Code == multiaddr.P_UDP &&
+		protos[2].Code == types.ProtocolAergoQUIC &&
+		protos[3].Code == multiaddr.P_P2P
+}
+
 // FromMultiAddrStringWithPID
 func FromMultiAddrStringWithPID(str string, id types.PeerID) (p2pcommon.PeerMeta, error) {
 	addr1, err := types.ParseMultiaddr(str)
@@ -269,6 +269,8 @@ func TestFromMultiAddrToPeerInfo(t *testing.T) {
 		{"TIP6peerAddr", "/ip6/FE80::0202:B3FF:FE1E:8329/tcp/11003/p2p/16Uiu2HAmHuBgtnisgPLbujFvxPNZw3Qvpk3VLUwTzh5C67LAZSFh", "/ip6/fe80::202:b3ff:fe1e:8329/tcp/11003", false},
 		{"TDomainName", "/dns4/mainnet-polaris.aergo.io/tcp/8916/p2p/16Uiu2HAkuxyDkMTQTGFpmnex2SdfTVzYfPztTyK339rqUdsv3ZUa", "/dns4/mainnet-polaris.aergo.io/tcp/8916", false},
 		{"TDomainName6", "/dns6/mainnet-polaris.aergo.io/tcp/8916/p2p/16Uiu2HAkuxyDkMTQTGFpmnex2SdfTVzYfPztTyK339rqUdsv3ZUa", "/dns6/mainnet-polaris.aergo.io/tcp/8916", false},
+		{"TQUICpeerAddr", "/ip4/192.168.0.58/udp/11002/aergo-quic/p2p/16Uiu2HAmHuBgtnisgPLbujFvxPNZw3Qvpk3VLUwTzh5C67LAZSFh", "/ip4/192.168.0.58/udp/11002/aergo-quic", false},
+		{"TUDPOnly", "/ip4/192.168.0.58/udp/11002/p2p/16Uiu2HAmHuBgtnisgPLbujFvxPNZw3Qvpk3VLUwTzh5C67LAZSFh", "", true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,47 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter is a thread-safe token bucket. It allows burst events at once, and refills tokens at the rate of
+// the given number per second.
+type RateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now func() time.Time
+}
+
+// NewRateLimiter create a new limiter, which bucket is full at the start.
+func NewRateLimiter(ratePerSec int, burst int) *RateLimiter {
+	return &RateLimiter{rate: float64(ratePerSec), burst: float64(burst), tokens: float64(burst), last: time.Now(), now: time.Now}
+}
+
+// Allow consumes a token and returns true if a token is available, or returns false if not.
+func (l *RateLimiter) Allow() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,46 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := NewRateLimiter(2, 4)
+	l.now = func() time.Time { return now }
+
+	// burst is allowed at once
+	for i := 0; i < 4; i++ {
+		if !l.Allow() {
+			t.Fatalf("Allow() = false at %d, want true", i)
+		}
+	}
+	if l.Allow() {
+		t.Fatalf("Allow() = true after burst, want false")
+	}
+
+	// two tokens are refilled per second
+	now = now.Add(time.Second)
+	if !l.Allow() || !l.Allow() {
+		t.Errorf("Allow() = false after refill, want true")
+	}
+	if l.Allow() {
+		t.Errorf("Allow() = true, want false")
+	}
+
+	// tokens are not refilled over burst
+	now = now.Add(time.Hour)
+	cnt := 0
+	for l.Allow() {
+		cnt++
+	}
+	if cnt != 4 {
+		t.Errorf("allowed count = %d, want 4", cnt)
+	}
+}
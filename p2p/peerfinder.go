@@ -11,6 +11,7 @@ import (
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/types"
+	"sync/atomic"
 	"time"
 )
 
@@ -18,14 +19,14 @@ const (
 	macConcurrentQueryCount = 4
 )
 
-func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool) p2pcommon.PeerFinder {
+func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool, dht p2pcommon.DHTDiscoverer) p2pcommon.PeerFinder {
 	var pf p2pcommon.PeerFinder
 	if !useDiscover {
 		logger.Info().Msg("peer discover option is disabled, so select static peer finder.")
 		pf = &staticPeerFinder{pm:pm, logger:logger}
 	} else {
-		logger.Info().Bool("usePolaris",usePolaris).Msg("peer discover option is enabled, so select dynamic peer finder.")
-		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris}
+		logger.Info().Bool("usePolaris",usePolaris).Bool("useDHT", dht != nil).Msg("peer discover option is enabled, so select dynamic peer finder.")
+		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris, dht: dht}
 		dp.qStats = make(map[types.PeerID]*queryStat)
 		pf = dp
 	}
@@ -59,12 +60,16 @@ type dynamicPeerFinder struct {
 	pm           *peerManager
 	actorService p2pcommon.ActorService
 	usePolaris   bool
+	// dht is nil if DHT discovery is disabled
+	dht p2pcommon.DHTDiscoverer
 
 	// qStats are logs of query. all connected peers must exist queryStat.
 	qStats map[types.PeerID]*queryStat
 	maxCap int
 
 	polarisTurn time.Time
+	dhtTurn     time.Time
+	dhtRunning  int32
 }
 
 var _ p2pcommon.PeerFinder = (*dynamicPeerFinder)(nil)
@@ -80,6 +85,13 @@ func (dp *dynamicPeerFinder) OnPeerConnect(pid types.PeerID) {
 		// first query will be sent quickly
 		dp.qStats[pid] = &queryStat{pid: pid, nextTurn: time.Now().Add(p2pcommon.PeerFirstInterval)}
 	}
+	// connected peer is confirmed to be in same chain by handshake. it is called in the goroutine of peer manager,
+	// so remotePeers can be accessed directly.
+	if dp.dht != nil {
+		if peer, found := dp.pm.remotePeers[pid]; found && !peer.RemoteInfo().Hidden {
+			dp.dht.AddPeer(peer.Meta())
+		}
+	}
 }
 
 func (dp *dynamicPeerFinder) CheckAndFill() {
@@ -95,6 +107,17 @@ func (dp *dynamicPeerFinder) CheckAndFill() {
 		dp.logger.Debug().Time("next_turn", dp.polarisTurn).Msg("querying to polaris")
 		dp.actorService.SendRequest(message.P2PSvc, &message.MapQueryMsg{Count: MaxAddrListSizePolaris})
 	}
+	// lookup DHT. it takes some seconds, so it runs in another goroutine and the result is sent back to peer manager.
+	if dp.dht != nil && now.After(dp.dhtTurn) && atomic.CompareAndSwapInt32(&dp.dhtRunning, 0, 1) {
+		dp.dhtTurn = now.Add(p2pcommon.DHTQueryInterval)
+		dp.logger.Debug().Time("next_turn", dp.dhtTurn).Msg("looking up DHT")
+		go func() {
+			defer atomic.StoreInt32(&dp.dhtRunning, 0)
+			if metas := dp.dht.FindPeers(); len(metas) > 0 {
+				dp.pm.NotifyPeerAddressReceived(metas)
+			}
+		}()
+	}
 	// query to peers
 	queried := 0
 	for _, stat := range dp.qStats {
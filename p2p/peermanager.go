@@ -42,6 +42,8 @@ type peerManager struct {
 	mm                metric.MetricsManager
 	lm                p2pcommon.ListManager
 	cm                p2pcommon.CertificateManager
+	rm                p2pcommon.ReputationManager
+	ab                p2pcommon.AddressBook
 	skipHandshakeSync bool
 
 	peerFinder p2pcommon.PeerFinder
@@ -153,7 +155,11 @@ func (pm *peerManager) init() {
 		pm.hiddenPeerSet[pid] = true
 	}
 
-	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris)
+	var dht p2pcommon.DHTDiscoverer
+	if pm.conf.NPDiscoverPeers && pm.conf.NPUseDHT {
+		dht = pm.is.DHTDiscoverer()
+	}
+	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris, dht)
 	pm.wpManager = NewWaitingPeerManager(pm.logger, pm.is, pm, pm.lm, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers)
 	pm.AddPeerEventListener(pm.peerFinder)
 	pm.AddPeerEventListener(pm.wpManager)
@@ -174,6 +180,8 @@ func (pm *peerManager) AddPeerEventListener(l p2pcommon.PeerEventListener) {
 func (pm *peerManager) Start() error {
 	// connect other sub modules
 	pm.cm = pm.is.CertificateManager()
+	pm.rm = pm.is.ReputationManager()
+	pm.ab = pm.is.AddressBook()
 	go pm.runManagePeers()
 
 	return nil
@@ -221,6 +229,10 @@ func (pm *peerManager) runManagePeers() {
 	initialAddrDelay := time.Second * 2
 	finderTimer := time.NewTimer(initialAddrDelay)
 	connManTimer := time.NewTimer(initialAddrDelay << 1)
+	// peers known before restart are tried first, without waiting polaris or other peers
+	if pm.ab != nil {
+		pm.wpManager.OnDiscoveredPeers(pm.ab.KnownPeers(pm.conf.NPPeerPool))
+	}
 
 MANLOOP:
 	for {
@@ -275,6 +287,9 @@ MANLOOP:
 		case peerMeta := <-pm.addPeerChannel:
 			pm.wpManager.InstantConnect(peerMeta)
 		case peerMetas := <-pm.fillPoolChannel:
+			if pm.ab != nil {
+				pm.ab.AddPeers(peerMetas)
+			}
 			if pm.wpManager.OnDiscoveredPeers(peerMetas) > 0 {
 				if !connManTimer.Stop() {
 					<-connManTimer.C
@@ -327,6 +342,13 @@ func (pm *peerManager) tryRegister(hsResult connPeerResult) p2pcommon.RemotePeer
 	remote := hsResult.remote
 	meta := remote.Meta
 	peerID := meta.ID
+	if pm.rm != nil {
+		if banned, until := pm.rm.IsBanned(peerID); banned {
+			pm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Time("until", until).Msg("Close connection of peer banned by bad reputation.")
+			hsResult.msgRW.Close()
+			return nil
+		}
+	}
 	preExistPeer, ok := pm.remotePeers[peerID]
 	if ok {
 		pm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("Peer add collision. Outbound connection of higher hash will survive.")
@@ -349,6 +371,9 @@ func (pm *peerManager) tryRegister(hsResult connPeerResult) p2pcommon.RemotePeer
 	go newPeer.RunPeer()
 
 	pm.insertPeer(peerID, newPeer)
+	if pm.ab != nil && !remote.Hidden {
+		pm.ab.OnConnected(newPeer.Meta(), newPeer.AcceptedRole())
+	}
 	pm.logger.Info().Str("claimedRole", newPeer.Meta().Role.String()).Str("role", newPeer.AcceptedRole().String()).Bool("outbound", remote.Connection.Outbound).Str("zone",remote.Zone.String()).Str(p2putil.LogPeerName, newPeer.Name()).Str("addr", remote.Connection.IP.String()+":"+strconv.Itoa(int(remote.Connection.Port))).Msg("peer is added to peerService")
 
 	pm.mutex.Lock()
@@ -497,7 +522,11 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 		lastStatus := aPeer.LastStatus()
 		rCerts, _ := p2putil.ConvertCertsToProto(aPeer.RemoteInfo().Certificates)
 		pi := &message.PeerInfo{
-			&addr, rCerts, aPeer.AcceptedRole(), meta.Version, ri.Hidden, lastStatus.CheckTime, lastStatus.BlockHash, lastStatus.BlockNumber, aPeer.State(), false}
+			Addr: &addr, Certificates: rCerts, AcceptedRole: aPeer.AcceptedRole(), Version: meta.Version, Hidden: ri.Hidden, CheckTime: lastStatus.CheckTime, LastBlockHash: lastStatus.BlockHash, LastBlockNumber: lastStatus.BlockNumber, State: aPeer.State(), Self: false}
+		if pm.rm != nil {
+			reputation := pm.rm.Reputation(aPeer.ID())
+			pi.Score, pi.LastPenalty = reputation.Score, reputation.LastPenalty
+		}
 		peers = append(peers, pi)
 	}
 	return peers
@@ -42,12 +42,15 @@ func SetupSelfMeta(peerID types.PeerID, conf *config.P2PConfig, produceBlock boo
 		ipAddress = extIP
 		protocolAddr = ipAddress.String()
 	}
-	ma,err := types.ToMultiAddr(ipAddress.String(), uint32(protocolPort))
+	addrs, err := p2pcommon.TransportAddrs(conf.NPTransports, ipAddress.String(), uint32(protocolPort))
+	if err != nil {
+		panic("invalid p2p.nptransports config : " + err.Error())
+	}
 	var meta p2pcommon.PeerMeta
 
 	meta.ID = peerID
 	meta.Role = setupPeerRole(produceBlock, conf)
-	meta.Addresses = []types.Multiaddr{ma}
+	meta.Addresses = addrs
 	switch meta.Role {
 	case types.PeerRole_Producer:
 		// register self id
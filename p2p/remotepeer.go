@@ -51,8 +51,11 @@ type remotePeerImpl struct {
 	mf         p2pcommon.MoFactory
 	signer     p2pcommon.MsgSigner
 	metric     *metric.PeerMetric
+	// rm is the reputation manager to report misbehaviors of this peer. it can be nil
+	rm p2pcommon.ReputationManager
 
 	certChan chan *p2pcommon.AgentCertificateV1
+	crlChan  chan *p2pcommon.CertRevocationListV1
 	stopChan chan struct{}
 
 	// direct write channel
@@ -67,8 +70,11 @@ type remotePeerImpl struct {
 	handlers map[p2pcommon.SubProtocol]p2pcommon.MessageHandler
 
 	blkHashCache *lru.Cache
-	txHashCache  *lru.Cache
-	lastStatus   *types.LastBlockStatus
+	// txHashCache has the hashes of txs known by the peer, which are sent to or
+	// received from it, and txRecvCache has only the received ones.
+	txHashCache *lru.Cache
+	txRecvCache *lru.Cache
+	lastStatus  *types.LastBlockStatus
 	// lastBlkNoticeTime is time that local peer sent NewBlockNotice to this remote peer
 	lastBlkNoticeTime time.Time
 	skipCnt           int32
@@ -76,6 +82,7 @@ type remotePeerImpl struct {
 	txQueueLock         *sync.Mutex
 	txNoticeQueue       *p2putil.PressableQueue
 	maxTxNoticeHashSize int
+	txQueryLimiter      *p2putil.RateLimiter
 
 	rw p2pcommon.MsgReadWriter
 
@@ -100,6 +107,7 @@ func newRemotePeer(remote p2pcommon.RemoteInfo, manageNum uint32, pm p2pcommon.P
 		stopChan:   make(chan struct{}, 1),
 		closeWrite: make(chan struct{}),
 		certChan:   make(chan *p2pcommon.AgentCertificateV1),
+		crlChan:    make(chan *p2pcommon.CertRevocationListV1),
 		requests:   make(map[p2pcommon.MsgID]*requestInfo),
 		reqMutex:   &sync.Mutex{},
 
@@ -108,6 +116,7 @@ func newRemotePeer(remote p2pcommon.RemoteInfo, manageNum uint32, pm p2pcommon.P
 		txQueueLock:         &sync.Mutex{},
 		txNoticeQueue:       p2putil.NewPressableQueue(DefaultPeerTxQueueSize),
 		maxTxNoticeHashSize: DefaultPeerTxQueueSize,
+		txQueryLimiter:      p2putil.NewRateLimiter(getTxQueryRate, getTxQueryBurst),
 		taskChannel: make(chan p2pcommon.PeerTask, 1),
 	}
 	rPeer.writeBuf = make(chan p2pcommon.MsgOrder, writeMsgBufferSize)
@@ -122,6 +131,10 @@ func newRemotePeer(remote p2pcommon.RemoteInfo, manageNum uint32, pm p2pcommon.P
 	if err != nil {
 		panic("Failed to create remote peer " + err.Error())
 	}
+	rPeer.txRecvCache, err = lru.New(DefaultPeerTxCacheSize)
+	if err != nil {
+		panic("Failed to create remote peer " + err.Error())
+	}
 
 	return rPeer
 }
@@ -200,6 +213,8 @@ READNOPLOOP:
 			p.cleanupCerts()
 		case c := <-p.certChan:
 			p.addCert(c)
+		case crl := <-p.crlChan:
+			p.removeRevokedCerts(crl)
 		case task := <- p.taskChannel:
 			p.logger.Debug().Str(p2putil.LogPeerName, p.Name()).Msg("Executing task for peer")
 			task(p)
@@ -299,6 +314,7 @@ func (p *remotePeerImpl) handleMsg(msg p2pcommon.Message) (err error) {
 	handler, found := p.handlers[subProto]
 	if !found {
 		p.logger.Debug().Str(p2putil.LogPeerName, p.Name()).Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogProtoID, subProto.String()).Msg("invalid protocol")
+		reportPeer(p.rm, p.ID(), p2pcommon.ProtocolViolation, "invalid protocol "+subProto.String())
 		return fmt.Errorf("invalid protocol %s", subProto)
 	}
 
@@ -307,6 +323,7 @@ func (p *remotePeerImpl) handleMsg(msg p2pcommon.Message) (err error) {
 	payload, err := handler.ParsePayload(msg.Payload())
 	if err != nil {
 		p.logger.Warn().Err(err).Str(p2putil.LogPeerName, p.Name()).Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogProtoID, subProto.String()).Msg("invalid message data")
+		reportPeer(p.rm, p.ID(), p2pcommon.ProtocolViolation, "invalid message data of "+subProto.String())
 		return fmt.Errorf("invalid message data")
 	}
 	//err = p.signer.verifyMsg(msg, p.remoteInfo.ID)
@@ -317,6 +334,7 @@ func (p *remotePeerImpl) handleMsg(msg p2pcommon.Message) (err error) {
 	err = handler.CheckAuth(msg, payload)
 	if err != nil {
 		p.logger.Warn().Err(err).Str(p2putil.LogPeerName, p.Name()).Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogProtoID, subProto.String()).Msg("Failed to authenticate message")
+		reportPeer(p.rm, p.ID(), p2pcommon.ProtocolViolation, "unauthorized message of "+subProto.String())
 		return fmt.Errorf("Failed to authenticate message.")
 	}
 
@@ -468,6 +486,9 @@ func (p *remotePeerImpl) sendTxNotices() {
 		if len(hashes) > 0 {
 			mo := p.mf.NewMsgTxBroadcastOrder(&types.NewTransactionsNotice{TxHashes: hashes})
 			p.SendMessage(mo)
+			if p.metric != nil {
+				p.metric.OnTxNoticeOut(len(hashes))
+			}
 		}
 	}
 }
@@ -530,14 +551,35 @@ func (p *remotePeerImpl) UpdateBlkCache(blkHash types.BlockID, blkNumber types.B
 func (p *remotePeerImpl) UpdateTxCache(hashes []types.TxID) []types.TxID {
 	// lru cache can't accept byte slice key
 	added := make([]types.TxID, 0, len(hashes))
+	resent := 0
 	for _, hash := range hashes {
 		if found, _ := p.txHashCache.ContainsOrAdd(hash, true); !found {
 			added = append(added, hash)
 		}
+		if found, _ := p.txRecvCache.ContainsOrAdd(hash, true); found {
+			resent++
+		}
+	}
+	if p.metric != nil {
+		p.metric.OnTxNoticeIn(len(hashes), len(hashes)-len(added))
+	}
+	// notice of several txs, all of which this peer had noticed already, is useless.
+	// the txs sent by this node are not counted, since the peer may have relayed
+	// them before receiving the notice of this node.
+	if len(hashes) > 1 && resent == len(hashes) {
+		reportPeer(p.rm, p.ID(), p2pcommon.Spam, "duplicated tx notice")
 	}
 	return added
 }
 
+func (p *remotePeerImpl) AllowTxQuery() bool {
+	allowed := p.txQueryLimiter.Allow()
+	if p.metric != nil {
+		p.metric.OnTxQuery(!allowed)
+	}
+	return allowed
+}
+
 func (p *remotePeerImpl) UpdateLastNotice(blkHash types.BlockID, blkNumber types.BlockNo) {
 	p.lastStatus = &types.LastBlockStatus{time.Now(), blkHash[:], blkNumber}
 }
@@ -580,10 +622,37 @@ func (p *remotePeerImpl) cleanupCerts() {
 	}
 }
 
+func (p *remotePeerImpl) removeRevokedCerts(crl *p2pcommon.CertRevocationListV1) {
+	if len(p.remoteInfo.Certificates) == 0 {
+		return
+	}
+	certs2 := make([]*p2pcommon.AgentCertificateV1, 0, len(p.remoteInfo.Certificates))
+	for _, cert := range p.remoteInfo.Certificates {
+		if crl.IsRevoked(cert) {
+			p.logger.Info().Str(p2putil.LogPeerName, p.Name()).Str("issuer", p2putil.ShortForm(cert.BPID)).Msg("Certificate is revoked")
+		} else {
+			certs2 = append(certs2, cert)
+		}
+	}
+	p.remoteInfo.Certificates = certs2
+	if len(certs2) == 0 && p.AcceptedRole() == types.PeerRole_Agent {
+		p.logger.Info().Str(p2putil.LogPeerName, p.Name()).Msg("All Certificates are revoked. peer is demoted to Watcher")
+		p.pm.UpdatePeerRole([]p2pcommon.RoleModifier{{ID: p.ID(), Role:types.PeerRole_Watcher}})
+	}
+}
+
 func (p *remotePeerImpl) AddCertificate(cert *p2pcommon.AgentCertificateV1) {
 	p.certChan <- cert
 }
 
+func (p *remotePeerImpl) RemoveRevokedCertificates(crl *p2pcommon.CertRevocationListV1) {
+	select {
+	case p.crlChan <- crl:
+	case <-p.closeWrite:
+		// peer is stopped
+	}
+}
+
 func (p *remotePeerImpl) DoTask(task p2pcommon.PeerTask) bool {
 	select {
 	case p.taskChannel <- task :
@@ -14,6 +14,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/metric"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2pmock"
 	"github.com/aergoio/aergo/p2p/p2putil"
@@ -380,6 +382,47 @@ func TestRemotePeerImpl_UpdateTxCache(t *testing.T) {
 	}
 }
 
+func TestRemotePeerImpl_UpdateTxCacheSpam(t *testing.T) {
+	tests := []struct {
+		name       string
+		sent       []types.TxID
+		received   []types.TxID
+		wantReport bool
+	}{
+		{"TNew", nil, nil, false},
+		{"TRelayOfSent", sampleTxIDs, nil, false},
+		{"TPartialResent", nil, sampleTxIDs[2:], false},
+		{"TAllResent", nil, sampleTxIDs, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRM := p2pmock.NewMockReputationManager(ctrl)
+			sampleConn := p2pcommon.RemoteConn{IP: net.ParseIP(sampleMeta.PrimaryAddress()), Port: sampleMeta.PrimaryPort()}
+			sampleRemote := p2pcommon.RemoteInfo{Meta: sampleMeta, Connection: sampleConn}
+			target := newRemotePeer(sampleRemote, 0, new(p2pmock.MockPeerManager), new(p2pmock.MockActorService), logger, new(p2pmock.MockMoFactory), new(p2pmock.MockMsgSigner), nil)
+			target.rm = mockRM
+			// the hashes sent by this node are known to the peer, but it's not
+			// a spam to notice them back.
+			for _, hash := range test.sent {
+				target.txHashCache.Add(hash, true)
+			}
+			if len(test.received) > 0 {
+				target.UpdateTxCache(test.received)
+			}
+			if test.wantReport {
+				mockRM.EXPECT().Report(sampleMeta.ID, p2pcommon.Spam, gomock.Any()).Return(false).Times(1)
+			} else {
+				mockRM.EXPECT().Report(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			target.UpdateTxCache(sampleTxIDs)
+		})
+	}
+}
+
 func TestRemotePeerImpl_GetReceiver(t *testing.T) {
 	idSize := 10
 	idList := make([]p2pcommon.MsgID, idSize)
@@ -549,6 +592,38 @@ func TestRemotePeer_writeToPeer(t *testing.T) {
 	}
 }
 
+func TestRemotePeer_writeToPeerThrottled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPeerManager := p2pmock.NewMockPeerManager(ctrl)
+	innerRW := p2pmock.NewMockMsgReadWriter(ctrl)
+	innerRW.EXPECT().WriteMsg(gomock.Any()).Return(nil).AnyTimes()
+	innerRW.EXPECT().Close().Return(nil).AnyTimes()
+	// 1 KB/s for tx relay, so that the notices wait in the queue of their class behind the first one
+	rw := metric.NewTrafficShaper(&config.P2PConfig{NPMaxTxBandwidth: 1}).WrapReadWriter(sampleMeta.ID, innerRW)
+	defer rw.Close()
+
+	sampleConn := p2pcommon.RemoteConn{IP: net.ParseIP(sampleMeta.PrimaryAddress()), Port: sampleMeta.PrimaryPort()}
+	sampleRemote := p2pcommon.RemoteInfo{Meta: sampleMeta, Connection: sampleConn}
+	p := newRemotePeer(sampleRemote, 0, mockPeerManager, nil, logger, nil, nil, rw)
+	p.state.SetAndGet(types.RUNNING)
+
+	// far more than the queue of a throttled class keeps
+	for i := 0; i < 100; i++ {
+		msg := p2pcommon.NewLiteMessageValue(p2pcommon.NewTxNotice, p2pcommon.NewMsgID(), p2pcommon.EmptyID, time.Now().UnixNano())
+		msg.SetPayload(make([]byte, 1024))
+		mockMO := p2pmock.NewMockMsgOrder(ctrl)
+		mockMO.EXPECT().SendTo(gomock.Any()).DoAndReturn(func(rp p2pcommon.RemotePeer) error {
+			return p.rw.WriteMsg(msg)
+		})
+		p.writeToPeer(mockMO)
+	}
+	if p.State() != types.RUNNING {
+		t.Errorf("state = %v after the queue is full, want %v", p.State(), types.RUNNING)
+	}
+}
+
 func Test_remotePeerImpl_handleMsg_InPanic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
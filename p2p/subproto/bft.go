@@ -0,0 +1,54 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type bftMessageNoticeHandler struct {
+	BaseMsgHandler
+	ba consensus.BFTAccessor
+	rm p2pcommon.ReputationManager
+}
+
+var _ p2pcommon.MessageHandler = (*bftMessageNoticeHandler)(nil)
+
+// NewBFTMessageNoticeHandler creates handler for BFTMessageNotice
+func NewBFTMessageNoticeHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, ba consensus.BFTAccessor, rm p2pcommon.ReputationManager) *bftMessageNoticeHandler {
+	bh := &bftMessageNoticeHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.BFTMessageNotice, pm: pm, peer: peer, actor: actor, logger: logger}, ba: ba, rm: rm}
+	return bh
+}
+
+func (bh *bftMessageNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.BFTMessage{})
+}
+
+func (bh *bftMessageNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.BFTMessage)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	isNew, err := bh.ba.HandleBFTMessage(data)
+	if err != nil {
+		// the error is of the signature or the format of message, which the
+		// peer must have checked before relaying it.
+		bh.logger.Debug().Err(err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("invalid BFT message")
+		if bh.rm != nil {
+			bh.rm.Report(remotePeer.ID(), p2pcommon.ProtocolViolation, "BFT message: "+err.Error())
+		}
+		return
+	}
+	// the valid message is relayed only once, so that it is gossiped to the validators which are not connected directly
+	if isNew {
+		bh.actor.TellRequest(message.P2PSvc, &message.NotifyBFTMessage{Message: data, From: remotePeer.ID()})
+	}
+}
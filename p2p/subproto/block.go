@@ -138,7 +138,10 @@ func (bh *getBlockHeadersResponseHandler) Handle(msg p2pcommon.Message, msgBody
 	data := msgBody.(*types.GetBlockHeadersResponse)
 	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
 
-	// send block headers to blockchain service
+	// headers requested by light service are handled by its receiver
+	if remotePeer.GetReceiver(msg.OriginalID())(msg, data) {
+		return
+	}
 	remotePeer.ConsumeRequest(msg.OriginalID())
 
 	// TODO: it's not used yet, but used in RPC and can be used in future performance tuning
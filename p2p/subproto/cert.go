@@ -7,6 +7,7 @@ package subproto
 
 import (
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/types"
@@ -44,7 +45,7 @@ func (h *issueCertRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommo
 	resp := &types.IssueCertificateResponse{}
 	cert, err := h.cm.CreateCertificate(remotePeer.Meta())
 	if err != nil {
-		if err == p2pcommon.ErrInvalidRole {
+		if err == p2pcommon.ErrInvalidRole || err == p2pcommon.ErrCertRevoked {
 			resp.Status = types.ResultStatus_PERMISSION_DENIED
 		} else {
 			resp.Status = types.ResultStatus_UNAVAILABLE
@@ -134,6 +135,53 @@ func (h *certRenewedNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcomm
 		h.logger.Info().Str(p2putil.LogPeerName, p.Name()).Str("bpID", p2putil.ShortForm(cert.BPID)).Str("agentID", p2putil.ShortForm(cert.AgentID)).Msg("drop renewed certificate, since agent id is not the remote peer")
 		return
 	}
+	if h.cm.IsRevoked(cert) {
+		h.logger.Info().Str(p2putil.LogPeerName, p.Name()).Str("bpID", p2putil.ShortForm(cert.BPID)).Msg("drop renewed certificate, since it is revoked")
+		return
+	}
 
 	p.AddCertificate(cert)
+}
+
+type certRevokedNoticeHandler struct {
+	BaseMsgHandler
+	cm p2pcommon.CertificateManager
+	rm p2pcommon.ReputationManager
+}
+
+var _ p2pcommon.MessageHandler = (*certRevokedNoticeHandler)(nil)
+
+// NewCertRevokedNoticeHandler creates handler for CertificateRevokedNotice
+func NewCertRevokedNoticeHandler(pm p2pcommon.PeerManager, cm p2pcommon.CertificateManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, rm p2pcommon.ReputationManager) *certRevokedNoticeHandler {
+	ph := &certRevokedNoticeHandler{BaseMsgHandler{protocol: p2pcommon.CertificateRevokedNotice, pm: pm, peer: peer, actor: actor, logger: logger}, cm, rm}
+	return ph
+}
+
+func (h *certRevokedNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.CertificateRevokedNotice{})
+}
+
+func (h *certRevokedNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	p := h.peer
+	data := msgBody.(*types.CertificateRevokedNotice)
+	p2putil.DebugLogReceive(h.logger, h.protocol, msg.ID().String(), p, data)
+
+	if data.RevocationList == nil {
+		return
+	}
+	// revocation list is relayed by any peer, so it is trusted only by the signature of block producer
+	crl, err := p2putil.CheckAndGetCRLV1(data.RevocationList)
+	if err != nil {
+		h.logger.Debug().Str(p2putil.LogPeerName, p.Name()).Err(err).Msg("revocation list verification failed")
+		return
+	}
+	if err = h.cm.AddRevocationList(crl); err == p2pcommon.ErrUnknownIssuer {
+		h.logger.Info().Str(p2putil.LogPeerName, p.Name()).Str("bpID", p2putil.ShortForm(crl.BPID)).Msg("drop revocation list, since issuer is not a known block producer")
+		h.rm.Report(p.ID(), p2pcommon.Spam, "revocation list of unknown issuer")
+		return
+	} else if err != nil {
+		// already known list
+		return
+	}
+	h.actor.TellRequest(message.P2PSvc, message.NotifyCertRevoked{CRL: data.RevocationList, From: p.ID()})
 }
\ No newline at end of file
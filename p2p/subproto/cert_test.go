@@ -2,6 +2,7 @@ package subproto
 
 import (
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2pmock"
 	"github.com/aergoio/aergo/p2p/p2putil"
@@ -84,3 +85,51 @@ func Test_certRenewedNoticeHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func Test_certRevokedNoticeHandler_Handle(t *testing.T) {
+	logger := log.NewLogger("test.subproto")
+	bpLPK, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	bpID, _ := peer.IDFromPrivateKey(bpLPK)
+	bpPK := p2putil.ConvertPKToBTCEC(bpLPK)
+
+	tests := []struct {
+		name   string
+		addErr error
+
+		wantRelay  bool
+		wantReport bool
+	}{
+		{"TNew", nil, true, false},
+		{"TOld", p2pcommon.ErrOldRevocationList, false, false},
+		{"TUnknownIssuer", p2pcommon.ErrUnknownIssuer, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pm := p2pmock.NewMockPeerManager(ctrl)
+			cm := p2pmock.NewMockCertificateManager(ctrl)
+			rm := p2pmock.NewMockReputationManager(ctrl)
+			peer := p2pmock.NewMockRemotePeer(ctrl)
+			actor := p2pmock.NewMockActorService(ctrl)
+			peerID := types.RandomPeerID()
+			peer.EXPECT().ID().Return(peerID).AnyTimes()
+			peer.EXPECT().Name().Return("samplePeer").AnyTimes()
+
+			crl, _ := p2putil.NewCRLV1(bpID, bpPK, nil)
+			cm.EXPECT().AddRevocationList(gomock.Any()).Return(tt.addErr)
+			if tt.wantRelay {
+				actor.EXPECT().TellRequest(message.P2PSvc, gomock.Any())
+			}
+			if tt.wantReport {
+				rm.EXPECT().Report(peerID, p2pcommon.Spam, gomock.Any())
+			}
+
+			dummyMsg := &testMessage{id: p2pcommon.NewMsgID(), subProtocol: p2pcommon.CertificateRevokedNotice}
+			body := &types.CertificateRevokedNotice{RevocationList: p2putil.ConvertCRLToProto(crl)}
+			h := NewCertRevokedNoticeHandler(pm, cm, peer, logger, actor, rm)
+			h.Handle(dummyMsg, body)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type compactBlockNoticeHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*compactBlockNoticeHandler)(nil)
+
+// NewCompactBlockNoticeHandler creates handler for CompactBlockNotice
+func NewCompactBlockNoticeHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, sm p2pcommon.SyncManager) *compactBlockNoticeHandler {
+	bh := &compactBlockNoticeHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.CompactBlockNotice, pm: pm, sm: sm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *compactBlockNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.CompactBlockNotice{})
+}
+
+func (bh *compactBlockNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.CompactBlockNotice)
+
+	blockID, ok := compactBlockID(data)
+	if !ok {
+		bh.logger.Info().Str(p2putil.LogPeerName, remotePeer.Name()).Msg("malformed compact block notice. header is null")
+		return
+	}
+	if !remotePeer.UpdateBlkCache(blockID, data.Header.BlockNo) {
+		bh.sm.HandleCompactBlockNotice(remotePeer, data)
+	}
+}
+
+// compactBlockID returns the id of block which the notice announces.
+func compactBlockID(data *types.CompactBlockNotice) (types.BlockID, bool) {
+	if data.GetHeader() == nil {
+		return types.BlockID{}, false
+	}
+	return types.ToBlockID((&types.Block{Header: data.Header}).BlockHash()), true
+}
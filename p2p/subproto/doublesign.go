@@ -0,0 +1,39 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type doubleSignEvidenceNoticeHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*doubleSignEvidenceNoticeHandler)(nil)
+
+// NewDoubleSignEvidenceNoticeHandler creates handler for DoubleSignEvidenceNotice
+func NewDoubleSignEvidenceNoticeHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *doubleSignEvidenceNoticeHandler {
+	bh := &doubleSignEvidenceNoticeHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.DoubleSignEvidenceNotice, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *doubleSignEvidenceNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.DoubleSignEvidence{})
+}
+
+func (bh *doubleSignEvidenceNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.DoubleSignEvidence)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	// chain service verifies and keeps the evidence, and relays it only if it's new
+	bh.actor.TellRequest(message.ChainSvc, &message.AddDoubleSignEvidence{Evidence: data, From: remotePeer.ID()})
+}
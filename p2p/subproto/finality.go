@@ -0,0 +1,90 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type finalityVoteNoticeHandler struct {
+	BaseMsgHandler
+	fa consensus.FinalityAccessor
+	rm p2pcommon.ReputationManager
+}
+
+var _ p2pcommon.MessageHandler = (*finalityVoteNoticeHandler)(nil)
+
+// NewFinalityVoteNoticeHandler creates handler for FinalityVoteNotice
+func NewFinalityVoteNoticeHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, fa consensus.FinalityAccessor, rm p2pcommon.ReputationManager) *finalityVoteNoticeHandler {
+	bh := &finalityVoteNoticeHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.FinalityVoteNotice, pm: pm, peer: peer, actor: actor, logger: logger}, fa: fa, rm: rm}
+	return bh
+}
+
+func (bh *finalityVoteNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.FinalityVote{})
+}
+
+func (bh *finalityVoteNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.FinalityVote)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	isNew, err := bh.fa.HandleFinalityVote(data)
+	if err != nil {
+		bh.logger.Debug().Err(err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("invalid finality vote")
+		// only the malformed vote is the fault of the peer, since the block
+		// producers may differ by the chain of this node.
+		if _, verr := data.Verify(); verr != nil && bh.rm != nil {
+			bh.rm.Report(remotePeer.ID(), p2pcommon.ProtocolViolation, "finality vote: "+verr.Error())
+		}
+		return
+	}
+	// the valid vote is relayed only once, so that it is gossiped to the block producers which are not connected directly
+	if isNew {
+		bh.actor.TellRequest(message.P2PSvc, &message.NotifyFinalityVote{Vote: data, From: remotePeer.ID()})
+	}
+}
+
+type voteEquivocationNoticeHandler struct {
+	BaseMsgHandler
+	fa consensus.FinalityAccessor
+	rm p2pcommon.ReputationManager
+}
+
+var _ p2pcommon.MessageHandler = (*voteEquivocationNoticeHandler)(nil)
+
+// NewVoteEquivocationNoticeHandler creates handler for VoteEquivocationNotice
+func NewVoteEquivocationNoticeHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, fa consensus.FinalityAccessor, rm p2pcommon.ReputationManager) *voteEquivocationNoticeHandler {
+	bh := &voteEquivocationNoticeHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.VoteEquivocationNotice, pm: pm, peer: peer, actor: actor, logger: logger}, fa: fa, rm: rm}
+	return bh
+}
+
+func (bh *voteEquivocationNoticeHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.VoteEquivocation{})
+}
+
+func (bh *voteEquivocationNoticeHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.VoteEquivocation)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	isNew, err := bh.fa.HandleVoteEquivocation(data)
+	if err != nil {
+		bh.logger.Debug().Err(err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("invalid finality vote equivocation")
+		if _, verr := data.Verify(); verr != nil && bh.rm != nil {
+			bh.rm.Report(remotePeer.ID(), p2pcommon.ProtocolViolation, "vote equivocation: "+verr.Error())
+		}
+		return
+	}
+	if isNew {
+		bh.actor.TellRequest(message.P2PSvc, &message.NotifyVoteEquivocation{Evidence: data, From: remotePeer.ID()})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type getStateProofRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getStateProofRequestHandler)(nil)
+
+type getStateProofResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getStateProofResponseHandler)(nil)
+
+type getReceiptsRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getReceiptsRequestHandler)(nil)
+
+type getReceiptsResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getReceiptsResponseHandler)(nil)
+
+// NewGetStateProofReqHandler creates handler for GetStateProofRequest
+func NewGetStateProofReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateProofRequestHandler {
+	bh := &getStateProofRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetStateProofRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getStateProofRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateProofRequest{})
+}
+
+func (bh *getStateProofRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateProofRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if data.Query == nil {
+		resp := &types.GetStateProofResponse{Status: types.ResultStatus_INVALID_ARGUMENT}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateProofResponse, resp))
+		return
+	}
+	if bh.issue() {
+		go bh.handleGetStateProof(msg, data)
+	} else {
+		resp := &types.GetStateProofResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateProofResponse, resp))
+	}
+}
+
+func (bh *getStateProofRequestHandler) handleGetStateProof(msg p2pcommon.Message, data *types.GetStateProofRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+
+	q := data.Query
+	resp := &types.GetStateProofResponse{Status: types.ResultStatus_OK}
+	rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetStateQuery{ContractAddress: q.ContractAddress, StorageKeys: q.StorageKeys, Root: q.Root, Compressed: q.Compressed})
+	if err != nil {
+		resp.Status = types.ResultStatus_INTERNAL
+	} else if rsp, ok := rawResponse.(message.GetStateQueryRsp); !ok || rsp.Err != nil {
+		bh.logger.Debug().Str(p2putil.LogPeerName, remotePeer.Name()).Msg("failed to get state proof")
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		resp.Proof = rsp.Result
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateProofResponse, resp))
+}
+
+// NewGetStateProofRespHandler creates handler for GetStateProofResponse
+func NewGetStateProofRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateProofResponseHandler {
+	bh := &getStateProofResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetStateProofResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateProofResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateProofResponse{})
+}
+
+func (bh *getStateProofResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetStateProofResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	// locate request data and remove it if found
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+// NewGetReceiptsReqHandler creates handler for GetReceiptsRequest
+func NewGetReceiptsReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getReceiptsRequestHandler {
+	bh := &getReceiptsRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetReceiptsRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getReceiptsRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetReceiptsRequest{})
+}
+
+func (bh *getReceiptsRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetReceiptsRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetReceipts(msg, data)
+	} else {
+		resp := &types.GetReceiptsResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetReceiptsResponse, resp))
+	}
+}
+
+func (bh *getReceiptsRequestHandler) handleGetReceipts(msg p2pcommon.Message, data *types.GetReceiptsRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+
+	resp := &types.GetReceiptsResponse{Status: types.ResultStatus_OK}
+	rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc, &message.GetReceipts{BlockHash: data.BlockHash})
+	if err != nil {
+		resp.Status = types.ResultStatus_INTERNAL
+	} else if rsp, ok := rawResponse.(message.GetReceiptsRsp); !ok || rsp.Err != nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else if resp.Receipts, err = rsp.Receipts.MarshalBinary(); err != nil {
+		resp.Status = types.ResultStatus_INTERNAL
+		resp.Receipts = nil
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetReceiptsResponse, resp))
+}
+
+// NewGetReceiptsRespHandler creates handler for GetReceiptsResponse
+func NewGetReceiptsRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getReceiptsResponseHandler {
+	bh := &getReceiptsResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetReceiptsResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getReceiptsResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetReceiptsResponse{})
+}
+
+func (bh *getReceiptsResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetReceiptsResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	// locate request data and remove it if found
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
@@ -76,3 +76,33 @@ func (bh *raftNewBlkNoticeDiscardHandler) Handle(msg p2pcommon.Message, msgBody
 		remotePeer.UpdateLastNotice(blockID, data.BlockNo)
 	}
 }
+
+// raftCompactBlkNoticeDiscardHandler silently discard compact blk notice, in the same manner as raftNewBlkNoticeDiscardHandler
+type raftCompactBlkNoticeDiscardHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*raftCompactBlkNoticeDiscardHandler)(nil)
+
+// NewCompactBlkNoticeDiscardHandler creates handler for CompactBlockNotice
+func NewCompactBlkNoticeDiscardHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService, sm p2pcommon.SyncManager) p2pcommon.MessageHandler {
+	bh := &raftCompactBlkNoticeDiscardHandler{BaseMsgHandler: BaseMsgHandler{protocol: p2pcommon.CompactBlockNotice, pm: pm, sm: sm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *raftCompactBlkNoticeDiscardHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.CompactBlockNotice{})
+}
+
+func (bh *raftCompactBlkNoticeDiscardHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.CompactBlockNotice)
+
+	if blockID, ok := compactBlockID(data); !ok {
+		bh.logger.Info().Str(p2putil.LogPeerName, remotePeer.Name()).Msg("malformed compact block notice. header is null")
+		return
+	} else {
+		// just update last status
+		remotePeer.UpdateLastNotice(blockID, data.Header.BlockNo)
+	}
+}
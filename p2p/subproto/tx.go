@@ -52,7 +52,13 @@ func (th *txRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.Mess
 	body := msgBody.(*types.GetTransactionsRequest)
 	p2putil.DebugLogReceive(th.logger, th.protocol, msg.ID().String(), remotePeer, body)
 
-	if err := th.sm.HandleGetTxReq(remotePeer, msg.ID(), body); err != nil {
+	var err error
+	if !remotePeer.AllowTxQuery() {
+		err = p2pcommon.SyncManagerBusyError
+	} else {
+		err = th.sm.HandleGetTxReq(remotePeer, msg.ID(), body)
+	}
+	if err != nil {
 		th.logger.Info().Str(p2putil.LogPeerName, remotePeer.Name()).Str(p2putil.LogMsgID, msg.ID().String()).Err(err).Msg("return err for concurrent get tx request")
 		resp := &types.GetTransactionsResponse{
 			Status: types.ResultStatus_RESOURCE_EXHAUSTED,
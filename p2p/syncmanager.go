@@ -22,16 +22,17 @@ type syncManager struct {
 	logger *log.Logger
 	actor  p2pcommon.ActorService
 	pm     p2pcommon.PeerManager
+	rm     p2pcommon.ReputationManager
 
 	tm *syncTxManager
 
 	blkCache *lru.Cache
 }
 
-func newSyncManager(actor p2pcommon.ActorService, pm p2pcommon.PeerManager, logger *log.Logger) p2pcommon.SyncManager {
+func newSyncManager(actor p2pcommon.ActorService, pm p2pcommon.PeerManager, rm p2pcommon.ReputationManager, logger *log.Logger) p2pcommon.SyncManager {
 	var err error
-	sm := &syncManager{actor: actor, pm: pm, logger: logger,}
-	sm.tm = newTxSyncManager(sm, actor, pm, logger)
+	sm := &syncManager{actor: actor, pm: pm, rm: rm, logger: logger,}
+	sm.tm = newTxSyncManager(sm, actor, pm, rm, logger)
 
 	sm.blkCache, err = lru.New(DefaultGlobalBlockCacheSize)
 	if err != nil {
@@ -59,6 +60,7 @@ func (sm *syncManager) HandleBlockProducedNotice(peer p2pcommon.RemotePeer, bloc
 	// check if block size is over the limit
 	if block.Size() > int(chain.MaxBlockSize()) {
 		sm.logger.Info().Str(p2putil.LogPeerName, peer.Name()).Str(p2putil.LogBlkHash, block.BlockID().String()).Int("size", block.Size()).Msg("invalid blockProduced notice. block size exceed limit")
+		reportPeer(sm.rm, peer.ID(), p2pcommon.ProtocolViolation, "too big block in blockProduced notice")
 		return
 	}
 
@@ -93,6 +95,23 @@ func (sm *syncManager) HandleNewBlockNotice(peer p2pcommon.RemotePeer, data *typ
 	}
 }
 
+func (sm *syncManager) HandleCompactBlockNotice(peer p2pcommon.RemotePeer, data *types.CompactBlockNotice) {
+	blockHash := (&types.Block{Header: data.Header}).BlockHash()
+	hash := types.ToBlockID(blockHash)
+	ok, _ := sm.blkCache.ContainsOrAdd(hash, cachePlaceHolder)
+	if ok {
+		// this notice is already handled by notice from other peer
+		return
+	}
+
+	foundBlock, _ := sm.actor.GetChainAccessor().GetBlock(blockHash)
+	if foundBlock == nil {
+		sm.logger.Debug().Str(p2putil.LogBlkHash, hash.String()).Int("tx_cnt", len(data.TxHashes)).Str(p2putil.LogPeerName, peer.Name()).Msg("compact block notice of unknown hash. rebuilding block")
+		receiver := NewCompactBlockReceiver(sm.actor, peer, sm.rm, sm.logger, data, p2pcommon.DefaultActorMsgTTL)
+		go receiver.StartGet(data.TxHashes, sm.tm.txCache)
+	}
+}
+
 // HandleGetBlockResponse handle when remote peer send a block information.
 // TODO this method will be removed after newer syncer is developed
 func (sm *syncManager) HandleGetBlockResponse(peer p2pcommon.RemotePeer, msg p2pcommon.Message, resp *types.GetBlockResponse) {
@@ -58,7 +58,7 @@ func TestSyncManager_HandleBlockProducedNotice(t *testing.T) {
 			}
 			mockActor.EXPECT().SendRequest(message.ChainSvc, gomock.Any()).Times(actorCallCnt)
 
-			target := newSyncManager(mockActor, mockPM, logger).(*syncManager)
+			target := newSyncManager(mockActor, mockPM, nil, logger).(*syncManager)
 			if test.put != nil {
 				target.blkCache.Add(*test.put, true)
 			}
@@ -133,7 +133,7 @@ func TestSyncManager_HandleNewBlockNotice(t *testing.T) {
 			mockPeer.EXPECT().ID().Return(sampleMeta.ID)
 
 			_, data := test.setup(t, mockActor, mockCA, mockPeer)
-			target := newSyncManager(mockActor, mockPM, logger).(*syncManager)
+			target := newSyncManager(mockActor, mockPM, nil, logger).(*syncManager)
 			if test.put != nil {
 				target.blkCache.Add(*test.put, true)
 			}
@@ -177,7 +177,7 @@ func TestSyncManager_HandleGetBlockResponse(t *testing.T) {
 
 			mockActor.EXPECT().SendRequest(gomock.Any(), gomock.Any()).Times(test.chainCallCnt)
 			dummyMsgID := p2pcommon.NewMsgID()
-			target := newSyncManager(mockActor, mockPM, logger).(*syncManager)
+			target := newSyncManager(mockActor, mockPM, nil, logger).(*syncManager)
 
 			msg := p2pcommon.NewSimpleRespMsgVal(p2pcommon.PingResponse, p2pcommon.NewMsgID(), dummyMsgID)
 			resp := &types.GetBlockResponse{Blocks: test.respBlocks}
@@ -205,7 +205,7 @@ func Test_syncManager_Constructor(t *testing.T) {
 			mockPM := p2pmock.NewMockPeerManager(ctrl)
 			mockActor := p2pmock.NewMockActorService(ctrl)
 
-			sm := newSyncManager(mockActor, mockPM, logger).(*syncManager)
+			sm := newSyncManager(mockActor, mockPM, nil, logger).(*syncManager)
 
 			sm.Start()
 			if sm.tm == nil {
@@ -27,6 +27,7 @@ type syncTxManager struct {
 	sm        p2pcommon.SyncManager
 	actor     p2pcommon.ActorService
 	pm        p2pcommon.PeerManager
+	rm        p2pcommon.ReputationManager
 	msgHelper message.Helper
 
 	txCache       *lru.Cache
@@ -48,8 +49,8 @@ type queryQueue struct {
 
 type smTask func()
 
-func newTxSyncManager(sm p2pcommon.SyncManager, actor p2pcommon.ActorService, pm p2pcommon.PeerManager, logger *log.Logger) *syncTxManager {
-	tm := &syncTxManager{sm:sm, actor: actor, pm: pm, logger: logger,
+func newTxSyncManager(sm p2pcommon.SyncManager, actor p2pcommon.ActorService, pm p2pcommon.PeerManager, rm p2pcommon.ReputationManager, logger *log.Logger) *syncTxManager {
+	tm := &syncTxManager{sm:sm, actor: actor, pm: pm, rm: rm, logger: logger,
 		frontCache:       make(map[types.TxID]*incomingTxNotice),
 		toNoticeIdQueue:  list.New(),
 		taskChannel:      make(chan smTask, 20),
@@ -195,7 +196,7 @@ func (tm *syncTxManager) HandleNewTxNotice(peer p2pcommon.RemotePeer, txIDs []ty
 
 func (tm *syncTxManager) sendGetTxs(peer p2pcommon.RemotePeer, ids []types.TxID) {
 	tm.logger.Debug().Int("tx_cnt", len(ids)).Array("hashes", types.NewLogTxIDsMarshaller(ids, 10)).Msg("syncManager request back unknown tx hashes")
-	receiver := NewGetTxsReceiver(tm.actor, peer, tm.sm, tm.logger, ids, p2pcommon.DefaultActorMsgTTL)
+	receiver := NewGetTxsReceiver(tm.actor, peer, tm.sm, tm.rm, tm.logger, ids, p2pcommon.DefaultActorMsgTTL)
 	receiver.StartGet()
 }
 
@@ -475,7 +476,7 @@ func (tm *syncTxManager) refineFrontCache() {
 func (tm *syncTxManager) sendGetTx(peer p2pcommon.RemotePeer, ids []types.TxID) {
 		tm.logger.Trace().Str(p2putil.LogPeerName,peer.Name()).Array("hashes", types.NewLogTxIDsMarshaller(ids, 10)).Msg("syncManager try to get tx to remote peer")
 		// create message data
-		receiver := NewGetTxsReceiver(tm.actor, peer, tm.sm, tm.logger, ids, p2pcommon.DefaultActorMsgTTL)
+		receiver := NewGetTxsReceiver(tm.actor, peer, tm.sm, tm.rm, tm.logger, ids, p2pcommon.DefaultActorMsgTTL)
 		receiver.StartGet()
 }
 
@@ -72,7 +72,7 @@ func Test_syncTxManager_HandleNewTxNotice(t *testing.T) {
 			}
 
 			data := &types.NewTransactionsNotice{TxHashes: rawHashes}
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 
 			if tt.front != nil {
 				for _, hash := range tt.front {
@@ -240,7 +240,7 @@ func Test_txSyncManager_refineFrontCacheConsumption(t *testing.T) {
 			mockPeer.EXPECT().Name().Return(sampleMeta.ID.Pretty()).AnyTimes()
 			mockPM.EXPECT().GetPeer(gomock.Any()).Return(mockPeer, true).AnyTimes()
 
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 			for _, q := range tt.ques {
 				tm.toNoticeIdQueue.PushBack(q)
 			}
@@ -378,7 +378,7 @@ func Test_txSyncManager_refineFrontCache(t *testing.T) {
 			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
 			mockPM.EXPECT().GetPeer(gomock.Any()).Return(mockPeer, true).AnyTimes()
 
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 
 			if tt.oldTx != nil {
 				lt := time.Now().Add(-time.Second * 61)
@@ -475,7 +475,7 @@ func Test_syncTxManager_pushBackToFrontCache(t *testing.T) {
 			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
 			mockPeer.EXPECT().Name().Return(sampleMeta.ID.String()).AnyTimes()
 
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 
 			if tt.front != nil {
 				for _, hash := range tt.front {
@@ -530,7 +530,7 @@ func Test_syncTxManager_RegisterTxNotice(t *testing.T) {
 			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
 			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
 
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 
 			if tt.frontTx != nil {
 				lt := time.Now()
@@ -709,7 +709,7 @@ func Test_syncTxManager_handleTxReq(t *testing.T) {
 			mockPeer.EXPECT().SendMessage(mockMo)
 
 			_, body := tt.setup(t, mockPM, mockActor, mockMsgHelper, mockMF, mockRW)
-			h := newTxSyncManager(nil, mockActor, mockPM, logger)
+			h := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 			h.msgHelper = mockMsgHelper
 
 			//h.Handle(header, body)
@@ -769,7 +769,7 @@ func Test_syncTxManager_handleBySize(t *testing.T) {
 
 			mockActor.EXPECT().CallRequestDefaultTimeout(message.MemPoolSvc, gomock.AssignableToTypeOf(&message.MemPoolExistEx{})).Return(validBigMempoolRsp, nil)
 
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 			dummyMsg := &testMessage{subProtocol: p2pcommon.GetTXsRequest, id: p2pcommon.NewMsgID()}
 			msgBody := &types.GetTransactionsRequest{Hashes: inHashes}
 			//h.Handle(dummyMsg, msgBody)
@@ -833,7 +833,7 @@ func Test_syncTxManager_assignTxToPeer(t *testing.T) {
 			}
 
 			in := incomingTxNotice{peers: tt.arg}
-			tm := newTxSyncManager(nil, mockActor, mockPM, logger)
+			tm := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 
 			if got := tm.assignTxToPeer(&in, argSendMap); got != tt.want {
 				t.Errorf("assignTxToPeer() = %v, want %v", got, tt.want)
@@ -960,7 +960,7 @@ func BenchmarkRefine(b *testing.B) {
 	for _, bm := range benchmarks {
 		b.Run(bm.name, func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
-				h := newTxSyncManager(nil, mockActor, mockPM, logger)
+				h := newTxSyncManager(nil, mockActor, mockPM, nil, logger)
 				h.frontCache = bm.inCache
 				h.refineFrontCache()
 			}
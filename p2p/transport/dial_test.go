@@ -0,0 +1,114 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const testProtocolID protocol.ID = "/aergo/test/1.0.0"
+
+func newListeningTransport(t *testing.T, transports []string) *networkTransport {
+	conf := config.NewServerContext("", "").GetDefaultP2PConfig()
+	conf.NPTransports = transports
+	return newListeningTransportWith(t, conf)
+}
+
+func newListeningTransportWith(t *testing.T, conf *config.P2PConfig) *networkTransport {
+	key, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sl := &networkTransport{conf: conf, logger: log.NewLogger("test.transport"), privateKey: key,
+		bindAddress: "127.0.0.1", bindPort: 0, hostInited: &sync.WaitGroup{}, closeC: make(chan struct{})}
+	sl.startListener()
+	return sl
+}
+
+// transportCode returns the multiaddr code of the transport in configuration.
+func transportCode(transport string) int {
+	if transport == p2pcommon.TransportQUIC {
+		return types.ProtocolAergoQUIC
+	}
+	return ma.ProtocolWithName(transport).Code
+}
+
+func Test_networkTransport_dialAndListen(t *testing.T) {
+	for _, tr := range []string{p2pcommon.TransportTCP, p2pcommon.TransportQUIC} {
+		t.Run(tr, func(t *testing.T) {
+			listener := newListeningTransport(t, []string{tr})
+			dialer := newListeningTransport(t, []string{tr})
+			defer dialer.Stop()
+			listener.SetStreamHandler(testProtocolID, func(s network.Stream) {
+				defer s.Close()
+				io.Copy(s, s)
+			})
+
+			meta := p2pcommon.PeerMeta{ID: listener.ID(), Addresses: listener.Addrs()}
+			s, err := dialer.GetOrCreateStreamWithTTL(meta, time.Minute, testProtocolID)
+			if err != nil {
+				t.Fatalf("GetOrCreateStreamWithTTL() error = %v", err)
+			}
+			defer s.Close()
+			if _, err := s.Conn().RemoteMultiaddr().ValueForProtocol(transportCode(tr)); err != nil {
+				t.Errorf("connected on %v, want %s", s.Conn().RemoteMultiaddr(), tr)
+			}
+
+			s.SetDeadline(time.Now().Add(5 * time.Second))
+			sent := []byte("ping")
+			if _, err := s.Write(sent); err != nil {
+				t.Fatal(err)
+			}
+			got := make([]byte, len(sent))
+			if _, err := io.ReadFull(s, got); err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(sent) {
+				t.Errorf("echo = %s, want %s", got, sent)
+			}
+
+			// stopping twice must not panic
+			listener.Stop()
+			listener.Stop()
+		})
+	}
+}
+
+func TestCheckTransports(t *testing.T) {
+	tests := []struct {
+		name         string
+		transports   []string
+		experimental bool
+
+		wantErr bool
+	}{
+		{"TEmpty", nil, false, false},
+		{"TTCP", []string{p2pcommon.TransportTCP}, false, false},
+		{"TQUIC", []string{p2pcommon.TransportTCP, p2pcommon.TransportQUIC}, true, false},
+		{"TQUICNotEnabled", []string{p2pcommon.TransportTCP, p2pcommon.TransportQUIC}, false, true},
+		{"TUnknown", []string{"sctp"}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config.P2PConfig{NPTransports: tt.transports, NPExperimentalQUIC: tt.experimental}
+			if err := CheckTransports(conf); (err != nil) != tt.wantErr {
+				t.Errorf("CheckTransports() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
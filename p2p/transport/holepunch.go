@@ -0,0 +1,335 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+// holePunchProtocolID is the protocol by which the peers connected through a relay node exchange their
+// addresses and synchronize their dials to each other.
+const holePunchProtocolID protocol.ID = "/aergo/holepunch/1.0.0"
+
+const (
+	holePunchTimeout = 15 * time.Second
+	// holePunchBackoff is the interval before punching holes to the peer again after a failure, which
+	// doubles on every failure up to holePunchMaxBackoff.
+	holePunchBackoff    = time.Minute
+	holePunchMaxBackoff = time.Hour
+	// holePunchMaxAddrs is the maximum number of addresses which a peer sends to punch holes.
+	holePunchMaxAddrs = 16
+
+	holePunchConnect byte = 0
+	holePunchSync    byte = 1
+)
+
+var (
+	errHolePunchNoAddr = errors.New("no address to punch holes")
+	errHolePunchMsg    = errors.New("invalid hole punching message")
+)
+
+// holePunchState is the state of punching holes to a peer.
+type holePunchState struct {
+	running  bool
+	failures uint
+	retryAt  time.Time
+}
+
+// tryUpgradeRelayed starts upgradeRelayed in background, unless it's running for the peer or it failed
+// recently.
+func (sl *networkTransport) tryUpgradeRelayed(pid peer.ID) {
+	if sl.quic == nil {
+		return
+	}
+	sl.punchMutex.Lock()
+	defer sl.punchMutex.Unlock()
+	if sl.punches == nil {
+		sl.punches = make(map[peer.ID]*holePunchState)
+	}
+	st, exist := sl.punches[pid]
+	if !exist {
+		st = &holePunchState{}
+		sl.punches[pid] = st
+	}
+	if st.running || time.Now().Before(st.retryAt) {
+		return
+	}
+	st.running = true
+	go func() {
+		upgraded := sl.upgradeRelayed(pid)
+		sl.punchMutex.Lock()
+		defer sl.punchMutex.Unlock()
+		if upgraded {
+			delete(sl.punches, pid)
+			return
+		}
+		backoff := holePunchBackoff
+		for i := uint(0); i < st.failures && backoff < holePunchMaxBackoff; i++ {
+			backoff *= 2
+		}
+		if backoff > holePunchMaxBackoff {
+			backoff = holePunchMaxBackoff
+		}
+		st.running = false
+		st.failures++
+		st.retryAt = time.Now().Add(backoff)
+	}()
+}
+
+// upgradeRelayed punches holes of NAT between local node and the peer connected through a relay node,
+// and closes the connection through the relay node if a direct one is made. It's done on the connection
+// through the relay node: local node sends its addresses, the peer replies with its own, and local node
+// measures the round trip time by them. Then local node sends sync and dials the peer after the half of the
+// round trip time, while the peer dials local node as soon as it receives sync, so that both of the NAT
+// devices see the outgoing packets before the incoming ones. The holes are punched on QUIC only, since
+// udp keeps the port of the listening endpoint of each peer.
+//
+// The streams opened on the connection through the relay node are reset when it's closed, and the peer
+// is connected again on the direct one.
+func (sl *networkTransport) upgradeRelayed(pid peer.ID) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), holePunchTimeout)
+	defer cancel()
+	// the swarm adds the punched connection asynchronously
+	connected := make(chan struct{}, 1)
+	notifiee := &network.NotifyBundle{ConnectedF: func(_ network.Network, c network.Conn) {
+		if c.RemotePeer() == pid && !isRelayAddr(c.RemoteMultiaddr()) {
+			select {
+			case connected <- struct{}{}:
+			default:
+			}
+		}
+	}}
+	sl.Network().Notify(notifiee)
+	defer sl.Network().StopNotify(notifiee)
+
+	if err := sl.holePunch(ctx, pid); err != nil {
+		sl.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Msg("failed to punch holes to peer")
+	} else {
+		select {
+		case <-connected:
+		case <-ctx.Done():
+		}
+	}
+	// the peer may have succeeded to dial local node even if local dial failed.
+	if !sl.hasDirectConn(pid) {
+		return false
+	}
+	sl.closeRelayedConns(pid)
+	sl.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Msg("upgraded the connection through relay to direct one")
+	return true
+}
+
+func (sl *networkTransport) holePunch(ctx context.Context, pid peer.ID) error {
+	addrs := sl.holePunchAddrs()
+	if len(addrs) == 0 {
+		return errHolePunchNoAddr
+	}
+	s, err := sl.NewStream(ctx, pid, holePunchProtocolID)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	deadline, _ := ctx.Deadline()
+	s.SetDeadline(deadline)
+	sl.waitIdentified(ctx, s.Conn())
+
+	rw := bufio.NewReadWriter(bufio.NewReader(s), bufio.NewWriter(s))
+	start := time.Now()
+	if err := writeHolePunchMsg(rw, holePunchConnect, addrs); err != nil {
+		return err
+	}
+	remoteAddrs, err := readHolePunchConnect(rw)
+	if err != nil {
+		return err
+	}
+	rtt := time.Since(start)
+	if err := writeHolePunchMsg(rw, holePunchSync, nil); err != nil {
+		return err
+	}
+	select {
+	case <-time.After(rtt / 2):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return sl.punch(ctx, pid, remoteAddrs)
+}
+
+// handleHolePunch is the counterpart of holePunch.
+func (sl *networkTransport) handleHolePunch(s network.Stream) {
+	defer s.Close()
+	pid := s.Conn().RemotePeer()
+	if !isRelayAddr(s.Conn().RemoteMultiaddr()) {
+		s.Reset()
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), holePunchTimeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	s.SetDeadline(deadline)
+	sl.waitIdentified(ctx, s.Conn())
+
+	rw := bufio.NewReadWriter(bufio.NewReader(s), bufio.NewWriter(s))
+	remoteAddrs, err := readHolePunchConnect(rw)
+	if err != nil {
+		sl.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Msg("invalid hole punching from peer")
+		s.Reset()
+		return
+	}
+	if err := writeHolePunchMsg(rw, holePunchConnect, sl.holePunchAddrs()); err != nil {
+		return
+	}
+	if msgType, err := rw.ReadByte(); err != nil || msgType != holePunchSync {
+		return
+	}
+	if err := sl.punch(ctx, pid, remoteAddrs); err != nil {
+		sl.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Msg("failed to punch holes to peer")
+	}
+}
+
+// holePunchAddrs returns the addresses of local node which the peers dial to punch holes.
+func (sl *networkTransport) holePunchAddrs() []ma.Multiaddr {
+	var addrs []ma.Multiaddr
+	for _, a := range sl.Addrs() {
+		if sl.quic.CanDial(a) && len(addrs) < holePunchMaxAddrs {
+			addrs = append(addrs, a)
+		}
+	}
+	return addrs
+}
+
+// waitIdentified waits until the identify protocol on the connection is done, by which the peerstore
+// knows the addresses of the peer.
+func (sl *networkTransport) waitIdentified(ctx context.Context, c network.Conn) {
+	if h, ok := sl.Host.(interface{ IDService() *identify.IDService }); ok {
+		select {
+		case <-h.IDService().IdentifyWait(c):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// punchableAddrs returns the addresses of the peer to punch holes. An address is dialed only if the
+// peerstore knows the peer at the IP of it, so that the peer can't make local node dial any other hosts.
+func (sl *networkTransport) punchableAddrs(pid peer.ID, addrs []ma.Multiaddr) []ma.Multiaddr {
+	knownIPs := make(map[string]bool)
+	for _, a := range sl.Peerstore().Addrs(pid) {
+		if ip, err := addrIP(a); err == nil && !isRelayAddr(a) {
+			knownIPs[ip] = true
+		}
+	}
+	var punchable []ma.Multiaddr
+	for _, a := range addrs {
+		if !sl.quic.CanDial(a) || manet.IsIPUnspecified(a) {
+			continue
+		}
+		if ip, err := addrIP(a); err == nil && knownIPs[ip] {
+			punchable = append(punchable, a)
+		}
+	}
+	return punchable
+}
+
+func addrIP(a ma.Multiaddr) (string, error) {
+	if ip, err := a.ValueForProtocol(ma.P_IP4); err == nil {
+		return ip, nil
+	}
+	return a.ValueForProtocol(ma.P_IP6)
+}
+
+// punch dials the addresses of the peer at once, and returns when any of them succeeds.
+func (sl *networkTransport) punch(ctx context.Context, pid peer.ID, addrs []ma.Multiaddr) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	addrs = sl.punchableAddrs(pid, addrs)
+	errC := make(chan error, len(addrs))
+	for _, a := range addrs {
+		go func(a ma.Multiaddr) {
+			errC <- sl.quic.punch(ctx, a, pid)
+		}(a)
+	}
+	err := errHolePunchNoAddr
+	for dials := len(addrs); dials > 0; dials-- {
+		if err = <-errC; err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (sl *networkTransport) hasDirectConn(pid peer.ID) bool {
+	for _, c := range sl.Network().ConnsToPeer(pid) {
+		if !isRelayAddr(c.RemoteMultiaddr()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (sl *networkTransport) closeRelayedConns(pid peer.ID) {
+	for _, c := range sl.Network().ConnsToPeer(pid) {
+		if isRelayAddr(c.RemoteMultiaddr()) {
+			c.Close()
+		}
+	}
+}
+
+// writeHolePunchMsg writes the type of message, followed by the addresses if it's connect.
+func writeHolePunchMsg(rw *bufio.ReadWriter, msgType byte, addrs []ma.Multiaddr) error {
+	rw.WriteByte(msgType)
+	if msgType == holePunchConnect {
+		binary.Write(rw, binary.BigEndian, uint16(len(addrs)))
+		for _, a := range addrs {
+			binary.Write(rw, binary.BigEndian, uint16(len(a.Bytes())))
+			rw.Write(a.Bytes())
+		}
+	}
+	return rw.Flush()
+}
+
+func readHolePunchConnect(rw *bufio.ReadWriter) ([]ma.Multiaddr, error) {
+	if msgType, err := rw.ReadByte(); err != nil {
+		return nil, err
+	} else if msgType != holePunchConnect {
+		return nil, errHolePunchMsg
+	}
+	var n uint16
+	if err := binary.Read(rw, binary.BigEndian, &n); err != nil {
+		return nil, err
+	}
+	if n > holePunchMaxAddrs {
+		return nil, errHolePunchMsg
+	}
+	addrs := make([]ma.Multiaddr, 0, n)
+	for i := 0; i < int(n); i++ {
+		var size uint16
+		if err := binary.Read(rw, binary.BigEndian, &size); err != nil {
+			return nil, err
+		}
+		b := make([]byte, size)
+		if _, err := io.ReadFull(rw, b); err != nil {
+			return nil, err
+		}
+		a, err := ma.NewMultiaddrBytes(b)
+		if err != nil {
+			return nil, errHolePunchMsg
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"context"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p"
+	circuit "github.com/libp2p/go-libp2p-circuit"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+// relayKeepInterval is the interval to check and reconnect the connections to relay nodes.
+const relayKeepInterval = time.Minute
+
+// relay is a relay node in configuration, and circuitAddr is the address through which other peers
+// connect to local node.
+type relay struct {
+	info        peer.AddrInfo
+	circuitAddr ma.Multiaddr
+}
+
+// natOptions returns the libp2p options for NAT traversal. The relay transport is enabled by default in
+// libp2p, so that local node can dial and accept connections through relay nodes.
+//
+// The libp2p in use has no hole punching service, so the connections through relay nodes are upgraded to
+// direct ones by upgradeRelayed, if both of the peers listen on QUIC.
+func (sl *networkTransport) natOptions() []libp2p.Option {
+	var opts []libp2p.Option
+	if sl.conf.NPNATPortMap {
+		opts = append(opts, libp2p.NATPortMap())
+	}
+	if sl.conf.NPRelayHop {
+		opts = append(opts, libp2p.EnableRelay(circuit.OptHop))
+	}
+	return opts
+}
+
+func (sl *networkTransport) initRelays() {
+	for _, addrStr := range sl.conf.NPRelays {
+		meta, err := p2putil.FromMultiAddrString(addrStr)
+		if err != nil {
+			sl.logger.Warn().Err(err).Str("addr", addrStr).Msg("invalid relay address")
+			continue
+		}
+		circuitAddr, err := ma.NewMultiaddr(addrStr + "/p2p-circuit")
+		if err != nil {
+			sl.logger.Warn().Err(err).Str("addr", addrStr).Msg("invalid relay address")
+			continue
+		}
+		sl.relays = append(sl.relays, relay{info: peer.AddrInfo{ID: meta.ID, Addrs: meta.Addresses}, circuitAddr: circuitAddr})
+	}
+}
+
+// keepRelays keeps local node connected to the relay nodes, since a relay node forwards connections only
+// to the nodes connected to it.
+func (sl *networkTransport) keepRelays() {
+	ticker := time.NewTicker(relayKeepInterval)
+	defer ticker.Stop()
+	for {
+		for _, r := range sl.relays {
+			if sl.Network().Connectedness(r.info.ID) == network.Connected {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), relayKeepInterval/2)
+			if err := sl.Connect(ctx, r.info); err != nil {
+				sl.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(r.info.ID)).Msg("failed to connect relay node")
+			}
+			cancel()
+		}
+		select {
+		case <-ticker.C:
+		case <-sl.closeC:
+			return
+		}
+	}
+}
+
+// ReachableAddrs returns the addresses which remote peers use to connect to local node. The address in
+// configuration comes first, and then the public addresses mapped on NAT device or observed by remote peers.
+// The guessed address at startup is used if no public address is found, and the addresses through relay
+// nodes are added in that case.
+func (sl *networkTransport) ReachableAddrs() []types.Multiaddr {
+	initial := sl.selfMeta.Addresses
+	addrs := make([]types.Multiaddr, 0, len(initial)+len(sl.relays))
+	if len(sl.conf.NetProtocolAddr) > 0 {
+		addrs = append(addrs, initial...)
+	}
+	foundPublic := false
+	if sl.Host != nil {
+		for _, a := range sl.Addrs() {
+			if !manet.IsPublicAddr(a) || isRelayAddr(a) || containsAddr(addrs, a) {
+				continue
+			}
+			addrs = append(addrs, a)
+			foundPublic = true
+		}
+	}
+	if !foundPublic {
+		if len(sl.conf.NetProtocolAddr) == 0 {
+			addrs = append(addrs, initial...)
+		}
+		for _, r := range sl.relays {
+			addrs = append(addrs, r.circuitAddr)
+		}
+	}
+	return addrs
+}
+
+func isRelayAddr(a ma.Multiaddr) bool {
+	_, err := a.ValueForProtocol(ma.P_CIRCUIT)
+	return err == nil
+}
+
+func containsAddr(addrs []types.Multiaddr, a ma.Multiaddr) bool {
+	for _, e := range addrs {
+		if e.Equal(a) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func Test_networkTransport_ReachableAddrs(t *testing.T) {
+	logger := log.NewLogger("test.transport")
+	svrctx := config.NewServerContext("", "")
+	meta := p2pcommon.NewMetaWith1Addr(types.RandomPeerID(), "192.168.0.2", 7846, "v2.0.0")
+	relayAddr := "/ip4/211.1.2.3/tcp/7846/p2p/16Uiu2HAmP2iRDpPumUbKhNnEngoxAUQWBmCyn7FaYUrkaDAMXJPJ"
+	withRelays := func(conf *config.P2PConfig, relays ...string) *config.P2PConfig {
+		conf.NPRelays = relays
+		return conf
+	}
+	withNetAddr := func(conf *config.P2PConfig, addr string) *config.P2PConfig {
+		conf.NetProtocolAddr = addr
+		return conf
+	}
+	tests := []struct {
+		name string
+		conf *config.P2PConfig
+
+		want []string
+	}{
+		{"TNoRelay", svrctx.GetDefaultP2PConfig(), []string{"/ip4/192.168.0.2/tcp/7846"}},
+		{"TRelay", withRelays(svrctx.GetDefaultP2PConfig(), relayAddr), []string{"/ip4/192.168.0.2/tcp/7846", relayAddr + "/p2p-circuit"}},
+		{"TInvalidRelay", withRelays(svrctx.GetDefaultP2PConfig(), "/ip4/211.1.2.3/tcp/7846"), []string{"/ip4/192.168.0.2/tcp/7846"}},
+		{"TConfigured", withRelays(withNetAddr(svrctx.GetDefaultP2PConfig(), "192.168.0.2"), relayAddr), []string{"/ip4/192.168.0.2/tcp/7846", relayAddr + "/p2p-circuit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl := &networkTransport{conf: tt.conf, logger: logger, selfMeta: meta}
+			sl.initRelays()
+
+			got := sl.ReachableAddrs()
+			if len(got) != len(tt.want) {
+				t.Fatalf("ReachableAddrs() = %v, want %v", got, tt.want)
+			}
+			for i, a := range got {
+				if want, _ := types.ParseMultiaddr(tt.want[i]); !a.Equal(want) {
+					t.Errorf("ReachableAddrs()[%d] = %v, want %v", i, a, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_networkTransport_upgradeRelayed(t *testing.T) {
+	both := []string{p2pcommon.TransportTCP, p2pcommon.TransportQUIC}
+	tests := []struct {
+		name             string
+		transports       []string
+		targetTransports []string
+
+		wantDirect bool
+		wantRetry  bool
+	}{
+		{"TQUIC", both, both, true, false},
+		{"TTCPOnly", []string{p2pcommon.TransportTCP}, both, false, false},
+		{"TTargetTCPOnly", both, []string{p2pcommon.TransportTCP}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := config.NewServerContext("", "").GetDefaultP2PConfig()
+			conf.NPTransports = both
+			conf.NPRelayHop = true
+			relay := newListeningTransportWith(t, conf)
+			defer relay.Stop()
+			target := newListeningTransport(t, tt.targetTransports)
+			defer target.Stop()
+			dialer := newListeningTransport(t, tt.transports)
+			defer dialer.Stop()
+			target.SetStreamHandler(testProtocolID, func(s network.Stream) {
+				defer s.Close()
+				io.Copy(s, s)
+			})
+
+			// the target is connected to the relay node, as keepRelays does
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := target.Connect(ctx, peer.AddrInfo{ID: relay.ID(), Addrs: relay.Addrs()}); err != nil {
+				t.Fatal(err)
+			}
+			var circuitAddr ma.Multiaddr
+			for _, a := range relay.Addrs() {
+				if _, err := a.ValueForProtocol(transportCode(tt.transports[len(tt.transports)-1])); err == nil {
+					circuitAddr = a.Encapsulate(ma.StringCast("/p2p/" + peer.IDB58Encode(relay.ID()) + "/p2p-circuit"))
+					break
+				}
+			}
+
+			meta := p2pcommon.PeerMeta{ID: target.ID(), Addresses: []ma.Multiaddr{circuitAddr}}
+			s, err := dialer.GetOrCreateStreamWithTTL(meta, time.Minute, testProtocolID)
+			if err != nil {
+				t.Fatalf("GetOrCreateStreamWithTTL() error = %v", err)
+			}
+			defer s.Close()
+			// the stream is opened on the connection through relay, which is upgraded in background
+			if !isRelayAddr(s.Conn().RemoteMultiaddr()) {
+				t.Errorf("stream is opened on %v, want relay", s.Conn().RemoteMultiaddr())
+			}
+			waitHolePunch(t, dialer, target.ID())
+			if retry, exist := dialer.punches[target.ID()]; exist != tt.wantRetry {
+				t.Errorf("failure of hole punching is recorded %v, want %v", exist, tt.wantRetry)
+			} else if exist {
+				// the peer is not punched again until the backoff is over
+				dialer.tryUpgradeRelayed(target.ID())
+				if retry.running || retry.failures != 1 || !retry.retryAt.After(time.Now()) {
+					t.Errorf("hole punching is retried before backoff: %+v", *retry)
+				}
+			}
+			if dialer.hasDirectConn(target.ID()) != tt.wantDirect {
+				t.Errorf("hasDirectConn() = %v, want %v", !tt.wantDirect, tt.wantDirect)
+			}
+			for _, c := range dialer.Network().ConnsToPeer(target.ID()) {
+				if tt.wantDirect && isRelayAddr(c.RemoteMultiaddr()) {
+					t.Errorf("connection through relay %v is not closed", c.RemoteMultiaddr())
+				}
+			}
+
+			s, err = dialer.GetOrCreateStreamWithTTL(meta, time.Minute, testProtocolID)
+			if err != nil {
+				t.Fatalf("GetOrCreateStreamWithTTL() error = %v", err)
+			}
+			defer s.Close()
+			if isRelayAddr(s.Conn().RemoteMultiaddr()) == tt.wantDirect {
+				t.Errorf("stream is opened on %v, want direct %v", s.Conn().RemoteMultiaddr(), tt.wantDirect)
+			}
+			s.SetDeadline(time.Now().Add(5 * time.Second))
+			sent := []byte("ping")
+			if _, err := s.Write(sent); err != nil {
+				t.Fatal(err)
+			}
+			got := make([]byte, len(sent))
+			if _, err := io.ReadFull(s, got); err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(sent) {
+				t.Errorf("echo = %s, want %s", got, sent)
+			}
+		})
+	}
+}
+
+// waitHolePunch waits until the hole punching to the peer in background is done.
+func waitHolePunch(t *testing.T, sl *networkTransport, pid peer.ID) {
+	for deadline := time.Now().Add(holePunchTimeout + 5*time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		sl.punchMutex.Lock()
+		st, exist := sl.punches[pid]
+		running := exist && st.running
+		sl.punchMutex.Unlock()
+		if !running {
+			return
+		}
+	}
+	t.Fatal("hole punching is not done")
+}
+
+func Test_networkTransport_punchableAddrs(t *testing.T) {
+	sl := newListeningTransport(t, []string{p2pcommon.TransportTCP, p2pcommon.TransportQUIC})
+	defer sl.Stop()
+	pid := peer.ID("remote")
+	sl.Peerstore().AddAddrs(pid, []ma.Multiaddr{ma.StringCast("/ip4/192.168.0.2/tcp/7846"), ma.StringCast("/ip4/203.0.113.7/tcp/7846/p2p-circuit")}, time.Minute)
+
+	addrs := []ma.Multiaddr{
+		ma.StringCast("/ip4/192.168.0.2/udp/7846/aergo-quic"),
+		ma.StringCast("/ip4/192.168.0.2/tcp/7846"),
+		ma.StringCast("/ip4/0.0.0.0/udp/7846/aergo-quic"),
+		// the peer is known at this IP only through relay
+		ma.StringCast("/ip4/203.0.113.7/udp/7846/aergo-quic"),
+		ma.StringCast("/ip4/198.51.100.1/udp/7846/aergo-quic"),
+	}
+	got := sl.punchableAddrs(pid, addrs)
+	if len(got) != 1 || !got[0].Equal(addrs[0]) {
+		t.Errorf("punchableAddrs() = %v, want %v", got, addrs[:1])
+	}
+}
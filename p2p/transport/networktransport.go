@@ -7,10 +7,12 @@ package transport
 
 import (
 	"context"
+	"fmt"
 	network2 "github.com/aergoio/aergo/internal/network"
 	"github.com/aergoio/aergo/types"
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"sync"
 	"time"
 
@@ -24,7 +26,6 @@ import (
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/libp2p/go-libp2p"
 	pstore "github.com/libp2p/go-libp2p-peerstore"
-	ma "github.com/multiformats/go-multiaddr"
 )
 
 /**
@@ -34,10 +35,20 @@ import (
 type networkTransport struct {
 	core.Host
 	privateKey crypto.PrivKey
+	is         p2pcommon.InternalService
 
+	// selfMeta is the meta at startup, and the addresses of it can be updated later.
 	selfMeta    p2pcommon.PeerMeta
 	bindAddress string
 	bindPort    uint32
+	relays      []relay
+	closeC      chan struct{}
+	closeOnce   sync.Once
+
+	// quic is the QUIC transport if local node listens on QUIC, which punches holes of NAT.
+	quic       *quicTransport
+	punchMutex sync.Mutex
+	punches    map[peer.ID]*holePunchState
 
 	// hostInited is
 	hostInited *sync.WaitGroup
@@ -49,7 +60,7 @@ type networkTransport struct {
 var _ p2pcommon.NetworkTransport = (*networkTransport)(nil)
 
 func (sl *networkTransport) SelfMeta() p2pcommon.PeerMeta {
-	return sl.selfMeta
+	return sl.is.SelfMeta()
 }
 
 func NewNetworkTransport(conf *cfg.P2PConfig, logger *log.Logger, internalService p2pcommon.InternalService) *networkTransport {
@@ -58,6 +69,7 @@ func NewNetworkTransport(conf *cfg.P2PConfig, logger *log.Logger, internalServic
 		logger: logger,
 
 		hostInited: &sync.WaitGroup{},
+		closeC:     make(chan struct{}),
 	}
 	nt.initNT(internalService)
 
@@ -69,15 +81,14 @@ func NewNetworkTransport(conf *cfg.P2PConfig, logger *log.Logger, internalServic
 func (sl *networkTransport) initNT(internalService p2pcommon.InternalService) {
 	// check Key and address
 	sl.privateKey = p2pkey.NodePrivKey()
+	sl.is = internalService
 	sl.selfMeta = internalService.SelfMeta()
 	// init address and port
 	// if not set, it look up ip addresses of machine and choose suitable one (but not so smart) and default port 7845
 	sl.initServiceBindAddress()
+	sl.initRelays()
 
 	sl.hostInited.Add(1)
-
-	// set meta info
-	// TODO more survey libp2p NAT configuration
 }
 
 func (sl *networkTransport) initServiceBindAddress() {
@@ -105,6 +116,9 @@ func (sl *networkTransport) Start() error {
 	sl.logger.Debug().Msg("Starting network transport")
 	sl.startListener()
 	sl.hostInited.Done()
+	if len(sl.relays) > 0 {
+		go sl.keepRelays()
+	}
 	return nil
 }
 
@@ -119,13 +133,18 @@ func (sl *networkTransport) GetOrCreateStreamWithTTL(meta p2pcommon.PeerMeta, tt
 	var peerAddr = meta.Addresses[0]
 	var peerID = meta.ID
 	sl.logger.Debug().Str("peerAddr",peerAddr.String()).Str(p2putil.LogPeerID,p2putil.ShortForm(peerID)).Msg("connecting to peer")
-	sl.Peerstore().AddAddr(peerID, peerAddr, ttl)
+	// all addresses are added, since the peer behind NAT may be reachable only through relay address
+	sl.Peerstore().AddAddrs(peerID, meta.Addresses, ttl)
 	ctx := context.Background()
 	s, err := sl.NewStream(ctx, peerID, protocolIDs...)
 	if err != nil {
 		sl.logger.Info().Err(err).Str("addr", peerAddr.String()).Str(p2putil.LogPeerID, p2putil.ShortForm(meta.ID)).Str("p2p_proto", p2putil.ProtocolIDsToString(protocolIDs)).Msg("Error while get stream")
 		return nil, err
 	}
+	// the connection through a relay node is upgraded to the direct one in background
+	if isRelayAddr(s.Conn().RemoteMultiaddr()) {
+		sl.tryUpgradeRelayed(peerID)
+	}
 	return s, nil
 }
 
@@ -163,26 +182,70 @@ func (sl *networkTransport) ClosePeerConnection(peerID types.PeerID) bool {
 //}
 
 func (sl *networkTransport) startListener() {
-	var err error
-	listens := make([]ma.Multiaddr, 0, 2)
-	listen, err := types.ToMultiAddr(sl.bindAddress, sl.bindPort)
+	listens, err := p2pcommon.TransportAddrs(sl.conf.NPTransports, sl.bindAddress, sl.bindPort)
 	if err != nil {
 		panic("Can't establish listening address: " + err.Error())
 	}
-	listens = append(listens, listen)
+
+	transportOpts, err := sl.transportOptions()
+	if err != nil {
+		sl.logger.Fatal().Err(err).Strs("transports", sl.conf.NPTransports).Msg("Couldn't set up transports")
+		panic(err.Error())
+	}
 
 	peerStore := pstore.NewPeerstore(pstoremem.NewKeyBook(), pstoremem.NewAddrBook(), pstoremem.NewProtoBook(),pstoremem.NewPeerMetadata())
 
-	newHost, err := libp2p.New(context.Background(), libp2p.Identity(sl.privateKey), libp2p.Peerstore(peerStore), libp2p.ListenAddrs(listens...))
+	opts := append([]libp2p.Option{libp2p.Identity(sl.privateKey), libp2p.Peerstore(peerStore), libp2p.ListenAddrs(listens...)}, transportOpts...)
+	opts = append(opts, sl.natOptions()...)
+	newHost, err := libp2p.New(context.Background(), opts...)
 	if err != nil {
-		sl.logger.Fatal().Err(err).Str("addr", listen.String()).Msg("Couldn't listen from")
+		sl.logger.Fatal().Err(err).Str("addr", listens[0].String()).Msg("Couldn't listen from")
 		panic(err.Error())
 	}
 	sl.Host = newHost
+	if sl.quic != nil {
+		sl.SetStreamHandler(holePunchProtocolID, sl.handleHolePunch)
+	}
 	sl.logger.Info().Str(p2putil.LogFullID, sl.ID().Pretty()).Str(p2putil.LogPeerID, p2putil.ShortForm(sl.ID())).Str("addr[0]", listens[0].String()).Msg("Set self node's pid, and listening for connections")
 }
 
+// transportOptions returns the libp2p options of transports. The default transports of libp2p are always
+// included so that local node can dial the peers listening on tcp, even if it listens on QUIC only.
+func (sl *networkTransport) transportOptions() ([]libp2p.Option, error) {
+	for _, t := range sl.conf.NPTransports {
+		if t == p2pcommon.TransportQUIC {
+			// QUIC carries each stream independently on udp, so a lost packet doesn't stall the others.
+			quic, err := newQUICTransport(sl.privateKey)
+			if err != nil {
+				return nil, err
+			}
+			sl.quic = quic
+			return []libp2p.Option{libp2p.DefaultTransports, libp2p.Transport(quic)}, nil
+		}
+	}
+	// libp2p uses the default transports if none is given
+	return nil, nil
+}
+
+// CheckTransports returns an error if a transport in configuration is unknown, or QUIC is not enabled as
+// experimental one, so that the node refuses the configuration before it starts.
+func CheckTransports(conf *cfg.P2PConfig) error {
+	for _, t := range conf.NPTransports {
+		switch t {
+		case p2pcommon.TransportTCP:
+		case p2pcommon.TransportQUIC:
+			if !conf.NPExperimentalQUIC {
+				return fmt.Errorf("transport %s is experimental and not enabled", t)
+			}
+		default:
+			return fmt.Errorf("unknown transport %s", t)
+		}
+	}
+	return nil
+}
+
 func (sl *networkTransport) Stop() error {
+	sl.closeOnce.Do(func() { close(sl.closeC) })
 	return sl.Host.Close()
 }
 
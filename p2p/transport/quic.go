@@ -0,0 +1,541 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/mux"
+	"github.com/libp2p/go-libp2p-core/peer"
+	tpt "github.com/libp2p/go-libp2p-core/transport"
+	libp2ptls "github.com/libp2p/go-libp2p-tls"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+	"github.com/whyrusleeping/mafmt"
+	"golang.org/x/net/quic"
+)
+
+const (
+	quicHandshakeTimeout = 10 * time.Second
+	quicIdleTimeout      = 30 * time.Second
+	quicKeepAlivePeriod  = 10 * time.Second
+	// quicAuthMaxSize is the maximum size of the authentication of a dialing peer.
+	quicAuthMaxSize = 4096
+	// quicALPN is the application protocol of TLS, by which the QUIC peers of other implementations fail
+	// at the handshake.
+	quicALPN = "aergo-quic/1"
+	// quicAuthLabel is the label of keying material exported from the TLS session, which the dialing peer
+	// signs.
+	quicAuthLabel     = "EXPORTER-aergo-quic-auth"
+	quicAuthKeyLength = 32
+)
+
+// quicAuthPrefix is prepended to the keying material of the TLS session, which the dialing peer signs.
+var quicAuthPrefix = []byte("aergo-quic-auth:")
+
+var quicFormat = mafmt.And(mafmt.UDP, mafmt.Base(types.ProtocolAergoQUIC))
+
+var (
+	errQUICNoPeerKey    = errors.New("quic: no public key of remote peer")
+	errQUICBadAuth      = errors.New("quic: invalid authentication of remote peer")
+	errQUICNotListening = errors.New("quic: not listening on the network of remote peer")
+)
+
+// quicTransport is the experimental libp2p transport over QUIC. The TLS handshake of QUIC authenticates
+// the accepting peer by the libp2p certificate of its key. The dialing peer is authenticated by the first
+// stream of the connection, which carries its public key and its signature over the keying material
+// exported from the TLS session, since the QUIC implementation doesn't expose the certificate of client to
+// the server. The signature is bound to the connection, so that it can't be replayed on another one.
+type quicTransport struct {
+	privKey   crypto.PrivKey
+	localPeer peer.ID
+	identity  *libp2ptls.Identity
+
+	mutex sync.Mutex
+	// listeners are used to dial the peers too, so that the peers see the listening port of local node.
+	listeners []*quicListener
+	dialers   map[string]*quic.Endpoint
+}
+
+func newQUICTransport(key crypto.PrivKey) (*quicTransport, error) {
+	localPeer, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := libp2ptls.NewIdentity(key)
+	if err != nil {
+		return nil, err
+	}
+	return &quicTransport{privKey: key, localPeer: localPeer, identity: identity, dialers: make(map[string]*quic.Endpoint)}, nil
+}
+
+func (t *quicTransport) config(tlsConf *tls.Config) *quic.Config {
+	tlsConf.NextProtos = []string{quicALPN}
+	return &quic.Config{
+		TLSConfig:        tlsConf,
+		HandshakeTimeout: quicHandshakeTimeout,
+		MaxIdleTimeout:   quicIdleTimeout,
+		KeepAlivePeriod:  quicKeepAlivePeriod,
+	}
+}
+
+func (t *quicTransport) CanDial(addr ma.Multiaddr) bool {
+	return quicFormat.Matches(addr)
+}
+
+func (t *quicTransport) Protocols() []int {
+	return []int{types.ProtocolAergoQUIC}
+}
+
+func (t *quicTransport) Proxy() bool {
+	return false
+}
+
+func (t *quicTransport) Dial(ctx context.Context, raddr ma.Multiaddr, p peer.ID) (tpt.CapableConn, error) {
+	network, host, err := quicDialArgs(raddr)
+	if err != nil {
+		return nil, err
+	}
+	ep, err := t.endpoint(network)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConf, keyC := t.identity.ConfigForPeer(p)
+	conn, err := ep.Dial(ctx, network, host, t.config(tlsConf))
+	if err != nil {
+		return nil, err
+	}
+	remotePubKey := <-keyC
+	if remotePubKey == nil {
+		conn.Abort(errQUICNoPeerKey)
+		return nil, errQUICNoPeerKey
+	}
+	if err := t.sendAuth(ctx, conn); err != nil {
+		conn.Abort(err)
+		return nil, err
+	}
+	return newQUICConn(t, conn, p, remotePubKey)
+}
+
+// punch dials the peer from the listening endpoint, and passes the connection to the swarm through the
+// listener, since the swarm returns the existing connection through a relay node instead of dialing again.
+// The packets sent by the dial open the NAT device of local node to the peer, which dials local node at
+// the same time.
+func (t *quicTransport) punch(ctx context.Context, raddr ma.Multiaddr, p peer.ID) error {
+	network, _, err := quicDialArgs(raddr)
+	if err != nil {
+		return err
+	}
+	l := t.listener(network)
+	if l == nil {
+		return errQUICNotListening
+	}
+	c, err := t.Dial(ctx, raddr, p)
+	if err != nil {
+		return err
+	}
+	select {
+	case l.acceptC <- c.(*quicConn):
+		return nil
+	case <-l.ctx.Done():
+		c.Close()
+		return l.ctx.Err()
+	}
+}
+
+// sendAuth authenticates local peer on the first stream of conn.
+func (t *quicTransport) sendAuth(ctx context.Context, conn *quic.Conn) error {
+	keyingMaterial, err := quicKeyingMaterial(conn)
+	if err != nil {
+		return err
+	}
+	return t.writeAuth(ctx, conn, keyingMaterial)
+}
+
+func (t *quicTransport) writeAuth(ctx context.Context, conn *quic.Conn, keyingMaterial []byte) error {
+	pubKey, err := crypto.MarshalPublicKey(t.privKey.GetPublic())
+	if err != nil {
+		return err
+	}
+	sig, err := t.privKey.Sign(append(append([]byte{}, quicAuthPrefix...), keyingMaterial...))
+	if err != nil {
+		return err
+	}
+	s, err := conn.NewStream(ctx)
+	if err != nil {
+		return err
+	}
+	s.SetWriteContext(ctx)
+	buf := &bytes.Buffer{}
+	for _, b := range [][]byte{pubKey, sig} {
+		binary.Write(buf, binary.BigEndian, uint16(len(b)))
+		buf.Write(b)
+	}
+	if _, err := s.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	s.CloseWrite()
+	return nil
+}
+
+// endpoint returns the endpoint to dial on network. The dialing endpoint is created at the first dial if
+// local node doesn't listen on network.
+func (t *quicTransport) endpoint(network string) (*quic.Endpoint, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if l := t.listenerLocked(network); l != nil {
+		return l.endpoint, nil
+	}
+	if ep, exist := t.dialers[network]; exist {
+		return ep, nil
+	}
+	ep, err := quic.Listen(network, ":0", nil)
+	if err != nil {
+		return nil, err
+	}
+	t.dialers[network] = ep
+	return ep, nil
+}
+
+func (t *quicTransport) Listen(laddr ma.Multiaddr) (tpt.Listener, error) {
+	network, host, err := quicDialArgs(laddr)
+	if err != nil {
+		return nil, err
+	}
+	// the dialing peer is authenticated by the first stream, not by the TLS handshake
+	tlsConf, _ := t.identity.ConfigForAny()
+	tlsConf.ClientAuth = tls.NoClientCert
+	tlsConf.VerifyPeerCertificate = nil
+	ep, err := quic.Listen(network, host, t.config(tlsConf))
+	if err != nil {
+		return nil, err
+	}
+	laddr, err = quicMultiaddr(ep.LocalAddr())
+	if err != nil {
+		ep.Close(context.Background())
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &quicListener{
+		transport: t,
+		network:   network,
+		endpoint:  ep,
+		laddr:     laddr,
+		ctx:       ctx,
+		cancel:    cancel,
+		acceptC:   make(chan *quicConn),
+	}
+	t.mutex.Lock()
+	t.listeners = append(t.listeners, l)
+	t.mutex.Unlock()
+	go l.acceptLoop()
+	return l, nil
+}
+
+func (t *quicTransport) listener(network string) *quicListener {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.listenerLocked(network)
+}
+
+func (t *quicTransport) listenerLocked(network string) *quicListener {
+	for _, l := range t.listeners {
+		if l.network == network {
+			return l
+		}
+	}
+	return nil
+}
+
+func (t *quicTransport) removeListener(l *quicListener) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for i, e := range t.listeners {
+		if e == l {
+			t.listeners = append(t.listeners[:i], t.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+type quicListener struct {
+	transport *quicTransport
+	network   string
+	endpoint  *quic.Endpoint
+	laddr     ma.Multiaddr
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	acceptC chan *quicConn
+}
+
+func (l *quicListener) acceptLoop() {
+	for {
+		conn, err := l.endpoint.Accept(l.ctx)
+		if err != nil {
+			return
+		}
+		go l.authenticate(conn)
+	}
+}
+
+// authenticate reads the authentication of the dialing peer from the first stream of conn, and passes the
+// connection to Accept if it's valid.
+func (l *quicListener) authenticate(conn *quic.Conn) {
+	ctx, cancel := context.WithTimeout(l.ctx, quicHandshakeTimeout)
+	defer cancel()
+	c, err := l.receiveAuth(ctx, conn)
+	if err != nil {
+		conn.Abort(err)
+		return
+	}
+	select {
+	case l.acceptC <- c:
+	case <-l.ctx.Done():
+		c.Close()
+	}
+}
+
+func (l *quicListener) receiveAuth(ctx context.Context, conn *quic.Conn) (*quicConn, error) {
+	s, err := conn.AcceptStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.SetReadContext(ctx)
+	data, err := ioutil.ReadAll(io.LimitReader(s, quicAuthMaxSize))
+	s.CloseRead()
+	if err != nil {
+		return nil, err
+	}
+	var fields [][]byte
+	for i := 0; i < 2; i++ {
+		if len(data) < 2 {
+			return nil, errQUICBadAuth
+		}
+		size := int(binary.BigEndian.Uint16(data))
+		if len(data) < 2+size {
+			return nil, errQUICBadAuth
+		}
+		fields, data = append(fields, data[2:2+size]), data[2+size:]
+	}
+	pubKey, err := crypto.UnmarshalPublicKey(fields[0])
+	if err != nil {
+		return nil, errQUICBadAuth
+	}
+	// the data of stream is readable after the handshake is done, so that the keying material is ready
+	keyingMaterial, err := quicKeyingMaterial(conn)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := pubKey.Verify(append(append([]byte{}, quicAuthPrefix...), keyingMaterial...), fields[1]); err != nil || !ok {
+		return nil, errQUICBadAuth
+	}
+	remotePeer, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return newQUICConn(l.transport, conn, remotePeer, pubKey)
+}
+
+func (l *quicListener) Accept() (tpt.CapableConn, error) {
+	select {
+	case c := <-l.acceptC:
+		return c, nil
+	case <-l.ctx.Done():
+		return nil, l.ctx.Err()
+	}
+}
+
+func (l *quicListener) Close() error {
+	l.cancel()
+	l.transport.removeListener(l)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	l.endpoint.Close(ctx)
+	return nil
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return net.UDPAddrFromAddrPort(l.endpoint.LocalAddr())
+}
+
+func (l *quicListener) Multiaddr() ma.Multiaddr {
+	return l.laddr
+}
+
+// quicConn is a QUIC connection to an authenticated peer. Every libp2p stream is a QUIC stream.
+type quicConn struct {
+	transport    *quicTransport
+	conn         *quic.Conn
+	remotePeer   peer.ID
+	remotePubKey crypto.PubKey
+	laddr, raddr ma.Multiaddr
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	closed int32
+}
+
+func newQUICConn(t *quicTransport, conn *quic.Conn, remotePeer peer.ID, remotePubKey crypto.PubKey) (*quicConn, error) {
+	laddr, err := quicMultiaddr(conn.LocalAddr())
+	if err != nil {
+		conn.Abort(err)
+		return nil, err
+	}
+	raddr, err := quicMultiaddr(conn.RemoteAddr())
+	if err != nil {
+		conn.Abort(err)
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &quicConn{transport: t, conn: conn, remotePeer: remotePeer, remotePubKey: remotePubKey, laddr: laddr, raddr: raddr, ctx: ctx, cancel: cancel}
+	go func() {
+		conn.Wait(ctx)
+		c.Close()
+	}()
+	return c, nil
+}
+
+func (c *quicConn) Close() error {
+	if atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
+		c.cancel()
+		c.conn.Abort(nil)
+	}
+	return nil
+}
+
+func (c *quicConn) IsClosed() bool {
+	return atomic.LoadInt32(&c.closed) != 0
+}
+
+func (c *quicConn) OpenStream() (mux.MuxedStream, error) {
+	s, err := c.conn.NewStream(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quicStream{stream: s}, nil
+}
+
+func (c *quicConn) AcceptStream() (mux.MuxedStream, error) {
+	s, err := c.conn.AcceptStream(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quicStream{stream: s}, nil
+}
+
+func (c *quicConn) LocalPeer() peer.ID              { return c.transport.localPeer }
+func (c *quicConn) LocalPrivateKey() crypto.PrivKey { return c.transport.privKey }
+func (c *quicConn) RemotePeer() peer.ID             { return c.remotePeer }
+func (c *quicConn) RemotePublicKey() crypto.PubKey  { return c.remotePubKey }
+func (c *quicConn) LocalMultiaddr() ma.Multiaddr    { return c.laddr }
+func (c *quicConn) RemoteMultiaddr() ma.Multiaddr   { return c.raddr }
+func (c *quicConn) Transport() tpt.Transport        { return c.transport }
+
+// quicStream adapts a QUIC stream to libp2p. The writes are flushed at once, and the deadlines are set by
+// the contexts of QUIC stream.
+type quicStream struct {
+	stream *quic.Stream
+
+	mutex                   sync.Mutex
+	cancelRead, cancelWrite context.CancelFunc
+}
+
+func (s *quicStream) Read(b []byte) (int, error) {
+	return s.stream.Read(b)
+}
+
+func (s *quicStream) Write(b []byte) (int, error) {
+	n, err := s.stream.Write(b)
+	if err != nil {
+		return n, err
+	}
+	return n, s.stream.Flush()
+}
+
+// Close closes the stream for writing, as libp2p does.
+func (s *quicStream) Close() error {
+	s.stream.CloseWrite()
+	return nil
+}
+
+func (s *quicStream) Reset() error {
+	s.stream.Reset(0)
+	s.stream.CloseRead()
+	return nil
+}
+
+func (s *quicStream) SetDeadline(t time.Time) error {
+	s.SetReadDeadline(t)
+	return s.SetWriteDeadline(t)
+}
+
+func (s *quicStream) SetReadDeadline(t time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stream.SetReadContext(deadlineContext(t, &s.cancelRead))
+	return nil
+}
+
+func (s *quicStream) SetWriteDeadline(t time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stream.SetWriteContext(deadlineContext(t, &s.cancelWrite))
+	return nil
+}
+
+// deadlineContext returns the context which expires at t, or never if t is zero. The context set before is
+// cancelled by cancel.
+func deadlineContext(t time.Time, cancel *context.CancelFunc) context.Context {
+	if *cancel != nil {
+		(*cancel)()
+		*cancel = nil
+	}
+	if t.IsZero() {
+		return context.Background()
+	}
+	ctx, c := context.WithDeadline(context.Background(), t)
+	*cancel = c
+	return ctx
+}
+
+// quicKeyingMaterial returns the keying material exported from the TLS session of conn, which is the same
+// in both of the peers.
+func quicKeyingMaterial(conn *quic.Conn) ([]byte, error) {
+	state := conn.ConnectionState()
+	return state.ExportKeyingMaterial(quicAuthLabel, nil, quicAuthKeyLength)
+}
+
+// quicDialArgs returns the network and the address of udp in the QUIC address.
+func quicDialArgs(addr ma.Multiaddr) (string, string, error) {
+	udpAddr, last := ma.SplitLast(addr)
+	if last == nil || last.Protocol().Code != types.ProtocolAergoQUIC || udpAddr == nil {
+		return "", "", errors.New("not an aergo-quic address: " + addr.String())
+	}
+	return manet.DialArgs(udpAddr)
+}
+
+func quicMultiaddr(addr netip.AddrPort) (ma.Multiaddr, error) {
+	udpAddr, err := manet.FromNetAddr(net.UDPAddrFromAddrPort(netip.AddrPortFrom(addr.Addr().Unmap(), addr.Port())))
+	if err != nil {
+		return nil, err
+	}
+	return udpAddr.Encapsulate(ma.StringCast("/" + ma.ProtocolWithCode(types.ProtocolAergoQUIC).Name)), nil
+}
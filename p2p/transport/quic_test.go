@@ -0,0 +1,136 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	tpt "github.com/libp2p/go-libp2p-core/transport"
+	ma "github.com/multiformats/go-multiaddr"
+	"golang.org/x/net/quic"
+)
+
+func newTestQUICTransport(t *testing.T) *quicTransport {
+	key, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qt, err := newQUICTransport(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return qt
+}
+
+func TestQUICTransport_authenticate(t *testing.T) {
+	server, client, other := newTestQUICTransport(t), newTestQUICTransport(t), newTestQUICTransport(t)
+	l, err := server.Listen(ma.StringCast("/ip4/127.0.0.1/udp/0/aergo-quic"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if !client.CanDial(l.Multiaddr()) || client.CanDial(ma.StringCast("/ip4/127.0.0.1/tcp/7846")) || client.CanDial(ma.StringCast("/ip4/127.0.0.1/udp/7846/quic")) {
+		t.Fatalf("CanDial() is wrong for %v", l.Multiaddr())
+	}
+	accepted := make(chan tpt.CapableConn, 2)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- c
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// the certificate of the listening peer must match the dialed peer
+	if _, err := client.Dial(ctx, l.Multiaddr(), other.localPeer); err == nil {
+		t.Errorf("Dial() to wrong peer succeeded")
+	}
+	// the dialing peer must sign the keying material of the connection, not of another one
+	forged, err := other.endpoint("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conns []*quic.Conn
+	for i := 0; i < 2; i++ {
+		tlsConf, _ := other.identity.ConfigForPeer(server.localPeer)
+		conn, err := forged.Dial(ctx, "udp4", l.Addr().String(), other.config(tlsConf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Abort(nil)
+		conns = append(conns, conn)
+	}
+	replayed, err := quicKeyingMaterial(conns[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.writeAuth(ctx, conns[1], replayed); err != nil {
+		t.Fatal(err)
+	}
+	if err := conns[1].Wait(ctx); err == nil || err == ctx.Err() {
+		t.Errorf("forged connection is not closed: %v", err)
+	}
+
+	c, err := client.Dial(ctx, l.Multiaddr(), server.localPeer)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer c.Close()
+	var sc tpt.CapableConn
+	select {
+	case sc = <-accepted:
+	case <-ctx.Done():
+		t.Fatal("connection is not accepted")
+	}
+	defer sc.Close()
+	for _, tt := range []struct {
+		conn   tpt.CapableConn
+		remote peer.ID
+	}{{c, server.localPeer}, {sc, client.localPeer}} {
+		if tt.conn.RemotePeer() != tt.remote {
+			t.Errorf("RemotePeer() = %v, want %v", tt.conn.RemotePeer(), tt.remote)
+		}
+		if id, err := peer.IDFromPublicKey(tt.conn.RemotePublicKey()); err != nil || id != tt.remote {
+			t.Errorf("RemotePublicKey() is of %v, want %v", id, tt.remote)
+		}
+	}
+
+	s, err := c.OpenStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := []byte("ping")
+	if _, err := s.Write(sent); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	ss, err := sc.AcceptStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss.SetReadDeadline(time.Now().Add(5 * time.Second))
+	got, err := ioutil.ReadAll(ss)
+	if err != nil || string(got) != string(sent) {
+		t.Errorf("read %s, %v, want %s", got, err, sent)
+	}
+
+	c.Close()
+	if !c.IsClosed() {
+		t.Errorf("IsClosed() = false after Close()")
+	}
+	if _, err := sc.AcceptStream(); err == nil {
+		t.Errorf("AcceptStream() succeeded on closed connection")
+	}
+}
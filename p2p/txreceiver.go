@@ -26,6 +26,7 @@ type GetTxsReceiver struct {
 	peer  p2pcommon.RemotePeer
 	actor p2pcommon.ActorService
 	sm    p2pcommon.SyncManager
+	rm    p2pcommon.ReputationManager
 
 	ids    []types.TxID
 	hashes [][]byte
@@ -41,7 +42,7 @@ type GetTxsReceiver struct {
 	senderFinished chan interface{}
 }
 
-func NewGetTxsReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, sm p2pcommon.SyncManager, logger *log.Logger, txIDs []types.TxID, ttl time.Duration) *GetTxsReceiver {
+func NewGetTxsReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, sm p2pcommon.SyncManager, rm p2pcommon.ReputationManager, logger *log.Logger, txIDs []types.TxID, ttl time.Duration) *GetTxsReceiver {
 	timeout := time.Now().Add(ttl)
 	ids := make([]types.TxID, len(txIDs))
 	hashes := make([][]byte, len(txIDs))
@@ -49,7 +50,7 @@ func NewGetTxsReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer,
 		ids[i] = txIDs[i]
 		hashes[i] = ids[i][:]
 	}
-	return &GetTxsReceiver{actor: actor, peer: peer, sm: sm, ids:ids, hashes: hashes, timeout: timeout,logger:logger}
+	return &GetTxsReceiver{actor: actor, peer: peer, sm: sm, rm: rm, ids:ids, hashes: hashes, timeout: timeout,logger:logger}
 }
 
 func (br *GetTxsReceiver) StartGet() {
@@ -88,6 +89,7 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 	// timeout
 	if br.timeout.Before(time.Now()) {
 		// silently ignore already status job
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ResponseTimeout, "getTXs response")
 		br.finishReceiver()
 		return
 	}
@@ -124,12 +126,13 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 				return
 			}
 		}
-		br.actor.SendRequest(message.MemPoolSvc, &message.MemPoolPut{Tx: tx})
+		br.actor.SendRequest(message.MemPoolSvc, &message.MemPoolPut{Tx: tx, From: br.peer.ID()})
 		br.sent++
 		br.offset++
 	}
 	// remote peer hopefully sent last chunk
 	if !body.HasNext {
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.UsefulResponse, "getTXs response")
 		br.finishReceiver()
 	}
 	return
@@ -139,6 +142,9 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 // not all part of response is received, it wait remaining (and useless) response. It is assumed cancelling is not frequently occur
 func (br *GetTxsReceiver) cancelReceiving(err error, hasNext bool) {
 	br.status = receiverStatusCanceled
+	if err != message.RemotePeerFailError {
+		reportPeer(br.rm, br.peer.ID(), p2pcommon.ProtocolViolation, "getTXs response: "+err.Error())
+	}
 	br.logger.Info().Str(p2putil.LogOrgReqID,br.requestID.String()).Err(err).Msg("tx receiver canceled by error")
 	// check time again. since negative duration of timer will not fire channel.
 	interval := br.timeout.Sub(time.Now())
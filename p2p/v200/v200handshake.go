@@ -276,8 +276,8 @@ func (h *V200Handshaker) checkAgent(status *types.Status) error {
 	for _, id := range h.remoteMeta.ProducerIDs {
 		producers[id] = true
 	}
-	certs := make([]*p2pcommon.AgentCertificateV1, len(status.Certificates))
-	for i, pCert := range status.Certificates {
+	certs := make([]*p2pcommon.AgentCertificateV1, 0, len(status.Certificates))
+	for _, pCert := range status.Certificates {
 		cert, err := p2putil.CheckAndGetV1(pCert)
 		if err != nil {
 			h.logger.Info().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(h.remoteMeta.ID)).Msg("invalid agent certificate")
@@ -292,8 +292,13 @@ func (h *V200Handshaker) checkAgent(status *types.Status) error {
 			h.logger.Info().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(h.remoteMeta.ID)).Str("bpID", p2putil.ShortForm(cert.BPID)).Msg("peer id of certificate not matched")
 			return ErrInvalidAgentStatus
 		}
+		// revoked certificate is just ignored, since the agent may not know the revocation yet
+		if h.cm.IsRevoked(cert) {
+			h.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(h.remoteMeta.ID)).Str("bpID", p2putil.ShortForm(cert.BPID)).Msg("certificate is revoked")
+			continue
+		}
 
-		certs[i] = cert
+		certs = append(certs, cert)
 	}
 	h.remoteCerts = certs
 	return nil
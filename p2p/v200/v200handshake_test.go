@@ -472,7 +472,8 @@ func TestV200Handshaker_checkAgent(t *testing.T) {
 		rID types.PeerID
 		rAddr string
 		rCerts []*types.AgentCertificate
-
+		// revoked is the issuers whose certificates are revoked
+		revoked []types.PeerID
 	}
 	tests := []struct {
 		name    string
@@ -481,13 +482,15 @@ func TestV200Handshaker_checkAgent(t *testing.T) {
 		wantErr bool
 	}{
 		// success
-		{"T1",args{agentID, ipInternal, pCerts[:3]}, false},
+		{"T1",args{agentID, ipInternal, pCerts[:3], nil}, false},
+		// revoked certificate is dropped
+		{"TRevokedCert",args{agentID, ipInternal, pCerts[:3], producerIDs[1:2]}, false},
 		// agentID mismatch
-		{"TAgentIDMismatch",args{types.RandomPeerID(), ipInternal, pCerts[:3]}, true},
+		{"TAgentIDMismatch",args{types.RandomPeerID(), ipInternal, pCerts[:3], nil}, true},
 		// not in charged
-		{"TBPIDMismatch",args{agentID, ipInternal, pCerts[2:4]}, true},
+		{"TBPIDMismatch",args{agentID, ipInternal, pCerts[2:4], nil}, true},
 		// wrong cert
-		{"TWrongCert",args{agentID, ipInternal, []*types.AgentCertificate{pCerts[2], wrongPCert}}, true},
+		{"TWrongCert",args{agentID, ipInternal, []*types.AgentCertificate{pCerts[2], wrongPCert}, nil}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -500,6 +503,9 @@ func TestV200Handshaker_checkAgent(t *testing.T) {
 			mockCM := p2pmock.NewMockCertificateManager(ctrl)
 			mockIS.EXPECT().SelfMeta().Return(selfMeta).AnyTimes()
 			mockIS.EXPECT().LocalSettings().Return(sampleSettings).AnyTimes()
+			mockCM.EXPECT().IsRevoked(gomock.Any()).DoAndReturn(func(cert *p2pcommon.AgentCertificateV1) bool {
+				return p2putil.ContainsID(tt.args.revoked, cert.BPID)
+			}).AnyTimes()
 
 			rMeta := p2pcommon.NewMetaWith1Addr(tt.args.rID, tt.args.rAddr, 7846, sampleVersion)
 			rMeta.Role = types.PeerRole_Agent
@@ -513,6 +519,9 @@ func TestV200Handshaker_checkAgent(t *testing.T) {
 			if err := h.checkAgent(inStatus); (err != nil) != tt.wantErr {
 				t.Errorf("checkAgent() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if !tt.wantErr && len(h.remoteCerts) != len(tt.args.rCerts)-len(tt.args.revoked) {
+				t.Errorf("checkAgent() certs = %v, want %v", len(h.remoteCerts), len(tt.args.rCerts)-len(tt.args.revoked))
+			}
 		})
 	}
 }
\ No newline at end of file
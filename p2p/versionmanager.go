@@ -44,7 +44,8 @@ func (vm *defaultVersionManager) FindBestP2PVersion(versions []p2pcommon.P2PVers
 
 func (vm *defaultVersionManager) GetVersionedHandshaker(version p2pcommon.P2PVersion, peerID types.PeerID, rwc io.ReadWriteCloser) (p2pcommon.VersionedHandshaker, error) {
 	switch version {
-	case p2pcommon.P2PVersion200:
+	case p2pcommon.P2PVersion210, p2pcommon.P2PVersion200:
+		// compact block relay does not change the handshake
 		vhs := v200.NewV200VersionedHS(vm.is, vm.logger, vm, vm.is.CertificateManager(), peerID, rwc, chain.Genesis.Block().Hash)
 		return vhs, nil
 	case p2pcommon.P2PVersion033:
@@ -66,6 +66,11 @@ func (dpm *basePeerManager) OnInboundConn(s network.Stream) {
 		s.Close()
 		return
 	}
+	if banned, until := dpm.isBannedByReputation(peerID); banned {
+		dpm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Time("until", until).Msg("inbound peer is banned by bad reputation")
+		s.Close()
+		return
+	}
 
 	query := inboundConnEvent{conn: conn, meta: tempMeta, p2pVer: p2pcommon.P2PVersionUnknown, foundC: make(chan bool)}
 	dpm.pm.inboundConnChan <- query
@@ -132,6 +137,11 @@ func (dpm *basePeerManager) connectWaitingPeers(maxJob int) {
 			//	dpm.logger.Info().Str(p2putil.LogPeerName, p2putil.ShortMetaForm(wp.Meta)).Msg("Skipping banned peer")
 			//	continue
 			//}
+			if banned, until := dpm.isBannedByReputation(wp.Meta.ID); banned {
+				// try again after the ban is released
+				wp.NextTrial = until
+				continue
+			}
 			dpm.logger.Info().Int("trial", wp.TrialCnt).Str(p2putil.LogPeerID, p2putil.ShortForm(wp.Meta.ID)).Msg("Starting scheduled try to connect peer")
 
 			dpm.workingJobs[wp.Meta.ID] = ConnWork{Meta: wp.Meta, PeerID: wp.Meta.ID, StartTime: time.Now()}
@@ -143,6 +153,14 @@ func (dpm *basePeerManager) connectWaitingPeers(maxJob int) {
 	}
 }
 
+// isBannedByReputation returns whether the peer is banned temporarily for its misbehaviors, and when the ban is released.
+func (dpm *basePeerManager) isBannedByReputation(pid types.PeerID) (bool, time.Time) {
+	if dpm.pm.rm == nil {
+		return false, time.Time{}
+	}
+	return dpm.pm.rm.IsBanned(pid)
+}
+
 // getRemainingSpaces check and return the number that can do connection work.
 // the number depends on the number of current works and the number of waiting peers
 func (dpm *basePeerManager) getRemainingSpaces() int {
@@ -226,7 +244,7 @@ func (dpm *basePeerManager) createRemoteInfo(conn network.Conn, r p2pcommon.Hand
 
 	connection := p2pcommon.RemoteConn{IP: ip, Port: port, Outbound: outbound}
 	zone := p2pcommon.PeerZone(p2putil.IsContainedIP(ip, dpm.is.LocalSettings().InternalZones))
-	ri := p2pcommon.RemoteInfo{Meta: r.Meta, Connection: connection, Hidden: r.Hidden, Certificates: r.Certificates, AcceptedRole: types.PeerRole_Watcher, Zone: zone}
+	ri := p2pcommon.RemoteInfo{Meta: r.Meta, Connection: connection, Hidden: r.Hidden, Certificates: r.Certificates, AcceptedRole: types.PeerRole_Watcher, Zone: zone, P2PVersion: r.Version}
 
 	// TODO Is it OK to this function has logic for policy?
 	// check role
@@ -259,6 +277,9 @@ func (dpm *basePeerManager) OnWorkDone(result p2pcommon.ConnWorkResult) {
 		dpm.logger.Debug().Str(p2putil.LogPeerName, p2putil.ShortMetaForm(meta)).Int("trial", wp.TrialCnt).Err(result.Result).Msg("Connection job finished")
 	}
 	wp.LastResult = result.Result
+	if result.Result != nil && dpm.pm.ab != nil {
+		dpm.pm.ab.OnConnectFailed(meta.ID)
+	}
 	// success to connect
 	if result.Result == nil {
 		dpm.logger.Debug().Str(p2putil.LogPeerName, p2putil.ShortMetaForm(meta)).Msg("Connected job succeeded, so delete it from waiting peers")
@@ -330,6 +351,9 @@ func (dpm *dynamicWPManager) OnDiscoveredPeers(metas []p2pcommon.PeerMeta) int {
 		}
 
 		// TODO check blacklist later.
+		if banned, _ := dpm.isBannedByReputation(meta.ID); banned {
+			continue
+		}
 		dpm.pm.waitingPeers[meta.ID] = &p2pcommon.WaitingPeer{Meta: meta, NextTrial: time.Now()}
 		addedWP++
 	}
@@ -212,6 +212,21 @@ func (lntc *LiteContainerService) CertificateManager() p2pcommon.CertificateMana
 	return nil
 }
 
+func (lntc *LiteContainerService) ReputationManager() p2pcommon.ReputationManager {
+	// return dummy value
+	return nil
+}
+
+func (lntc *LiteContainerService) DHTDiscoverer() p2pcommon.DHTDiscoverer {
+	// return dummy value
+	return nil
+}
+
+func (lntc *LiteContainerService) AddressBook() p2pcommon.AddressBook {
+	// return dummy value
+	return nil
+}
+
 // it is copy of initMeta() in p2p package
 func initMeta(peerID types.PeerID, conf *config.P2PConfig) p2pcommon.PeerMeta {
 	protocolAddr := conf.NetProtocolAddr
@@ -0,0 +1,89 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type testEventStreamServer struct {
+	grpc.ServerStream
+	sent []*types.Event
+}
+
+func (s *testEventStreamServer) Send(ev *types.Event) error {
+	s.sent = append(s.sent, ev)
+	return nil
+}
+
+func TestEventStream_send(t *testing.T) {
+	event := func(no types.BlockNo, txIdx int32, removed bool) *types.Event {
+		return &types.Event{BlockNo: no, TxIndex: txIdx, Removed: removed}
+	}
+	server := &testEventStreamServer{}
+	from := event(10, 0, false)
+	es := newEventStream(&types.FilterInfo{Cursor: from.Cursor()}, server)
+
+	// the events replayed and the same events received live
+	replayed := []*types.Event{event(10, 1, false), event(11, 0, false)}
+	for _, ev := range append([]*types.Event{from}, replayed...) {
+		assert.NoError(t, es.send(ev))
+	}
+	for _, ev := range replayed {
+		assert.NoError(t, es.send(ev))
+	}
+	assert.Equal(t, replayed, server.sent)
+
+	// block 11 is replaced by reorganization
+	server.sent = nil
+	assert.NoError(t, es.send(event(12, 0, true)))
+	assert.NoError(t, es.send(event(11, 0, true)))
+	assert.NoError(t, es.send(event(11, 1, false)))
+	assert.Equal(t, []*types.Event{event(11, 0, true), event(11, 1, false)}, server.sent)
+}
+
+func TestEventStream_sendRemovedNotSent(t *testing.T) {
+	event := func(no types.BlockNo, hash string, removed bool) *types.Event {
+		return &types.Event{BlockNo: no, BlockHash: []byte(hash), Removed: removed}
+	}
+	server := &testEventStreamServer{}
+	// subscribed while block 10 is the best one
+	es := newEventStream(&types.FilterInfo{}, server)
+	assert.NoError(t, es.send(event(11, "a", false)))
+
+	// block 10 and 11 are replaced by reorganization, but the events of block
+	// 10 were never sent to the stream
+	server.sent = nil
+	assert.NoError(t, es.send(event(11, "a", true)))
+	assert.NoError(t, es.send(event(10, "x", true)))
+	assert.Equal(t, []*types.Event{event(11, "a", true)}, server.sent)
+
+	// the removal of the block at the same height on the other fork
+	server.sent = nil
+	assert.NoError(t, es.send(event(11, "b", false)))
+	assert.NoError(t, es.send(event(11, "c", true)))
+	assert.Equal(t, []*types.Event{event(11, "b", false)}, server.sent)
+}
+
+func TestEventStream_push(t *testing.T) {
+	es := newEventStream(&types.FilterInfo{}, &testEventStreamServer{})
+	for i := 0; i < eventStreamBufSize; i++ {
+		es.push(&types.Event{})
+	}
+	select {
+	case <-es.overflow:
+		t.Fatal("unexpected overflow")
+	default:
+	}
+	es.push(&types.Event{})
+	es.push(&types.Event{})
+	_, ok := <-es.overflow
+	assert.False(t, ok)
+}
@@ -6,6 +6,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
@@ -42,9 +43,99 @@ var (
 	ErrNotSupportedConsensus = errors.New("not supported by this consensus")
 )
 
+const (
+	// eventStreamBufSize is the number of events which can be queued for a
+	// subscriber. The subscription is ended if it overflows.
+	eventStreamBufSize = 4096
+	// eventReplayPageSize is the number of events fetched at once while
+	// replaying the past events of a subscription.
+	eventReplayPageSize = 100
+	// eventSentBlocks is the number of recent blocks whose delivered events
+	// are remembered, so that their removal by reorganization is notified.
+	eventSentBlocks = 1024
+)
+
 type EventStream struct {
 	filter *types.FilterInfo
 	stream types.AergoRPCService_ListEventStreamServer
+
+	eventC     chan *types.Event
+	overflow   chan struct{}
+	overflowed bool
+	// next is the cursor of the first event not delivered yet. nil means
+	// every live event is delivered.
+	next []byte
+	// sent is the hash of block by its number, whose events are delivered.
+	sent map[types.BlockNo][]byte
+}
+
+func newEventStream(filter *types.FilterInfo, stream types.AergoRPCService_ListEventStreamServer) *EventStream {
+	es := &EventStream{
+		filter:   filter,
+		stream:   stream,
+		eventC:   make(chan *types.Event, eventStreamBufSize),
+		overflow: make(chan struct{}),
+		sent:     make(map[types.BlockNo][]byte),
+	}
+	if len(filter.Cursor) != 0 {
+		es.next = nextEventCursor(filter.Cursor)
+	}
+	return es
+}
+
+// nextEventCursor returns the smallest cursor which is greater than cursor.
+func nextEventCursor(cursor []byte) []byte {
+	return append(append(make([]byte, 0, len(cursor)+1), cursor...), 0)
+}
+
+// push queues event to be sent by the subscriber. It must be called with the
+// lock of event streams held.
+func (es *EventStream) push(event *types.Event) {
+	if es.overflowed {
+		return
+	}
+	select {
+	case es.eventC <- event:
+	default:
+		es.overflowed = true
+		close(es.overflow)
+	}
+}
+
+// send sends event unless it is delivered already. An event removed by
+// reorganization is sent only if it was delivered by this stream before.
+func (es *EventStream) send(event *types.Event) error {
+	cursor := event.Cursor()
+	if event.Removed {
+		if hash, exist := es.sent[event.BlockNo]; !exist || !bytes.Equal(hash, event.BlockHash) {
+			return nil
+		}
+		if es.next == nil || bytes.Compare(cursor, es.next) >= 0 {
+			return nil
+		}
+		es.next = cursor
+	} else {
+		if es.next != nil && bytes.Compare(cursor, es.next) < 0 {
+			return nil
+		}
+		es.next = nextEventCursor(cursor)
+		es.markSent(event)
+	}
+	return es.stream.Send(event)
+}
+
+// markSent remembers the block of event as delivered, and forgets the blocks
+// too old to be disconnected.
+func (es *EventStream) markSent(event *types.Event) {
+	es.sent[event.BlockNo] = event.BlockHash
+	if len(es.sent) <= eventSentBlocks {
+		return
+	}
+	for no := range es.sent {
+		if no+eventSentBlocks <= event.BlockNo {
+			delete(es.sent, no)
+		}
+	}
 }
 
 // AergoRPCService implements GRPC server which is defined in rpc.proto
@@ -53,6 +144,8 @@ type AergoRPCService struct {
 	actorHelper       p2pcommon.ActorService
 	consensusAccessor consensus.ConsensusAccessor //TODO refactor with actorHelper
 	msgHelper         message.Helper
+	// stateSvc is the actor which answers state queries with merkle proofs
+	stateSvc string
 
 	streamID                uint32
 	blockStreamLock         sync.RWMutex
@@ -115,8 +208,11 @@ func (rpc *AergoRPCService) fillPeerMetrics(result *types.Metrics) {
 	metrics := presult.([]*metric.PeerMetric)
 	mets := make([]*types.PeerMetric, len(metrics))
 	for i, met := range metrics {
+		txRelay := met.TxRelay()
 		rMet := &types.PeerMetric{PeerID: []byte(met.PeerID), SumIn: met.TotalIn(), AvrIn: met.InMetric.APS(),
-			SumOut: met.TotalOut(), AvrOut: met.OutMetric.APS()}
+			SumOut: met.TotalOut(), AvrOut: met.OutMetric.APS(),
+			TxNoticeIn: txRelay.NoticeIn, TxNoticeDup: txRelay.NoticeDup, TxNoticeOut: txRelay.NoticeOut,
+			TxQueryIn: txRelay.QueryIn, TxQueryLimited: txRelay.QueryLimited}
 		mets[i] = rMet
 	}
 
@@ -713,7 +809,7 @@ func (rpc *AergoRPCService) GetStateAndProof(ctx context.Context, in *types.Acco
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
 	}
-	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+	result, err := rpc.hub.RequestFuture(rpc.stateSvc,
 		&message.GetStateAndProof{Account: in.Account, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAndProof").Result()
 	if err != nil {
 		return nil, err
@@ -943,7 +1039,7 @@ func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams)
 		return nil, err
 	}
 	result, err := rpc.hub.RequestFuture(message.P2PSvc,
-		&message.GetPeers{in.NoHidden, in.ShowSelf}, halfMinute, "rpc.(*AergoRPCService).GetPeers").Result()
+		&message.GetPeers{NoHidden: in.NoHidden, ShowSelf: in.ShowSelf, ShowBanned: in.ShowBanned}, halfMinute, "rpc.(*AergoRPCService).GetPeers").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -955,13 +1051,102 @@ func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams)
 	ret := &types.PeerList{Peers: make([]*types.Peer, 0, len(rsp.Peers))}
 	for _, pi := range rsp.Peers {
 		blkNotice := &types.NewBlockNotice{BlockHash: pi.LastBlockHash, BlockNo: pi.LastBlockNumber}
-		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version, Certificates: pi.Certificates, AcceptedRole: pi.AcceptedRole}
+		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version, Certificates: pi.Certificates, AcceptedRole: pi.AcceptedRole, Score: int32(pi.Score), LastPenalty: pi.LastPenalty}
+		if !pi.BannedUntil.IsZero() {
+			peer.BannedUntil = pi.BannedUntil.UnixNano()
+		}
 		ret.Peers = append(ret.Peers, peer)
 	}
 
 	return ret, nil
 }
 
+// GetAddressBook handle rpc request getaddrbook
+func (rpc *AergoRPCService) GetAddressBook(ctx context.Context, in *types.Empty) (*types.AddressBook, error) {
+	if err := rpc.checkAuth(ctx, ShowNode); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.P2PSvc,
+		&message.GetAddressBook{}, halfMinute, "rpc.(*AergoRPCService).GetAddressBook").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetAddressBookRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.AddressBook{Entries: rsp.Entries}, nil
+}
+
+// ListAgentCertificates handle rpc request agentcert list
+func (rpc *AergoRPCService) ListAgentCertificates(ctx context.Context, in *types.Empty) (*types.AgentCertificateList, error) {
+	if err := rpc.checkAuth(ctx, ShowNode); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.P2PSvc,
+		&message.GetAgentCertificates{}, halfMinute, "rpc.(*AergoRPCService).ListAgentCertificates").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetAgentCertificatesRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.AgentCertificateList{Certificates: rsp.Certificates, RevocationLists: rsp.RevocationLists}, nil
+}
+
+// IssueAgentCertificate handle rpc request agentcert issue. The certificates are renewed asynchronously, so the
+// returned list may not have the new certificate yet.
+func (rpc *AergoRPCService) IssueAgentCertificate(ctx context.Context, in *types.SingleBytes) (*types.AgentCertificateList, error) {
+	if err := rpc.checkAuth(ctx, ControlNode); err != nil {
+		return nil, err
+	}
+	var pid types.PeerID
+	if len(in.Value) > 0 {
+		var err error
+		if pid, err = types.IDFromBytes(in.Value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid peer id: %s", err.Error())
+		}
+	}
+	result, err := rpc.hub.RequestFuture(message.P2PSvc,
+		&message.RenewAgentCertificates{PeerID: pid}, halfMinute, "rpc.(*AergoRPCService).IssueAgentCertificate").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.RenewAgentCertificatesRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Error(codes.FailedPrecondition, rsp.Err.Error())
+	}
+	return rpc.ListAgentCertificates(ctx, &types.Empty{})
+}
+
+// RevokeAgentCertificates handle rpc request agentcert revoke
+func (rpc *AergoRPCService) RevokeAgentCertificates(ctx context.Context, in *types.AgentCertRevokeRequest) (*types.CertificateRevocationList, error) {
+	if err := rpc.checkAuth(ctx, ControlNode); err != nil {
+		return nil, err
+	}
+	agentID, err := types.IDFromBytes(in.AgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid agent id: %s", err.Error())
+	}
+	result, err := rpc.hub.RequestFuture(message.P2PSvc,
+		&message.RevokeAgentCertificates{AgentID: agentID, Renewable: in.Renewable}, halfMinute, "rpc.(*AergoRPCService).RevokeAgentCertificates").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.RevokeAgentCertificatesRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Error(codes.FailedPrecondition, rsp.Err.Error())
+	}
+	return rsp.CRL, nil
+}
+
 // NodeState handle rpc request nodestate
 func (rpc *AergoRPCService) NodeState(ctx context.Context, in *types.NodeReq) (*types.SingleBytes, error) {
 	if err := rpc.checkAuth(ctx, ShowNode); err != nil {
@@ -1078,6 +1263,22 @@ func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleByte
 	return rsp.Receipt, rsp.Err
 }
 
+func (rpc *AergoRPCService) GetReceiptWithProof(ctx context.Context, in *types.SingleBytes) (*types.ReceiptProof, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetReceiptWithProof{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetReceiptWithProof").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetReceiptWithProofRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Proof, rsp.Err
+}
+
 func (rpc *AergoRPCService) GetABI(ctx context.Context, in *types.SingleBytes) (*types.ABI, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
@@ -1115,7 +1316,7 @@ func (rpc *AergoRPCService) QueryContractState(ctx context.Context, in *types.St
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
 	}
-	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+	result, err := rpc.hub.RequestFuture(rpc.stateSvc,
 		&message.GetStateQuery{ContractAddress: in.ContractAddress, StorageKeys: in.StorageKeys, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateQuery").Result()
 	if err != nil {
 		return nil, err
@@ -1137,6 +1338,10 @@ func fromTimestamp(timestamp *timestamp.Timestamp) time.Time {
 	return time.Unix(timestamp.Seconds, int64(timestamp.Nanos))
 }
 
+// ListEventStream sends the events matching the filter, as they are added to
+// the chain. If the cursor of filter is given, the past events after the
+// cursor are sent first. The events of blocks disconnected by reorganization
+// are sent again with removed flag, if they were sent before.
 func (rpc *AergoRPCService) ListEventStream(in *types.FilterInfo, stream types.AergoRPCService_ListEventStreamServer) error {
 	err := in.ValidateCheck(0)
 	if err != nil {
@@ -1146,42 +1351,119 @@ func (rpc *AergoRPCService) ListEventStream(in *types.FilterInfo, stream types.A
 	if err != nil {
 		return err
 	}
+	if len(in.Cursor) != 0 {
+		if _, _, _, err = types.ParseEventCursor(in.Cursor); err != nil {
+			return err
+		}
+	}
 
-	eventStream := &EventStream{in, stream}
+	// the stream is registered before replay, so that no event is missed
+	// between the replay and the live events
+	eventStream := newEventStream(in, stream)
 	rpc.eventStreamLock.Lock()
 	rpc.eventStream[eventStream] = eventStream
 	rpc.eventStreamLock.Unlock()
+	defer func() {
+		rpc.eventStreamLock.Lock()
+		delete(rpc.eventStream, eventStream)
+		rpc.eventStreamLock.Unlock()
+	}()
+
+	if len(in.Cursor) != 0 {
+		if err := rpc.replayEvents(eventStream); err != nil {
+			return err
+		}
+	}
 
 	for {
 		select {
+		case event := <-eventStream.eventC:
+			if err := eventStream.send(event); err != nil {
+				logger.Warn().Err(err).Msg("failed to send event stream")
+				return err
+			}
+		case <-eventStream.overflow:
+			return status.Errorf(codes.ResourceExhausted, "too many pending events, resume from the cursor of the last event")
 		case <-eventStream.stream.Context().Done():
-			rpc.eventStreamLock.Lock()
-			delete(rpc.eventStream, eventStream)
-			rpc.eventStreamLock.Unlock()
 			return nil
 		}
 	}
 }
 
+// replayEvents sends the past events after the cursor of stream up to the
+// best block.
+func (rpc *AergoRPCService) replayEvents(es *EventStream) error {
+	cursor := es.filter.Cursor
+	from, _, _, err := types.ParseEventCursor(cursor)
+	if err != nil {
+		return err
+	}
+	ca := rpc.actorHelper.GetChainAccessor()
+	for {
+		best, err := ca.GetBestBlock()
+		if err != nil {
+			return err
+		}
+		if from > best.BlockNo() {
+			return nil
+		}
+		to := from + types.MAXBLOCKRANGE
+		if to > best.BlockNo() {
+			to = best.BlockNo()
+		}
+		filter := &types.FilterInfo{
+			ContractAddress: es.filter.ContractAddress,
+			EventName:       es.filter.EventName,
+			Blockfrom:       from,
+			Blockto:         to,
+			ArgFilter:       es.filter.ArgFilter,
+			Size:            eventReplayPageSize,
+			Cursor:          cursor,
+		}
+		result, err := rpc.hub.RequestFuture(message.ChainSvc,
+			&message.ListEvents{Filter: filter}, defaultActorTimeout, "rpc.(*AergoRPCService).replayEvents").Result()
+		if err != nil {
+			return err
+		}
+		rsp, ok := result.(*message.ListEventsRsp)
+		if !ok {
+			return status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+		}
+		if rsp.Err != nil {
+			return rsp.Err
+		}
+		for _, event := range rsp.Events {
+			if err := es.send(event); err != nil {
+				return err
+			}
+		}
+		if len(rsp.Cursor) != 0 {
+			cursor = rsp.Cursor
+			from, _, _, _ = types.ParseEventCursor(cursor)
+		} else {
+			from = to + 1
+		}
+		select {
+		case <-es.overflow:
+			return status.Errorf(codes.ResourceExhausted, "too many pending events, resume from the cursor of the last event")
+		case <-es.stream.Context().Done():
+			return nil
+		default:
+		}
+	}
+}
+
+// BroadcastToEventStream queues the events to the matching subscribers.
 func (rpc *AergoRPCService) BroadcastToEventStream(events []*types.Event) error {
-	var err error
-	rpc.eventStreamLock.RLock()
-	defer rpc.eventStreamLock.RUnlock()
+	rpc.eventStreamLock.Lock()
+	defer rpc.eventStreamLock.Unlock()
 
 	for _, es := range rpc.eventStream {
-		if es != nil {
-			rpc.eventStreamLock.RUnlock()
-			argFilter, _ := es.filter.GetExArgFilter()
-			for _, event := range events {
-				if event.Filter(es.filter, argFilter) {
-					err = es.stream.Send(event)
-					if err != nil {
-						logger.Warn().Err(err).Msg("failed to broadcast block stream")
-						break
-					}
-				}
+		argFilter, _ := es.filter.GetExArgFilter()
+		for _, event := range events {
+			if event.Filter(es.filter, argFilter) {
+				es.push(event)
 			}
-			rpc.eventStreamLock.RLock()
 		}
 	}
 	return nil
@@ -1200,7 +1482,7 @@ func (rpc *AergoRPCService) ListEvents(ctx context.Context, in *types.FilterInfo
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return &types.EventList{Events: rsp.Events}, rsp.Err
+	return &types.EventList{Events: rsp.Events, Cursor: rsp.Cursor}, rsp.Err
 }
 
 func (rpc *AergoRPCService) GetServerInfo(ctx context.Context, in *types.KeyParams) (*types.ServerInfo, error) {
@@ -1231,6 +1513,128 @@ func (rpc *AergoRPCService) GetConsensusInfo(ctx context.Context, in *types.Empt
 	return rpc.consensusAccessor.ConsensusInfo(), nil
 }
 
+// GetFinalityCertificate returns the certificate of the block finalized by
+// the votes of block producers. The input is the 8 byte block number, or empty
+// for the last certificate.
+func (rpc *AergoRPCService) GetFinalityCertificate(ctx context.Context, in *types.SingleBytes) (*types.FinalityCertificate, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	if rpc.consensusAccessor == nil {
+		return nil, ErrUninitAccessor
+	}
+	fa, ok := rpc.consensusAccessor.(consensus.FinalityAccessor)
+	if !ok {
+		return nil, ErrNotSupportedConsensus
+	}
+	var number types.BlockNo
+	switch len(in.Value) {
+	case 0:
+	case 8:
+		number = binary.LittleEndian.Uint64(in.Value)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid input. Should be a 8 byte number.")
+	}
+	cert, err := fa.FinalityCertificate(number)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	return cert, nil
+}
+
+// GetBPSchedule returns the block producers of the next slots and their
+// statistics. The input is the 8 byte number of slots, or empty for a round of
+// the current block producers.
+func (rpc *AergoRPCService) GetBPSchedule(ctx context.Context, in *types.SingleBytes) (*types.BPSchedule, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	if rpc.consensusAccessor == nil {
+		return nil, ErrUninitAccessor
+	}
+	sa, ok := rpc.consensusAccessor.(consensus.BPScheduleAccessor)
+	if !ok {
+		return nil, ErrNotSupportedConsensus
+	}
+	var slots uint64
+	switch len(in.Value) {
+	case 0:
+	case 8:
+		slots = binary.LittleEndian.Uint64(in.Value)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid input. Should be a 8 byte number.")
+	}
+	sched, err := sa.BPSchedule(slots)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return sched, nil
+}
+
+// GetStakingHistory returns the staking, unstaking, voting and voting reward
+// records of an account in the block range, which are kept by the staking
+// ledger of the node.
+func (rpc *AergoRPCService) GetStakingHistory(ctx context.Context, in *types.StakingHistoryParams) (*types.StakingHistory, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	if len(in.Account) == 0 || len(in.Account) > types.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetStakingHistory{Account: in.Account, BlockFrom: in.BlockFrom, BlockTo: in.BlockTo},
+		defaultActorTimeout, "rpc.(*AergoRPCService).GetStakingHistory").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetStakingHistoryRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
+	return &types.StakingHistory{Records: rsp.Records}, nil
+}
+
+// GetHardforkSchedule returns the block numbers of hardfork versions, which
+// include the versions scheduled by the governance of private chains and
+// those unsupported by the node.
+func (rpc *AergoRPCService) GetHardforkSchedule(ctx context.Context, in *types.Empty) (*types.HardforkSchedule, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetHardforkSchedule{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetHardforkSchedule").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetHardforkScheduleRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Schedule, nil
+}
+
+// ListDoubleSignEvidence returns the evidences of double-signing block
+// producers detected by this node. The input is the ID of a block producer, or
+// empty to list those of all block producers.
+func (rpc *AergoRPCService) ListDoubleSignEvidence(ctx context.Context, in *types.SingleBytes) (*types.DoubleSignEvidenceList, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListDoubleSignEvidence{BPID: types.PeerID(in.Value)}, defaultActorTimeout, "rpc.(*AergoRPCService).ListDoubleSignEvidence").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListDoubleSignEvidenceRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.DoubleSignEvidenceList{Evidences: rsp.Evidences}, rsp.Err
+}
+
 // ChainStat handles rpc request chainstat.
 func (rpc *AergoRPCService) ChainStat(ctx context.Context, in *types.Empty) (*types.ChainStats, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
@@ -1262,10 +1666,54 @@ func (rpc *AergoRPCService) ChangeMembership(ctx context.Context, in *types.Memb
 		return nil, err
 	}
 
+	reply := &types.MembershipChangeReply{Attr: &types.MemberAttr{ID: uint64(member.ID), Name: member.Name, Address: member.Address, PeerID: []byte(types.PeerID(member.PeerID)), Learner: member.Learner}}
+	return reply, nil
+}
+
+func (rpc *AergoRPCService) TransferLeadership(ctx context.Context, in *types.Name) (*types.MembershipChangeReply, error) {
+	if err := rpc.checkAuth(ctx, ControlNode); err != nil {
+		return nil, err
+	}
+	if rpc.consensusAccessor == nil {
+		return nil, ErrUninitAccessor
+	}
+
+	if genesisInfo := rpc.actorHelper.GetChainAccessor().GetGenesisInfo(); genesisInfo != nil {
+		if genesisInfo.ID.Consensus != raftv2.GetName() {
+			return nil, ErrNotSupportedConsensus
+		}
+	}
+
+	member, err := rpc.consensusAccessor.TransferLeadership(in.GetName())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	reply := &types.MembershipChangeReply{Attr: &types.MemberAttr{ID: uint64(member.ID), Name: member.Name, Address: member.Address, PeerID: []byte(types.PeerID(member.PeerID))}}
 	return reply, nil
 }
 
+func (rpc *AergoRPCService) SetMaintenanceMode(ctx context.Context, in *types.MaintenanceMode) (*types.MaintenanceMode, error) {
+	if err := rpc.checkAuth(ctx, ControlNode); err != nil {
+		return nil, err
+	}
+	if rpc.consensusAccessor == nil {
+		return nil, ErrUninitAccessor
+	}
+
+	if genesisInfo := rpc.actorHelper.GetChainAccessor().GetGenesisInfo(); genesisInfo != nil {
+		if genesisInfo.ID.Consensus != raftv2.GetName() {
+			return nil, ErrNotSupportedConsensus
+		}
+	}
+
+	mode, err := rpc.consensusAccessor.SetMaintenance(in.GetEnabled())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return mode, nil
+}
+
 //GetEnterpriseConfig return aergo.enterprise configure values. key "ADMINS" is for getting register admin addresses and "ALL" is for getting all key list.
 func (rpc *AergoRPCService) GetEnterpriseConfig(ctx context.Context, in *types.EnterpriseConfigKey) (*types.EnterpriseConfig, error) {
 	genesis := rpc.actorHelper.GetChainAccessor().GetGenesisInfo()
@@ -63,6 +63,11 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 		blockStream:         map[uint32]types.AergoRPCService_ListBlockStreamServer{},
 		blockMetadataStream: map[uint32]types.AergoRPCService_ListBlockMetadataStreamServer{},
 		eventStream:         make(map[*EventStream]*EventStream),
+		stateSvc:            message.ChainSvc,
+	}
+	if cfg.Light != nil && cfg.Light.Enable {
+		// light node verifies the proofs from full nodes instead of its own state
+		actualServer.stateSvc = message.LightSvc
 	}
 
 	tracer := opentracing.GlobalTracer()
@@ -0,0 +1,121 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// AccessSet records the keys of the account states and the contract storage
+// variables which are read or written through a StateDB. It is used to detect
+// the conflicts between transactions executed speculatively in parallel.
+type AccessSet struct {
+	lock     sync.Mutex
+	reads    map[types.HashID]struct{}
+	writes   map[types.HashID]struct{}
+	accounts []types.AccountID
+	storage  bool
+}
+
+// NewAccessSet returns an empty AccessSet.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		reads:  make(map[types.HashID]struct{}),
+		writes: make(map[types.HashID]struct{}),
+	}
+}
+
+func storageKeyID(aid types.AccountID, key types.HashID) types.HashID {
+	return types.GetHashID(aid[:], key[:])
+}
+
+func (as *AccessSet) readAccount(aid types.AccountID) {
+	if as == nil {
+		return
+	}
+	as.lock.Lock()
+	as.reads[types.HashID(aid)] = struct{}{}
+	as.lock.Unlock()
+}
+
+func (as *AccessSet) writeAccount(aid types.AccountID) {
+	if as == nil {
+		return
+	}
+	as.lock.Lock()
+	id := types.HashID(aid)
+	if _, exist := as.writes[id]; !exist {
+		as.writes[id] = struct{}{}
+		as.accounts = append(as.accounts, aid)
+	}
+	as.lock.Unlock()
+}
+
+func (as *AccessSet) readStorage(aid types.AccountID, key types.HashID) {
+	if as == nil {
+		return
+	}
+	as.lock.Lock()
+	as.reads[storageKeyID(aid, key)] = struct{}{}
+	as.lock.Unlock()
+}
+
+func (as *AccessSet) writeStorage(aid types.AccountID, key types.HashID) {
+	if as == nil {
+		return
+	}
+	as.lock.Lock()
+	as.writes[storageKeyID(aid, key)] = struct{}{}
+	as.storage = true
+	as.lock.Unlock()
+}
+
+// WrittenAccounts returns the IDs of the accounts whose states were written,
+// in the order of the first write.
+func (as *AccessSet) WrittenAccounts() []types.AccountID {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	return append([]types.AccountID(nil), as.accounts...)
+}
+
+// HasStorageWrite reports whether any contract storage variable was written.
+func (as *AccessSet) HasStorageWrite() bool {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	return as.storage
+}
+
+// Conflicts reports whether any key read or written in other was written in as.
+func (as *AccessSet) Conflicts(other *AccessSet) bool {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	other.lock.Lock()
+	defer other.lock.Unlock()
+
+	for k := range other.reads {
+		if _, exist := as.writes[k]; exist {
+			return true
+		}
+	}
+	for k := range other.writes {
+		if _, exist := as.writes[k]; exist {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAccessSet attaches as to the StateDB. Every account state and contract
+// storage access made afterwards is recorded to as. A nil as stops recording.
+func (states *StateDB) SetAccessSet(as *AccessSet) {
+	states.lock.Lock()
+	defer states.lock.Unlock()
+	states.access = as
+}
+
+// AccessSet returns the AccessSet attached to the StateDB.
+func (states *StateDB) AccessSet() *AccessSet {
+	states.lock.RLock()
+	defer states.lock.RUnlock()
+	return states.access
+}
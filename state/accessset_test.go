@@ -0,0 +1,76 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessSetAccount(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	sender := types.ToAccountID([]byte("sender"))
+	receiver := types.ToAccountID([]byte("receiver"))
+	other := types.ToAccountID([]byte("other"))
+
+	spec := NewAccessSet()
+	stateDB.SetAccessSet(spec)
+	_, err := stateDB.GetAccountState(sender)
+	assert.NoError(t, err)
+	assert.NoError(t, stateDB.PutState(sender, &testStates[0]))
+	assert.NoError(t, stateDB.PutState(receiver, &testStates[1]))
+	assert.NoError(t, stateDB.PutState(sender, &testStates[2]))
+	stateDB.SetAccessSet(nil)
+
+	assert.Equal(t, []types.AccountID{sender, receiver}, spec.WrittenAccounts())
+	assert.False(t, spec.HasStorageWrite())
+
+	dirty := NewAccessSet()
+	stateDB.SetAccessSet(dirty)
+	assert.NoError(t, stateDB.PutState(other, &testStates[3]))
+	stateDB.SetAccessSet(nil)
+	assert.False(t, dirty.Conflicts(spec), "disjoint write")
+
+	stateDB.SetAccessSet(dirty)
+	assert.NoError(t, stateDB.PutState(receiver, &testStates[4]))
+	stateDB.SetAccessSet(nil)
+	assert.True(t, dirty.Conflicts(spec), "write after write")
+
+	// nothing is recorded without access set
+	assert.NoError(t, stateDB.PutState(types.ToAccountID([]byte("untracked")), &testStates[0]))
+	assert.Len(t, dirty.WrittenAccounts(), 2)
+}
+
+func TestAccessSetStorage(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	aid := types.ToAccountID([]byte("contract"))
+
+	spec := NewAccessSet()
+	stateDB.SetAccessSet(spec)
+	contractState, err := stateDB.OpenContractStateAccount(aid)
+	assert.NoError(t, err)
+	_, err = contractState.GetData([]byte("key1"))
+	assert.NoError(t, err)
+	stateDB.SetAccessSet(nil)
+	assert.False(t, spec.HasStorageWrite())
+
+	dirty := NewAccessSet()
+	stateDB.SetAccessSet(dirty)
+	contractState, err = stateDB.OpenContractStateAccount(aid)
+	assert.NoError(t, err)
+	assert.NoError(t, contractState.SetData([]byte("key2"), []byte("value")))
+	stateDB.SetAccessSet(nil)
+	assert.True(t, dirty.HasStorageWrite())
+	assert.False(t, dirty.Conflicts(spec), "different storage key")
+
+	stateDB.SetAccessSet(dirty)
+	contractState, err = stateDB.OpenContractStateAccount(aid)
+	assert.NoError(t, err)
+	assert.NoError(t, contractState.DeleteData([]byte("key1")))
+	stateDB.SetAccessSet(nil)
+	assert.True(t, dirty.Conflicts(spec), "read after write")
+}
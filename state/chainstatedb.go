@@ -94,21 +94,30 @@ func (sdb *ChainStateDB) SetGenesis(genesis *types.Genesis, bpInit func(*StateDB
 	// create state of genesis block
 	gbState := sdb.NewBlockState(stateDB.GetRoot())
 
-	if len(genesis.BPs) > 0 && bpInit != nil {
+	if (len(genesis.BPs) > 0 || len(genesis.Admins) > 0) && bpInit != nil {
 		// To avoid cyclic dedendency, BP initilization is called via function
-		// pointer.
+		// pointer. It also initializes the enterprise admins.
 		if err := bpInit(stateDB, genesis); err != nil {
 			return err
 		}
 
-		aid := types.ToAccountID([]byte(types.AergoSystem))
-		scs, err := stateDB.OpenContractStateAccount(aid)
-		if err != nil {
-			return err
+		var accounts []string
+		if len(genesis.BPs) > 0 {
+			accounts = append(accounts, types.AergoSystem)
+		}
+		if len(genesis.Admins) > 0 {
+			accounts = append(accounts, types.AergoEnterprise)
 		}
+		for _, account := range accounts {
+			aid := types.ToAccountID([]byte(account))
+			scs, err := stateDB.OpenContractStateAccount(aid)
+			if err != nil {
+				return err
+			}
 
-		if err := gbState.PutState(aid, scs.State); err != nil {
-			return err
+			if err := gbState.PutState(aid, scs.State); err != nil {
+				return err
+			}
 		}
 	}
 
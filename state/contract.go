@@ -27,6 +27,7 @@ func (states *StateDB) OpenContractState(aid types.AccountID, st *types.State) (
 		account: aid,
 		storage: storage,
 		store:   states.store,
+		access:  states.access,
 	}
 	return res, nil
 }
@@ -58,6 +59,7 @@ type ContractState struct {
 	code    []byte
 	storage *bufferedStorage
 	store   db.DB
+	access  *AccessSet
 }
 
 func (st *ContractState) SetNonce(nonce uint64) {
@@ -122,18 +124,23 @@ func (st *ContractState) GetRawKV(key []byte) ([]byte, error) {
 
 // HasKey returns existence of the key
 func (st *ContractState) HasKey(key []byte) bool {
-	return st.storage.has(types.GetHashID(key), true)
+	id := types.GetHashID(key)
+	st.access.readStorage(st.account, id)
+	return st.storage.has(id, true)
 }
 
 // SetData store key and value pair to the storage.
 func (st *ContractState) SetData(key, value []byte) error {
-	st.storage.put(newValueEntry(types.GetHashID(key), value))
+	id := types.GetHashID(key)
+	st.access.writeStorage(st.account, id)
+	st.storage.put(newValueEntry(id, value))
 	return nil
 }
 
 // GetData returns the value corresponding to the key from the buffered storage.
 func (st *ContractState) GetData(key []byte) ([]byte, error) {
 	id := types.GetHashID(key)
+	st.access.readStorage(st.account, id)
 	if entry := st.storage.get(id); entry != nil {
 		if value := entry.Value(); value != nil {
 			return value.([]byte), nil
@@ -161,12 +168,15 @@ func (st *ContractState) getInitialData(id []byte) ([]byte, error) {
 // GetInitialData returns the value corresponding to the key from the contract storage.
 func (st *ContractState) GetInitialData(key []byte) ([]byte, error) {
 	id := types.GetHashID(key)
+	st.access.readStorage(st.account, id)
 	return st.getInitialData(id[:])
 }
 
 // DeleteData remove key and value pair from the storage.
 func (st *ContractState) DeleteData(key []byte) error {
-	st.storage.put(newValueEntryDelete(types.GetHashID(key)))
+	id := types.GetHashID(key)
+	st.access.writeStorage(st.account, id)
+	st.storage.put(newValueEntryDelete(id))
 	return nil
 }
 
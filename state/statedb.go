@@ -64,6 +64,7 @@ type StateDB struct {
 	store    db.DB
 	batchtx  db.Transaction
 	testmode bool
+	access   *AccessSet
 }
 
 // NewStateDB craete StateDB instance
@@ -146,6 +147,7 @@ func (states *StateDB) PutState(id types.AccountID, state *types.State) error {
 	if id == emptyAccountID {
 		return errPutState
 	}
+	states.access.writeAccount(id)
 	states.buffer.put(newValueEntry(types.HashID(id), state))
 	return nil
 }
@@ -323,6 +325,7 @@ func (states *StateDB) GetState(id types.AccountID) (*types.State, error) {
 // getState returns state of account id from buffer and trie.
 // nil value is returned when there is no state corresponding to account id.
 func (states *StateDB) getState(id types.AccountID) (*types.State, error) {
+	states.access.readAccount(id)
 	// get state from buffer
 	if entry := states.buffer.get(types.HashID(id)); entry != nil {
 		return entry.Value().(*types.State), nil
@@ -0,0 +1,295 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a genesis file from the flags or interactively",
+	Long: `Create a genesis file from the flags or interactively. The genesis is
+validated in the same way as aergosvr init, and the hash of its genesis block
+is printed. The hardfork versions are written as the hardfork section of the
+node config file, which must be shared by all the nodes of the network.`,
+	Args: cobra.NoArgs,
+	Run:  runCreateCmd,
+}
+
+var (
+	createOut         string
+	createInteractive bool
+	createMagic       string
+	createConsensus   string
+	createPublic      bool
+	createMainNet     bool
+	createVersion     int32
+	createTimestamp   int64
+	createBPs         []string
+	createEntBPs      []string
+	createBalances    []string
+	createAdmins      []string
+	createHardforks   []string
+	createHardforkOut string
+)
+
+func init() {
+	fs := createCmd.Flags()
+	fs.StringVarP(&createOut, "out", "o", "", "path of the genesis file to create (default: stdout)")
+	fs.BoolVarP(&createInteractive, "interactive", "i", false, "prompt for the fields not given by the flags")
+	fs.StringVar(&createMagic, "magic", "", "magic of the chain id")
+	fs.StringVar(&createConsensus, "consensus", consensus.ConsensusName[consensus.ConsensusDPOS], "consensus of the chain ("+strings.Join(consensus.ConsensusName, ", ")+")")
+	fs.BoolVar(&createPublic, "public", false, "whether the chain is a public network")
+	fs.BoolVar(&createMainNet, "mainnet", false, "whether the chain is a main network")
+	fs.Int32Var(&createVersion, "version", 0, "version of the chain id")
+	fs.Int64Var(&createTimestamp, "timestamp", 0, "timestamp of the genesis block in unix nanoseconds (default: now)")
+	fs.StringSliceVar(&createBPs, "bp", nil, "peer id of a BP for dpos and sbp")
+	fs.StringArrayVar(&createEntBPs, "enterprise-bp", nil, "BP for raft and bft in the form of name,address,peerid")
+	fs.StringArrayVar(&createBalances, "balance", nil, "initial balance in the form of address=amount (e.g. aergo units: 1000aergo)")
+	fs.StringSliceVar(&createAdmins, "admin", nil, "address of an enterprise admin of a private chain")
+	fs.StringArrayVar(&createHardforks, "hardfork", nil, "block number of a hardfork version in the form of V2=0 (default: all enabled at 0)")
+	fs.StringVar(&createHardforkOut, "hardfork-out", "", "path of the hardfork config to create")
+}
+
+func runCreateCmd(cmd *cobra.Command, args []string) {
+	if createInteractive {
+		if err := prompt(cmd, bufio.NewReader(os.Stdin)); err != nil {
+			cmd.Println("error:", err)
+			os.Exit(1)
+		}
+	}
+	genesis, err := buildGenesis()
+	if err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	hardfork, err := buildHardfork()
+	if err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+
+	b, err := json.MarshalIndent(genesis, "", "    ")
+	if err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	// the genesis block is generated from a copy, since it reorders the BPs
+	// of the genesis.
+	var copied types.Genesis
+	if err := json.Unmarshal(b, &copied); err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	block, err := genesisBlock(&copied)
+	if err != nil {
+		cmd.Println("error: invalid genesis:", err)
+		os.Exit(1)
+	}
+
+	if createOut == "" {
+		cmd.Println(string(b))
+	} else if err := ioutil.WriteFile(createOut, append(b, '\n'), 0644); err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	if createHardforkOut != "" {
+		toml, err := hardfork.TOML()
+		if err == nil {
+			err = ioutil.WriteFile(createHardforkOut, []byte(toml), 0644)
+		}
+		if err != nil {
+			cmd.Println("error:", err)
+			os.Exit(1)
+		}
+	}
+	fmt.Fprintln(os.Stderr, "genesis block hash:", block.ID())
+}
+
+func buildGenesis() (*types.Genesis, error) {
+	if createMagic == "" {
+		return nil, fmt.Errorf("magic is required")
+	}
+	genesis := &types.Genesis{
+		ID: types.ChainID{
+			Version:   createVersion,
+			PublicNet: createPublic,
+			MainNet:   createMainNet,
+			Magic:     createMagic,
+			Consensus: strings.ToLower(createConsensus),
+		},
+		Timestamp: createTimestamp,
+		Balance:   make(map[string]string),
+		BPs:       createBPs,
+		Admins:    createAdmins,
+	}
+	if genesis.Timestamp == 0 {
+		genesis.Timestamp = time.Now().UnixNano()
+	}
+	for _, bp := range createEntBPs {
+		fields := strings.Split(bp, ",")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid enterprise BP (name,address,peerid): %s", bp)
+		}
+		genesis.EnterpriseBPs = append(genesis.EnterpriseBPs, types.EnterpriseBP{
+			Name:    strings.TrimSpace(fields[0]),
+			Address: strings.TrimSpace(fields[1]),
+			PeerID:  strings.TrimSpace(fields[2]),
+		})
+	}
+	for _, balance := range createBalances {
+		kv := strings.SplitN(balance, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid balance (address=amount): %s", balance)
+		}
+		amount, err := util.ParseUnit(kv[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance amount: %s", kv[1])
+		}
+		address := strings.TrimSpace(kv[0])
+		if _, exist := genesis.Balance[address]; exist {
+			return nil, fmt.Errorf("duplicate balance of %s", address)
+		}
+		genesis.Balance[address] = amount.String()
+	}
+	return genesis, nil
+}
+
+func buildHardfork() (*config.HardforkConfig, error) {
+	hardfork := *config.AllEnabledHardforkConfig
+	for _, v := range createHardforks {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid hardfork (version=block number): %s", v)
+		}
+		h, err := strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block number of hardfork: %s", v)
+		}
+		if err := hardfork.Set(strings.TrimSpace(kv[0]), h); err != nil {
+			return nil, err
+		}
+	}
+	if err := hardfork.Validate(); err != nil {
+		return nil, err
+	}
+	if len(createHardforks) != 0 && createHardforkOut == "" {
+		return nil, fmt.Errorf("hardfork-out is required to write the hardfork config")
+	}
+	return &hardfork, nil
+}
+
+// prompt asks the fields of the genesis which are not given by the flags.
+func prompt(cmd *cobra.Command, r *bufio.Reader) error {
+	fs := cmd.Flags()
+	ask := func(question, dflt string) (string, error) {
+		if dflt != "" {
+			question = fmt.Sprintf("%s [%s]", question, dflt)
+		}
+		fmt.Fprintf(os.Stderr, "%s: ", question)
+		line, err := r.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", err
+		}
+		if line = strings.TrimSpace(line); line == "" {
+			return dflt, nil
+		}
+		return line, nil
+	}
+	askBool := func(question string, dflt bool) (bool, error) {
+		answer, err := ask(question+" (y/n)", map[bool]string{true: "y", false: "n"}[dflt])
+		if err != nil {
+			return false, err
+		}
+		return strings.HasPrefix(strings.ToLower(answer), "y"), nil
+	}
+	// askList asks the elements one by one until an empty line.
+	askList := func(question string) ([]string, error) {
+		var list []string
+		for {
+			answer, err := ask(question+" (empty to finish)", "")
+			if err != nil {
+				return nil, err
+			}
+			if answer == "" {
+				return list, nil
+			}
+			list = append(list, answer)
+		}
+	}
+
+	var err error
+	if !fs.Changed("magic") {
+		if createMagic, err = ask("magic", createMagic); err != nil {
+			return err
+		}
+	}
+	if !fs.Changed("consensus") {
+		if createConsensus, err = ask("consensus ("+strings.Join(consensus.ConsensusName, ", ")+")", createConsensus); err != nil {
+			return err
+		}
+	}
+	if !fs.Changed("public") {
+		if createPublic, err = askBool("public network", createPublic); err != nil {
+			return err
+		}
+	}
+	if !fs.Changed("mainnet") {
+		if createMainNet, err = askBool("main network", createMainNet); err != nil {
+			return err
+		}
+	}
+	switch strings.ToLower(createConsensus) {
+	case consensus.ConsensusName[consensus.ConsensusRAFT], consensus.ConsensusName[consensus.ConsensusBFT]:
+		if !fs.Changed("enterprise-bp") {
+			if createEntBPs, err = askList("BP (name,address,peerid)"); err != nil {
+				return err
+			}
+		}
+	default:
+		if !fs.Changed("bp") {
+			if createBPs, err = askList("BP peer id"); err != nil {
+				return err
+			}
+		}
+	}
+	if !fs.Changed("balance") {
+		if createBalances, err = askList("balance (address=amount)"); err != nil {
+			return err
+		}
+	}
+	if !createPublic && !fs.Changed("admin") {
+		if createAdmins, err = askList("enterprise admin address"); err != nil {
+			return err
+		}
+	}
+	if !fs.Changed("hardfork") {
+		if createHardforks, err = askList("hardfork (version=block number)"); err != nil {
+			return err
+		}
+	}
+	if len(createHardforks) != 0 && !fs.Changed("hardfork-out") {
+		if createHardforkOut, err = ask("hardfork config path", "hardfork.toml"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// diffGenesis returns the differences from a to b, one per line, in the
+// order of the genesis fields.
+func diffGenesis(a, b *types.Genesis) []string {
+	var diffs []string
+	changed := func(field string, x, y interface{}) {
+		if x != y {
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", field, x, y))
+		}
+	}
+
+	changed("chain_id.version", a.ID.Version, b.ID.Version)
+	changed("chain_id.public", a.ID.PublicNet, b.ID.PublicNet)
+	changed("chain_id.mainnet", a.ID.MainNet, b.ID.MainNet)
+	changed("chain_id.magic", a.ID.Magic, b.ID.Magic)
+	changed("chain_id.consensus", a.ID.Consensus, b.ID.Consensus)
+	changed("timestamp", a.Timestamp, b.Timestamp)
+
+	addresses := make([]string, 0, len(a.Balance)+len(b.Balance))
+	for address := range a.Balance {
+		addresses = append(addresses, address)
+	}
+	for address := range b.Balance {
+		if _, exist := a.Balance[address]; !exist {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		x, inA := a.Balance[address]
+		y, inB := b.Balance[address]
+		switch {
+		case !inA:
+			diffs = append(diffs, fmt.Sprintf("+ balance[%s]: %s", address, y))
+		case !inB:
+			diffs = append(diffs, fmt.Sprintf("- balance[%s]: %s", address, x))
+		default:
+			changed(fmt.Sprintf("balance[%s]", address), x, y)
+		}
+	}
+
+	diffs = append(diffs, diffList("bps", a.BPs, b.BPs)...)
+	diffs = append(diffs, diffList("enterprise_bps", enterpriseBPs(a), enterpriseBPs(b))...)
+	diffs = append(diffs, diffList("admins", a.Admins, b.Admins)...)
+	return diffs
+}
+
+// diffList returns the elements added to or removed from a, or the change of
+// the order if only the order differs.
+func diffList(field string, a, b []string) []string {
+	var diffs []string
+	count := make(map[string]int)
+	for _, v := range a {
+		count[v]++
+	}
+	for _, v := range b {
+		count[v]--
+	}
+	for _, v := range a {
+		if count[v] > 0 {
+			diffs = append(diffs, fmt.Sprintf("- %s: %s", field, v))
+			count[v]--
+		}
+	}
+	for _, v := range b {
+		if count[v] < 0 {
+			diffs = append(diffs, fmt.Sprintf("+ %s: %s", field, v))
+			count[v]++
+		}
+	}
+	if len(diffs) == 0 && strings.Join(a, "\n") != strings.Join(b, "\n") {
+		diffs = append(diffs, fmt.Sprintf("%s: order changed", field))
+	}
+	return diffs
+}
+
+func enterpriseBPs(g *types.Genesis) []string {
+	bps := make([]string, len(g.EnterpriseBPs))
+	for i, bp := range g.EnterpriseBPs {
+		bps[i] = fmt.Sprintf("%s,%s,%s", bp.Name, bp.Address, bp.PeerID)
+	}
+	return bps
+}
@@ -0,0 +1,45 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	a := &types.Genesis{
+		ID:        types.ChainID{Magic: "alpha", Consensus: "dpos"},
+		Timestamp: 1,
+		Balance:   map[string]string{"abc": "100", "def": "200"},
+		BPs:       []string{"bp1", "bp2"},
+	}
+	b := &types.Genesis{
+		ID:        types.ChainID{Magic: "beta", Consensus: "dpos"},
+		Timestamp: 1,
+		Balance:   map[string]string{"abc": "150", "ghi": "300"},
+		BPs:       []string{"bp2", "bp3"},
+		Admins:    []string{"admin"},
+	}
+	assert.Equal(t, []string{
+		"chain_id.magic: alpha -> beta",
+		"balance[abc]: 100 -> 150",
+		"- balance[def]: 200",
+		"+ balance[ghi]: 300",
+		"- bps: bp1",
+		"+ bps: bp3",
+		"+ admins: admin",
+	}, diffGenesis(a, b))
+	assert.Empty(t, diffGenesis(a, a))
+}
+
+func TestDiffList(t *testing.T) {
+	assert.Empty(t, diffList("bps", []string{"a", "b"}, []string{"a", "b"}))
+	assert.Equal(t, []string{"bps: order changed"}, diffList("bps", []string{"a", "b"}, []string{"b", "a"}))
+	assert.Equal(t, []string{"- bps: a", "+ bps: c"}, diffList("bps", []string{"a", "a", "b"}, []string{"a", "b", "c"}))
+}
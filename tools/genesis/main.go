@@ -0,0 +1,145 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/consensus/impl"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "genesis",
+		Short: "Create, validate and diff the genesis files of custom networks",
+	}
+	validateCmd = &cobra.Command{
+		Use:   "validate <genesis file>",
+		Short: "Validate a genesis file and print its genesis block",
+		Args:  cobra.ExactArgs(1),
+		Run:   runValidateCmd,
+	}
+	hashCmd = &cobra.Command{
+		Use:   "hash <genesis file>",
+		Short: "Print the hash of the genesis block",
+		Args:  cobra.ExactArgs(1),
+		Run:   runHashCmd,
+	}
+	diffCmd = &cobra.Command{
+		Use:   "diff <genesis file> <genesis file>",
+		Short: "Print the differences between two genesis files",
+		Args:  cobra.ExactArgs(2),
+		Run:   runDiffCmd,
+	}
+)
+
+func init() {
+	rootCmd.SetOutput(os.Stdout)
+	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(validateCmd)
+	rootCmd.AddCommand(hashCmd)
+	rootCmd.AddCommand(diffCmd)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func readGenesis(path string) (*types.Genesis, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(types.Genesis)
+	if err := json.Unmarshal(b, genesis); err != nil {
+		return nil, fmt.Errorf("failed to deserialize %s: %v", path, err)
+	}
+	return genesis, nil
+}
+
+// genesisBlock validates the genesis as aergosvr init does, and returns its
+// genesis block.
+func genesisBlock(genesis *types.Genesis) (*types.Block, error) {
+	if err := impl.ValidateGenesis(genesis); err != nil {
+		return nil, err
+	}
+	return chain.ComputeGenesisBlock(genesis)
+}
+
+func runValidateCmd(cmd *cobra.Command, args []string) {
+	genesis, err := readGenesis(args[0])
+	if err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	block, err := genesisBlock(genesis)
+	if err != nil {
+		cmd.Printf("error: invalid genesis %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+	cmd.Println("chain id:", genesis.ID.ToJSON())
+	cmd.Println("timestamp:", genesis.Timestamp)
+	cmd.Println("total balance:", genesis.TotalBalance())
+	cmd.Println("state root:", enc.ToString(block.GetHeader().GetBlocksRootHash()))
+	cmd.Println("hash:", block.ID())
+}
+
+func runHashCmd(cmd *cobra.Command, args []string) {
+	genesis, err := readGenesis(args[0])
+	if err != nil {
+		cmd.Println("error:", err)
+		os.Exit(1)
+	}
+	block, err := genesisBlock(genesis)
+	if err != nil {
+		cmd.Printf("error: invalid genesis %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+	cmd.Println(block.ID())
+}
+
+func runDiffCmd(cmd *cobra.Command, args []string) {
+	var genesis [2]*types.Genesis
+	for i, path := range args {
+		g, err := readGenesis(path)
+		if err != nil {
+			cmd.Println("error:", err)
+			os.Exit(2)
+		}
+		genesis[i] = g
+	}
+	diffs := diffGenesis(genesis[0], genesis[1])
+
+	// generating the genesis block reorders the BPs, so it's done after the
+	// comparison. The other differences are still printed for an invalid
+	// genesis.
+	var hash [2]string
+	for i, g := range genesis {
+		if block, err := genesisBlock(g); err != nil {
+			hash[i] = fmt.Sprintf("(invalid: %v)", err)
+		} else {
+			hash[i] = block.ID()
+		}
+	}
+	if hash[0] != hash[1] {
+		diffs = append(diffs, fmt.Sprintf("hash: %s -> %s", hash[0], hash[1]))
+	}
+	for _, d := range diffs {
+		cmd.Println(d)
+	}
+	if len(diffs) != 0 {
+		os.Exit(1)
+	}
+}
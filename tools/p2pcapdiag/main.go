@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/capture"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use: "p2pcapdiag",
+	}
+	printCmd = &cobra.Command{
+		Use:   "print <path to p2p capture>",
+		Short: "Print the messages in capture as json",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   runPrintCmd,
+	}
+	replayCmd = &cobra.Command{
+		Use:   "replay <path to p2p capture> <multiaddr of target node with peer id>",
+		Short: "Send the messages which the capturing node received, to the target node",
+		Args:  cobra.MinimumNArgs(2),
+		Run:   runReplayCmd,
+	}
+
+	peerFilter string
+	speed      float64
+	linger     time.Duration
+	keyFile    string
+)
+
+func init() {
+	rootCmd.SetOutput(os.Stdout)
+	printCmd.Flags().StringVar(&peerFilter, "peer", "", "print only the messages of the peer id")
+	replayCmd.Flags().StringVar(&peerFilter, "peer", "", "replay only the messages from the peer id")
+	replayCmd.Flags().Float64Var(&speed, "speed", 0, "ratio to the captured intervals of messages. 0 sends messages without waiting")
+	replayCmd.Flags().DurationVar(&linger, "linger", 5*time.Second, "duration to wait for responses after sending all messages")
+	replayCmd.Flags().StringVar(&keyFile, "key", "", "key file of the replaying peer. new key is generated if not set")
+	rootCmd.AddCommand(printCmd)
+	rootCmd.AddCommand(replayCmd)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+type outRecord struct {
+	Time        string
+	Direction   string
+	Peer        string
+	Subprotocol string
+	ID          string
+	OriginalID  string `json:",omitempty"`
+	Length      uint32
+	Body        interface{} `json:",omitempty"`
+	Error       string      `json:",omitempty"`
+}
+
+// readCapture returns the header and the records in capture, filtered by peerFilter.
+func readCapture(cmd *cobra.Command, filename string) (capture.Header, []*capture.Record, bool) {
+	file, err := os.Open(filename)
+	if err != nil {
+		cmd.Printf("error: failed to open file %s\n", filename)
+		return capture.Header{}, nil, false
+	}
+	defer file.Close()
+	reader, err := capture.NewReader(file)
+	if err != nil {
+		cmd.Println("error: failed to read header of capture", err.Error())
+		return capture.Header{}, nil, false
+	}
+	var records []*capture.Record
+	for {
+		r, err := reader.Next()
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				cmd.Println("warn: the last record is truncated")
+			} else if err != io.EOF {
+				cmd.Println("error: on read file", err.Error())
+			}
+			break
+		}
+		if len(peerFilter) > 0 && types.IDB58Encode(r.PeerID) != peerFilter {
+			continue
+		}
+		records = append(records, r)
+	}
+	return reader.Header(), records, true
+}
+
+func runPrintCmd(cmd *cobra.Command, args []string) {
+	header, records, ok := readCapture(cmd, args[0])
+	if !ok {
+		return
+	}
+	out := struct {
+		Self     string
+		Started  string
+		Messages []outRecord
+	}{Self: types.IDB58Encode(header.SelfID), Started: header.Started.Format(time.RFC3339Nano)}
+	for _, r := range records {
+		o := outRecord{Time: r.Time.Format(time.RFC3339Nano), Direction: r.Direction(), Peer: types.IDB58Encode(r.PeerID),
+			Subprotocol: r.Msg.Subprotocol().String(), ID: r.Msg.ID().String(), Length: r.Msg.Length()}
+		if r.Msg.OriginalID() != p2pcommon.EmptyID {
+			o.OriginalID = r.Msg.OriginalID().String()
+		}
+		if body, err := capture.DecodeBody(r.Msg); err != nil {
+			o.Error = err.Error()
+		} else {
+			o.Body = body
+		}
+		out.Messages = append(out.Messages, o)
+	}
+	b, e := json.MarshalIndent(out, "", " ")
+	if e == nil {
+		cmd.Printf("%s\n", b)
+	} else {
+		cmd.Println("error: convert to json ", e.Error())
+	}
+}
+
+func runReplayCmd(cmd *cobra.Command, args []string) {
+	header, records, ok := readCapture(cmd, args[0])
+	if !ok {
+		return
+	}
+	target, err := p2putil.FromMultiAddrString(args[1])
+	if err != nil {
+		cmd.Println("error: invalid target address", err.Error())
+		return
+	}
+
+	var priv crypto.PrivKey
+	if len(keyFile) > 0 {
+		priv, _, err = p2putil.LoadKeyFile(keyFile)
+	} else {
+		priv, _, err = crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	}
+	if err != nil {
+		cmd.Println("error: failed to load key", err.Error())
+		return
+	}
+	ctx := context.Background()
+	host, err := libp2p.New(ctx, libp2p.Identity(priv), libp2p.NoListenAddrs)
+	if err != nil {
+		cmd.Println("error: failed to create host", err.Error())
+		return
+	}
+	defer host.Close()
+	host.Peerstore().AddAddrs(target.ID, target.Addresses, peerstore.TempAddrTTL)
+	s, err := host.NewStream(ctx, target.ID, p2pcommon.P2PSubAddr)
+	if err != nil {
+		cmd.Println("error: failed to connect target node", err.Error())
+		return
+	}
+
+	// the replaying peer doesn't listen, so the address is just to pass the check of handshake
+	self := p2pcommon.NewMetaWith1Addr(host.ID(), "127.0.0.1", 0, "")
+	rp := capture.NewReplayer(log.NewLogger("p2pcapdiag"), header, self)
+	rp.Speed = speed
+	rp.Linger = linger
+	rp.OnRead = func(msg p2pcommon.Message) {
+		cmd.Printf("recv %s id=%s orgid=%s len=%d\n", msg.Subprotocol(), msg.ID(), msg.OriginalID(), msg.Length())
+	}
+	sent, err := rp.Replay(ctx, s, records)
+	if err != nil {
+		cmd.Println("error: replay failed after sending", sent, "messages:", err.Error())
+		return
+	}
+	cmd.Println("sent", sent, "messages")
+}
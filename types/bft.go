@@ -0,0 +1,140 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+// BFTNilRound is the POL round of a proposal whose block isn't locked by the
+// prevotes of any previous round.
+const BFTNilRound = int32(-1)
+
+var (
+	ErrInvalidBFTSignature = errors.New("invalid signature of BFT message")
+	ErrInvalidBFTProposal  = errors.New("invalid BFT proposal")
+)
+
+func signBFT(privKey crypto.PrivKey, data []byte) ([]byte, []byte, error) {
+	pubKey, err := crypto.MarshalPublicKey(privKey.GetPublic())
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := privKey.Sign(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, sig, nil
+}
+
+func verifyBFT(rawPubKey, data, sig []byte) (PeerID, error) {
+	pubKey, err := crypto.UnmarshalPublicKey(rawPubKey)
+	if err != nil {
+		return "", err
+	}
+	if valid, err := pubKey.Verify(data, sig); err != nil || !valid {
+		return "", ErrInvalidBFTSignature
+	}
+	return IDFromPublicKey(pubKey)
+}
+
+func (v *BFTVote) bytesForDigest() []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, v.Type)
+	binary.Write(&buf, binary.LittleEndian, v.Height)
+	binary.Write(&buf, binary.LittleEndian, v.Round)
+	buf.Write(v.BlockHash)
+	return buf.Bytes()
+}
+
+// IsNil reports whether the vote is for no block.
+func (v *BFTVote) IsNil() bool {
+	return len(v.BlockHash) == 0
+}
+
+// Sign signs the vote with the key of validator.
+func (v *BFTVote) Sign(privKey crypto.PrivKey) (err error) {
+	v.PubKey, v.Signature, err = signBFT(privKey, v.bytesForDigest())
+	return err
+}
+
+// Verify checks the signature of vote and returns the ID of validator.
+func (v *BFTVote) Verify() (PeerID, error) {
+	if v.Type != VotePrevote && v.Type != VotePrecommit {
+		return "", ErrInvalidVoteType
+	}
+	return verifyBFT(v.PubKey, v.bytesForDigest(), v.Signature)
+}
+
+func (p *BFTProposal) bytesForDigest() []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, p.Height)
+	binary.Write(&buf, binary.LittleEndian, p.Round)
+	binary.Write(&buf, binary.LittleEndian, p.PolRound)
+	buf.Write(p.Block.BlockHash())
+	return buf.Bytes()
+}
+
+// Sign signs the proposal with the key of proposer. The proposer may be
+// different from the block producer if it proposes the block locked in a
+// previous round.
+func (p *BFTProposal) Sign(privKey crypto.PrivKey) (err error) {
+	p.PubKey, p.Signature, err = signBFT(privKey, p.bytesForDigest())
+	return err
+}
+
+// Verify checks the signature of proposal and returns the ID of proposer.
+func (p *BFTProposal) Verify() (PeerID, error) {
+	if p.Block == nil || p.Block.Header == nil || p.Block.BlockNo() != p.Height ||
+		p.PolRound < BFTNilRound || p.PolRound >= int32(p.Round) {
+		return "", ErrInvalidBFTProposal
+	}
+	return verifyBFT(p.PubKey, p.bytesForDigest(), p.Signature)
+}
+
+// Height returns the height of the proposal or vote in msg.
+func (m *BFTMessage) Height() uint64 {
+	if m.Proposal != nil {
+		return m.Proposal.Height
+	}
+	return m.GetVote().GetHeight()
+}
+
+// Round returns the round of the proposal or vote in msg.
+func (m *BFTMessage) Round() uint32 {
+	if m.Proposal != nil {
+		return m.Proposal.Round
+	}
+	return m.GetVote().GetRound()
+}
+
+// Verify checks that the commit consists of the valid precommits of the
+// distinct validators for the block, and at least quorum of them are the
+// members of validators.
+func (c *BFTCommit) Verify(validators map[PeerID]bool, quorum int) error {
+	voters := make(map[PeerID]bool)
+	for _, v := range c.Votes {
+		if v.Type != VotePrecommit || v.Height != c.Height || v.Round != c.Round || !bytes.Equal(v.BlockHash, c.BlockHash) {
+			return fmt.Errorf("vote for other block in commit of height %d", c.Height)
+		}
+		id, err := v.Verify()
+		if err != nil {
+			return err
+		}
+		if validators[id] {
+			voters[id] = true
+		}
+	}
+	if len(c.BlockHash) == 0 || len(voters) < quorum {
+		return fmt.Errorf("insufficient precommits in commit of height %d: %d (required %d)", c.Height, len(voters), quorum)
+	}
+	return nil
+}
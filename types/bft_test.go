@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBFTVote(t *testing.T) {
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := IDFromPrivateKey(key)
+
+	vote := &BFTVote{Type: VotePrevote, Height: 10, Round: 2, BlockHash: []byte("block hash")}
+	assert.NoError(t, vote.Sign(key))
+	voter, err := vote.Verify()
+	assert.NoError(t, err)
+	assert.Equal(t, id, voter)
+	assert.False(t, vote.IsNil())
+
+	forged := *vote
+	forged.Round = 3
+	_, err = forged.Verify()
+	assert.Equal(t, ErrInvalidBFTSignature, err)
+	forged.Type = 3
+	_, err = forged.Verify()
+	assert.Equal(t, ErrInvalidVoteType, err)
+
+	nilVote := &BFTVote{Type: VotePrecommit, Height: 10, Round: 2}
+	assert.True(t, nilVote.IsNil())
+	assert.NoError(t, nilVote.Sign(key))
+	_, err = nilVote.Verify()
+	assert.NoError(t, err)
+}
+
+func TestBFTProposal(t *testing.T) {
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := IDFromPrivateKey(key)
+
+	block := &Block{Header: &BlockHeader{BlockNo: 10}}
+	proposal := &BFTProposal{Height: 10, Round: 1, PolRound: BFTNilRound, Block: block}
+	assert.NoError(t, proposal.Sign(key))
+	proposer, err := proposal.Verify()
+	assert.NoError(t, err)
+	assert.Equal(t, id, proposer)
+
+	msg := &BFTMessage{Proposal: proposal}
+	assert.Equal(t, uint64(10), msg.Height())
+	assert.Equal(t, uint32(1), msg.Round())
+
+	// the block locked in a later round
+	proposal.PolRound = 1
+	assert.NoError(t, proposal.Sign(key))
+	_, err = proposal.Verify()
+	assert.Equal(t, ErrInvalidBFTProposal, err)
+
+	// the block of other height
+	proposal.PolRound = 0
+	proposal.Height = 11
+	assert.NoError(t, proposal.Sign(key))
+	_, err = proposal.Verify()
+	assert.Equal(t, ErrInvalidBFTProposal, err)
+}
+
+func TestBFTCommit(t *testing.T) {
+	validators := make(map[PeerID]bool)
+	commit := &BFTCommit{Height: 10, Round: 1, BlockHash: []byte("block hash")}
+	for i := 0; i < 3; i++ {
+		key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := IDFromPrivateKey(key)
+		validators[id] = true
+		vote := &BFTVote{Type: VotePrecommit, Height: commit.Height, Round: commit.Round, BlockHash: commit.BlockHash}
+		assert.NoError(t, vote.Sign(key))
+		commit.Votes = append(commit.Votes, vote)
+	}
+	assert.NoError(t, commit.Verify(validators, 3))
+	assert.Error(t, commit.Verify(validators, 4))
+
+	// the same votes are counted once
+	dup := &BFTCommit{Height: commit.Height, Round: commit.Round, BlockHash: commit.BlockHash, Votes: []*BFTVote{commit.Votes[0], commit.Votes[0]}}
+	assert.Error(t, dup.Verify(validators, 2))
+
+	// the vote of other round
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	vote := &BFTVote{Type: VotePrecommit, Height: commit.Height, Round: 0, BlockHash: commit.BlockHash}
+	assert.NoError(t, vote.Sign(key))
+	commit.Votes = append(commit.Votes, vote)
+	assert.Error(t, commit.Verify(validators, 3))
+}
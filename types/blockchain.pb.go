@@ -1041,6 +1041,7 @@ type Event struct {
 	BlockHash            []byte   `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
 	BlockNo              uint64   `protobuf:"varint,7,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
 	TxIndex              int32    `protobuf:"varint,8,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	Removed              bool     `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1127,6 +1128,13 @@ func (m *Event) GetTxIndex() int32 {
 	return 0
 }
 
+func (m *Event) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
 type FnArgument struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1473,6 +1481,8 @@ type FilterInfo struct {
 	Desc                 bool     `protobuf:"varint,5,opt,name=desc,proto3" json:"desc,omitempty"`
 	ArgFilter            []byte   `protobuf:"bytes,6,opt,name=argFilter,proto3" json:"argFilter,omitempty"`
 	RecentBlockCnt       int32    `protobuf:"varint,7,opt,name=recentBlockCnt,proto3" json:"recentBlockCnt,omitempty"`
+	Size                 uint32   `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1552,6 +1562,20 @@ func (m *FilterInfo) GetRecentBlockCnt() int32 {
 	return 0
 }
 
+func (m *FilterInfo) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FilterInfo) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 type Proposal struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
@@ -1607,6 +1631,742 @@ func (m *Proposal) GetMultipleChoice() uint32 {
 	return 0
 }
 
+type ReceiptProof struct {
+	Receipt              *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	MerklePath           [][]byte `protobuf:"bytes,5,rep,name=merklePath,proto3" json:"merklePath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptProof) Reset()         { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()    {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{22}
+}
+
+func (m *ReceiptProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptProof.Unmarshal(m, b)
+}
+func (m *ReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptProof.Marshal(b, m, deterministic)
+}
+func (m *ReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProof.Merge(m, src)
+}
+func (m *ReceiptProof) XXX_Size() int {
+	return xxx_messageInfo_ReceiptProof.Size(m)
+}
+func (m *ReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProof proto.InternalMessageInfo
+
+func (m *ReceiptProof) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+// signed vote of block producer for a block, which is gossiped to finalize the block
+type FinalityVote struct {
+	Type      uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	BlockNo   uint64 `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash []byte `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	PubKey    []byte `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// the block producers vote again in the next round if the votes of a height are split
+	Round                uint32   `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalityVote) Reset()         { *m = FinalityVote{} }
+func (m *FinalityVote) String() string { return proto.CompactTextString(m) }
+func (*FinalityVote) ProtoMessage()    {}
+func (*FinalityVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{23}
+}
+
+func (m *FinalityVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityVote.Unmarshal(m, b)
+}
+func (m *FinalityVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityVote.Marshal(b, m, deterministic)
+}
+func (m *FinalityVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityVote.Merge(m, src)
+}
+func (m *FinalityVote) XXX_Size() int {
+	return xxx_messageInfo_FinalityVote.Size(m)
+}
+func (m *FinalityVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityVote proto.InternalMessageInfo
+
+func (m *FinalityVote) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *FinalityVote) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *FinalityVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *FinalityVote) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *FinalityVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *FinalityVote) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// precommit votes of the 2/3+ block producers in the same round, which finalize a block
+type FinalityCertificate struct {
+	BlockNo              uint64          `protobuf:"varint,1,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte          `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Votes                []*FinalityVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FinalityCertificate) Reset()         { *m = FinalityCertificate{} }
+func (m *FinalityCertificate) String() string { return proto.CompactTextString(m) }
+func (*FinalityCertificate) ProtoMessage()    {}
+func (*FinalityCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{24}
+}
+
+func (m *FinalityCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityCertificate.Unmarshal(m, b)
+}
+func (m *FinalityCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityCertificate.Marshal(b, m, deterministic)
+}
+func (m *FinalityCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCertificate.Merge(m, src)
+}
+func (m *FinalityCertificate) XXX_Size() int {
+	return xxx_messageInfo_FinalityCertificate.Size(m)
+}
+func (m *FinalityCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCertificate proto.InternalMessageInfo
+
+func (m *FinalityCertificate) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *FinalityCertificate) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetVotes() []*FinalityVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// evidence of block producer which signed conflicting votes of the same height and round
+type VoteEquivocation struct {
+	First                *FinalityVote `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second               *FinalityVote `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VoteEquivocation) Reset()         { *m = VoteEquivocation{} }
+func (m *VoteEquivocation) String() string { return proto.CompactTextString(m) }
+func (*VoteEquivocation) ProtoMessage()    {}
+func (*VoteEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{25}
+}
+
+func (m *VoteEquivocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteEquivocation.Unmarshal(m, b)
+}
+func (m *VoteEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteEquivocation.Marshal(b, m, deterministic)
+}
+func (m *VoteEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteEquivocation.Merge(m, src)
+}
+func (m *VoteEquivocation) XXX_Size() int {
+	return xxx_messageInfo_VoteEquivocation.Size(m)
+}
+func (m *VoteEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteEquivocation proto.InternalMessageInfo
+
+func (m *VoteEquivocation) GetFirst() *FinalityVote {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *VoteEquivocation) GetSecond() *FinalityVote {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+// evidence of block producer which signed two different blocks for the same slot
+type DoubleSignEvidence struct {
+	First                *BlockHeader `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second               *BlockHeader `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DoubleSignEvidence) Reset()         { *m = DoubleSignEvidence{} }
+func (m *DoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidence) ProtoMessage()    {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{26}
+}
+
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSignEvidence.Unmarshal(m, b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return xxx_messageInfo_DoubleSignEvidence.Size(m)
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
+func (m *DoubleSignEvidence) GetFirst() *BlockHeader {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSecond() *BlockHeader {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+// list of double-sign evidences
+type DoubleSignEvidenceList struct {
+	Evidences            []*DoubleSignEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DoubleSignEvidenceList) Reset()         { *m = DoubleSignEvidenceList{} }
+func (m *DoubleSignEvidenceList) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidenceList) ProtoMessage()    {}
+func (*DoubleSignEvidenceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{27}
+}
+
+func (m *DoubleSignEvidenceList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSignEvidenceList.Unmarshal(m, b)
+}
+func (m *DoubleSignEvidenceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSignEvidenceList.Marshal(b, m, deterministic)
+}
+func (m *DoubleSignEvidenceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidenceList.Merge(m, src)
+}
+func (m *DoubleSignEvidenceList) XXX_Size() int {
+	return xxx_messageInfo_DoubleSignEvidenceList.Size(m)
+}
+func (m *DoubleSignEvidenceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidenceList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidenceList proto.InternalMessageInfo
+
+func (m *DoubleSignEvidenceList) GetEvidences() []*DoubleSignEvidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+// BFTVote is the signed prevote or precommit of a BFT validator for a block in a round. BlockHash is empty for the vote for nil.
+type BFTVote struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round                uint32   `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,4,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,5,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BFTVote) Reset()         { *m = BFTVote{} }
+func (m *BFTVote) String() string { return proto.CompactTextString(m) }
+func (*BFTVote) ProtoMessage()    {}
+func (*BFTVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{28}
+}
+
+func (m *BFTVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BFTVote.Unmarshal(m, b)
+}
+func (m *BFTVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BFTVote.Marshal(b, m, deterministic)
+}
+func (m *BFTVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BFTVote.Merge(m, src)
+}
+func (m *BFTVote) XXX_Size() int {
+	return xxx_messageInfo_BFTVote.Size(m)
+}
+func (m *BFTVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BFTVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BFTVote proto.InternalMessageInfo
+
+func (m *BFTVote) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *BFTVote) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BFTVote) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BFTVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BFTVote) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *BFTVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// BFTProposal is the block proposed by the proposer of a round. PolRound is the round of the prevotes which the block is locked by, or -1.
+type BFTProposal struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                uint32   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	PolRound             int32    `protobuf:"varint,3,opt,name=polRound,proto3" json:"polRound,omitempty"`
+	Block                *Block   `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,5,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BFTProposal) Reset()         { *m = BFTProposal{} }
+func (m *BFTProposal) String() string { return proto.CompactTextString(m) }
+func (*BFTProposal) ProtoMessage()    {}
+func (*BFTProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{29}
+}
+
+func (m *BFTProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BFTProposal.Unmarshal(m, b)
+}
+func (m *BFTProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BFTProposal.Marshal(b, m, deterministic)
+}
+func (m *BFTProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BFTProposal.Merge(m, src)
+}
+func (m *BFTProposal) XXX_Size() int {
+	return xxx_messageInfo_BFTProposal.Size(m)
+}
+func (m *BFTProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BFTProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BFTProposal proto.InternalMessageInfo
+
+func (m *BFTProposal) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BFTProposal) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BFTProposal) GetPolRound() int32 {
+	if m != nil {
+		return m.PolRound
+	}
+	return 0
+}
+
+func (m *BFTProposal) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BFTProposal) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *BFTProposal) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// BFTCommit is the precommits of 2/3+ validators for a block, which finalize it.
+type BFTCommit struct {
+	Height               uint64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                uint32     `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash            []byte     `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Votes                []*BFTVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BFTCommit) Reset()         { *m = BFTCommit{} }
+func (m *BFTCommit) String() string { return proto.CompactTextString(m) }
+func (*BFTCommit) ProtoMessage()    {}
+func (*BFTCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{30}
+}
+
+func (m *BFTCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BFTCommit.Unmarshal(m, b)
+}
+func (m *BFTCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BFTCommit.Marshal(b, m, deterministic)
+}
+func (m *BFTCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BFTCommit.Merge(m, src)
+}
+func (m *BFTCommit) XXX_Size() int {
+	return xxx_messageInfo_BFTCommit.Size(m)
+}
+func (m *BFTCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BFTCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BFTCommit proto.InternalMessageInfo
+
+func (m *BFTCommit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BFTCommit) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BFTCommit) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BFTCommit) GetVotes() []*BFTVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// BFTMessage is the message of BFT validators gossiped over p2p. Either of Proposal or Vote is set.
+type BFTMessage struct {
+	Proposal             *BFTProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Vote                 *BFTVote     `protobuf:"bytes,2,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BFTMessage) Reset()         { *m = BFTMessage{} }
+func (m *BFTMessage) String() string { return proto.CompactTextString(m) }
+func (*BFTMessage) ProtoMessage()    {}
+func (*BFTMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{31}
+}
+
+func (m *BFTMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BFTMessage.Unmarshal(m, b)
+}
+func (m *BFTMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BFTMessage.Marshal(b, m, deterministic)
+}
+func (m *BFTMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BFTMessage.Merge(m, src)
+}
+func (m *BFTMessage) XXX_Size() int {
+	return xxx_messageInfo_BFTMessage.Size(m)
+}
+func (m *BFTMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BFTMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BFTMessage proto.InternalMessageInfo
+
+func (m *BFTMessage) GetProposal() *BFTProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *BFTMessage) GetVote() *BFTVote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+// BPKeyRotation binds the voted identity of a block producer to a new signing key
+type BPKeyRotation struct {
+	BpID                 []byte   `protobuf:"bytes,1,opt,name=bpID,proto3" json:"bpID,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	ChainID              []byte   `protobuf:"bytes,4,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	BpSign               []byte   `protobuf:"bytes,5,opt,name=bpSign,proto3" json:"bpSign,omitempty"`
+	NewKeySign           []byte   `protobuf:"bytes,6,opt,name=newKeySign,proto3" json:"newKeySign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BPKeyRotation) Reset()         { *m = BPKeyRotation{} }
+func (m *BPKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*BPKeyRotation) ProtoMessage()    {}
+func (*BPKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{32}
+}
+
+func (m *BPKeyRotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPKeyRotation.Unmarshal(m, b)
+}
+func (m *BPKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPKeyRotation.Marshal(b, m, deterministic)
+}
+func (m *BPKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPKeyRotation.Merge(m, src)
+}
+func (m *BPKeyRotation) XXX_Size() int {
+	return xxx_messageInfo_BPKeyRotation.Size(m)
+}
+func (m *BPKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BPKeyRotation proto.InternalMessageInfo
+
+func (m *BPKeyRotation) GetBpID() []byte {
+	if m != nil {
+		return m.BpID
+	}
+	return nil
+}
+
+func (m *BPKeyRotation) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *BPKeyRotation) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *BPKeyRotation) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *BPKeyRotation) GetBpSign() []byte {
+	if m != nil {
+		return m.BpSign
+	}
+	return nil
+}
+
+func (m *BPKeyRotation) GetNewKeySign() []byte {
+	if m != nil {
+		return m.NewKeySign
+	}
+	return nil
+}
+
+// BPKeyHistory is the signing key rotations of a block producer
+type BPKeyHistory struct {
+	Rotations            []*BPKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BPKeyHistory) Reset()         { *m = BPKeyHistory{} }
+func (m *BPKeyHistory) String() string { return proto.CompactTextString(m) }
+func (*BPKeyHistory) ProtoMessage()    {}
+func (*BPKeyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{33}
+}
+
+func (m *BPKeyHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPKeyHistory.Unmarshal(m, b)
+}
+func (m *BPKeyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPKeyHistory.Marshal(b, m, deterministic)
+}
+func (m *BPKeyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPKeyHistory.Merge(m, src)
+}
+func (m *BPKeyHistory) XXX_Size() int {
+	return xxx_messageInfo_BPKeyHistory.Size(m)
+}
+func (m *BPKeyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPKeyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BPKeyHistory proto.InternalMessageInfo
+
+func (m *BPKeyHistory) GetRotations() []*BPKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.TxType", TxType_name, TxType_value)
 	proto.RegisterType((*Block)(nil), "types.Block")
@@ -1631,103 +2391,146 @@ func init() {
 	proto.RegisterType((*StateQuery)(nil), "types.StateQuery")
 	proto.RegisterType((*FilterInfo)(nil), "types.FilterInfo")
 	proto.RegisterType((*Proposal)(nil), "types.Proposal")
+	proto.RegisterType((*ReceiptProof)(nil), "types.ReceiptProof")
+	proto.RegisterType((*FinalityVote)(nil), "types.FinalityVote")
+	proto.RegisterType((*FinalityCertificate)(nil), "types.FinalityCertificate")
+	proto.RegisterType((*VoteEquivocation)(nil), "types.VoteEquivocation")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "types.DoubleSignEvidence")
+	proto.RegisterType((*DoubleSignEvidenceList)(nil), "types.DoubleSignEvidenceList")
+	proto.RegisterType((*BFTVote)(nil), "types.BFTVote")
+	proto.RegisterType((*BFTProposal)(nil), "types.BFTProposal")
+	proto.RegisterType((*BFTCommit)(nil), "types.BFTCommit")
+	proto.RegisterType((*BFTMessage)(nil), "types.BFTMessage")
+	proto.RegisterType((*BPKeyRotation)(nil), "types.BPKeyRotation")
+	proto.RegisterType((*BPKeyHistory)(nil), "types.BPKeyHistory")
 }
 
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_e9ac6287ce250c9a) }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x8f, 0x1b, 0xc7,
-	0x11, 0x0e, 0xc9, 0x19, 0x2e, 0x59, 0xfb, 0xa2, 0x3a, 0x42, 0x32, 0x49, 0x84, 0x60, 0x33, 0x90,
-	0x82, 0x85, 0x90, 0x28, 0x80, 0x82, 0x20, 0x09, 0x72, 0xa2, 0x76, 0xb9, 0x0a, 0xa5, 0xcd, 0xee,
-	0xa6, 0xc5, 0x2c, 0x90, 0x93, 0xd0, 0x9c, 0x69, 0x92, 0x13, 0x0d, 0xa7, 0xa9, 0xe9, 0x26, 0x43,
-	0x9e, 0x7d, 0xf4, 0xcd, 0x37, 0x1f, 0x0d, 0xf8, 0xee, 0x3f, 0x65, 0x18, 0xbe, 0xfa, 0x1f, 0x18,
-	0x55, 0xdd, 0xf3, 0x20, 0x77, 0x6d, 0x43, 0x80, 0x0f, 0xbe, 0x75, 0x7d, 0x5d, 0xdd, 0x53, 0x55,
-	0x5f, 0x3d, 0x7a, 0xa0, 0x37, 0x4e, 0x55, 0xf4, 0x2e, 0x9a, 0x89, 0x24, 0x7b, 0xb6, 0xc8, 0x95,
-	0x51, 0xcc, 0x37, 0x9b, 0x85, 0xd4, 0xe1, 0x1c, 0xfc, 0x17, 0xb8, 0xc5, 0x18, 0x78, 0x33, 0xa1,
-	0x67, 0x41, 0xe3, 0xa4, 0x71, 0x7a, 0xc0, 0x69, 0xcd, 0x9e, 0x42, 0x7b, 0x26, 0x45, 0x2c, 0xf3,
-	0xa0, 0x79, 0xd2, 0x38, 0xdd, 0x7f, 0xce, 0x9e, 0xd1, 0xa1, 0x67, 0x74, 0xe2, 0x9f, 0xb4, 0xc3,
-	0x9d, 0x06, 0x7b, 0x0c, 0xde, 0x58, 0xc5, 0x9b, 0xa0, 0x45, 0x9a, 0xbd, 0xba, 0xe6, 0x0b, 0x15,
-	0x6f, 0x38, 0xed, 0x86, 0x1f, 0xb7, 0x60, 0xbf, 0x76, 0x9a, 0x05, 0xb0, 0x47, 0x46, 0x0d, 0xcf,
-	0xdd, 0x87, 0x0b, 0x91, 0x3d, 0x86, 0xc3, 0x45, 0x2e, 0x57, 0x56, 0x19, 0x0d, 0x6b, 0xd2, 0xfe,
-	0x36, 0x88, 0xe7, 0xc9, 0xb3, 0x2b, 0x45, 0x1f, 0xf6, 0x78, 0x21, 0xb2, 0x47, 0xd0, 0x35, 0xc9,
-	0x5c, 0x6a, 0x23, 0xe6, 0x8b, 0xc0, 0x3b, 0x69, 0x9c, 0xb6, 0x78, 0x05, 0xb0, 0xdf, 0xc3, 0x11,
-	0x29, 0x6a, 0xae, 0x94, 0xa1, 0xeb, 0x7d, 0xba, 0x7e, 0x07, 0x65, 0x27, 0xb0, 0x6f, 0xd6, 0x95,
-	0x52, 0x9b, 0x94, 0xea, 0x10, 0x7b, 0x0a, 0xbd, 0x5c, 0x46, 0x32, 0x59, 0x98, 0x4a, 0x6d, 0x8f,
-	0xd4, 0xee, 0xe0, 0xec, 0xd7, 0xd0, 0x89, 0x54, 0x36, 0x49, 0xf2, 0xb9, 0x0e, 0x3a, 0x64, 0x6e,
-	0x29, 0xb3, 0x5f, 0x40, 0x7b, 0xb1, 0x1c, 0xbf, 0x96, 0x9b, 0xa0, 0x4b, 0xa7, 0x9d, 0xc4, 0x4e,
-	0xe1, 0x38, 0x52, 0x49, 0x36, 0x16, 0x5a, 0xf6, 0xa3, 0x48, 0x2d, 0x33, 0x13, 0x00, 0x29, 0xec,
-	0xc2, 0xc8, 0xa0, 0x4e, 0xa6, 0x59, 0xb0, 0x6f, 0x19, 0xc4, 0x35, 0x46, 0x21, 0x52, 0x99, 0x96,
-	0x99, 0x5e, 0xea, 0xe0, 0x80, 0x36, 0x2a, 0x20, 0x3c, 0x85, 0x6e, 0x49, 0x10, 0xfb, 0x0d, 0xb4,
-	0xcc, 0x5a, 0x07, 0x8d, 0x93, 0xd6, 0xe9, 0xfe, 0xf3, 0xae, 0xe3, 0x6f, 0xb4, 0xe6, 0x88, 0x86,
-	0x4f, 0xa0, 0x3d, 0x5a, 0x5f, 0x26, 0xda, 0x7c, 0xbf, 0xda, 0x3f, 0xa0, 0x39, 0x5a, 0xdf, 0x9b,
-	0x4a, 0xbf, 0x73, 0xe9, 0x61, 0x13, 0xe9, 0xb0, 0x3c, 0x57, 0xcb, 0x8d, 0x4f, 0x9b, 0xf8, 0x11,
-	0xb2, 0xe5, 0x21, 0xf8, 0x99, 0xca, 0x22, 0x49, 0x57, 0x78, 0xdc, 0x0a, 0x48, 0xb6, 0x70, 0x21,
-	0xb0, 0xc9, 0x50, 0x88, 0xe8, 0x66, 0x2e, 0xa3, 0x64, 0x91, 0xc8, 0xcc, 0x50, 0x22, 0x1c, 0xf0,
-	0x0a, 0xc0, 0xd0, 0x8a, 0x39, 0x1d, 0xf3, 0x6c, 0x68, 0xad, 0x84, 0xf7, 0x2d, 0xc4, 0x26, 0x55,
-	0x22, 0x76, 0xec, 0x17, 0x22, 0x12, 0x35, 0x15, 0xfa, 0x32, 0x99, 0x27, 0x86, 0x38, 0xf7, 0x78,
-	0x29, 0xbb, 0xbd, 0x9b, 0x3c, 0x89, 0xa4, 0x23, 0xba, 0x94, 0xd1, 0x4b, 0x74, 0x8c, 0xc8, 0x3d,
-	0xaa, 0x79, 0x39, 0xda, 0x2c, 0x24, 0xa7, 0x2d, 0xcc, 0x28, 0x9b, 0xe2, 0x31, 0xa5, 0x8a, 0x25,
-	0xbb, 0x0e, 0x95, 0x3c, 0x42, 0xc5, 0x63, 0xf8, 0x57, 0xf0, 0x47, 0xeb, 0x61, 0xbc, 0x46, 0x4f,
-	0xc7, 0x65, 0x49, 0xd8, 0x00, 0x57, 0x00, 0xeb, 0x41, 0x2b, 0x89, 0xd7, 0x14, 0x1d, 0x9f, 0xe3,
-	0x32, 0x7c, 0x05, 0xdd, 0xd1, 0x7a, 0x98, 0xd9, 0x1a, 0x0f, 0xc1, 0x37, 0x78, 0x0b, 0x1d, 0xdc,
-	0x7f, 0x7e, 0x50, 0xda, 0x37, 0x8c, 0xd7, 0xdc, 0x6e, 0xb1, 0x5f, 0x41, 0xd3, 0xac, 0x1d, 0x4d,
-	0x35, 0x7a, 0x9b, 0x66, 0x1d, 0x7e, 0xd6, 0x00, 0xff, 0x8d, 0x11, 0x46, 0x7e, 0x37, 0x3f, 0x63,
-	0x91, 0x0a, 0xc4, 0x1d, 0x3f, 0x4e, 0xb4, 0x89, 0x1f, 0x4b, 0x32, 0xda, 0xd2, 0x53, 0xca, 0x18,
-	0x10, 0x6d, 0x54, 0x2e, 0xa6, 0x12, 0xeb, 0xc4, 0x51, 0x54, 0x87, 0xb0, 0xc4, 0xf4, 0xfb, 0x94,
-	0xcb, 0x48, 0xad, 0x64, 0xbe, 0xb9, 0x51, 0x49, 0x66, 0x88, 0x30, 0x8f, 0xdf, 0xc1, 0xc3, 0xaf,
-	0x1b, 0x70, 0xe0, 0x0a, 0xe2, 0x26, 0x57, 0x6a, 0x82, 0x3e, 0x6b, 0xb4, 0x79, 0xc7, 0x67, 0xf2,
-	0x83, 0xdb, 0x2d, 0x0c, 0x6a, 0x92, 0x45, 0xe9, 0x52, 0x27, 0x2a, 0x23, 0xd3, 0x3b, 0xbc, 0x02,
-	0x30, 0xa8, 0xef, 0xe4, 0xc6, 0xd9, 0x8d, 0x4b, 0x74, 0x67, 0x81, 0x97, 0x63, 0xb5, 0x5a, 0x7b,
-	0x4b, 0xb9, 0xdc, 0xbb, 0x15, 0xa9, 0xcb, 0xaa, 0x52, 0xc6, 0x44, 0x1c, 0x27, 0x66, 0x2e, 0x16,
-	0xae, 0x91, 0x38, 0x09, 0xf1, 0x99, 0x4c, 0xa6, 0x33, 0x43, 0x09, 0x75, 0xc8, 0x9d, 0x84, 0x76,
-	0x89, 0x65, 0x9c, 0x98, 0x1b, 0x61, 0x66, 0x41, 0xe7, 0xa4, 0x85, 0x64, 0x97, 0x40, 0xf8, 0x65,
-	0x03, 0x7a, 0x67, 0x2a, 0x33, 0xb9, 0x88, 0xcc, 0xad, 0xc8, 0xad, 0xbb, 0x0f, 0xc1, 0x5f, 0x89,
-	0x74, 0x29, 0x5d, 0x6e, 0x58, 0xe1, 0x07, 0x1c, 0xfc, 0x49, 0xb8, 0x53, 0x84, 0xb9, 0x5b, 0x86,
-	0xf9, 0x95, 0xd7, 0x69, 0xf5, 0xbc, 0xf0, 0xa3, 0x06, 0x1c, 0x13, 0x5b, 0xff, 0x5e, 0x22, 0xcb,
-	0xe4, 0xe5, 0xdf, 0xe1, 0x30, 0x72, 0x9e, 0x13, 0xe0, 0xc8, 0xfd, 0xb9, 0x23, 0xb7, 0x9e, 0x00,
-	0x7c, 0x5b, 0x93, 0xfd, 0x05, 0xba, 0x2b, 0x17, 0x2c, 0x1d, 0x34, 0xa9, 0x8b, 0xfd, 0xd2, 0x1d,
-	0xdb, 0x0d, 0x26, 0xaf, 0x34, 0xc3, 0x2f, 0x5a, 0xb0, 0xc7, 0x6d, 0x3f, 0xb7, 0x2d, 0xd9, 0xaa,
-	0xf6, 0xe3, 0x38, 0x97, 0x5a, 0xbb, 0x68, 0xef, 0xc2, 0x18, 0x09, 0xcc, 0xb0, 0xa5, 0xa6, 0xa0,
-	0x77, 0xb9, 0x93, 0xd0, 0xd7, 0x5c, 0xda, 0x4e, 0xd5, 0xe5, 0xb8, 0x44, 0x4d, 0xb3, 0xa6, 0xfa,
-	0x70, 0x3d, 0xca, 0x4a, 0x58, 0x53, 0x13, 0x29, 0xff, 0xa3, 0x65, 0xd9, 0xa3, 0x9c, 0xc8, 0xfe,
-	0x00, 0x0f, 0xa2, 0xe5, 0x7c, 0x99, 0x0a, 0x93, 0xac, 0xe4, 0x85, 0xd3, 0xb1, 0x44, 0xdc, 0xdd,
-	0xc0, 0xbc, 0x18, 0xa7, 0x4a, 0xcd, 0x5d, 0xcb, 0xb2, 0x02, 0x7b, 0x0c, 0x6d, 0xb9, 0x92, 0x99,
-	0xd1, 0x44, 0x47, 0x55, 0x1d, 0x03, 0x04, 0xb9, 0xdb, 0xab, 0x0f, 0xd9, 0xee, 0x9d, 0x21, 0x5b,
-	0x75, 0x23, 0xd8, 0xed, 0x46, 0x01, 0xec, 0x99, 0xf5, 0x30, 0x8b, 0xe5, 0x9a, 0x66, 0x92, 0xcf,
-	0x0b, 0x11, 0x5b, 0xdc, 0x24, 0x57, 0x73, 0x37, 0x91, 0x68, 0xcd, 0x8e, 0xa0, 0x69, 0x54, 0x70,
-	0x48, 0x48, 0xd3, 0x28, 0x7c, 0x00, 0x4c, 0xa4, 0x3c, 0x97, 0xa9, 0x9c, 0x0a, 0x83, 0x79, 0x7b,
-	0x44, 0x79, 0xbb, 0x0d, 0xe2, 0x37, 0xa6, 0x42, 0x93, 0xef, 0xc7, 0xd6, 0x36, 0x27, 0x86, 0xdf,
-	0x34, 0xc0, 0x27, 0x3f, 0x3e, 0x80, 0xaf, 0x47, 0xd0, 0x25, 0x9f, 0xaf, 0xc4, 0x5c, 0x3a, 0xca,
-	0x2a, 0x00, 0x6b, 0xe1, 0x7f, 0x5a, 0x65, 0xfd, 0x7c, 0xaa, 0x1d, 0x75, 0xa5, 0x8c, 0x7b, 0xa4,
-	0x88, 0xdd, 0xd5, 0x23, 0x67, 0x4b, 0xb9, 0xc6, 0xad, 0xbf, 0xc5, 0xed, 0x56, 0xf4, 0xda, 0xf7,
-	0x44, 0xaf, 0x88, 0xfa, 0xde, 0x76, 0xd4, 0x6b, 0x71, 0xed, 0x6c, 0xc5, 0x35, 0x3c, 0x01, 0xb8,
-	0x40, 0x7b, 0x96, 0x73, 0x69, 0x1f, 0x04, 0x19, 0x3a, 0xd2, 0x20, 0x5b, 0x69, 0x1d, 0x7e, 0xde,
-	0x80, 0xce, 0xc5, 0x32, 0x8b, 0x28, 0x78, 0xf7, 0x28, 0xb0, 0x3f, 0x41, 0x57, 0xb8, 0x0b, 0x8a,
-	0xfa, 0x78, 0xe0, 0xb2, 0xa2, 0xba, 0x9a, 0x57, 0x3a, 0x6e, 0x8a, 0x8a, 0x71, 0x2a, 0x29, 0x28,
-	0x1d, 0x5e, 0x88, 0x78, 0xfd, 0x2a, 0x91, 0xff, 0xa7, 0x78, 0x74, 0x38, 0xad, 0xd9, 0x13, 0x38,
-	0x9a, 0x48, 0xf9, 0x36, 0xae, 0x68, 0xf5, 0xef, 0xa1, 0x35, 0x3c, 0x87, 0x0e, 0xd5, 0xfc, 0xad,
-	0xc8, 0xef, 0xb5, 0x92, 0xb9, 0x41, 0x6b, 0x39, 0xb2, 0x93, 0xb5, 0x07, 0xad, 0x54, 0x66, 0x64,
-	0x84, 0xcf, 0x71, 0x89, 0xce, 0xb6, 0xfa, 0x2f, 0x86, 0x68, 0xe2, 0x4a, 0xe6, 0xd4, 0xfc, 0xec,
-	0x25, 0x85, 0x88, 0xb4, 0xa5, 0x22, 0x9b, 0x2e, 0xc5, 0xb4, 0xb8, 0xab, 0x94, 0xd9, 0x1f, 0xa1,
-	0x3b, 0x71, 0x91, 0x42, 0xbe, 0x31, 0x12, 0xc7, 0x45, 0x24, 0x1c, 0xce, 0x2b, 0x0d, 0xf6, 0x37,
-	0x38, 0xa6, 0x69, 0xf2, 0x76, 0x25, 0xf2, 0x04, 0xfd, 0xd7, 0x81, 0xb7, 0x75, 0xa8, 0x70, 0x88,
-	0x1f, 0x69, 0xb7, 0xb2, 0x6a, 0xe1, 0x35, 0xf8, 0xd4, 0xdb, 0x3e, 0x2c, 0x51, 0xdf, 0xe3, 0x91,
-	0x24, 0x9b, 0x28, 0x37, 0x6c, 0x2b, 0x20, 0xfc, 0xa4, 0x01, 0x50, 0xb5, 0xcc, 0x0f, 0xb8, 0x96,
-	0x81, 0x97, 0xe3, 0x10, 0xb6, 0xb3, 0x8e, 0xd6, 0xec, 0xb7, 0x00, 0x91, 0x9a, 0x2f, 0x70, 0x5f,
-	0xc6, 0x8e, 0xcb, 0x1a, 0x52, 0x9b, 0xdf, 0xaf, 0xe5, 0x46, 0x07, 0x3e, 0xf5, 0xf5, 0x3a, 0xf4,
-	0xca, 0xeb, 0x34, 0x7b, 0xad, 0xf0, 0xab, 0x06, 0xc0, 0x45, 0x92, 0x1a, 0x99, 0x0f, 0xb3, 0x89,
-	0xfa, 0xd1, 0x8a, 0xb2, 0x28, 0x22, 0xea, 0x27, 0xf6, 0x1f, 0xa0, 0x02, 0xca, 0x22, 0x32, 0x8a,
-	0x2c, 0x2f, 0x8a, 0xc8, 0x28, 0x74, 0x35, 0x96, 0x3a, 0x72, 0xe9, 0x47, 0x6b, 0x1a, 0x50, 0xf9,
-	0xd4, 0x1a, 0x59, 0x14, 0x64, 0x09, 0xe0, 0x3f, 0x03, 0xbe, 0xe8, 0x33, 0x43, 0x8f, 0xa9, 0xb3,
-	0xcc, 0x8e, 0x37, 0x9f, 0xef, 0xa0, 0x61, 0x0c, 0x9d, 0x9b, 0x5c, 0x2d, 0x94, 0x16, 0x29, 0x36,
-	0xb5, 0x24, 0x76, 0x49, 0xd7, 0x4c, 0x28, 0x58, 0xf8, 0xa5, 0x3c, 0x59, 0x50, 0xee, 0xdb, 0x2e,
-	0x52, 0x87, 0xf0, 0x2b, 0xf3, 0x65, 0x6a, 0x92, 0x45, 0x2a, 0xcf, 0x66, 0x0a, 0x1f, 0x99, 0x6d,
-	0x1a, 0xa2, 0x3b, 0xe8, 0xd3, 0x04, 0x1f, 0xcb, 0xf8, 0xae, 0x64, 0x00, 0xed, 0xab, 0x6b, 0xfe,
-	0xaf, 0xfe, 0x65, 0xef, 0x67, 0xec, 0x08, 0xe0, 0xe5, 0xf5, 0xed, 0x80, 0x5f, 0xf5, 0xaf, 0xce,
-	0x06, 0xbd, 0x06, 0x3b, 0x80, 0x0e, 0x1f, 0x9c, 0x0f, 0x6e, 0x2e, 0xaf, 0xff, 0xdb, 0x6b, 0xb2,
-	0x07, 0x70, 0x78, 0x31, 0x18, 0x9c, 0x0f, 0x2e, 0x07, 0x2f, 0xfb, 0xa3, 0xe1, 0xf5, 0x55, 0xaf,
-	0x85, 0x0a, 0x23, 0xde, 0xbf, 0x7a, 0x73, 0x31, 0xe0, 0x3d, 0x8f, 0x75, 0xc0, 0x3b, 0xeb, 0x5f,
-	0x5e, 0xf6, 0x7c, 0xbc, 0xd4, 0x1d, 0x6b, 0x8f, 0xdb, 0xf4, 0xc7, 0xf8, 0xe7, 0x6f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x25, 0xc1, 0x82, 0x2d, 0x45, 0x0e, 0x00, 0x00,
+	// 1982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x8f, 0x23, 0x49,
+	0xf1, 0xff, 0x97, 0x5d, 0xe5, 0xb6, 0xa3, 0x5f, 0x9e, 0xdc, 0xd1, 0xfe, 0x6b, 0x61, 0x84, 0x9a,
+	0xd2, 0x2c, 0xea, 0x1d, 0x60, 0x90, 0x06, 0xa1, 0x05, 0x71, 0xea, 0x87, 0xbd, 0xdb, 0x33, 0xbd,
+	0xdd, 0xbd, 0x39, 0xa6, 0x25, 0x4e, 0x4b, 0xb9, 0x2a, 0xed, 0xce, 0x99, 0x72, 0xa5, 0xa7, 0x2a,
+	0xcb, 0x6b, 0x23, 0x71, 0xe2, 0xb8, 0xb7, 0xbd, 0x71, 0x42, 0x48, 0x08, 0x8e, 0x88, 0xef, 0xc4,
+	0x81, 0x8f, 0x81, 0x22, 0x32, 0xeb, 0x61, 0xf7, 0x03, 0x0d, 0xe2, 0xc0, 0x2d, 0x23, 0x32, 0x32,
+	0x33, 0x22, 0x7e, 0xf1, 0xaa, 0x82, 0xfe, 0x38, 0x51, 0xd1, 0xdb, 0xe8, 0x26, 0x94, 0xe9, 0xf3,
+	0x79, 0xa6, 0xb4, 0x62, 0x9e, 0x5e, 0xcd, 0x45, 0x1e, 0xcc, 0xc0, 0x3b, 0xc6, 0x2d, 0xc6, 0xc0,
+	0xbd, 0x09, 0xf3, 0x1b, 0xdf, 0x39, 0x70, 0x0e, 0x77, 0x38, 0xad, 0xd9, 0x33, 0xe8, 0xdc, 0x88,
+	0x30, 0x16, 0x99, 0xdf, 0x3a, 0x70, 0x0e, 0xb7, 0x5f, 0xb0, 0xe7, 0x74, 0xe8, 0x39, 0x9d, 0xf8,
+	0x9c, 0x76, 0xb8, 0x95, 0x60, 0x4f, 0xc1, 0x1d, 0xab, 0x78, 0xe5, 0xb7, 0x49, 0xb2, 0xdf, 0x94,
+	0x3c, 0x56, 0xf1, 0x8a, 0xd3, 0x6e, 0xf0, 0x4d, 0x1b, 0xb6, 0x1b, 0xa7, 0x99, 0x0f, 0x5b, 0xa4,
+	0xd4, 0xd9, 0xa9, 0x7d, 0xb8, 0x24, 0xd9, 0x53, 0xd8, 0x9d, 0x67, 0x62, 0x61, 0x84, 0x51, 0xb1,
+	0x16, 0xed, 0xaf, 0x33, 0xf1, 0x3c, 0x59, 0x76, 0xa1, 0xe8, 0x61, 0x97, 0x97, 0x24, 0x7b, 0x02,
+	0x3d, 0x2d, 0x67, 0x22, 0xd7, 0xe1, 0x6c, 0xee, 0xbb, 0x07, 0xce, 0x61, 0x9b, 0xd7, 0x0c, 0xf6,
+	0x03, 0xd8, 0x23, 0xc1, 0x9c, 0x2b, 0xa5, 0xe9, 0x7a, 0x8f, 0xae, 0xdf, 0xe0, 0xb2, 0x03, 0xd8,
+	0xd6, 0xcb, 0x5a, 0xa8, 0x43, 0x42, 0x4d, 0x16, 0x7b, 0x06, 0xfd, 0x4c, 0x44, 0x42, 0xce, 0x75,
+	0x2d, 0xb6, 0x45, 0x62, 0xb7, 0xf8, 0xec, 0x3b, 0xd0, 0x8d, 0x54, 0x3a, 0x91, 0xd9, 0x2c, 0xf7,
+	0xbb, 0xa4, 0x6e, 0x45, 0xb3, 0x0f, 0xa1, 0x33, 0x2f, 0xc6, 0xaf, 0xc4, 0xca, 0xef, 0xd1, 0x69,
+	0x4b, 0xb1, 0x43, 0xd8, 0x8f, 0x94, 0x4c, 0xc7, 0x61, 0x2e, 0x8e, 0xa2, 0x48, 0x15, 0xa9, 0xf6,
+	0x81, 0x04, 0x36, 0xd9, 0x88, 0x60, 0x2e, 0xa7, 0xa9, 0xbf, 0x6d, 0x10, 0xc4, 0x35, 0x7a, 0x21,
+	0x52, 0x69, 0x2e, 0xd2, 0xbc, 0xc8, 0xfd, 0x1d, 0xda, 0xa8, 0x19, 0xc1, 0x21, 0xf4, 0x2a, 0x80,
+	0xd8, 0x77, 0xa1, 0xad, 0x97, 0xb9, 0xef, 0x1c, 0xb4, 0x0f, 0xb7, 0x5f, 0xf4, 0x2c, 0x7e, 0xa3,
+	0x25, 0x47, 0x6e, 0xf0, 0x31, 0x74, 0x46, 0xcb, 0x73, 0x99, 0xeb, 0x87, 0xc5, 0x7e, 0x09, 0xad,
+	0xd1, 0xf2, 0xce, 0x50, 0xfa, 0xbe, 0x0d, 0x0f, 0x13, 0x48, 0xbb, 0xd5, 0xb9, 0x46, 0x6c, 0xfc,
+	0xa1, 0x85, 0x8f, 0x90, 0x2e, 0x8f, 0xc1, 0x4b, 0x55, 0x1a, 0x09, 0xba, 0xc2, 0xe5, 0x86, 0x40,
+	0xb0, 0x43, 0xeb, 0x02, 0x13, 0x0c, 0x25, 0x89, 0x66, 0x66, 0x22, 0x92, 0x73, 0x29, 0x52, 0x4d,
+	0x81, 0xb0, 0xc3, 0x6b, 0x06, 0xba, 0x36, 0x9c, 0xd1, 0x31, 0xd7, 0xb8, 0xd6, 0x50, 0x78, 0xdf,
+	0x3c, 0x5c, 0x25, 0x2a, 0x8c, 0x2d, 0xfa, 0x25, 0x89, 0x40, 0x4d, 0xc3, 0xfc, 0x5c, 0xce, 0xa4,
+	0x26, 0xcc, 0x5d, 0x5e, 0xd1, 0x76, 0xef, 0x2a, 0x93, 0x91, 0xb0, 0x40, 0x57, 0x34, 0x5a, 0x89,
+	0x86, 0x11, 0xb8, 0x7b, 0x0d, 0x2b, 0x47, 0xab, 0xb9, 0xe0, 0xb4, 0x85, 0x11, 0x65, 0x42, 0x3c,
+	0xa6, 0x50, 0x31, 0x60, 0x37, 0x59, 0x15, 0x8e, 0x50, 0xe3, 0x18, 0x7c, 0x0a, 0xde, 0x68, 0x79,
+	0x16, 0x2f, 0xd1, 0xd2, 0x71, 0x95, 0x12, 0xc6, 0xc1, 0x35, 0x83, 0xf5, 0xa1, 0x2d, 0xe3, 0x25,
+	0x79, 0xc7, 0xe3, 0xb8, 0x0c, 0x5e, 0x42, 0x6f, 0xb4, 0x3c, 0x4b, 0x4d, 0x8e, 0x07, 0xe0, 0x69,
+	0xbc, 0x85, 0x0e, 0x6e, 0xbf, 0xd8, 0xa9, 0xf4, 0x3b, 0x8b, 0x97, 0xdc, 0x6c, 0xb1, 0x8f, 0xa0,
+	0xa5, 0x97, 0x16, 0xa6, 0x06, 0xbc, 0x2d, 0xbd, 0x0c, 0xfe, 0xe4, 0x80, 0xf7, 0x5a, 0x87, 0x5a,
+	0xdc, 0x8f, 0xcf, 0x38, 0x4c, 0x42, 0xe4, 0x5b, 0x7c, 0x2c, 0x69, 0x02, 0x3f, 0x16, 0xa4, 0xb4,
+	0x81, 0xa7, 0xa2, 0xd1, 0x21, 0xb9, 0x56, 0x59, 0x38, 0x15, 0x98, 0x27, 0x16, 0xa2, 0x26, 0x0b,
+	0x53, 0x2c, 0x7f, 0x97, 0x70, 0x11, 0xa9, 0x85, 0xc8, 0x56, 0x57, 0x4a, 0xa6, 0x9a, 0x00, 0x73,
+	0xf9, 0x2d, 0x7e, 0xf0, 0x4f, 0x07, 0x76, 0x6c, 0x42, 0x5c, 0x65, 0x4a, 0x4d, 0xd0, 0xe6, 0x1c,
+	0x75, 0xde, 0xb0, 0x99, 0xec, 0xe0, 0x66, 0x0b, 0x9d, 0x2a, 0xd3, 0x28, 0x29, 0x72, 0xa9, 0x52,
+	0x52, 0xbd, 0xcb, 0x6b, 0x06, 0x3a, 0xf5, 0xad, 0x58, 0x59, 0xbd, 0x71, 0x89, 0xe6, 0xcc, 0xf1,
+	0x72, 0xcc, 0x56, 0xa3, 0x6f, 0x45, 0x57, 0x7b, 0xd7, 0x61, 0x62, 0xa3, 0xaa, 0xa2, 0x31, 0x10,
+	0xc7, 0x52, 0xcf, 0xc2, 0xb9, 0x2d, 0x24, 0x96, 0x42, 0xfe, 0x8d, 0x90, 0xd3, 0x1b, 0x4d, 0x01,
+	0xb5, 0xcb, 0x2d, 0x85, 0x7a, 0x85, 0x45, 0x2c, 0xf5, 0x55, 0xa8, 0x6f, 0xfc, 0xee, 0x41, 0x1b,
+	0xc1, 0xae, 0x18, 0xc1, 0x3f, 0x1c, 0xe8, 0x9f, 0xa8, 0x54, 0x67, 0x61, 0xa4, 0xaf, 0xc3, 0xcc,
+	0x98, 0xfb, 0x18, 0xbc, 0x45, 0x98, 0x14, 0xc2, 0xc6, 0x86, 0x21, 0xfe, 0x8d, 0x81, 0xff, 0x13,
+	0xe6, 0x94, 0x6e, 0xee, 0x55, 0x6e, 0x7e, 0xe9, 0x76, 0xdb, 0x7d, 0x37, 0xf8, 0xbd, 0x03, 0xfb,
+	0x84, 0xd6, 0x97, 0x05, 0xa2, 0x4c, 0x56, 0xfe, 0x02, 0x76, 0x23, 0x6b, 0x39, 0x31, 0x2c, 0xb8,
+	0x1f, 0x58, 0x70, 0x9b, 0x01, 0xc0, 0xd7, 0x25, 0xd9, 0xcf, 0xa0, 0xb7, 0xb0, 0xce, 0xca, 0xfd,
+	0x16, 0x55, 0xb1, 0xff, 0xb7, 0xc7, 0x36, 0x9d, 0xc9, 0x6b, 0xc9, 0xe0, 0x6f, 0x6d, 0xd8, 0xe2,
+	0xa6, 0x9e, 0x9b, 0x92, 0x6c, 0x44, 0x8f, 0xe2, 0x38, 0x13, 0x79, 0x6e, 0xbd, 0xbd, 0xc9, 0x46,
+	0x4f, 0x60, 0x84, 0x15, 0x39, 0x39, 0xbd, 0xc7, 0x2d, 0x85, 0xb6, 0x66, 0xc2, 0x54, 0xaa, 0x1e,
+	0xc7, 0x25, 0x4a, 0xea, 0x25, 0xe5, 0x87, 0xad, 0x51, 0x86, 0xc2, 0x9c, 0x9a, 0x08, 0xf1, 0xab,
+	0x5c, 0x54, 0x35, 0xca, 0x92, 0xec, 0x47, 0xf0, 0x28, 0x2a, 0x66, 0x45, 0x12, 0x6a, 0xb9, 0x10,
+	0x43, 0x2b, 0x63, 0x80, 0xb8, 0xbd, 0x81, 0x71, 0x31, 0x4e, 0x94, 0x9a, 0xd9, 0x92, 0x65, 0x08,
+	0xf6, 0x14, 0x3a, 0x62, 0x21, 0x52, 0x9d, 0x13, 0x1c, 0x75, 0x76, 0x0c, 0x90, 0xc9, 0xed, 0x5e,
+	0xb3, 0xc9, 0xf6, 0x6e, 0x35, 0xd9, 0xba, 0x1a, 0xc1, 0x66, 0x35, 0xf2, 0x61, 0x4b, 0x2f, 0xcf,
+	0xd2, 0x58, 0x2c, 0xa9, 0x27, 0x79, 0xbc, 0x24, 0xb1, 0xc4, 0x4d, 0x32, 0x35, 0xb3, 0x1d, 0x89,
+	0xd6, 0x6c, 0x0f, 0x5a, 0x5a, 0xf9, 0xbb, 0xc4, 0x69, 0x69, 0x85, 0x03, 0xc0, 0x44, 0x88, 0x53,
+	0x91, 0x88, 0x69, 0xa8, 0x31, 0x6e, 0xf7, 0x28, 0x6e, 0xd7, 0x99, 0xf8, 0xc6, 0x34, 0xcc, 0xc9,
+	0xf6, 0x7d, 0xa3, 0x9b, 0x25, 0x83, 0x6f, 0x5a, 0xe0, 0x91, 0x1d, 0xef, 0x81, 0xd7, 0x13, 0xe8,
+	0x91, 0xcd, 0x17, 0xe1, 0x4c, 0x58, 0xc8, 0x6a, 0x06, 0xe6, 0xc2, 0x9b, 0x5c, 0xa5, 0x47, 0xd9,
+	0x34, 0xb7, 0xd0, 0x55, 0x34, 0xee, 0x91, 0x20, 0x56, 0x57, 0x97, 0x8c, 0xad, 0xe8, 0x06, 0xb6,
+	0xde, 0x1a, 0xb6, 0x6b, 0xde, 0xeb, 0xdc, 0xe1, 0xbd, 0xd2, 0xeb, 0x5b, 0xeb, 0x5e, 0x6f, 0xf8,
+	0xb5, 0xbb, 0xee, 0x57, 0x1f, 0xb6, 0x32, 0x31, 0x53, 0x0b, 0x11, 0x13, 0x52, 0x5d, 0x5e, 0x92,
+	0xc1, 0x01, 0xc0, 0x10, 0x35, 0x2d, 0x66, 0xc2, 0x8c, 0x0a, 0x29, 0x9a, 0xe8, 0x90, 0x15, 0xb4,
+	0x0e, 0xfe, 0xec, 0x40, 0x77, 0x58, 0xa4, 0x11, 0xb9, 0xf5, 0x0e, 0x01, 0xf6, 0x13, 0xe8, 0x85,
+	0xf6, 0x82, 0x32, 0x73, 0x1e, 0xd9, 0x78, 0xa9, 0xaf, 0xe6, 0xb5, 0x8c, 0xed, 0xaf, 0xe1, 0x38,
+	0x11, 0xe4, 0xae, 0x2e, 0x2f, 0x49, 0xbc, 0x7e, 0x21, 0xc5, 0xd7, 0xe4, 0xa9, 0x2e, 0xa7, 0x35,
+	0xfb, 0x18, 0xf6, 0x26, 0x42, 0x7c, 0x15, 0xd7, 0x80, 0x7b, 0x77, 0x00, 0x1e, 0x9c, 0x42, 0x97,
+	0xaa, 0xc1, 0x75, 0x98, 0xdd, 0xa9, 0x25, 0xb3, 0x2d, 0xd8, 0xa0, 0x67, 0x7a, 0x6e, 0x1f, 0xda,
+	0x89, 0x48, 0x49, 0x09, 0x8f, 0xe3, 0x12, 0x8d, 0x6d, 0x1f, 0x1d, 0x9f, 0xa1, 0x8a, 0x0b, 0x91,
+	0x51, 0x59, 0x34, 0x97, 0x94, 0x24, 0x02, 0x9a, 0x84, 0xe9, 0xb4, 0x08, 0xa7, 0xe5, 0x5d, 0x15,
+	0xcd, 0x7e, 0x0c, 0xbd, 0x89, 0xf5, 0x14, 0x46, 0x02, 0x7a, 0x62, 0xbf, 0xf4, 0x84, 0xe5, 0xf3,
+	0x5a, 0x82, 0xfd, 0x1c, 0xf6, 0xa9, 0xcf, 0x7c, 0xb5, 0x08, 0x33, 0x89, 0xf6, 0xe7, 0xbe, 0xbb,
+	0x76, 0xa8, 0x34, 0x88, 0xef, 0xe5, 0x76, 0x65, 0xc4, 0x82, 0x4b, 0xf0, 0xa8, 0xea, 0xbd, 0x5f,
+	0x08, 0xbf, 0xc3, 0x23, 0x32, 0x9d, 0x28, 0xdb, 0x86, 0x6b, 0x46, 0xf0, 0xad, 0x03, 0x50, 0x17,
+	0xd3, 0xf7, 0xb8, 0x96, 0x81, 0x9b, 0x61, 0x7b, 0x36, 0x5d, 0x90, 0xd6, 0xec, 0x7b, 0x00, 0x91,
+	0x9a, 0xcd, 0x71, 0x5f, 0xc4, 0x16, 0xcb, 0x06, 0xa7, 0xd1, 0xd9, 0x5f, 0x89, 0x55, 0xee, 0x7b,
+	0x54, 0xf1, 0x9b, 0xac, 0x97, 0x6e, 0xb7, 0xd5, 0x6f, 0x07, 0xdf, 0xb6, 0x00, 0x86, 0x32, 0xd1,
+	0x22, 0x3b, 0x4b, 0x27, 0xea, 0xbf, 0x96, 0xae, 0x65, 0x7a, 0x51, 0xa5, 0x31, 0x5f, 0x07, 0x35,
+	0xa3, 0x4a, 0x2f, 0xad, 0x48, 0xf3, 0x32, 0xbd, 0xb4, 0x42, 0x53, 0x63, 0x91, 0x47, 0x36, 0xfc,
+	0x68, 0x4d, 0xad, 0x2b, 0x9b, 0x1a, 0x25, 0xcb, 0x54, 0xad, 0x18, 0xf8, 0x35, 0x81, 0xb3, 0x7e,
+	0xaa, 0x69, 0xcc, 0x3a, 0x49, 0x4d, 0xe3, 0xf3, 0xf8, 0x06, 0xd7, 0x4c, 0x76, 0xbf, 0x35, 0xe3,
+	0xe1, 0x2e, 0xa7, 0x35, 0x16, 0x87, 0xa8, 0xc8, 0x72, 0x95, 0x95, 0x73, 0xbf, 0xa1, 0x82, 0x18,
+	0xba, 0x57, 0x99, 0x9a, 0xab, 0x3c, 0x4c, 0xb0, 0x34, 0xca, 0xd8, 0x06, 0x68, 0x4b, 0x92, 0x63,
+	0x51, 0xab, 0x4c, 0xce, 0x29, 0x4f, 0x4c, 0x2d, 0x6a, 0xb2, 0x50, 0xa3, 0x59, 0x91, 0x68, 0x39,
+	0x4f, 0xc4, 0xc9, 0x8d, 0xc2, 0x51, 0xb5, 0x43, 0x6f, 0x6e, 0x70, 0x83, 0xbf, 0x38, 0xb0, 0x63,
+	0xdb, 0x9a, 0x69, 0x8f, 0x87, 0x58, 0x41, 0x88, 0xb6, 0x3d, 0x75, 0xcf, 0xc6, 0xa8, 0x95, 0xe2,
+	0xe5, 0xf6, 0x7a, 0xf5, 0x6a, 0x3d, 0x50, 0xbd, 0x36, 0x3e, 0xcc, 0x1e, 0x83, 0x27, 0xa9, 0x76,
+	0xb9, 0xa4, 0x91, 0x21, 0x30, 0x96, 0x66, 0x22, 0x7b, 0x9b, 0x08, 0x1a, 0x0e, 0x4c, 0xa8, 0x34,
+	0x38, 0xa4, 0xe8, 0x50, 0xa6, 0x61, 0x22, 0xf5, 0xea, 0x5a, 0xe9, 0x3a, 0xcf, 0x1d, 0xe3, 0x4b,
+	0xca, 0xf3, 0xc6, 0xa3, 0xad, 0x07, 0x1a, 0x55, 0x7b, 0x53, 0xd9, 0xfa, 0xdb, 0xcb, 0x5d, 0xfb,
+	0xf6, 0x7a, 0x02, 0x3d, 0x9c, 0xbe, 0x43, 0x5d, 0x64, 0xc2, 0xd6, 0xee, 0x9a, 0x81, 0x86, 0x64,
+	0xaa, 0x48, 0x63, 0xeb, 0x5a, 0x43, 0x04, 0x4b, 0xf8, 0xa0, 0xd4, 0xf3, 0x44, 0x64, 0x5a, 0x4e,
+	0x64, 0x84, 0x23, 0x66, 0x43, 0x35, 0xe7, 0x01, 0xd5, 0x6e, 0xf9, 0xf1, 0x13, 0xf0, 0x16, 0x4a,
+	0x8b, 0xb2, 0xcc, 0x94, 0x13, 0x4e, 0xd3, 0x15, 0xdc, 0x48, 0x04, 0x6f, 0xa0, 0x8f, 0xe4, 0xe0,
+	0x5d, 0x21, 0x17, 0x2a, 0x32, 0xed, 0xf1, 0x13, 0xf0, 0x26, 0x32, 0xcb, 0xf5, 0xc6, 0x80, 0xb4,
+	0x7e, 0x9c, 0x24, 0xd8, 0x0f, 0xa1, 0x93, 0x8b, 0x48, 0xa5, 0xb1, 0x1d, 0xfe, 0xef, 0x94, 0xb5,
+	0x22, 0xc1, 0x1b, 0x60, 0xa7, 0xaa, 0x18, 0x27, 0xe2, 0xb5, 0x9c, 0xa6, 0x83, 0x85, 0x8c, 0x05,
+	0x8e, 0xf9, 0x87, 0xeb, 0xaf, 0xdd, 0xf5, 0xbb, 0xc0, 0x3e, 0xf6, 0x6c, 0xe3, 0xb1, 0x3b, 0xff,
+	0x2c, 0xd8, 0xb7, 0xbe, 0x84, 0x0f, 0x6f, 0xbf, 0x45, 0xdf, 0xa2, 0x9f, 0x62, 0xfe, 0x1b, 0xba,
+	0xfc, 0x22, 0xfd, 0xc8, 0x5e, 0x74, 0xfb, 0x04, 0xaf, 0x65, 0x83, 0x3f, 0x3a, 0xb0, 0x75, 0x3c,
+	0x1c, 0xdd, 0x1b, 0x48, 0xf5, 0x04, 0x6b, 0xe2, 0xa8, 0x9c, 0x60, 0x2b, 0xc8, 0xdb, 0x0d, 0xc8,
+	0xd7, 0x11, 0x74, 0xef, 0x0f, 0x2e, 0xef, 0xfe, 0xe0, 0xea, 0x6c, 0x04, 0x57, 0xf0, 0x77, 0x07,
+	0xb6, 0x8f, 0x87, 0xa3, 0xaa, 0x04, 0xd4, 0x1a, 0x39, 0x77, 0x6b, 0xd4, 0x6a, 0x6a, 0x84, 0x53,
+	0xbb, 0x4a, 0x78, 0xa5, 0xaa, 0xc7, 0x2b, 0x1a, 0x3f, 0x88, 0x48, 0x39, 0xd2, 0xb4, 0x1e, 0xf9,
+	0xc8, 0xf3, 0xdc, 0x6c, 0xfd, 0x87, 0x3a, 0xff, 0x0e, 0x7a, 0xc7, 0xc3, 0xd1, 0x89, 0x9a, 0xe1,
+	0x67, 0xf2, 0xfb, 0x29, 0xfc, 0x70, 0x7e, 0x3e, 0x2d, 0x93, 0xc0, 0xb4, 0xcd, 0xb2, 0x24, 0x59,
+	0x04, 0xcb, 0xf8, 0xff, 0x0d, 0xc0, 0xf1, 0x70, 0xf4, 0x85, 0xc8, 0x73, 0xec, 0xd1, 0xcf, 0xe9,
+	0xc3, 0x85, 0x9c, 0xb7, 0x19, 0x8e, 0xb5, 0x5b, 0x79, 0x25, 0xc3, 0x02, 0x70, 0xf1, 0x1a, 0x1b,
+	0x8f, 0x9b, 0x4f, 0xd0, 0x5e, 0xf0, 0x57, 0x07, 0x76, 0x8f, 0xaf, 0x5e, 0x89, 0x15, 0x57, 0x3a,
+	0x2c, 0xe7, 0xa4, 0xf1, 0xbc, 0xfa, 0x79, 0x45, 0xeb, 0x86, 0xf3, 0x5a, 0x6b, 0xce, 0xbb, 0xbf,
+	0x24, 0x36, 0xfe, 0x82, 0xb9, 0xeb, 0x7f, 0xc1, 0xf0, 0x13, 0x6b, 0x8e, 0x51, 0x5c, 0x02, 0x61,
+	0x28, 0x2c, 0x97, 0xa9, 0xf8, 0xfa, 0x95, 0x58, 0xd1, 0x9e, 0x41, 0xa2, 0xc1, 0x09, 0x8e, 0x61,
+	0x87, 0x14, 0xfd, 0x5c, 0x62, 0xbb, 0x5d, 0xb1, 0x17, 0xd0, 0xcb, 0xac, 0xce, 0x65, 0xa6, 0x3c,
+	0x2e, 0x4d, 0x6c, 0x1a, 0xc4, 0x6b, 0xb1, 0x67, 0x12, 0x3a, 0xe6, 0xcf, 0x05, 0x03, 0xe8, 0x5c,
+	0x5c, 0xf2, 0x2f, 0x8e, 0xce, 0xfb, 0xff, 0xc7, 0xf6, 0x00, 0x3e, 0xbb, 0xbc, 0x1e, 0xf0, 0x8b,
+	0xa3, 0x8b, 0x93, 0x41, 0xdf, 0x61, 0x3b, 0xd0, 0xe5, 0x83, 0xd3, 0xc1, 0xd5, 0xf9, 0xe5, 0xaf,
+	0xfb, 0x2d, 0xf6, 0x08, 0x76, 0x87, 0x83, 0xc1, 0xe9, 0xe0, 0x7c, 0xf0, 0xd9, 0xd1, 0xe8, 0xec,
+	0xf2, 0xa2, 0xdf, 0x46, 0x81, 0x11, 0x3f, 0xba, 0x78, 0x3d, 0x1c, 0xf0, 0xbe, 0xcb, 0xba, 0xe0,
+	0x9e, 0x1c, 0x9d, 0x9f, 0xf7, 0x3d, 0xbc, 0xd4, 0x1e, 0xeb, 0x8c, 0x3b, 0xf4, 0x4f, 0xf2, 0xa7,
+	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xba, 0xb6, 0x5a, 0xe4, 0xa7, 0x14, 0x00, 0x00,
 }
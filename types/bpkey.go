@@ -0,0 +1,65 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+var ErrBPKeyRotationSignature = errors.New("invalid signature in BP key rotation")
+
+// NewBPKeyRotation returns the rotation which binds the block producer of
+// bpID to newKey from the block blockNo.
+func NewBPKeyRotation(bpID PeerID, newKey crypto.PubKey, blockNo BlockNo, chainID []byte) (*BPKeyRotation, error) {
+	pubKey, err := crypto.MarshalPublicKey(newKey)
+	if err != nil {
+		return nil, err
+	}
+	return &BPKeyRotation{BpID: []byte(bpID), PubKey: pubKey, BlockNo: blockNo, ChainID: chainID}, nil
+}
+
+func (r *BPKeyRotation) bytesForDigest() ([]byte, error) {
+	return proto.Marshal(&BPKeyRotation{BpID: r.BpID, PubKey: r.PubKey, BlockNo: r.BlockNo, ChainID: r.ChainID})
+}
+
+// Sign signs r by the current signing key of the block producer and the new
+// key, which proves that the holder of the new key agrees to the binding.
+func (r *BPKeyRotation) Sign(current, next crypto.PrivKey) error {
+	msg, err := r.bytesForDigest()
+	if err != nil {
+		return err
+	}
+	if r.BpSign, err = current.Sign(msg); err != nil {
+		return err
+	}
+	r.NewKeySign, err = next.Sign(msg)
+	return err
+}
+
+// Verify checks the signatures of r by current, the current signing key of
+// the block producer, and by the new key. It returns the ID of the new key.
+func (r *BPKeyRotation) Verify(current crypto.PubKey) (PeerID, error) {
+	newKey, err := crypto.UnmarshalPublicKey(r.PubKey)
+	if err != nil {
+		return "", err
+	}
+	msg, err := r.bytesForDigest()
+	if err != nil {
+		return "", err
+	}
+	for _, v := range []struct {
+		key  crypto.PubKey
+		sign []byte
+	}{{current, r.BpSign}, {newKey, r.NewKeySign}} {
+		if valid, err := v.key.Verify(msg, v.sign); err != nil || !valid {
+			return "", ErrBPKeyRotationSignature
+		}
+	}
+	return IDFromPublicKey(newKey)
+}
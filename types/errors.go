@@ -78,6 +78,8 @@ var (
 	ErrNotAllowedFeeDelegation = errors.New("fee delegation is not allowed")
 
 	ErrNotEnoughGas = errors.New("not enough gas")
+
+	ErrInvalidEventCursor = errors.New("invalid event cursor")
 )
 
 type InternalError struct {
@@ -0,0 +1,73 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"time"
+)
+
+var (
+	ErrEvidenceIncomplete  = errors.New("double-sign evidence lacks block header")
+	ErrEvidenceNotConflict = errors.New("blocks of double-sign evidence do not conflict")
+	ErrEvidenceSignature   = errors.New("invalid signature in double-sign evidence")
+	ErrEvidenceNoSlot      = errors.New("double-sign evidence is not supported without production slots")
+)
+
+// ProductionSlot returns the slot in which a DPoS block producer is allowed
+// to sign only one block, which is the slot index of the block timestamp.
+// There is no such slot in the other consensus: e.g. a raft leader may sign
+// another block of the same height after a rollback.
+func ProductionSlot(bh *BlockHeader, blockInterval time.Duration) uint64 {
+	return uint64(bh.GetTimestamp() / blockInterval.Nanoseconds())
+}
+
+// NewDoubleSignEvidence returns the evidence of the two conflicting block
+// headers. The headers are ordered by their hashes so that the evidence of
+// the same blocks is identical regardless of the order of detection.
+func NewDoubleSignEvidence(a, b *BlockHeader) *DoubleSignEvidence {
+	if bytes.Compare(headerHash(a), headerHash(b)) > 0 {
+		a, b = b, a
+	}
+	return &DoubleSignEvidence{First: a, Second: b}
+}
+
+func headerHash(bh *BlockHeader) []byte {
+	if bh == nil {
+		return nil
+	}
+	return (&Block{Header: bh}).calculateBlockHash()
+}
+
+// Slot returns the production slot of the conflicting blocks.
+func (e *DoubleSignEvidence) Slot(blockInterval time.Duration) uint64 {
+	return ProductionSlot(e.GetFirst(), blockInterval)
+}
+
+// Verify checks that both block headers of the evidence are validly signed
+// by the same block producer for the same slot of the same chain, and returns
+// the ID of the block producer.
+func (e *DoubleSignEvidence) Verify(blockInterval time.Duration) (PeerID, error) {
+	if blockInterval <= 0 {
+		return "", ErrEvidenceNoSlot
+	}
+	first, second := e.GetFirst(), e.GetSecond()
+	if first == nil || second == nil {
+		return "", ErrEvidenceIncomplete
+	}
+	if !bytes.Equal(first.PubKey, second.PubKey) || !bytes.Equal(first.ChainID, second.ChainID) ||
+		ProductionSlot(first, blockInterval) != ProductionSlot(second, blockInterval) ||
+		bytes.Equal(headerHash(first), headerHash(second)) {
+		return "", ErrEvidenceNotConflict
+	}
+	for _, bh := range []*BlockHeader{first, second} {
+		if valid, err := (&Block{Header: bh}).VerifySign(); err != nil || !valid {
+			return "", ErrEvidenceSignature
+		}
+	}
+	return (&Block{Header: first}).BPID()
+}
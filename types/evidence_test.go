@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoubleSignEvidence(t *testing.T) {
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	other, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	signed := func(key crypto.PrivKey, no BlockNo, ts time.Duration, prev string) *BlockHeader {
+		block := &Block{Header: &BlockHeader{
+			ChainID:       []byte("chain"),
+			PrevBlockHash: []byte(prev),
+			BlockNo:       no,
+			Timestamp:     int64(ts),
+		}}
+		assert.NoError(t, block.Sign(key))
+		return block.Header
+	}
+	interval := time.Second
+	a := signed(key, 10, 100*time.Second, "a")
+	b := signed(key, 10, 100*time.Second+interval/2, "b")
+
+	evidence := NewDoubleSignEvidence(a, b)
+	id, err := evidence.Verify(interval)
+	assert.NoError(t, err)
+	expected, _ := IDFromPrivateKey(key)
+	assert.Equal(t, expected, id)
+	assert.Equal(t, uint64(100), evidence.Slot(interval))
+	assert.Equal(t, evidence, NewDoubleSignEvidence(b, a))
+
+	// the same block, the blocks of different slots or block producers
+	tests := []struct {
+		name   string
+		first  *BlockHeader
+		second *BlockHeader
+	}{
+		{"same block", a, a},
+		{"different slot", a, signed(key, 11, 101*time.Second, "b")},
+		{"different BP", a, signed(other, 10, 100*time.Second, "b")},
+	}
+	for _, tt := range tests {
+		_, err := (&DoubleSignEvidence{First: tt.first, Second: tt.second}).Verify(interval)
+		assert.Equal(t, ErrEvidenceNotConflict, err, tt.name)
+	}
+
+	// the blocks of the same height aren't double-signed without slots (raft)
+	c := signed(key, 10, 200*time.Second, "c")
+	_, err = NewDoubleSignEvidence(a, c).Verify(0)
+	assert.Equal(t, ErrEvidenceNoSlot, err)
+	_, err = NewDoubleSignEvidence(a, c).Verify(interval)
+	assert.Equal(t, ErrEvidenceNotConflict, err)
+
+	// tampered header
+	forged := *b
+	forged.TxsRootHash = []byte("forged")
+	_, err = NewDoubleSignEvidence(a, &forged).Verify(interval)
+	assert.Equal(t, ErrEvidenceSignature, err)
+
+	_, err = (&DoubleSignEvidence{First: a}).Verify(interval)
+	assert.Equal(t, ErrEvidenceIncomplete, err)
+}
@@ -0,0 +1,122 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/minio/sha256-simd"
+)
+
+// the types of finality vote. A block producer prevotes a block when it is
+// connected to the best chain, and precommits it after 2/3+ prevotes of it
+// are collected in the same round.
+const (
+	VotePrevote uint32 = iota + 1
+	VotePrecommit
+)
+
+var (
+	ErrInvalidVoteType      = errors.New("invalid type of finality vote")
+	ErrInvalidVoteSignature = errors.New("invalid signature of finality vote")
+	ErrVotesNotConflict     = errors.New("votes of equivocation evidence do not conflict")
+)
+
+func (v *FinalityVote) bytesForDigest() []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, v.Type)
+	binary.Write(&buf, binary.LittleEndian, v.BlockNo)
+	buf.Write(v.BlockHash)
+	binary.Write(&buf, binary.LittleEndian, v.Round)
+	return buf.Bytes()
+}
+
+// Hash returns the hash of vote including the signature, which identifies
+// the vote while it is gossiped.
+func (v *FinalityVote) Hash() []byte {
+	digest := sha256.New()
+	digest.Write(v.bytesForDigest())
+	digest.Write(v.PubKey)
+	digest.Write(v.Signature)
+	return digest.Sum(nil)
+}
+
+// Sign signs the vote with the key of block producer.
+func (v *FinalityVote) Sign(privKey crypto.PrivKey) error {
+	pubKey, err := crypto.MarshalPublicKey(privKey.GetPublic())
+	if err != nil {
+		return err
+	}
+	v.PubKey = pubKey
+	v.Signature, err = privKey.Sign(v.bytesForDigest())
+	return err
+}
+
+// Verify checks the signature of vote and returns the ID of voter.
+func (v *FinalityVote) Verify() (PeerID, error) {
+	if v.Type != VotePrevote && v.Type != VotePrecommit {
+		return "", ErrInvalidVoteType
+	}
+	pubKey, err := crypto.UnmarshalPublicKey(v.PubKey)
+	if err != nil {
+		return "", err
+	}
+	if valid, err := pubKey.Verify(v.bytesForDigest(), v.Signature); err != nil || !valid {
+		return "", ErrInvalidVoteSignature
+	}
+	return IDFromPublicKey(pubKey)
+}
+
+// IsEquivocation reports whether the two votes of the same voter conflict,
+// that is, they are the votes of the same type, height and round for
+// different blocks.
+func (v *FinalityVote) IsEquivocation(other *FinalityVote) bool {
+	return v.Type == other.Type && v.BlockNo == other.BlockNo && v.Round == other.Round &&
+		bytes.Equal(v.PubKey, other.PubKey) && !bytes.Equal(v.BlockHash, other.BlockHash)
+}
+
+// Verify checks that both votes of the evidence are validly signed and
+// conflict, and returns the ID of the voter.
+func (e *VoteEquivocation) Verify() (PeerID, error) {
+	first, second := e.GetFirst(), e.GetSecond()
+	if first == nil || second == nil || !first.IsEquivocation(second) {
+		return "", ErrVotesNotConflict
+	}
+	if _, err := second.Verify(); err != nil {
+		return "", err
+	}
+	return first.Verify()
+}
+
+// Verify checks that the certificate consists of the valid precommits of
+// the distinct block producers for the block in the same round, and at least
+// quorum of them are the members of bps.
+func (fc *FinalityCertificate) Verify(bps map[PeerID]bool, quorum int) error {
+	voters := make(map[PeerID]bool)
+	for _, v := range fc.Votes {
+		if v.Type != VotePrecommit || v.BlockNo != fc.BlockNo || !bytes.Equal(v.BlockHash, fc.BlockHash) {
+			return fmt.Errorf("vote for other block in certificate of block %d", fc.BlockNo)
+		}
+		if v.Round != fc.Votes[0].Round {
+			return fmt.Errorf("votes of different rounds in certificate of block %d", fc.BlockNo)
+		}
+		id, err := v.Verify()
+		if err != nil {
+			return err
+		}
+		if bps[id] {
+			voters[id] = true
+		}
+	}
+	if len(voters) < quorum {
+		return fmt.Errorf("insufficient votes in certificate of block %d: %d (required %d)", fc.BlockNo, len(voters), quorum)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinalityVote(t *testing.T) {
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := IDFromPrivateKey(key)
+
+	vote := &FinalityVote{Type: VotePrevote, BlockNo: 10, BlockHash: []byte("block hash")}
+	assert.NoError(t, vote.Sign(key))
+	voter, err := vote.Verify()
+	assert.NoError(t, err)
+	assert.Equal(t, id, voter)
+
+	forged := *vote
+	forged.Type = VotePrecommit
+	_, err = forged.Verify()
+	assert.Equal(t, ErrInvalidVoteSignature, err)
+	forged.Type = 3
+	_, err = forged.Verify()
+	assert.Equal(t, ErrInvalidVoteType, err)
+
+	other := &FinalityVote{Type: VotePrevote, BlockNo: 10, BlockHash: []byte("other hash")}
+	assert.NoError(t, other.Sign(key))
+	assert.True(t, vote.IsEquivocation(other))
+	assert.NotEqual(t, vote.Hash(), other.Hash())
+	evidence := &VoteEquivocation{First: vote, Second: other}
+	voter, err = evidence.Verify()
+	assert.NoError(t, err)
+	assert.Equal(t, id, voter)
+	evidence.Second = &forged
+	_, err = evidence.Verify()
+	assert.Equal(t, ErrVotesNotConflict, err)
+
+	// the votes of other rounds or heights
+	other.Round = 1
+	assert.NoError(t, other.Sign(key))
+	assert.False(t, vote.IsEquivocation(other))
+	_, err = (&VoteEquivocation{First: vote, Second: other}).Verify()
+	assert.Equal(t, ErrVotesNotConflict, err)
+	other.BlockNo, other.Round = 11, 0
+	assert.NoError(t, other.Sign(key))
+	assert.False(t, vote.IsEquivocation(other))
+}
+
+func TestFinalityCertificate(t *testing.T) {
+	bps := make(map[PeerID]bool)
+	cert := &FinalityCertificate{BlockNo: 10, BlockHash: []byte("block hash")}
+	for i := 0; i < 3; i++ {
+		key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		id, _ := IDFromPrivateKey(key)
+		bps[id] = true
+		vote := &FinalityVote{Type: VotePrecommit, BlockNo: cert.BlockNo, BlockHash: cert.BlockHash}
+		assert.NoError(t, vote.Sign(key))
+		cert.Votes = append(cert.Votes, vote)
+	}
+	assert.NoError(t, cert.Verify(bps, 3))
+	assert.Error(t, cert.Verify(bps, 4))
+
+	// the same votes are counted once
+	dup := &FinalityCertificate{BlockNo: cert.BlockNo, BlockHash: cert.BlockHash, Votes: []*FinalityVote{cert.Votes[0], cert.Votes[0], cert.Votes[0]}}
+	assert.Error(t, dup.Verify(bps, 2))
+
+	// the votes of different rounds
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := IDFromPrivateKey(key)
+	bps[id] = true
+	later := &FinalityVote{Type: VotePrecommit, BlockNo: cert.BlockNo, BlockHash: cert.BlockHash, Round: 1}
+	assert.NoError(t, later.Sign(key))
+	mixed := &FinalityCertificate{BlockNo: cert.BlockNo, BlockHash: cert.BlockHash, Votes: append([]*FinalityVote{later}, cert.Votes...)}
+	assert.Error(t, mixed.Verify(bps, 3))
+
+	// the vote for other block
+	vote := &FinalityVote{Type: VotePrecommit, BlockNo: cert.BlockNo, BlockHash: []byte("other hash")}
+	assert.NoError(t, vote.Sign(key))
+	cert.Votes = append(cert.Votes, vote)
+	assert.Error(t, cert.Verify(bps, 3))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -216,6 +217,9 @@ type Genesis struct {
 	Balance       map[string]string `json:"balance"`
 	BPs           []string          `json:"bps"`
 	EnterpriseBPs []EnterpriseBP    `json:"enterprise_bps,omitempty"`
+	// Admins are the initial admins of the enterprise contract, which is
+	// available only on a private chain.
+	Admins []string `json:"admins,omitempty"`
 
 	// followings are for internal use only
 	totalBalance *big.Int
@@ -229,10 +233,37 @@ func (g *Genesis) Validate() error {
 	if err != nil {
 		return err
 	}
+	for address, balance := range g.Balance {
+		if _, err := DecodeAddress(address); err != nil {
+			return fmt.Errorf("invalid address in balance: %s (%v)", address, err)
+		}
+		if v, ok := new(big.Int).SetString(balance, 10); !ok || v.Sign() < 0 {
+			return fmt.Errorf("invalid balance of %s: %s", address, balance)
+		}
+	}
+	if len(g.Admins) > 0 && g.PublicNet() {
+		return errors.New("enterprise admins are not allowed on a public chain")
+	}
+	for _, admin := range g.Admins {
+		if addr, err := DecodeAddress(admin); err != nil || len(addr) != AddressLength {
+			return fmt.Errorf("invalid enterprise admin address: %s", admin)
+		}
+	}
 	//TODO check BP count
 	return nil
 }
 
+// AdminAddresses returns the decoded addresses of g.Admins.
+func (g *Genesis) AdminAddresses() [][]byte {
+	var addresses [][]byte
+	for _, admin := range g.Admins {
+		if addr, err := DecodeAddress(admin); err == nil {
+			addresses = append(addresses, addr)
+		}
+	}
+	return addresses
+}
+
 // Block returns Block corresponding to g.
 func (g *Genesis) Block() *Block {
 	if g.block == nil {
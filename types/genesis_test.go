@@ -89,3 +89,29 @@ func TestUpdateChainIdVersion(t *testing.T) {
 		t.Log(cid1.ToJSON())
 	}
 }
+
+func TestGenesisValidate(t *testing.T) {
+	a := assert.New(t)
+	admin := EncodeAddress(AddressPadding([]byte("admin")))
+
+	g := GetDefaultGenesis()
+	g.Balance = map[string]string{admin: "1000", "abc": "0"}
+	g.Admins = []string{admin}
+	a.NoError(g.Validate())
+	a.Equal([][]byte{AddressPadding([]byte("admin"))}, g.AdminAddresses())
+
+	g.Balance["abc"] = "-1"
+	a.Error(g.Validate(), "negative balance")
+	g.Balance["abc"] = "1.5"
+	a.Error(g.Validate(), "invalid balance")
+	delete(g.Balance, "abc")
+	g.Balance["ab c"] = "1"
+	a.Error(g.Validate(), "invalid address")
+	delete(g.Balance, "ab c")
+
+	g.Admins = []string{"abc"}
+	a.Error(g.Validate(), "name can't be an admin")
+	g.Admins = []string{admin}
+	g.ID.PublicNet = true
+	a.Error(g.Validate(), "admins on a public chain")
+}
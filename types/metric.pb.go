@@ -131,6 +131,11 @@ type PeerMetric struct {
 	AvrIn                int64    `protobuf:"varint,3,opt,name=avrIn,proto3" json:"avrIn,omitempty"`
 	SumOut               int64    `protobuf:"varint,4,opt,name=sumOut,proto3" json:"sumOut,omitempty"`
 	AvrOut               int64    `protobuf:"varint,5,opt,name=avrOut,proto3" json:"avrOut,omitempty"`
+	TxNoticeIn           int64    `protobuf:"varint,6,opt,name=txNoticeIn,proto3" json:"txNoticeIn,omitempty"`
+	TxNoticeDup          int64    `protobuf:"varint,7,opt,name=txNoticeDup,proto3" json:"txNoticeDup,omitempty"`
+	TxNoticeOut          int64    `protobuf:"varint,8,opt,name=txNoticeOut,proto3" json:"txNoticeOut,omitempty"`
+	TxQueryIn            int64    `protobuf:"varint,9,opt,name=txQueryIn,proto3" json:"txQueryIn,omitempty"`
+	TxQueryLimited       int64    `protobuf:"varint,10,opt,name=txQueryLimited,proto3" json:"txQueryLimited,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -196,6 +201,41 @@ func (m *PeerMetric) GetAvrOut() int64 {
 	return 0
 }
 
+func (m *PeerMetric) GetTxNoticeIn() int64 {
+	if m != nil {
+		return m.TxNoticeIn
+	}
+	return 0
+}
+
+func (m *PeerMetric) GetTxNoticeDup() int64 {
+	if m != nil {
+		return m.TxNoticeDup
+	}
+	return 0
+}
+
+func (m *PeerMetric) GetTxNoticeOut() int64 {
+	if m != nil {
+		return m.TxNoticeOut
+	}
+	return 0
+}
+
+func (m *PeerMetric) GetTxQueryIn() int64 {
+	if m != nil {
+		return m.TxQueryIn
+	}
+	return 0
+}
+
+func (m *PeerMetric) GetTxQueryLimited() int64 {
+	if m != nil {
+		return m.TxQueryLimited
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("types.MetricType", MetricType_name, MetricType_value)
 	proto.RegisterType((*MetricsRequest)(nil), "types.MetricsRequest")
@@ -206,20 +246,24 @@ func init() {
 func init() { proto.RegisterFile("metric.proto", fileDescriptor_da41641f55bff5df) }
 
 var fileDescriptor_da41641f55bff5df = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcf, 0x4b, 0xc3, 0x30,
-	0x14, 0x80, 0x8d, 0xb5, 0x1b, 0xbc, 0x8e, 0x39, 0x83, 0x48, 0x8e, 0x65, 0x17, 0xcb, 0x0e, 0x3d,
-	0xd4, 0x93, 0x77, 0x45, 0x83, 0xd8, 0x96, 0x50, 0xf0, 0x28, 0x53, 0xde, 0xc1, 0x43, 0xb7, 0x98,
-	0x1f, 0x83, 0xde, 0xfc, 0xd3, 0x25, 0x79, 0x61, 0x3d, 0x7e, 0xdf, 0x7b, 0x5f, 0x20, 0x0f, 0x56,
-	0x23, 0x3a, 0xf3, 0xf3, 0x5d, 0x6b, 0x73, 0x74, 0x47, 0x9e, 0xbb, 0x49, 0xa3, 0xdd, 0x3e, 0xc2,
-	0xfa, 0x3d, 0x6a, 0xab, 0xf0, 0xd7, 0xa3, 0x75, 0xfc, 0x1e, 0x68, 0x24, 0x58, 0x99, 0x55, 0xeb,
-	0xe6, 0xa6, 0x8e, 0x54, 0xd3, 0xd6, 0x30, 0x69, 0x54, 0x29, 0x6d, 0x60, 0x99, 0xd2, 0xd0, 0x68,
-	0x44, 0x43, 0x4d, 0x71, 0x6e, 0x7a, 0x44, 0x43, 0x2b, 0x8a, 0xe6, 0xdb, 0x3f, 0x06, 0x30, 0x5b,
-	0x7e, 0x07, 0x8b, 0xe0, 0xe5, 0x93, 0x60, 0x25, 0xab, 0x56, 0x2a, 0x11, 0xbf, 0x85, 0xdc, 0xfa,
-	0x51, 0x1e, 0xc4, 0x65, 0xc9, 0xaa, 0x4c, 0x11, 0x04, 0xbb, 0x3f, 0x19, 0x79, 0x10, 0x19, 0xd9,
-	0x08, 0xe1, 0x0d, 0xeb, 0xc7, 0xce, 0x3b, 0x71, 0x15, 0x75, 0xa2, 0xe0, 0xf7, 0x27, 0x13, 0x7c,
-	0x4e, 0x9e, 0x68, 0xb7, 0x03, 0x98, 0xff, 0xc2, 0x0b, 0x58, 0xb6, 0xdd, 0xf0, 0x2a, 0xdb, 0x97,
-	0xcd, 0x05, 0xbf, 0x86, 0xa2, 0x6f, 0xfa, 0xcf, 0xf6, 0x79, 0xf8, 0xe8, 0xd4, 0xdb, 0x86, 0x7d,
-	0x2d, 0xe2, 0xad, 0x1e, 0xfe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x5e, 0x48, 0xee, 0x3b, 0x01,
-	0x00, 0x00,
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x3d, 0x4f, 0xf3, 0x30,
+	0x14, 0x85, 0xdf, 0xb4, 0x6f, 0x5b, 0x7a, 0x53, 0x85, 0x62, 0x21, 0xe4, 0x01, 0xa1, 0xa8, 0x03,
+	0x44, 0x1d, 0x32, 0x84, 0x89, 0xbd, 0x08, 0x2c, 0x20, 0x09, 0x56, 0x24, 0x46, 0x54, 0xca, 0x1d,
+	0x32, 0xe4, 0x03, 0xc7, 0xae, 0x9a, 0xbf, 0xc4, 0xaf, 0x44, 0xb6, 0x03, 0x09, 0x8c, 0xcf, 0x73,
+	0xce, 0xb9, 0x91, 0x62, 0x58, 0x14, 0x28, 0x45, 0xbe, 0x0b, 0x6b, 0x51, 0xc9, 0x8a, 0x4c, 0x64,
+	0x5b, 0x63, 0xb3, 0xba, 0x01, 0xef, 0xc9, 0xe8, 0x86, 0xe3, 0x87, 0xc2, 0x46, 0x92, 0x2b, 0xb0,
+	0x11, 0x75, 0xfc, 0x71, 0xe0, 0x45, 0x27, 0xa1, 0xa1, 0xd0, 0xb6, 0xb2, 0xb6, 0x46, 0xde, 0x4d,
+	0x23, 0x98, 0x75, 0x53, 0xbd, 0xa9, 0x11, 0x85, 0xdd, 0xb8, 0x3f, 0x9b, 0x14, 0x51, 0xd8, 0x0a,
+	0xb7, 0xf9, 0xea, 0x73, 0x04, 0xd0, 0x5b, 0x72, 0x06, 0x53, 0xed, 0xd9, 0x86, 0x3a, 0xbe, 0x13,
+	0x2c, 0x78, 0x47, 0xe4, 0x14, 0x26, 0x8d, 0x2a, 0x58, 0x49, 0x47, 0xbe, 0x13, 0x8c, 0xb9, 0x05,
+	0x6d, 0xb7, 0x7b, 0xc1, 0x4a, 0x3a, 0xb6, 0xd6, 0x80, 0xbe, 0xd1, 0xa8, 0x22, 0x51, 0x92, 0xfe,
+	0x37, 0xba, 0x23, 0xed, 0xb7, 0x7b, 0xa1, 0xfd, 0xc4, 0x7a, 0x4b, 0xe4, 0x02, 0x40, 0x1e, 0xe2,
+	0x4a, 0xe6, 0x3b, 0x64, 0x25, 0x9d, 0x9a, 0x6c, 0x60, 0x88, 0x0f, 0xee, 0x37, 0x6d, 0x54, 0x4d,
+	0x67, 0xa6, 0x30, 0x54, 0xc3, 0x86, 0x3e, 0x7f, 0xf4, 0xbb, 0xa1, 0xbf, 0x71, 0x0e, 0x73, 0x79,
+	0x78, 0x56, 0x28, 0x5a, 0x56, 0xd2, 0xb9, 0xc9, 0x7b, 0x41, 0x2e, 0xc1, 0xeb, 0xe0, 0x31, 0x2f,
+	0x72, 0x89, 0xef, 0x14, 0x4c, 0xe5, 0x8f, 0x5d, 0xaf, 0x01, 0xfa, 0xbf, 0x4e, 0x5c, 0x98, 0xc5,
+	0x49, 0x76, 0xcf, 0xe2, 0xbb, 0xe5, 0x3f, 0x72, 0x0c, 0x6e, 0x1a, 0xa5, 0xaf, 0xf1, 0x6d, 0xf6,
+	0x92, 0xf0, 0x87, 0xa5, 0xf3, 0x36, 0x35, 0xaf, 0x7a, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x96,
+	0xfd, 0x95, 0x22, 0xe5, 0x01, 0x00, 0x00,
 }
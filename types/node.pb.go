@@ -239,37 +239,250 @@ func (m *AgentCertificate) GetSignature() []byte {
 	return nil
 }
 
+// AddressBookEntry is a peer in the address book of node
+type AddressBookEntry struct {
+	Address              *PeerAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LastSeen             int64        `protobuf:"varint,2,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	SuccessCount         uint32       `protobuf:"varint,3,opt,name=successCount,proto3" json:"successCount,omitempty"`
+	FailCount            uint32       `protobuf:"varint,4,opt,name=failCount,proto3" json:"failCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AddressBookEntry) Reset()         { *m = AddressBookEntry{} }
+func (m *AddressBookEntry) String() string { return proto.CompactTextString(m) }
+func (*AddressBookEntry) ProtoMessage()    {}
+func (*AddressBookEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c843d59d2d938e7, []int{2}
+}
+
+func (m *AddressBookEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBookEntry.Unmarshal(m, b)
+}
+func (m *AddressBookEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBookEntry.Marshal(b, m, deterministic)
+}
+func (m *AddressBookEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBookEntry.Merge(m, src)
+}
+func (m *AddressBookEntry) XXX_Size() int {
+	return xxx_messageInfo_AddressBookEntry.Size(m)
+}
+func (m *AddressBookEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBookEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBookEntry proto.InternalMessageInfo
+
+func (m *AddressBookEntry) GetAddress() *PeerAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AddressBookEntry) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *AddressBookEntry) GetSuccessCount() uint32 {
+	if m != nil {
+		return m.SuccessCount
+	}
+	return 0
+}
+
+func (m *AddressBookEntry) GetFailCount() uint32 {
+	if m != nil {
+		return m.FailCount
+	}
+	return 0
+}
+
+// CertRevocation revokes the certificates issued to the agent, which are created at or before revokeTime.
+type CertRevocation struct {
+	AgentID              []byte   `protobuf:"bytes,1,opt,name=agentID,proto3" json:"agentID,omitempty"`
+	RevokeTime           int64    `protobuf:"varint,2,opt,name=revokeTime,proto3" json:"revokeTime,omitempty"`
+	Renewable            bool     `protobuf:"varint,3,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertRevocation) Reset()         { *m = CertRevocation{} }
+func (m *CertRevocation) String() string { return proto.CompactTextString(m) }
+func (*CertRevocation) ProtoMessage()    {}
+func (*CertRevocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c843d59d2d938e7, []int{3}
+}
+
+func (m *CertRevocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertRevocation.Unmarshal(m, b)
+}
+func (m *CertRevocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertRevocation.Marshal(b, m, deterministic)
+}
+func (m *CertRevocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertRevocation.Merge(m, src)
+}
+func (m *CertRevocation) XXX_Size() int {
+	return xxx_messageInfo_CertRevocation.Size(m)
+}
+func (m *CertRevocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertRevocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertRevocation proto.InternalMessageInfo
+
+func (m *CertRevocation) GetAgentID() []byte {
+	if m != nil {
+		return m.AgentID
+	}
+	return nil
+}
+
+func (m *CertRevocation) GetRevokeTime() int64 {
+	if m != nil {
+		return m.RevokeTime
+	}
+	return 0
+}
+
+func (m *CertRevocation) GetRenewable() bool {
+	if m != nil {
+		return m.Renewable
+	}
+	return false
+}
+
+// CertificateRevocationList is the list of revocations signed by block producer.
+type CertificateRevocationList struct {
+	CrlVersion           uint32            `protobuf:"varint,1,opt,name=crlVersion,proto3" json:"crlVersion,omitempty"`
+	BPID                 []byte            `protobuf:"bytes,2,opt,name=BPID,proto3" json:"BPID,omitempty"`
+	BPPubKey             []byte            `protobuf:"bytes,3,opt,name=BPPubKey,proto3" json:"BPPubKey,omitempty"`
+	CreateTime           int64             `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Revocations          []*CertRevocation `protobuf:"bytes,5,rep,name=revocations,proto3" json:"revocations,omitempty"`
+	Signature            []byte            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CertificateRevocationList) Reset()         { *m = CertificateRevocationList{} }
+func (m *CertificateRevocationList) String() string { return proto.CompactTextString(m) }
+func (*CertificateRevocationList) ProtoMessage()    {}
+func (*CertificateRevocationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c843d59d2d938e7, []int{4}
+}
+
+func (m *CertificateRevocationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertificateRevocationList.Unmarshal(m, b)
+}
+func (m *CertificateRevocationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertificateRevocationList.Marshal(b, m, deterministic)
+}
+func (m *CertificateRevocationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateRevocationList.Merge(m, src)
+}
+func (m *CertificateRevocationList) XXX_Size() int {
+	return xxx_messageInfo_CertificateRevocationList.Size(m)
+}
+func (m *CertificateRevocationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateRevocationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateRevocationList proto.InternalMessageInfo
+
+func (m *CertificateRevocationList) GetCrlVersion() uint32 {
+	if m != nil {
+		return m.CrlVersion
+	}
+	return 0
+}
+
+func (m *CertificateRevocationList) GetBPID() []byte {
+	if m != nil {
+		return m.BPID
+	}
+	return nil
+}
+
+func (m *CertificateRevocationList) GetBPPubKey() []byte {
+	if m != nil {
+		return m.BPPubKey
+	}
+	return nil
+}
+
+func (m *CertificateRevocationList) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *CertificateRevocationList) GetRevocations() []*CertRevocation {
+	if m != nil {
+		return m.Revocations
+	}
+	return nil
+}
+
+func (m *CertificateRevocationList) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.PeerRole", PeerRole_name, PeerRole_value)
 	proto.RegisterType((*PeerAddress)(nil), "types.PeerAddress")
 	proto.RegisterType((*AgentCertificate)(nil), "types.AgentCertificate")
+	proto.RegisterType((*AddressBookEntry)(nil), "types.AddressBookEntry")
+	proto.RegisterType((*CertRevocation)(nil), "types.CertRevocation")
+	proto.RegisterType((*CertificateRevocationList)(nil), "types.CertificateRevocationList")
 }
 
 func init() { proto.RegisterFile("node.proto", fileDescriptor_0c843d59d2d938e7) }
 
 var fileDescriptor_0c843d59d2d938e7 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x4f, 0x4f, 0xe3, 0x30,
-	0x10, 0xc5, 0xd7, 0x4d, 0x93, 0x26, 0xd3, 0x74, 0x37, 0x3b, 0x87, 0x95, 0xb5, 0x42, 0x28, 0x2a,
-	0x97, 0x88, 0x43, 0x0f, 0xf0, 0x09, 0xfa, 0xe7, 0x52, 0xc1, 0x21, 0xb2, 0x10, 0x9c, 0xd3, 0x64,
-	0x28, 0x91, 0x4a, 0x1c, 0x39, 0x2e, 0xa2, 0x37, 0x3e, 0x23, 0x9f, 0x08, 0xd9, 0x71, 0x69, 0xb9,
-	0xcd, 0x7b, 0x23, 0xe7, 0xe7, 0xf7, 0x62, 0x80, 0x46, 0x56, 0x34, 0x6b, 0x95, 0xd4, 0x12, 0x7d,
-	0x7d, 0x68, 0xa9, 0x9b, 0x7e, 0x32, 0x18, 0xe7, 0x44, 0x6a, 0x5e, 0x55, 0x8a, 0xba, 0x0e, 0x39,
-	0x8c, 0x8a, 0x7e, 0xe4, 0x2c, 0x65, 0x59, 0x24, 0x8e, 0x12, 0x11, 0x86, 0xad, 0x54, 0x9a, 0x0f,
-	0x52, 0x96, 0x4d, 0x84, 0x9d, 0xf1, 0x1f, 0x04, 0x2d, 0x91, 0x5a, 0xaf, 0xb8, 0x97, 0xb2, 0x2c,
-	0x16, 0x4e, 0xe1, 0x15, 0x0c, 0x95, 0xdc, 0x11, 0x1f, 0xa6, 0x2c, 0xfb, 0x7d, 0xf3, 0x67, 0x66,
-	0x59, 0x33, 0xc3, 0x11, 0x72, 0x47, 0xc2, 0x2e, 0x0d, 0xea, 0x8d, 0x54, 0x57, 0xcb, 0x86, 0xfb,
-	0x3d, 0xca, 0x49, 0xbc, 0x80, 0xc8, 0x51, 0xa9, 0xe3, 0x41, 0xea, 0x65, 0x91, 0x38, 0x19, 0x98,
-	0xc2, 0xb8, 0x55, 0xb2, 0xda, 0x97, 0x06, 0xd5, 0xf1, 0x51, 0xea, 0x65, 0xb1, 0x38, 0xb7, 0xa6,
-	0x1f, 0x03, 0x48, 0xe6, 0x5b, 0x6a, 0xf4, 0x92, 0x94, 0xae, 0x9f, 0xeb, 0xb2, 0xd0, 0x64, 0x8e,
-	0x95, 0xa4, 0xf4, 0xa3, 0x43, 0x32, 0x1b, 0xe3, 0xdc, 0x32, 0x09, 0x17, 0xf9, 0x7a, 0x65, 0x13,
-	0xc6, 0xc2, 0xce, 0xf8, 0x1f, 0xc2, 0x45, 0x9e, 0xef, 0x37, 0x77, 0x74, 0x70, 0x19, 0xbf, 0x35,
-	0x5e, 0x02, 0x94, 0x8a, 0x0a, 0x4d, 0x0f, 0xf5, 0x6b, 0x9f, 0xd5, 0x13, 0x67, 0x8e, 0xd9, 0xd3,
-	0x7b, 0x5b, 0xab, 0x7e, 0xef, 0xf7, 0xfb, 0x93, 0x63, 0xbb, 0x36, 0xb7, 0x5c, 0xaf, 0x78, 0x60,
-	0x3f, 0x7d, 0x94, 0x38, 0x85, 0xd8, 0xde, 0xdf, 0xfd, 0x15, 0x97, 0xf1, 0x87, 0x67, 0x4a, 0xea,
-	0xea, 0x6d, 0x53, 0xe8, 0xbd, 0x22, 0x1e, 0xda, 0xf3, 0x27, 0xe3, 0x7a, 0x09, 0xe1, 0xb1, 0x6e,
-	0xfc, 0x0b, 0x93, 0x7b, 0xda, 0x16, 0xe5, 0xc1, 0x05, 0x4d, 0x7e, 0x61, 0x0c, 0x61, 0xee, 0x0a,
-	0x4b, 0x18, 0x8e, 0x61, 0xf4, 0x54, 0xe8, 0xf2, 0x85, 0x54, 0x32, 0xc0, 0x08, 0x7c, 0xcb, 0x49,
-	0xbc, 0x4d, 0x60, 0x9f, 0xca, 0xed, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xb6, 0x20, 0x9b,
-	0x38, 0x02, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0x4d, 0x7f, 0xd2, 0x49, 0xbb, 0x04, 0x4b, 0x20, 0xb3, 0x42, 0xab, 0x28, 0x5c, 0x22,
+	0x84, 0x7a, 0x28, 0x07, 0xce, 0xdb, 0x96, 0x43, 0xc5, 0x1e, 0x2a, 0x83, 0xe0, 0x9c, 0xa6, 0xb3,
+	0xdd, 0x68, 0x43, 0x1c, 0xd9, 0x6e, 0xa1, 0x37, 0x9e, 0x84, 0x87, 0xe2, 0x11, 0x78, 0x12, 0x64,
+	0xc7, 0x6d, 0x12, 0xce, 0xdc, 0x3c, 0xdf, 0x8c, 0xf3, 0xcd, 0x7c, 0xdf, 0x38, 0x00, 0xa5, 0xd8,
+	0xe1, 0xac, 0x92, 0x42, 0x0b, 0x3a, 0xd0, 0xa7, 0x0a, 0x55, 0xfc, 0x9b, 0x40, 0xb0, 0x41, 0x94,
+	0xb7, 0xbb, 0x9d, 0x44, 0xa5, 0x28, 0x83, 0x51, 0x5a, 0x1f, 0x19, 0x89, 0x48, 0x32, 0xe6, 0xe7,
+	0x90, 0x52, 0xe8, 0x57, 0x42, 0x6a, 0xd6, 0x8b, 0x48, 0x32, 0xe5, 0xf6, 0x4c, 0x5f, 0xc0, 0xb0,
+	0x42, 0x94, 0xeb, 0x15, 0xf3, 0x22, 0x92, 0x4c, 0xb8, 0x8b, 0xe8, 0x6b, 0xe8, 0x4b, 0x51, 0x20,
+	0xeb, 0x47, 0x24, 0xb9, 0x9a, 0x3f, 0x9d, 0x59, 0xae, 0x99, 0xe1, 0xe1, 0xa2, 0x40, 0x6e, 0x93,
+	0x86, 0xea, 0x88, 0x52, 0xe5, 0xa2, 0x64, 0x83, 0x9a, 0xca, 0x85, 0xf4, 0x15, 0x8c, 0x1d, 0x2b,
+	0x2a, 0x36, 0x8c, 0xbc, 0x64, 0xcc, 0x1b, 0x80, 0x46, 0x10, 0x54, 0x52, 0xec, 0x0e, 0x99, 0xa1,
+	0x52, 0x6c, 0x14, 0x79, 0xc9, 0x84, 0xb7, 0xa1, 0xf8, 0x67, 0x0f, 0xc2, 0xdb, 0x3d, 0x96, 0x7a,
+	0x89, 0x52, 0xe7, 0xf7, 0x79, 0x96, 0x6a, 0x34, 0xd7, 0x32, 0x94, 0xfa, 0x8b, 0xa3, 0x24, 0x76,
+	0x8c, 0x36, 0x64, 0x26, 0x5c, 0x6c, 0xd6, 0x2b, 0x3b, 0xe1, 0x84, 0xdb, 0x33, 0xbd, 0x06, 0x7f,
+	0xb1, 0xd9, 0x1c, 0xb6, 0x1f, 0xf1, 0xe4, 0x66, 0xbc, 0xc4, 0xf4, 0x06, 0x20, 0x93, 0x98, 0x6a,
+	0xfc, 0x9c, 0x7f, 0xab, 0x67, 0xf5, 0x78, 0x0b, 0x31, 0x79, 0xfc, 0x51, 0xe5, 0xb2, 0xce, 0x0f,
+	0xea, 0x7c, 0x83, 0x58, 0xad, 0x4d, 0x97, 0xeb, 0x15, 0x1b, 0xda, 0x4f, 0x9f, 0x43, 0x1a, 0xc3,
+	0xc4, 0xf6, 0xef, 0x5c, 0x71, 0x33, 0x76, 0x30, 0x23, 0x92, 0xca, 0xf7, 0x65, 0xaa, 0x0f, 0x12,
+	0x99, 0x6f, 0xef, 0x37, 0x40, 0xfc, 0x8b, 0x40, 0xe8, 0x2a, 0x17, 0x42, 0x3c, 0x7e, 0x28, 0xb5,
+	0x3c, 0xd1, 0xb7, 0x5d, 0x73, 0x83, 0x39, 0x6d, 0x39, 0xe3, 0xaa, 0x1b, 0xc3, 0xaf, 0xc1, 0x2f,
+	0x52, 0xa5, 0x3f, 0x21, 0x96, 0x56, 0x12, 0x8f, 0x5f, 0x62, 0xd3, 0xa0, 0x3a, 0x64, 0x19, 0x2a,
+	0xb5, 0x14, 0x87, 0x52, 0x5b, 0x69, 0xa6, 0xbc, 0x83, 0x99, 0x06, 0xef, 0xd3, 0xbc, 0xa8, 0x0b,
+	0xfa, 0xb6, 0xa0, 0x01, 0xe2, 0x07, 0xb8, 0x32, 0xee, 0x70, 0x3c, 0x8a, 0x2c, 0xd5, 0x46, 0xfe,
+	0x96, 0x1c, 0xa4, 0x2b, 0xc7, 0x0d, 0x80, 0xc4, 0xa3, 0x78, 0xac, 0x85, 0xac, 0x7b, 0x69, 0x21,
+	0x86, 0x49, 0x62, 0x89, 0xdf, 0xd3, 0x6d, 0x81, 0xb6, 0x15, 0x9f, 0x37, 0x40, 0xfc, 0x87, 0xc0,
+	0xcb, 0xd6, 0x22, 0x34, 0x8c, 0x77, 0xb9, 0xd2, 0xb5, 0x89, 0x45, 0x77, 0x2b, 0x5a, 0xc8, 0x7f,
+	0x5f, 0x8a, 0xf7, 0x10, 0xc8, 0x4b, 0x07, 0x8a, 0x0d, 0x22, 0x2f, 0x09, 0xe6, 0xcf, 0x9d, 0x0f,
+	0x5d, 0x45, 0x78, 0xbb, 0xb2, 0xeb, 0xf7, 0xf0, 0x1f, 0xbf, 0xdf, 0x2c, 0xc1, 0x3f, 0x3f, 0x2f,
+	0xfa, 0x0c, 0xa6, 0x77, 0xb8, 0x4f, 0xb3, 0x93, 0x9b, 0x21, 0x7c, 0x42, 0x27, 0xe0, 0x6f, 0xdc,
+	0x03, 0x09, 0x09, 0x0d, 0x60, 0xf4, 0x35, 0xd5, 0xd9, 0x03, 0xca, 0xb0, 0x47, 0xc7, 0x30, 0xb0,
+	0x7b, 0x15, 0x7a, 0xdb, 0xa1, 0xfd, 0x35, 0xbc, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x87,
+	0x55, 0xf8, 0x28, 0x04, 0x00, 0x00,
 }
@@ -12,12 +12,14 @@ func _() {
 	_ = x[OpvoteDAO-1]
 	_ = x[Opstake-2]
 	_ = x[Opunstake-3]
-	_ = x[OpSysTxMax-4]
+	_ = x[OpreportDoubleSign-4]
+	_ = x[OprotateBPKey-5]
+	_ = x[OpSysTxMax-6]
 }
 
-const _OpSysTx_name = "OpvoteBPOpvoteDAOOpstakeOpunstakeOpSysTxMax"
+const _OpSysTx_name = "OpvoteBPOpvoteDAOOpstakeOpunstakeOpreportDoubleSignOprotateBPKeyOpSysTxMax"
 
-var _OpSysTx_index = [...]uint8{0, 8, 17, 24, 33, 43}
+var _OpSysTx_index = [...]uint8{0, 8, 17, 24, 33, 51, 64, 74}
 
 func (i OpSysTx) String() string {
 	if i < 0 || i >= OpSysTx(len(_OpSysTx_index)-1) {
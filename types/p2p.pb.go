@@ -41,13 +41,10 @@ const (
 	ResultStatus_ALREADY_EXISTS ResultStatus = 6
 	// PERMISSION_DENIED
 	ResultStatus_PERMISSION_DENIED ResultStatus = 7
-	//
 	ResultStatus_RESOURCE_EXHAUSTED ResultStatus = 8
-	//
 	ResultStatus_FAILED_PRECONDITION ResultStatus = 9
 	// ABORTED
 	ResultStatus_ABORTED ResultStatus = 10
-	//
 	ResultStatus_OUT_OF_RANGE ResultStatus = 11
 	// UNIMPLEMENTED indicates operation is not implemented or not
 	// supported/enabled in this service.
@@ -1567,6 +1564,380 @@ func (m *CertificateRenewedNotice) GetCertificate() *AgentCertificate {
 	return nil
 }
 
+// GetStateProofRequest asks a full node for merkle proofs of an account and its storage variables.
+type GetStateProofRequest struct {
+	Query                *StateQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetStateProofRequest) Reset()         { *m = GetStateProofRequest{} }
+func (m *GetStateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofRequest) ProtoMessage()    {}
+func (*GetStateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{27}
+}
+
+func (m *GetStateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofRequest.Unmarshal(m, b)
+}
+func (m *GetStateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofRequest.Merge(m, src)
+}
+func (m *GetStateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofRequest.Size(m)
+}
+func (m *GetStateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofRequest proto.InternalMessageInfo
+
+func (m *GetStateProofRequest) GetQuery() *StateQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type GetStateProofResponse struct {
+	Status               ResultStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Proof                *StateQueryProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetStateProofResponse) Reset()         { *m = GetStateProofResponse{} }
+func (m *GetStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofResponse) ProtoMessage()    {}
+func (*GetStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{28}
+}
+
+func (m *GetStateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofResponse.Unmarshal(m, b)
+}
+func (m *GetStateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofResponse.Merge(m, src)
+}
+func (m *GetStateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofResponse.Size(m)
+}
+func (m *GetStateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofResponse proto.InternalMessageInfo
+
+func (m *GetStateProofResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateProofResponse) GetProof() *StateQueryProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// GetReceiptsRequest asks a full node for all receipts of the given block.
+type GetReceiptsRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReceiptsRequest) Reset()         { *m = GetReceiptsRequest{} }
+func (m *GetReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptsRequest) ProtoMessage()    {}
+func (*GetReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{29}
+}
+
+func (m *GetReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptsRequest.Unmarshal(m, b)
+}
+func (m *GetReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptsRequest.Merge(m, src)
+}
+func (m *GetReceiptsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptsRequest.Size(m)
+}
+func (m *GetReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptsRequest proto.InternalMessageInfo
+
+func (m *GetReceiptsRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetReceiptsResponse struct {
+	Status ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	// binary form of the receipts, which is same as the one stored in chain db
+	Receipts             []byte   `protobuf:"bytes,2,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReceiptsResponse) Reset()         { *m = GetReceiptsResponse{} }
+func (m *GetReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptsResponse) ProtoMessage()    {}
+func (*GetReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{30}
+}
+
+func (m *GetReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptsResponse.Unmarshal(m, b)
+}
+func (m *GetReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptsResponse.Merge(m, src)
+}
+func (m *GetReceiptsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptsResponse.Size(m)
+}
+func (m *GetReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptsResponse proto.InternalMessageInfo
+
+func (m *GetReceiptsResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetReceiptsResponse) GetReceipts() []byte {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+// CompactBlockNotice announces a new block by its header and the hashes of its txs, so that the receiver can rebuild the block from its own mempool.
+type CompactBlockNotice struct {
+	Header               *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxHashes             [][]byte     `protobuf:"bytes,2,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CompactBlockNotice) Reset()         { *m = CompactBlockNotice{} }
+func (m *CompactBlockNotice) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockNotice) ProtoMessage()    {}
+func (*CompactBlockNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{31}
+}
+
+func (m *CompactBlockNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactBlockNotice.Unmarshal(m, b)
+}
+func (m *CompactBlockNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactBlockNotice.Marshal(b, m, deterministic)
+}
+func (m *CompactBlockNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockNotice.Merge(m, src)
+}
+func (m *CompactBlockNotice) XXX_Size() int {
+	return xxx_messageInfo_CompactBlockNotice.Size(m)
+}
+func (m *CompactBlockNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockNotice proto.InternalMessageInfo
+
+func (m *CompactBlockNotice) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactBlockNotice) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// FindNodeRequest asks remote node the nodes closest to target key in kademlia DHT.
+type FindNodeRequest struct {
+	Status               *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Target               []byte   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Size                 int32    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindNodeRequest) Reset()         { *m = FindNodeRequest{} }
+func (m *FindNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*FindNodeRequest) ProtoMessage()    {}
+func (*FindNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{32}
+}
+
+func (m *FindNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindNodeRequest.Unmarshal(m, b)
+}
+func (m *FindNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *FindNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindNodeRequest.Merge(m, src)
+}
+func (m *FindNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_FindNodeRequest.Size(m)
+}
+func (m *FindNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindNodeRequest proto.InternalMessageInfo
+
+func (m *FindNodeRequest) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FindNodeRequest) GetTarget() []byte {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *FindNodeRequest) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type FindNodeResponse struct {
+	Status               ResultStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Peers                []*PeerAddress `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	Message              string         `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FindNodeResponse) Reset()         { *m = FindNodeResponse{} }
+func (m *FindNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*FindNodeResponse) ProtoMessage()    {}
+func (*FindNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{33}
+}
+
+func (m *FindNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindNodeResponse.Unmarshal(m, b)
+}
+func (m *FindNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *FindNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindNodeResponse.Merge(m, src)
+}
+func (m *FindNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_FindNodeResponse.Size(m)
+}
+func (m *FindNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindNodeResponse proto.InternalMessageInfo
+
+func (m *FindNodeResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *FindNodeResponse) GetPeers() []*PeerAddress {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *FindNodeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// CertificateRevokedNotice gossips the revocation list of block producer to all peers.
+type CertificateRevokedNotice struct {
+	RevocationList       *CertificateRevocationList `protobuf:"bytes,1,opt,name=revocationList,proto3" json:"revocationList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CertificateRevokedNotice) Reset()         { *m = CertificateRevokedNotice{} }
+func (m *CertificateRevokedNotice) String() string { return proto.CompactTextString(m) }
+func (*CertificateRevokedNotice) ProtoMessage()    {}
+func (*CertificateRevokedNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{34}
+}
+
+func (m *CertificateRevokedNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertificateRevokedNotice.Unmarshal(m, b)
+}
+func (m *CertificateRevokedNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertificateRevokedNotice.Marshal(b, m, deterministic)
+}
+func (m *CertificateRevokedNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateRevokedNotice.Merge(m, src)
+}
+func (m *CertificateRevokedNotice) XXX_Size() int {
+	return xxx_messageInfo_CertificateRevokedNotice.Size(m)
+}
+func (m *CertificateRevokedNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateRevokedNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateRevokedNotice proto.InternalMessageInfo
+
+func (m *CertificateRevokedNotice) GetRevocationList() *CertificateRevocationList {
+	if m != nil {
+		return m.RevocationList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*MsgHeader)(nil), "types.MsgHeader")
@@ -1596,90 +1967,111 @@ func init() {
 	proto.RegisterType((*IssueCertificateRequest)(nil), "types.IssueCertificateRequest")
 	proto.RegisterType((*IssueCertificateResponse)(nil), "types.IssueCertificateResponse")
 	proto.RegisterType((*CertificateRenewedNotice)(nil), "types.CertificateRenewedNotice")
+	proto.RegisterType((*GetStateProofRequest)(nil), "types.GetStateProofRequest")
+	proto.RegisterType((*GetStateProofResponse)(nil), "types.GetStateProofResponse")
+	proto.RegisterType((*GetReceiptsRequest)(nil), "types.GetReceiptsRequest")
+	proto.RegisterType((*GetReceiptsResponse)(nil), "types.GetReceiptsResponse")
+	proto.RegisterType((*CompactBlockNotice)(nil), "types.CompactBlockNotice")
+	proto.RegisterType((*FindNodeRequest)(nil), "types.FindNodeRequest")
+	proto.RegisterType((*FindNodeResponse)(nil), "types.FindNodeResponse")
+	proto.RegisterType((*CertificateRevokedNotice)(nil), "types.CertificateRevokedNotice")
 }
 
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor_e7fdddb109e6467a) }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0xff, 0x28, 0xd9, 0xb2, 0x34, 0xa2, 0x6c, 0x7a, 0xfd, 0x25, 0x66, 0xdd, 0x20, 0x15, 0x88,
-	0xa0, 0x55, 0xd3, 0x20, 0x28, 0x9c, 0x53, 0xd1, 0x13, 0x2d, 0x32, 0x12, 0x1b, 0x79, 0x29, 0xac,
-	0xa4, 0x34, 0x3d, 0xa9, 0x94, 0xb4, 0x91, 0xd8, 0xda, 0xa4, 0xca, 0x5d, 0xc5, 0x76, 0x2e, 0x05,
-	0x7a, 0xe8, 0x1b, 0xf4, 0x15, 0xfa, 0x18, 0x7d, 0x83, 0x3e, 0x52, 0x81, 0x62, 0x97, 0x4b, 0x89,
-	0xb4, 0x93, 0x18, 0x35, 0x72, 0xdb, 0xf9, 0xc3, 0x99, 0xdf, 0xcc, 0xfc, 0x76, 0x96, 0x50, 0x5b,
-	0x1e, 0x2f, 0x9f, 0x2e, 0x93, 0x98, 0xc7, 0x68, 0x9b, 0x5f, 0x2d, 0x29, 0x3b, 0x32, 0x26, 0x67,
-	0xf1, 0xf4, 0xe7, 0xe9, 0x22, 0x08, 0xa3, 0xd4, 0x70, 0x04, 0x51, 0x3c, 0xa3, 0xe9, 0xd9, 0xfa,
-	0x47, 0x83, 0xda, 0x29, 0x9b, 0x77, 0x69, 0x30, 0xa3, 0x09, 0x7a, 0x04, 0x8d, 0xe9, 0x59, 0x48,
-	0x23, 0xfe, 0x92, 0x26, 0x2c, 0x8c, 0x23, 0x53, 0x6b, 0x6a, 0xad, 0x1a, 0x29, 0x2a, 0xd1, 0x03,
-	0xa8, 0xf1, 0xf0, 0x9c, 0x32, 0x1e, 0x9c, 0x2f, 0xcd, 0x52, 0x53, 0x6b, 0x95, 0xc9, 0x46, 0x81,
-	0x76, 0xa1, 0x14, 0xce, 0xcc, 0xb2, 0xfc, 0xb0, 0x14, 0xce, 0xd0, 0x7d, 0xa8, 0xcc, 0x63, 0xc6,
-	0xc2, 0xa5, 0xb9, 0xd5, 0xd4, 0x5a, 0x55, 0xa2, 0x24, 0xa1, 0x5f, 0x52, 0x9a, 0x78, 0x8e, 0xb9,
-	0xdd, 0xd4, 0x5a, 0x3a, 0x51, 0x12, 0x7a, 0x08, 0x12, 0x5f, 0x7f, 0x35, 0x79, 0x41, 0xaf, 0xcc,
-	0x8a, 0xb4, 0xe5, 0x34, 0x08, 0xc1, 0x16, 0x0b, 0xe7, 0x91, 0xb9, 0x23, 0x2d, 0xf2, 0x8c, 0x9a,
-	0x50, 0x67, 0xab, 0x89, 0xac, 0x68, 0x1a, 0x9f, 0x99, 0xd5, 0xa6, 0xd6, 0x6a, 0x90, 0xbc, 0x4a,
-	0x64, 0x3b, 0xa3, 0xd1, 0x9c, 0x2f, 0xcc, 0x9a, 0x34, 0x2a, 0xc9, 0xfa, 0x0e, 0xa0, 0x7f, 0xdc,
-	0x3f, 0xa5, 0x8c, 0x05, 0x73, 0x8a, 0x5a, 0x50, 0x59, 0xc8, 0x4e, 0xc8, 0xc2, 0xeb, 0xc7, 0xc6,
-	0x53, 0xd9, 0xc3, 0xa7, 0xeb, 0x0e, 0x11, 0x65, 0x17, 0x28, 0x66, 0x01, 0x0f, 0x64, 0xf9, 0x3a,
-	0x91, 0x67, 0xcb, 0x87, 0xad, 0x7e, 0x18, 0xcd, 0xd1, 0xe7, 0xb0, 0x37, 0xa1, 0x8c, 0x8f, 0x65,
-	0xe3, 0xc7, 0x8b, 0x80, 0x2d, 0x64, 0x38, 0x9d, 0x34, 0x84, 0xfa, 0x44, 0x68, 0xbb, 0x01, 0x5b,
-	0xa0, 0xcf, 0xa0, 0x2e, 0xfd, 0x16, 0x34, 0x9c, 0x2f, 0xb8, 0x0c, 0xb5, 0x45, 0x40, 0xa8, 0xba,
-	0x52, 0x63, 0xf5, 0x60, 0xab, 0x1f, 0x47, 0x73, 0x31, 0x96, 0xc2, 0x97, 0xef, 0x0e, 0xf7, 0x10,
-	0x72, 0xdf, 0xbe, 0x23, 0xda, 0xdf, 0x25, 0xa8, 0x0c, 0x78, 0xc0, 0x57, 0x0c, 0x3d, 0x86, 0x0a,
-	0xa3, 0xd1, 0xa6, 0x4e, 0xa4, 0xea, 0xec, 0x53, 0x9a, 0xd8, 0xb3, 0x59, 0x42, 0x19, 0x23, 0xca,
-	0xe3, 0x66, 0xf2, 0xd2, 0xed, 0xc9, 0xcb, 0xd7, 0x93, 0x23, 0x13, 0x76, 0x24, 0x05, 0x3d, 0x47,
-	0xd2, 0x40, 0x27, 0x99, 0x88, 0x8e, 0xa0, 0x1a, 0xc5, 0xee, 0xe5, 0x32, 0x66, 0x54, 0x32, 0xa1,
-	0x4a, 0xd6, 0xb2, 0xf8, 0xea, 0x8d, 0x62, 0x62, 0x45, 0x12, 0x2a, 0x13, 0x85, 0x65, 0x4e, 0x23,
-	0xca, 0x42, 0xa6, 0x88, 0x90, 0x89, 0xe8, 0x5b, 0xd0, 0xa7, 0x34, 0xe1, 0xe1, 0xeb, 0x70, 0x1a,
-	0x70, 0xca, 0xcc, 0x6a, 0xb3, 0xdc, 0xaa, 0x1f, 0x1f, 0xaa, 0x0a, 0xed, 0x39, 0x8d, 0x78, 0x7b,
-	0x63, 0x27, 0x05, 0x67, 0xf4, 0x18, 0x8c, 0x90, 0xb1, 0x15, 0xcd, 0x79, 0x48, 0xc2, 0x54, 0xc9,
-	0x0d, 0xbd, 0xd5, 0x02, 0xbd, 0x13, 0xdb, 0x17, 0xc1, 0x15, 0x8e, 0x79, 0x38, 0x95, 0x60, 0xcf,
-	0x53, 0x1e, 0xa9, 0x6b, 0x93, 0x89, 0xd6, 0x2b, 0x30, 0x54, 0x57, 0x29, 0x23, 0xf4, 0x97, 0x15,
-	0x65, 0xfc, 0x3f, 0x8d, 0x40, 0x44, 0x0e, 0x2e, 0x07, 0xe1, 0x5b, 0x2a, 0x9b, 0xdf, 0x20, 0x99,
-	0x68, 0xfd, 0x04, 0xfb, 0xb9, 0xc8, 0x6c, 0x19, 0x47, 0x8c, 0xa2, 0xaf, 0xa0, 0xc2, 0xe4, 0x9c,
-	0x65, 0xe8, 0xdd, 0xe3, 0x03, 0x15, 0x9a, 0x50, 0xb6, 0x3a, 0xe3, 0x29, 0x05, 0x88, 0x72, 0x41,
-	0x2d, 0xd8, 0x16, 0x17, 0x8f, 0x99, 0x25, 0xd9, 0xa7, 0x77, 0xc1, 0x48, 0x1d, 0xac, 0x2e, 0xec,
-	0x62, 0x7a, 0x21, 0x47, 0xae, 0x2a, 0x7e, 0x00, 0xb5, 0xc9, 0x35, 0x4e, 0x6e, 0x14, 0x02, 0xf5,
-	0x24, 0x75, 0x56, 0x64, 0xcc, 0x44, 0x8b, 0xc1, 0x81, 0x0c, 0xd3, 0x4f, 0xe2, 0xd9, 0x6a, 0x4a,
-	0x67, 0x2a, 0xdc, 0x43, 0x80, 0x65, 0xaa, 0x11, 0x5b, 0x21, 0x8d, 0x97, 0xd3, 0xbc, 0x3f, 0x20,
-	0xb2, 0x60, 0x5b, 0x1e, 0x25, 0xf1, 0xea, 0xc7, 0xba, 0x2a, 0x42, 0x26, 0x21, 0xa9, 0xc9, 0xfa,
-	0x4d, 0x83, 0xfb, 0x1d, 0xaa, 0x28, 0x2b, 0x2f, 0xf1, 0x7a, 0x16, 0x08, 0xb6, 0x72, 0xb7, 0x54,
-	0x9e, 0xc5, 0xc2, 0x28, 0xdc, 0x4b, 0x25, 0x09, 0x7d, 0xfc, 0xfa, 0x35, 0xa3, 0x19, 0xc9, 0x95,
-	0x94, 0xae, 0xa5, 0xb7, 0x54, 0xb2, 0xbb, 0x41, 0xe4, 0x19, 0x19, 0x50, 0x0e, 0xd8, 0x54, 0xb1,
-	0x5a, 0x1c, 0xad, 0x3f, 0x35, 0x38, 0xbc, 0x01, 0xe2, 0x2e, 0x63, 0x13, 0xf0, 0x02, 0xb6, 0xa0,
-	0xe9, 0xdc, 0x74, 0xa2, 0x24, 0xf4, 0x04, 0x76, 0xd2, 0x0d, 0xc5, 0xcc, 0x72, 0x61, 0xa0, 0xb9,
-	0x94, 0x24, 0x73, 0x11, 0x1d, 0x5d, 0x04, 0x0c, 0xd3, 0x4b, 0xae, 0x96, 0x73, 0x26, 0x5a, 0x5f,
-	0xc2, 0x5e, 0x86, 0x33, 0xeb, 0xd2, 0x26, 0xa5, 0x96, 0x4f, 0x69, 0xfd, 0x0a, 0xc6, 0xc6, 0xf5,
-	0x2e, 0xb5, 0x3c, 0x82, 0x8a, 0x1c, 0x51, 0xc6, 0xc1, 0xe2, 0xf8, 0x94, 0x2d, 0x8f, 0xb5, 0x5c,
-	0xc4, 0xfa, 0x0c, 0xee, 0x61, 0x7a, 0x31, 0x4c, 0x82, 0x88, 0x05, 0x53, 0x1e, 0xc6, 0x11, 0x53,
-	0x84, 0x3a, 0x82, 0x2a, 0xbf, 0xec, 0xe6, 0x31, 0xaf, 0x65, 0xeb, 0x6b, 0xc9, 0x86, 0xfc, 0x47,
-	0xb7, 0xd5, 0xf9, 0x47, 0x3a, 0xbb, 0xe2, 0x27, 0x1f, 0x73, 0x76, 0x9f, 0x42, 0x99, 0x5f, 0x66,
-	0x73, 0xab, 0xa9, 0x08, 0xc3, 0x4b, 0x22, 0xb4, 0x1f, 0x18, 0x55, 0x07, 0xf6, 0x3b, 0x94, 0x9f,
-	0x86, 0x8c, 0x85, 0xd1, 0xfc, 0x96, 0x22, 0x44, 0x4b, 0x18, 0x8f, 0x97, 0x8b, 0xcd, 0x22, 0x5f,
-	0xcb, 0xd6, 0x13, 0x40, 0x1d, 0xca, 0xed, 0x68, 0x4a, 0x19, 0x8f, 0x93, 0xdb, 0xda, 0xf1, 0xbb,
-	0x06, 0x07, 0x05, 0xf7, 0xbb, 0xb4, 0xc2, 0x02, 0x3d, 0x50, 0x01, 0x72, 0x6f, 0x4b, 0x41, 0x27,
-	0xd6, 0x42, 0x26, 0xe3, 0x38, 0x7b, 0x5a, 0x36, 0x1a, 0xeb, 0x0b, 0xa8, 0x77, 0x28, 0x17, 0xae,
-	0x27, 0x57, 0x38, 0xce, 0x6f, 0x09, 0xad, 0xb8, 0x76, 0x7e, 0x94, 0x80, 0x33, 0xc7, 0xbb, 0x01,
-	0x2e, 0xac, 0xbc, 0xd2, 0xb5, 0x95, 0x67, 0x4d, 0xe4, 0x55, 0x48, 0x19, 0x96, 0xf5, 0xef, 0x08,
-	0xaa, 0xcb, 0x84, 0xbe, 0xc9, 0xed, 0xc8, 0xb5, 0x9c, 0x6e, 0x3c, 0xfa, 0x06, 0xaf, 0xce, 0x27,
-	0x34, 0xc9, 0x9e, 0xec, 0x8d, 0x66, 0xbd, 0x54, 0xd2, 0xa2, 0xe5, 0xd9, 0x4a, 0xe4, 0xb8, 0xb3,
-	0x1c, 0x1f, 0x93, 0x7f, 0xef, 0xbf, 0x61, 0x9f, 0xc0, 0xa1, 0x77, 0xed, 0xf9, 0x53, 0xe5, 0x89,
-	0xb5, 0x6a, 0xde, 0xb4, 0xdd, 0x05, 0xd6, 0x37, 0x50, 0xcf, 0xbd, 0xc5, 0xb2, 0x1b, 0x1f, 0x78,
-	0xb7, 0xf3, 0xbe, 0xd6, 0x08, 0xcc, 0x42, 0xfa, 0x88, 0x5e, 0xac, 0x5f, 0x95, 0xbb, 0x87, 0x7d,
-	0xfc, 0x57, 0x09, 0xf4, 0x3c, 0x54, 0x54, 0x81, 0x92, 0xff, 0xc2, 0xf8, 0x1f, 0xd2, 0xa1, 0xda,
-	0xb6, 0x71, 0xdb, 0xed, 0xb9, 0x8e, 0xa1, 0xa1, 0x3a, 0xec, 0x8c, 0xf0, 0x0b, 0xec, 0x7f, 0x8f,
-	0x8d, 0x12, 0xfa, 0x3f, 0x18, 0x1e, 0x7e, 0x69, 0xf7, 0x3c, 0x67, 0x6c, 0x93, 0xce, 0xe8, 0xd4,
-	0xc5, 0x43, 0xa3, 0x8c, 0xee, 0xc1, 0xbe, 0xe3, 0xda, 0x4e, 0xcf, 0xc3, 0xee, 0xd8, 0x7d, 0xd5,
-	0x76, 0x5d, 0xc7, 0x75, 0x8c, 0x2d, 0xd4, 0x80, 0x1a, 0xf6, 0x87, 0xe3, 0xe7, 0xfe, 0x08, 0x3b,
-	0xc6, 0x36, 0x42, 0xb0, 0x6b, 0xf7, 0x88, 0x6b, 0x3b, 0x3f, 0x8c, 0xdd, 0x57, 0xde, 0x60, 0x38,
-	0x30, 0x2a, 0xe2, 0xcb, 0xbe, 0x4b, 0x4e, 0xbd, 0xc1, 0xc0, 0xf3, 0xf1, 0xd8, 0x71, 0xb1, 0xe7,
-	0x3a, 0xc6, 0x0e, 0xba, 0x0f, 0x88, 0xb8, 0x03, 0x7f, 0x44, 0xda, 0x22, 0x60, 0xd7, 0x1e, 0x0d,
-	0x86, 0xae, 0x63, 0x54, 0xd1, 0x21, 0x1c, 0x3c, 0xb7, 0xbd, 0x9e, 0xeb, 0x8c, 0xfb, 0xc4, 0x6d,
-	0xfb, 0xd8, 0xf1, 0x86, 0x9e, 0x8f, 0x8d, 0x9a, 0x00, 0x69, 0x9f, 0xf8, 0x44, 0x78, 0x01, 0x32,
-	0x40, 0xf7, 0x47, 0xc3, 0xb1, 0xff, 0x7c, 0x4c, 0x6c, 0xdc, 0x71, 0x8d, 0x3a, 0xda, 0x87, 0xc6,
-	0x08, 0x7b, 0xa7, 0xfd, 0x9e, 0x2b, 0x10, 0xbb, 0x8e, 0xa1, 0x8b, 0x22, 0x3d, 0x3c, 0x74, 0x09,
-	0xb6, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x7d, 0x84, 0xed, 0x97, 0xb6, 0xd7, 0xb3, 0x4f, 0x7a, 0xae,
-	0xb1, 0x2b, 0xb0, 0x3b, 0xf6, 0xd0, 0x1e, 0xf7, 0xfc, 0xc1, 0xc0, 0xd8, 0x43, 0x07, 0xb0, 0x37,
-	0xc2, 0xf6, 0x68, 0xd8, 0x75, 0xf1, 0xd0, 0x6b, 0xdb, 0x22, 0x84, 0x31, 0xa9, 0xc8, 0xff, 0xef,
-	0x67, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xa1, 0xfe, 0x94, 0x96, 0x0c, 0x00, 0x00,
+	// 1480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xdb, 0x46,
+	0x16, 0x5e, 0x49, 0x96, 0x2c, 0x1d, 0x49, 0x36, 0x3d, 0x4e, 0x6c, 0xae, 0x37, 0xc8, 0x0a, 0x44,
+	0x76, 0xa3, 0xf5, 0x06, 0xc1, 0x42, 0xb9, 0x5a, 0xf4, 0xa2, 0xa0, 0x45, 0x5a, 0x62, 0x23, 0x53,
+	0xea, 0x48, 0x4a, 0x53, 0xa0, 0xa8, 0x4a, 0x49, 0x63, 0x89, 0x8d, 0x4d, 0x32, 0x9c, 0x91, 0x7f,
+	0x72, 0x53, 0xa0, 0x05, 0xfa, 0x06, 0x7d, 0x85, 0x3e, 0x46, 0xdf, 0xa0, 0x8f, 0x54, 0xa0, 0x98,
+	0xe1, 0x50, 0x22, 0x65, 0x27, 0x46, 0xdd, 0xf4, 0x6e, 0xce, 0xcf, 0x9c, 0xdf, 0xef, 0x9c, 0x21,
+	0xa1, 0x14, 0x34, 0x82, 0xe7, 0x41, 0xe8, 0x33, 0x1f, 0xe5, 0xd9, 0x75, 0x40, 0xe8, 0x81, 0x32,
+	0x3e, 0xf3, 0x27, 0x6f, 0x26, 0x73, 0xc7, 0xf5, 0x22, 0xc1, 0x01, 0x78, 0xfe, 0x94, 0x44, 0x67,
+	0xed, 0xb7, 0x0c, 0x94, 0x4e, 0xe8, 0xac, 0x4d, 0x9c, 0x29, 0x09, 0xd1, 0x13, 0xa8, 0x4e, 0xce,
+	0x5c, 0xe2, 0xb1, 0x57, 0x24, 0xa4, 0xae, 0xef, 0xa9, 0x99, 0x5a, 0xa6, 0x5e, 0xc2, 0x69, 0x26,
+	0x7a, 0x04, 0x25, 0xe6, 0x9e, 0x13, 0xca, 0x9c, 0xf3, 0x40, 0xcd, 0xd6, 0x32, 0xf5, 0x1c, 0x5e,
+	0x31, 0xd0, 0x16, 0x64, 0xdd, 0xa9, 0x9a, 0x13, 0x17, 0xb3, 0xee, 0x14, 0xed, 0x41, 0x61, 0xe6,
+	0x53, 0xea, 0x06, 0xea, 0x46, 0x2d, 0x53, 0x2f, 0x62, 0x49, 0x71, 0x7e, 0x40, 0x48, 0x68, 0x19,
+	0x6a, 0xbe, 0x96, 0xa9, 0x57, 0xb0, 0xa4, 0xd0, 0x63, 0x10, 0xf1, 0xf5, 0x16, 0xe3, 0x97, 0xe4,
+	0x5a, 0x2d, 0x08, 0x59, 0x82, 0x83, 0x10, 0x6c, 0x50, 0x77, 0xe6, 0xa9, 0x9b, 0x42, 0x22, 0xce,
+	0xa8, 0x06, 0x65, 0xba, 0x18, 0x8b, 0x8c, 0x26, 0xfe, 0x99, 0x5a, 0xac, 0x65, 0xea, 0x55, 0x9c,
+	0x64, 0x71, 0x6f, 0x67, 0xc4, 0x9b, 0xb1, 0xb9, 0x5a, 0x12, 0x42, 0x49, 0x69, 0x9f, 0x01, 0xf4,
+	0x1a, 0xbd, 0x13, 0x42, 0xa9, 0x33, 0x23, 0xa8, 0x0e, 0x85, 0xb9, 0xa8, 0x84, 0x48, 0xbc, 0xdc,
+	0x50, 0x9e, 0x8b, 0x1a, 0x3e, 0x5f, 0x56, 0x08, 0x4b, 0x39, 0x8f, 0x62, 0xea, 0x30, 0x47, 0xa4,
+	0x5f, 0xc1, 0xe2, 0xac, 0x75, 0x61, 0xa3, 0xe7, 0x7a, 0x33, 0xf4, 0x6f, 0xd8, 0x1e, 0x13, 0xca,
+	0x46, 0xa2, 0xf0, 0xa3, 0xb9, 0x43, 0xe7, 0xc2, 0x5c, 0x05, 0x57, 0x39, 0xfb, 0x88, 0x73, 0xdb,
+	0x0e, 0x9d, 0xa3, 0x7f, 0x42, 0x59, 0xe8, 0xcd, 0x89, 0x3b, 0x9b, 0x33, 0x61, 0x6a, 0x03, 0x03,
+	0x67, 0xb5, 0x05, 0x47, 0xeb, 0xc0, 0x46, 0xcf, 0xf7, 0x66, 0xbc, 0x2d, 0xa9, 0x9b, 0xb7, 0x9b,
+	0x7b, 0x0c, 0x89, 0xbb, 0xb7, 0x58, 0xfb, 0x35, 0x0b, 0x85, 0x3e, 0x73, 0xd8, 0x82, 0xa2, 0x43,
+	0x28, 0x50, 0xe2, 0xad, 0xf2, 0x44, 0x32, 0xcf, 0x1e, 0x21, 0xa1, 0x3e, 0x9d, 0x86, 0x84, 0x52,
+	0x2c, 0x35, 0x6e, 0x3a, 0xcf, 0xde, 0xed, 0x3c, 0xb7, 0xee, 0x1c, 0xa9, 0xb0, 0x29, 0x20, 0x68,
+	0x19, 0x02, 0x06, 0x15, 0x1c, 0x93, 0xe8, 0x00, 0x8a, 0x9e, 0x6f, 0x5e, 0x05, 0x3e, 0x25, 0x02,
+	0x09, 0x45, 0xbc, 0xa4, 0xf9, 0xad, 0x0b, 0x89, 0xc4, 0x82, 0x00, 0x54, 0x4c, 0x72, 0xc9, 0x8c,
+	0x78, 0x84, 0xba, 0x54, 0x02, 0x21, 0x26, 0xd1, 0x27, 0x50, 0x99, 0x90, 0x90, 0xb9, 0xa7, 0xee,
+	0xc4, 0x61, 0x84, 0xaa, 0xc5, 0x5a, 0xae, 0x5e, 0x6e, 0xec, 0xcb, 0x0c, 0xf5, 0x19, 0xf1, 0x58,
+	0x73, 0x25, 0xc7, 0x29, 0x65, 0x74, 0x08, 0x8a, 0x4b, 0xe9, 0x82, 0x24, 0x34, 0x04, 0x60, 0x8a,
+	0xf8, 0x06, 0x5f, 0xab, 0x43, 0xa5, 0xe5, 0xeb, 0x97, 0xce, 0xb5, 0xed, 0x33, 0x77, 0x22, 0x82,
+	0x3d, 0x8f, 0x70, 0x24, 0xc7, 0x26, 0x26, 0xb5, 0xd7, 0xa0, 0xc8, 0xaa, 0x12, 0x8a, 0xc9, 0xdb,
+	0x05, 0xa1, 0xec, 0x0f, 0xb5, 0x80, 0x5b, 0x76, 0xae, 0xfa, 0xee, 0x3b, 0x22, 0x8a, 0x5f, 0xc5,
+	0x31, 0xa9, 0x7d, 0x0b, 0x3b, 0x09, 0xcb, 0x34, 0xf0, 0x3d, 0x4a, 0xd0, 0x7f, 0xa1, 0x40, 0x45,
+	0x9f, 0x85, 0xe9, 0xad, 0xc6, 0xae, 0x34, 0x8d, 0x09, 0x5d, 0x9c, 0xb1, 0x08, 0x02, 0x58, 0xaa,
+	0xa0, 0x3a, 0xe4, 0xf9, 0xe0, 0x51, 0x35, 0x2b, 0xea, 0x74, 0x5b, 0x18, 0x91, 0x82, 0xd6, 0x86,
+	0x2d, 0x9b, 0x5c, 0x8a, 0x96, 0xcb, 0x8c, 0x1f, 0x41, 0x69, 0xbc, 0x86, 0xc9, 0x15, 0x83, 0x47,
+	0x3d, 0x8e, 0x94, 0x25, 0x18, 0x63, 0x52, 0xa3, 0xb0, 0x2b, 0xcc, 0xf4, 0x42, 0x7f, 0xba, 0x98,
+	0x90, 0xa9, 0x34, 0xf7, 0x18, 0x20, 0x88, 0x38, 0x7c, 0x2b, 0x44, 0xf6, 0x12, 0x9c, 0xf7, 0x1b,
+	0x44, 0x1a, 0xe4, 0xc5, 0x51, 0x00, 0xaf, 0xdc, 0xa8, 0xc8, 0x24, 0x84, 0x13, 0x1c, 0x89, 0xb4,
+	0xef, 0x33, 0xb0, 0xd7, 0x22, 0x12, 0xb2, 0x62, 0x88, 0x97, 0xbd, 0x40, 0xb0, 0x91, 0x98, 0x52,
+	0x71, 0xe6, 0x0b, 0x23, 0x35, 0x97, 0x92, 0xe2, 0x7c, 0xff, 0xf4, 0x94, 0x92, 0x18, 0xe4, 0x92,
+	0x8a, 0xd6, 0xd2, 0x3b, 0x22, 0xd0, 0x5d, 0xc5, 0xe2, 0x8c, 0x14, 0xc8, 0x39, 0x74, 0x22, 0x51,
+	0xcd, 0x8f, 0xda, 0xcf, 0x19, 0xd8, 0xbf, 0x11, 0xc4, 0x7d, 0xda, 0xc6, 0xc3, 0x73, 0xe8, 0x9c,
+	0x44, 0x7d, 0xab, 0x60, 0x49, 0xa1, 0x67, 0xb0, 0x19, 0x6d, 0x28, 0xaa, 0xe6, 0x52, 0x0d, 0x4d,
+	0xb8, 0xc4, 0xb1, 0x0a, 0xaf, 0xe8, 0xdc, 0xa1, 0x36, 0xb9, 0x62, 0x72, 0x39, 0xc7, 0xa4, 0xf6,
+	0x1f, 0xd8, 0x8e, 0xe3, 0x8c, 0xab, 0xb4, 0x72, 0x99, 0x49, 0xba, 0xd4, 0xbe, 0x03, 0x65, 0xa5,
+	0x7a, 0x9f, 0x5c, 0x9e, 0x40, 0x41, 0xb4, 0x28, 0xc6, 0x60, 0xba, 0x7d, 0x52, 0x96, 0x8c, 0x35,
+	0x97, 0x8e, 0xf5, 0x05, 0x3c, 0xb4, 0xc9, 0xe5, 0x20, 0x74, 0x3c, 0xea, 0x4c, 0x98, 0xeb, 0x7b,
+	0x54, 0x02, 0xea, 0x00, 0x8a, 0xec, 0xaa, 0x9d, 0x8c, 0x79, 0x49, 0x6b, 0xff, 0x13, 0x68, 0x48,
+	0x5e, 0xba, 0x2b, 0xcf, 0x9f, 0xa2, 0xde, 0xa5, 0xaf, 0x7c, 0xcc, 0xde, 0xfd, 0x03, 0x72, 0xec,
+	0x2a, 0xee, 0x5b, 0x49, 0x5a, 0x18, 0x5c, 0x61, 0xce, 0xfd, 0x40, 0xab, 0x5a, 0xb0, 0xd3, 0x22,
+	0xec, 0xc4, 0xa5, 0xd4, 0xf5, 0x66, 0x77, 0x24, 0xc1, 0x4b, 0x42, 0x99, 0x1f, 0xcc, 0x57, 0x8b,
+	0x7c, 0x49, 0x6b, 0xcf, 0x00, 0xb5, 0x08, 0xd3, 0xbd, 0x09, 0xa1, 0xcc, 0x0f, 0xef, 0x2a, 0xc7,
+	0x8f, 0x19, 0xd8, 0x4d, 0xa9, 0xdf, 0xa7, 0x14, 0x1a, 0x54, 0x1c, 0x69, 0x20, 0xf1, 0xb6, 0xa4,
+	0x78, 0x7c, 0x2d, 0xc4, 0xb4, 0xed, 0xc7, 0x4f, 0xcb, 0x8a, 0xa3, 0x3d, 0x85, 0x72, 0x8b, 0x30,
+	0xae, 0x7a, 0x74, 0x6d, 0xfb, 0xc9, 0x2d, 0x91, 0x49, 0xaf, 0x9d, 0x6f, 0x44, 0xc0, 0xb1, 0xe2,
+	0xfd, 0x02, 0x4e, 0xad, 0xbc, 0xec, 0xda, 0xca, 0xd3, 0xc6, 0x62, 0x14, 0x22, 0x84, 0xc5, 0xf5,
+	0x3b, 0x80, 0x62, 0x10, 0x92, 0x8b, 0xc4, 0x8e, 0x5c, 0xd2, 0xd1, 0xc6, 0x23, 0x17, 0xf6, 0xe2,
+	0x7c, 0x4c, 0xc2, 0xf8, 0xc9, 0x5e, 0x71, 0x96, 0x4b, 0x25, 0x4a, 0x5a, 0x9c, 0xb5, 0x50, 0xb4,
+	0x3b, 0xf6, 0xf1, 0x31, 0xf1, 0xf7, 0xfe, 0x09, 0xfb, 0x3b, 0xec, 0x5b, 0x6b, 0xcf, 0x9f, 0x4c,
+	0x8f, 0xaf, 0x55, 0xf5, 0xa6, 0xec, 0x3e, 0x61, 0xfd, 0x1f, 0xca, 0x89, 0xb7, 0x58, 0x54, 0xe3,
+	0x03, 0xef, 0x76, 0x52, 0x57, 0x1b, 0x82, 0x9a, 0x72, 0xef, 0x91, 0xcb, 0xe5, 0xab, 0xf2, 0x27,
+	0xcc, 0x7e, 0x0a, 0x0f, 0x5a, 0x44, 0x84, 0x49, 0x7a, 0xa1, 0xef, 0x9f, 0xc6, 0x2d, 0x7d, 0x0a,
+	0xf9, 0xb7, 0x0b, 0x12, 0x5e, 0xcb, 0xa7, 0x7b, 0x47, 0x1a, 0x13, 0x8a, 0x9f, 0x73, 0x01, 0x8e,
+	0xe4, 0x5a, 0x08, 0x0f, 0xd7, 0x0c, 0xdc, 0xa7, 0x30, 0xcf, 0x20, 0x1f, 0xf0, 0xdb, 0x32, 0xf6,
+	0xbd, 0x1b, 0xee, 0x22, 0xdb, 0x91, 0x92, 0xd6, 0x10, 0x53, 0x8c, 0xc9, 0x84, 0xb8, 0x01, 0x5b,
+	0xa2, 0xf0, 0x83, 0x4f, 0xb5, 0xf6, 0xb5, 0x98, 0x8c, 0xd5, 0x9d, 0xfb, 0x44, 0x79, 0x00, 0xc5,
+	0x50, 0x1a, 0x88, 0x37, 0x4b, 0x4c, 0x6b, 0x5f, 0x01, 0x6a, 0xfa, 0xe7, 0x81, 0x33, 0x61, 0xc9,
+	0xcf, 0x87, 0xc3, 0xb5, 0xaf, 0xed, 0xdb, 0x9e, 0xaa, 0xf8, 0x7b, 0x3b, 0xb9, 0xca, 0xb3, 0x6b,
+	0xab, 0x7c, 0x0a, 0xdb, 0xc7, 0xae, 0x37, 0xb5, 0xfd, 0x69, 0x8c, 0x4a, 0xf4, 0xaf, 0x54, 0xe4,
+	0xe5, 0x46, 0x35, 0x51, 0xb3, 0xf4, 0x24, 0x30, 0x27, 0x9c, 0x11, 0x26, 0x23, 0x96, 0x54, 0x6a,
+	0xee, 0xf2, 0x72, 0xee, 0x7e, 0xc8, 0x80, 0xb2, 0x72, 0xf3, 0x97, 0x7e, 0x6a, 0x25, 0x3f, 0x25,
+	0x73, 0xe9, 0x4f, 0xc9, 0xe9, 0x1a, 0xd2, 0x2f, 0xfc, 0x37, 0x4b, 0xa4, 0xb7, 0x61, 0x2b, 0x24,
+	0x17, 0xfe, 0xc4, 0xe1, 0x6f, 0x53, 0xc7, 0xa5, 0x4c, 0x26, 0x5f, 0x93, 0x8e, 0xd6, 0x2e, 0xae,
+	0xf4, 0xf0, 0xda, 0xbd, 0xc3, 0x5f, 0xb2, 0x50, 0x49, 0xa6, 0x80, 0x0a, 0x90, 0xed, 0xbe, 0x54,
+	0xfe, 0x86, 0x2a, 0x50, 0x6c, 0xea, 0x76, 0xd3, 0xec, 0x98, 0x86, 0x92, 0x41, 0x65, 0xd8, 0x1c,
+	0xda, 0x2f, 0xed, 0xee, 0x17, 0xb6, 0x92, 0x45, 0x0f, 0x40, 0xb1, 0xec, 0x57, 0x7a, 0xc7, 0x32,
+	0x46, 0x3a, 0x6e, 0x0d, 0x4f, 0x4c, 0x7b, 0xa0, 0xe4, 0xd0, 0x43, 0xd8, 0x31, 0x4c, 0xdd, 0xe8,
+	0x58, 0xb6, 0x39, 0x32, 0x5f, 0x37, 0x4d, 0xd3, 0x30, 0x0d, 0x65, 0x03, 0x55, 0xa1, 0x64, 0x77,
+	0x07, 0xa3, 0xe3, 0xee, 0xd0, 0x36, 0x94, 0x3c, 0x42, 0xb0, 0xa5, 0x77, 0xb0, 0xa9, 0x1b, 0x5f,
+	0x8e, 0xcc, 0xd7, 0x56, 0x7f, 0xd0, 0x57, 0x0a, 0xfc, 0x66, 0xcf, 0xc4, 0x27, 0x56, 0xbf, 0x6f,
+	0x75, 0xed, 0x91, 0x61, 0xda, 0x96, 0x69, 0x28, 0x9b, 0x68, 0x0f, 0x10, 0x36, 0xfb, 0xdd, 0x21,
+	0x6e, 0x72, 0x83, 0x6d, 0x7d, 0xd8, 0x1f, 0x98, 0x86, 0x52, 0x44, 0xfb, 0xb0, 0x7b, 0xac, 0x5b,
+	0x1d, 0xd3, 0x18, 0xf5, 0xb0, 0xd9, 0xec, 0xda, 0x86, 0x35, 0xb0, 0xba, 0xb6, 0x52, 0xe2, 0x41,
+	0xea, 0x47, 0x5d, 0xcc, 0xb5, 0x00, 0x29, 0x50, 0xe9, 0x0e, 0x07, 0xa3, 0xee, 0xf1, 0x08, 0xeb,
+	0x76, 0xcb, 0x54, 0xca, 0x68, 0x07, 0xaa, 0x43, 0xdb, 0x3a, 0xe9, 0x75, 0x4c, 0x1e, 0xb1, 0x69,
+	0x28, 0x15, 0x9e, 0xa4, 0x65, 0x0f, 0x4c, 0x6c, 0xeb, 0x1d, 0xa5, 0x8a, 0xb6, 0xa1, 0x3c, 0xb4,
+	0xf5, 0x57, 0xba, 0xd5, 0xd1, 0x8f, 0x3a, 0xa6, 0xb2, 0xc5, 0x63, 0x37, 0xf4, 0x81, 0x3e, 0xea,
+	0x74, 0xfb, 0x7d, 0x65, 0x1b, 0xed, 0xc2, 0xf6, 0xd0, 0xd6, 0x87, 0x83, 0xb6, 0x69, 0x0f, 0xac,
+	0xa6, 0xce, 0x4d, 0x28, 0xe3, 0x82, 0xf8, 0xf1, 0x7c, 0xf1, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x0d, 0xff, 0x5e, 0xb7, 0x8f, 0x0f, 0x00, 0x00,
 }
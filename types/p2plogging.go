@@ -191,3 +191,47 @@ func (m *CertificateRenewedNotice) MarshalZerologObject(e *zerolog.Event) {
 		e.Str("cert", m.Certificate.String())
 	}
 }
+
+func (m *CertificateRevokedNotice) MarshalZerologObject(e *zerolog.Event) {
+	if m.RevocationList != nil {
+		e.Str("bpID", IDB58Encode(PeerID(m.RevocationList.BPID))).Int64("cTime", m.RevocationList.CreateTime).Int("revocations", len(m.RevocationList.Revocations))
+	}
+}
+
+func (m *GetStateProofRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("root", enc.ToString(m.GetQuery().GetRoot())).Str("address", enc.ToString(m.GetQuery().GetContractAddress())).Int("keys", len(m.GetQuery().GetStorageKeys()))
+}
+
+func (m *GetStateProofResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Bool("inclusion", m.GetProof().GetContractProof().GetInclusion())
+}
+
+func (m *GetReceiptsRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogBlkHash, enc.ToString(m.BlockHash))
+}
+
+func (m *GetReceiptsResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("size", len(m.Receipts))
+}
+
+func (m *FinalityVote) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint32("type", m.Type).Uint64(LogBlkNo, m.BlockNo).Uint32("round", m.Round).Str(LogBlkHash, enc.ToString(m.BlockHash))
+}
+
+func (m *VoteEquivocation) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint32("type", m.GetFirst().GetType()).Uint64(LogBlkNo, m.GetFirst().GetBlockNo()).Uint32("round", m.GetFirst().GetRound())
+}
+
+func (m *DoubleSignEvidence) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint64(LogBlkNo, m.GetFirst().GetBlockNo()).Str("first", enc.ToString(headerHash(m.GetFirst()))).
+		Str("second", enc.ToString(headerHash(m.GetSecond())))
+}
+
+func (m *BFTMessage) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint64("height", m.Height()).Uint32("round", m.Round())
+	if m.Proposal.GetBlock() != nil {
+		e.Str(LogBlkHash, m.Proposal.Block.ID())
+	} else if m.Vote != nil {
+		e.Uint32("type", m.Vote.Type).Str(LogBlkHash, enc.ToString(m.Vote.BlockHash))
+	}
+}
@@ -63,9 +63,23 @@ const (
 	protoIP4  = "ip4"
 	protoIP6  = "ip6"
 	protoTCP  = "tcp"
+	protoUDP  = "udp"
+	protoQUIC = "aergo-quic"
 	protoP2P  = "p2p"
 )
 
+// ProtocolAergoQUIC is the multiaddr code of the experimental QUIC transport of aergo, which is in the
+// private use range of multicodec. It's not the quic of libp2p, since the peers are authenticated by the
+// handshake of aergo, which the QUIC transports of libp2p can't complete.
+const ProtocolAergoQUIC = 0x3a0001
+
+func init() {
+	err := multiaddr.AddProtocol(multiaddr.Protocol{Name: protoQUIC, Code: ProtocolAergoQUIC, VCode: multiaddr.CodeToVarint(ProtocolAergoQUIC)})
+	if err != nil {
+		panic(err)
+	}
+}
+
 func PeerToMultiAddr(address string, port uint32, pid PeerID) (Multiaddr, error) {
 	idport, err := ToMultiAddr(address, port)
 	if err != nil {
@@ -80,7 +94,36 @@ func PeerToMultiAddr(address string, port uint32, pid PeerID) (Multiaddr, error)
 
 // ToMultiAddr make libp2p compatible Multiaddr object
 func ToMultiAddr(address string, port uint32) (Multiaddr, error) {
-	var maddr, mport *multiaddr.Component
+	maddr, err := addressComponent(address)
+	if err != nil {
+		return nil, err
+	}
+	mport, err := multiaddr.NewComponent(protoTCP, strconv.Itoa(int(port)))
+	if err != nil {
+		return nil, err
+	}
+	return multiaddr.Join(maddr, mport), nil
+}
+
+// ToQUICMultiAddr make Multiaddr object of the QUIC transport of aergo, which is on the udp port.
+func ToQUICMultiAddr(address string, port uint32) (Multiaddr, error) {
+	maddr, err := addressComponent(address)
+	if err != nil {
+		return nil, err
+	}
+	mport, err := multiaddr.NewComponent(protoUDP, strconv.Itoa(int(port)))
+	if err != nil {
+		return nil, err
+	}
+	mquic, err := multiaddr.NewComponent(protoQUIC, "")
+	if err != nil {
+		return nil, err
+	}
+	return multiaddr.Join(maddr, mport, mquic), nil
+}
+
+func addressComponent(address string) (*multiaddr.Component, error) {
+	var maddr *multiaddr.Component
 	var err error
 	switch network.CheckAddressType(address) {
 	case network.AddressTypeFQDN:
@@ -95,14 +138,7 @@ func ToMultiAddr(address string, port uint32) (Multiaddr, error) {
 	default:
 		return nil, errors.New("invalid address")
 	}
-	if err != nil {
-		return nil, err
-	}
-	mport, err = multiaddr.NewComponent(protoTCP, strconv.Itoa(int(port)))
-	if err != nil {
-		return nil, err
-	}
-	return multiaddr.Join(maddr, mport), nil
+	return maddr, err
 }
 
 // ParseMultiaddr parse multiaddr formatted string to Multiaddr with slightly different manner; it automatically auusme that /dns is /dns4
@@ -164,8 +200,12 @@ func GetIPFromMultiaddr(ma Multiaddr) net.IP {
 	}
 }
 
+// GetPortFromMultiaddr returns tcp port, or udp port if the multiaddr is of QUIC transport.
 func GetPortFromMultiaddr(ma Multiaddr) uint32 {
 	val, err := ma.ValueForProtocol(multiaddr.P_TCP)
+	if err != nil {
+		val, err = ma.ValueForProtocol(multiaddr.P_UDP)
+	}
 	if err != nil {
 		return PortUndefined
 	}
@@ -156,6 +156,39 @@ func TestToMultiAddr(t *testing.T) {
 	}
 }
 
+func TestToQUICMultiAddr(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		port    uint32
+
+		want    string
+		wantErr bool
+	}{
+		{"TIP4", "192.168.1.2", 7846, "/ip4/192.168.1.2/udp/7846/aergo-quic", false},
+		{"TIP6", "2001:db8::1", 7846, "/ip6/2001:db8::1/udp/7846/aergo-quic", false},
+		{"TDomain", "no1.blocko.com", 7846, "/dns4/no1.blocko.com/udp/7846/aergo-quic", false},
+		{"TErrDomain", "dw!.com", 7846, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToQUICMultiAddr(tt.address, tt.port)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToQUICMultiAddr() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if got.String() != tt.want {
+					t.Errorf("ToQUICMultiAddr() got = %v, want %v", got, tt.want)
+				}
+				if port := GetPortFromMultiaddr(got); port != tt.port {
+					t.Errorf("GetPortFromMultiaddr() got = %v, want %v", port, tt.port)
+				}
+			}
+		})
+	}
+}
+
 func TestGetIPFromMultiaddr(t *testing.T) {
 	tests := []struct {
 		name      string
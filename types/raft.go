@@ -51,11 +51,13 @@ func (mattr *MemberAttr) MarshalJSON() ([]byte, error) {
 		Name    string `json:"name,omitempty"`
 		Address string `json:"address,omitempty"`
 		PeerID  string `json:"peerid,omitempty"`
+		Learner bool   `json:"learner,omitempty"`
 	}{
 		ID:      Uint64ToHexaString(mattr.ID),
 		Name:    mattr.Name,
 		Address: mattr.Address,
 		PeerID:  IDB58Encode(PeerID(mattr.PeerID)),
+		Learner: mattr.Learner,
 	})
 }
 
@@ -70,6 +72,7 @@ func (mattr *MemberAttr) UnmarshalJSON(data []byte) error {
 		Name    string `json:"name,omitempty"`
 		Address string `json:"address,omitempty"`
 		PeerID  string `json:"peerid,omitempty"`
+		Learner bool   `json:"learner,omitempty"`
 	}{}
 
 	if err = json.Unmarshal(data, aux); err != nil {
@@ -93,6 +96,7 @@ func (mattr *MemberAttr) UnmarshalJSON(data []byte) error {
 	}
 	mattr.Name = aux.Name
 	mattr.Address = aux.Address
+	mattr.Learner = aux.Learner
 
 	return nil
 }
@@ -24,18 +24,24 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type MembershipChangeType int32
 
 const (
-	MembershipChangeType_ADD_MEMBER    MembershipChangeType = 0
-	MembershipChangeType_REMOVE_MEMBER MembershipChangeType = 1
+	MembershipChangeType_ADD_MEMBER      MembershipChangeType = 0
+	MembershipChangeType_REMOVE_MEMBER   MembershipChangeType = 1
+	MembershipChangeType_ADD_LEARNER     MembershipChangeType = 2
+	MembershipChangeType_PROMOTE_LEARNER MembershipChangeType = 3
 )
 
 var MembershipChangeType_name = map[int32]string{
 	0: "ADD_MEMBER",
 	1: "REMOVE_MEMBER",
+	2: "ADD_LEARNER",
+	3: "PROMOTE_LEARNER",
 }
 
 var MembershipChangeType_value = map[string]int32{
-	"ADD_MEMBER":    0,
-	"REMOVE_MEMBER": 1,
+	"ADD_MEMBER":      0,
+	"REMOVE_MEMBER":   1,
+	"ADD_LEARNER":     2,
+	"PROMOTE_LEARNER": 3,
 }
 
 func (x MembershipChangeType) String() string {
@@ -79,6 +85,7 @@ type MemberAttr struct {
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	PeerID               []byte   `protobuf:"bytes,4,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Learner              bool     `protobuf:"varint,5,opt,name=learner,proto3" json:"learner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -137,6 +144,13 @@ func (m *MemberAttr) GetPeerID() []byte {
 	return nil
 }
 
+func (m *MemberAttr) GetLearner() bool {
+	if m != nil {
+		return m.Learner
+	}
+	return false
+}
+
 type MembershipChange struct {
 	Type                 MembershipChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=types.MembershipChangeType" json:"type,omitempty"`
 	RequestID            uint64               `protobuf:"varint,2,opt,name=requestID,proto3" json:"requestID,omitempty"`
@@ -501,6 +515,62 @@ func (m *SnapshotResponse) GetMessage() string {
 	return ""
 }
 
+// MaintenanceMode is the maintenance mode of a raft node. Drained is set if the node is neither leader nor has pending blocks to connect.
+type MaintenanceMode struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IsLeader             bool     `protobuf:"varint,2,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	Drained              bool     `protobuf:"varint,3,opt,name=drained,proto3" json:"drained,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceMode) Reset()         { *m = MaintenanceMode{} }
+func (m *MaintenanceMode) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceMode) ProtoMessage()    {}
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{8}
+}
+
+func (m *MaintenanceMode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceMode.Unmarshal(m, b)
+}
+func (m *MaintenanceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceMode.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceMode.Merge(m, src)
+}
+func (m *MaintenanceMode) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceMode.Size(m)
+}
+func (m *MaintenanceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceMode proto.InternalMessageInfo
+
+func (m *MaintenanceMode) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MaintenanceMode) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *MaintenanceMode) GetDrained() bool {
+	if m != nil {
+		return m.Drained
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("types.MembershipChangeType", MembershipChangeType_name, MembershipChangeType_value)
 	proto.RegisterEnum("types.ConfChangeState", ConfChangeState_name, ConfChangeState_value)
@@ -512,47 +582,53 @@ func init() {
 	proto.RegisterType((*GetClusterInfoResponse)(nil), "types.GetClusterInfoResponse")
 	proto.RegisterType((*ConfChangeProgress)(nil), "types.ConfChangeProgress")
 	proto.RegisterType((*SnapshotResponse)(nil), "types.SnapshotResponse")
+	proto.RegisterType((*MaintenanceMode)(nil), "types.MaintenanceMode")
 }
 
 func init() { proto.RegisterFile("raft.proto", fileDescriptor_b042552c306ae59b) }
 
 var fileDescriptor_b042552c306ae59b = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
+	// 665 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xad, 0x13, 0x27, 0xa5, 0xd3, 0xa6, 0xb8, 0x4b, 0x5b, 0x4c, 0x0b, 0x28, 0xb2, 0x40, 0x8a,
-	0x5a, 0x08, 0x52, 0x38, 0x01, 0x02, 0xc9, 0x8d, 0x4d, 0x63, 0x89, 0x7c, 0x68, 0x1d, 0x55, 0xe2,
-	0x14, 0x6d, 0x92, 0x4d, 0x1c, 0x11, 0x7f, 0xb0, 0xbb, 0x39, 0xe4, 0xc8, 0x8d, 0xff, 0xcb, 0x1f,
-	0x40, 0x5e, 0xaf, 0xdd, 0x26, 0x2d, 0xdc, 0x76, 0x66, 0xde, 0xcc, 0xbc, 0x79, 0x33, 0x36, 0x00,
-	0x23, 0x33, 0xd1, 0x4c, 0x58, 0x2c, 0x62, 0x54, 0x11, 0xeb, 0x84, 0xf2, 0xb3, 0xbd, 0xa4, 0x95,
-	0x64, 0x1e, 0x6b, 0x0c, 0xd0, 0xa5, 0xe1, 0x98, 0x32, 0x5b, 0x08, 0x86, 0x0e, 0xa1, 0xe4, 0x39,
-	0xa6, 0x56, 0xd7, 0x1a, 0x3a, 0x2e, 0x79, 0x0e, 0x42, 0xa0, 0x47, 0x24, 0xa4, 0x66, 0xa9, 0xae,
-	0x35, 0xf6, 0xb0, 0x7c, 0x23, 0x13, 0x76, 0xc9, 0x74, 0xca, 0x28, 0xe7, 0x66, 0x59, 0xba, 0x73,
-	0x13, 0x9d, 0x42, 0x35, 0xa1, 0x94, 0x79, 0x8e, 0xa9, 0xd7, 0xb5, 0xc6, 0x01, 0x56, 0x96, 0xf5,
-	0x5b, 0x03, 0x23, 0x6b, 0xc2, 0x83, 0x45, 0xd2, 0x0e, 0x48, 0x34, 0xa7, 0xe8, 0x1d, 0xe8, 0x29,
-	0x19, 0xd9, 0xec, 0xb0, 0x75, 0xde, 0x94, 0xcc, 0x9a, 0xdb, 0xb0, 0xe1, 0x3a, 0xa1, 0x58, 0x02,
-	0xd1, 0x73, 0xd8, 0x63, 0xf4, 0xe7, 0x8a, 0x72, 0xe1, 0x39, 0x92, 0x90, 0x8e, 0x6f, 0x1d, 0xe8,
-	0x35, 0xe8, 0x44, 0x08, 0x26, 0x29, 0xed, 0xb7, 0x8e, 0x36, 0xca, 0xa5, 0xa3, 0x61, 0x19, 0xb6,
-	0xbe, 0xc0, 0xc9, 0x76, 0x0b, 0x4c, 0x93, 0xe5, 0xba, 0xc8, 0xd7, 0xfe, 0x9f, 0xff, 0x09, 0x6a,
-	0x1d, 0xc2, 0xa6, 0xbe, 0x20, 0x82, 0x7a, 0xd1, 0x2c, 0x4e, 0x15, 0x12, 0x94, 0x85, 0x4a, 0x33,
-	0xf9, 0x4e, 0x75, 0x98, 0xc4, 0x61, 0xb8, 0x10, 0x8a, 0xa6, 0xb2, 0xac, 0xcf, 0x70, 0x72, 0x4d,
-	0x45, 0x7b, 0xb9, 0xe2, 0x82, 0xb2, 0x34, 0x1b, 0x67, 0xf4, 0xd1, 0x2b, 0xa8, 0x8d, 0x29, 0x17,
-	0x57, 0xcb, 0x78, 0xf2, 0xa3, 0x43, 0x78, 0x20, 0xab, 0x1d, 0xe0, 0x4d, 0xa7, 0xf5, 0x47, 0x83,
-	0xd3, 0xed, 0x7c, 0x9e, 0xc4, 0x11, 0x97, 0x3b, 0x99, 0x04, 0x64, 0x11, 0xa9, 0xe5, 0x1d, 0xe0,
-	0xdc, 0x4c, 0x55, 0x9b, 0xa8, 0x84, 0x42, 0xb5, 0xc2, 0x81, 0x8e, 0xa1, 0x42, 0x19, 0x8b, 0x99,
-	0xda, 0x64, 0x66, 0xa0, 0xb7, 0xf0, 0x28, 0x1c, 0xcb, 0xa9, 0xb9, 0xa9, 0xd7, 0xcb, 0x0f, 0xeb,
-	0x51, 0x40, 0x50, 0x1d, 0xf6, 0x0b, 0xa2, 0xbd, 0xd8, 0xac, 0xc8, 0x26, 0x77, 0x5d, 0xe8, 0x23,
-	0xd4, 0x82, 0xbb, 0xaa, 0x99, 0x55, 0xa9, 0xf2, 0xb1, 0xaa, 0xba, 0xa1, 0x28, 0xde, 0x84, 0x5a,
-	0xbf, 0x34, 0x40, 0xed, 0x38, 0x9a, 0x65, 0xcb, 0x1a, 0xb0, 0x78, 0x2e, 0x6f, 0xed, 0x0d, 0x54,
-	0x24, 0x46, 0xdd, 0xcf, 0xa9, 0x2a, 0x75, 0x8b, 0x94, 0x51, 0x9c, 0x81, 0x90, 0x01, 0x65, 0x97,
-	0x31, 0x75, 0xc6, 0xe9, 0x13, 0x5d, 0xc2, 0xae, 0x3a, 0x04, 0xb3, 0xfc, 0xaf, 0x11, 0x73, 0x84,
-	0xf5, 0x1d, 0x0c, 0x3f, 0x22, 0x09, 0x0f, 0x62, 0x51, 0x48, 0x7e, 0x09, 0x55, 0x2e, 0x88, 0x58,
-	0x71, 0xc5, 0xe0, 0x89, 0xca, 0xc7, 0x94, 0xaf, 0x96, 0xc2, 0x97, 0x21, 0xac, 0x20, 0xe9, 0x7e,
-	0x42, 0xca, 0x39, 0x99, 0xe7, 0x9f, 0x52, 0x6e, 0x5e, 0x7c, 0x80, 0xe3, 0x87, 0x6e, 0x1e, 0x1d,
-	0x02, 0xd8, 0x8e, 0x33, 0xea, 0xba, 0xdd, 0x2b, 0x17, 0x1b, 0x3b, 0xe8, 0x08, 0x6a, 0xd8, 0xed,
-	0xf6, 0x6f, 0xdc, 0xdc, 0xa5, 0x5d, 0x84, 0xf0, 0x78, 0x6b, 0x5c, 0xf4, 0x12, 0xce, 0xda, 0xfd,
-	0xde, 0xd7, 0x51, 0xbb, 0x63, 0xf7, 0xae, 0xdd, 0x91, 0x3f, 0xb4, 0x87, 0xee, 0x68, 0x80, 0xfb,
-	0x83, 0xbe, 0xef, 0x3a, 0xc6, 0x0e, 0x3a, 0x87, 0xa7, 0xf7, 0xe3, 0xbe, 0x7d, 0xe3, 0x3a, 0x86,
-	0x86, 0x5e, 0xc0, 0xb3, 0xfb, 0x41, 0x7b, 0x30, 0xf8, 0xe6, 0xb9, 0x8e, 0x51, 0x1a, 0x57, 0xe5,
-	0x0f, 0xe3, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x67, 0x2b, 0xa7, 0x50, 0x04, 0x00,
-	0x00,
+	0x10, 0xad, 0x13, 0x27, 0x4d, 0xa7, 0x4d, 0xe3, 0x6e, 0x3f, 0x30, 0x2d, 0xa0, 0xc8, 0x02, 0x29,
+	0x6a, 0xa1, 0x48, 0xe5, 0x06, 0x02, 0x29, 0x8d, 0x4d, 0x1b, 0xa9, 0xf9, 0xd0, 0x26, 0xaa, 0xc4,
+	0x29, 0xda, 0xc4, 0xd3, 0x26, 0x22, 0xfe, 0x60, 0x77, 0x73, 0xa8, 0xc4, 0x85, 0x1b, 0xff, 0x97,
+	0x3f, 0x80, 0xbc, 0x5e, 0xbb, 0x4d, 0x5a, 0xb8, 0xed, 0x9b, 0x79, 0x33, 0x7e, 0xfb, 0x66, 0xd6,
+	0x00, 0x9c, 0xdd, 0xc8, 0xd3, 0x98, 0x47, 0x32, 0x22, 0x25, 0x79, 0x17, 0xa3, 0x38, 0xdc, 0x88,
+	0xcf, 0xe2, 0x34, 0xe2, 0xfc, 0x04, 0xe8, 0x60, 0x30, 0x46, 0xde, 0x94, 0x92, 0x93, 0x6d, 0x28,
+	0xb4, 0x5d, 0xdb, 0xa8, 0x1b, 0x0d, 0x93, 0x16, 0xda, 0x2e, 0x21, 0x60, 0x86, 0x2c, 0x40, 0xbb,
+	0x50, 0x37, 0x1a, 0x1b, 0x54, 0x9d, 0x89, 0x0d, 0xeb, 0xcc, 0xf7, 0x39, 0x0a, 0x61, 0x17, 0x55,
+	0x38, 0x83, 0xe4, 0x00, 0xca, 0x31, 0x22, 0x6f, 0xbb, 0xb6, 0x59, 0x37, 0x1a, 0x5b, 0x54, 0xa3,
+	0xa4, 0x62, 0x8e, 0x8c, 0x87, 0xc8, 0xed, 0x52, 0xdd, 0x68, 0x54, 0x68, 0x06, 0x9d, 0xdf, 0x06,
+	0x58, 0xe9, 0xe7, 0xc5, 0x74, 0x16, 0xb7, 0xa6, 0x2c, 0xbc, 0x45, 0xf2, 0x1e, 0xcc, 0x44, 0xa6,
+	0x92, 0xb1, 0x7d, 0x76, 0x74, 0xaa, 0x34, 0x9f, 0xae, 0xd2, 0x86, 0x77, 0x31, 0x52, 0x45, 0x24,
+	0x2f, 0x60, 0x83, 0xe3, 0x8f, 0x05, 0x0a, 0xd9, 0x76, 0x95, 0x54, 0x93, 0xde, 0x07, 0xc8, 0x1b,
+	0x30, 0x99, 0x94, 0x5c, 0x89, 0xdd, 0x3c, 0xdb, 0x59, 0x6a, 0x97, 0x5c, 0x9a, 0xaa, 0xb4, 0xf3,
+	0x05, 0xf6, 0x57, 0x3f, 0x41, 0x31, 0x9e, 0xdf, 0xe5, 0xf5, 0xc6, 0xff, 0xeb, 0x3f, 0x41, 0xf5,
+	0x92, 0x71, 0x7f, 0x20, 0x99, 0xc4, 0x76, 0x78, 0x13, 0x25, 0xde, 0x49, 0xe4, 0x81, 0x76, 0x53,
+	0x9d, 0x13, 0x87, 0x26, 0x51, 0x10, 0xcc, 0xa4, 0x96, 0xa9, 0x91, 0xf3, 0x19, 0xf6, 0x2f, 0x50,
+	0xb6, 0xe6, 0x0b, 0x21, 0x91, 0x27, 0xd5, 0x34, 0x95, 0x4f, 0x5e, 0x43, 0x75, 0x8c, 0x42, 0x9e,
+	0xcf, 0xa3, 0xc9, 0xf7, 0x4b, 0x26, 0xa6, 0xaa, 0xdb, 0x16, 0x5d, 0x0e, 0x3a, 0x7f, 0x0c, 0x38,
+	0x58, 0xad, 0x17, 0x71, 0x14, 0x0a, 0x35, 0xad, 0xc9, 0x94, 0xcd, 0x42, 0x3d, 0xd6, 0x2d, 0x9a,
+	0xc1, 0xc4, 0xb5, 0x89, 0x2e, 0xc8, 0x5d, 0xcb, 0x03, 0x64, 0x0f, 0x4a, 0xc8, 0x79, 0xc4, 0xf5,
+	0x8c, 0x53, 0x40, 0xde, 0x41, 0x25, 0x18, 0xab, 0x5b, 0x0b, 0xdb, 0xac, 0x17, 0x9f, 0xf6, 0x23,
+	0xa7, 0x90, 0x3a, 0x6c, 0xe6, 0x42, 0xbb, 0x91, 0x1a, 0xbe, 0x49, 0x1f, 0x86, 0xc8, 0x47, 0xa8,
+	0x4e, 0x1f, 0xba, 0x66, 0x97, 0x95, 0xcb, 0x7b, 0xba, 0xeb, 0x92, 0xa3, 0x74, 0x99, 0xea, 0xfc,
+	0x32, 0x80, 0xb4, 0xa2, 0xf0, 0x26, 0x1d, 0x56, 0x9f, 0x47, 0xb7, 0x6a, 0x0b, 0xdf, 0x42, 0x49,
+	0x71, 0xf4, 0xfe, 0x1c, 0xe8, 0x56, 0xf7, 0x4c, 0x95, 0xa5, 0x29, 0x89, 0x58, 0x50, 0xf4, 0x38,
+	0xd7, 0x0b, 0x9e, 0x1c, 0xc9, 0x09, 0xac, 0xeb, 0x45, 0xb0, 0x8b, 0xff, 0xba, 0x62, 0xc6, 0x70,
+	0xbe, 0x81, 0x35, 0x08, 0x59, 0x2c, 0xa6, 0x91, 0xcc, 0x2d, 0x3f, 0x81, 0xb2, 0x90, 0x4c, 0x2e,
+	0x84, 0x56, 0xb0, 0xab, 0xeb, 0x29, 0x8a, 0xc5, 0x5c, 0x0e, 0x54, 0x8a, 0x6a, 0x4a, 0x32, 0x9f,
+	0x00, 0x85, 0x60, 0xb7, 0xd9, 0x23, 0xcb, 0xa0, 0xc3, 0xa0, 0xd6, 0x61, 0xb3, 0x50, 0x62, 0xc8,
+	0xc2, 0x09, 0x76, 0x22, 0x5f, 0x0d, 0x13, 0x43, 0x36, 0x9e, 0xa3, 0xaf, 0x5a, 0x57, 0x68, 0x06,
+	0xc9, 0x21, 0x54, 0x66, 0xe2, 0x0a, 0x99, 0x8f, 0xe9, 0x5d, 0x2a, 0x34, 0xc7, 0x49, 0x95, 0xcf,
+	0xd9, 0x2c, 0x44, 0x5f, 0x0d, 0xb3, 0x42, 0x33, 0x78, 0x3c, 0x82, 0xbd, 0xa7, 0x9e, 0x15, 0xd9,
+	0x06, 0x68, 0xba, 0xee, 0xa8, 0xe3, 0x75, 0xce, 0x3d, 0x6a, 0xad, 0x91, 0x1d, 0xa8, 0x52, 0xaf,
+	0xd3, 0xbb, 0xf6, 0xb2, 0x90, 0x41, 0x6a, 0xb0, 0x99, 0x50, 0xae, 0xbc, 0x26, 0xed, 0x7a, 0xd4,
+	0x2a, 0x90, 0x5d, 0xa8, 0xf5, 0x69, 0xaf, 0xd3, 0x1b, 0x7a, 0x79, 0xb0, 0x78, 0x1c, 0x40, 0x6d,
+	0xc5, 0x77, 0xf2, 0x0a, 0x0e, 0x5b, 0xbd, 0xee, 0xd7, 0x51, 0xeb, 0xb2, 0xd9, 0xbd, 0xf0, 0x46,
+	0x83, 0x61, 0x73, 0xe8, 0x8d, 0xfa, 0xb4, 0xd7, 0xef, 0x0d, 0x3c, 0xd7, 0x5a, 0x23, 0x47, 0xf0,
+	0xec, 0x71, 0x7e, 0xd0, 0xbc, 0xf6, 0x5c, 0xcb, 0x20, 0x2f, 0xe1, 0xf9, 0xe3, 0x64, 0xb3, 0xdf,
+	0xbf, 0x6a, 0x7b, 0xae, 0x55, 0x18, 0x97, 0xd5, 0x3f, 0xed, 0xc3, 0xdf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x5f, 0x39, 0x64, 0x06, 0xf3, 0x04, 0x00, 0x00,
 }
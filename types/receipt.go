@@ -521,6 +521,10 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs == nil {
 		return merkle.CalculateMerkleRoot(nil)
 	}
+	return merkle.CalculateMerkleRoot(rs.merkleEntries())
+}
+
+func (rs *Receipts) merkleEntries() []merkle.MerkleEntry {
 	rsSize := len(rs.receipts)
 	if rs.bloom != nil {
 		rsSize++
@@ -532,7 +536,35 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs.bloom != nil {
 		mes[rsSize-1] = rs.bloom
 	}
-	return merkle.CalculateMerkleRoot(mes)
+	return mes
+}
+
+// MerklePath returns the merkle path of the receipt of index, which proves
+// its inclusion in MerkleRoot.
+func (rs *Receipts) MerklePath(index int) ([][]byte, error) {
+	if index < 0 || index >= len(rs.receipts) {
+		return nil, errors.New("receipt index out of range")
+	}
+	return merkle.GetMerklePath(merkle.CalculateMerkleTree(rs.merkleEntries()), index), nil
+}
+
+// VerifyReceiptProof checks that the receipt of proof is included in
+// receiptsRoot, which must be taken from the trusted header of the block of
+// proof. The contract address of receipt may be either in the stored form or
+// in the origin form returned by RPC.
+func VerifyReceiptProof(receiptsRoot []byte, proof *ReceiptProof, hardForkConfig BlockVersionner) bool {
+	r := proof.GetReceipt()
+	if r == nil {
+		return false
+	}
+	if len(r.ContractAddress) < AddressLength {
+		padded := *r
+		padded.ContractAddress = AddressPadding(r.ContractAddress)
+		r = &padded
+	}
+	leaf := (&ReceiptMerkle{r, BlockNo(proof.GetBlockNo()), hardForkConfig}).GetHash()
+	root := merkle.CalculateMerkleRootByPath(leaf, int(proof.GetIndex()), proof.GetMerklePath())
+	return root != nil && bytes.Equal(receiptsRoot, root)
 }
 
 func (rs *Receipts) MarshalBinary() ([]byte, error) {
@@ -740,10 +772,37 @@ func (ev *Event) MarshalJSON() ([]byte, error) {
 	b.WriteString(fmt.Sprintf("%d", ev.BlockNo))
 	b.WriteString(`,"TxIndex":`)
 	b.WriteString(fmt.Sprintf("%d", ev.TxIndex))
+	if ev.Removed {
+		b.WriteString(`,"Removed":true`)
+	}
 	b.WriteString(`}`)
 	return b.Bytes(), nil
 }
 
+const EventCursorLength = 16
+
+// Cursor returns the position of event in the chain, which consists of the
+// block number, the tx index and the event index. Listing and streaming
+// events can be resumed after the cursor.
+func (ev *Event) Cursor() []byte {
+	cursor := make([]byte, EventCursorLength)
+	binary.BigEndian.PutUint64(cursor, ev.BlockNo)
+	binary.BigEndian.PutUint32(cursor[8:], uint32(ev.TxIndex))
+	binary.BigEndian.PutUint32(cursor[12:], uint32(ev.EventIdx))
+	return cursor
+}
+
+// ParseEventCursor returns the position of event from the cursor.
+func ParseEventCursor(cursor []byte) (blockNo BlockNo, txIdx int32, eventIdx int32, err error) {
+	if len(cursor) != EventCursorLength {
+		return 0, 0, 0, ErrInvalidEventCursor
+	}
+	blockNo = binary.BigEndian.Uint64(cursor)
+	txIdx = int32(binary.BigEndian.Uint32(cursor[8:]))
+	eventIdx = int32(binary.BigEndian.Uint32(cursor[12:]))
+	return blockNo, txIdx, eventIdx, nil
+}
+
 func (ev *Event) SetMemoryInfo(receipt *Receipt, blkHash []byte, blkNo BlockNo, txIdx int32) {
 	ev.TxHash = receipt.TxHash
 	ev.TxIndex = txIdx
@@ -843,6 +902,14 @@ type ArgFilter struct {
 	value interface{}
 }
 
+func (af ArgFilter) ArgNo() int {
+	return af.argNo
+}
+
+func (af ArgFilter) Value() interface{} {
+	return af.value
+}
+
 const MAXBLOCKRANGE = 10000
 const padprefix = 0x80
 
@@ -859,7 +926,9 @@ func AddressOrigin(addr []byte) []byte {
 	return addr
 }
 
-func (fi *FilterInfo) ValidateCheck(to uint64) error {
+// ValidateAddress checks the contract address of filter, and converts the
+// name of system contract to the padded form which is stored in receipts.
+func (fi *FilterInfo) ValidateAddress() error {
 	if fi.ContractAddress == nil {
 		return errors.New("invalid contractAddress:" + string(fi.ContractAddress))
 	}
@@ -868,6 +937,13 @@ func (fi *FilterInfo) ValidateCheck(to uint64) error {
 	} else if len(fi.ContractAddress) != AddressLength {
 		return errors.New("invalid contractAddress:" + string(fi.ContractAddress))
 	}
+	return nil
+}
+
+func (fi *FilterInfo) ValidateCheck(to uint64) error {
+	if err := fi.ValidateAddress(); err != nil {
+		return err
+	}
 	if fi.RecentBlockCnt > 0 {
 		if fi.RecentBlockCnt > MAXBLOCKRANGE {
 			return errors.New(fmt.Sprintf("too large value at recentBlockCnt %d (max %d)",
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
+)
+
+func TestReceiptProof(t *testing.T) {
+	bv := DummyBlockVersionner(0)
+	contract := make([]byte, AddressLength)
+	contract[0] = 0x02
+	var rs []*Receipt
+	for i, status := range []string{"SUCCESS", "CREATED", "ERROR", "SUCCESS", "SUCCESS"} {
+		txHash := sha256.Sum256([]byte{byte(i)})
+		rs = append(rs, &Receipt{ContractAddress: contract, Status: status, Ret: "ret", TxHash: txHash[:]})
+	}
+	// the receipt of system contract, whose address is stored in padded form
+	rs[3].ContractAddress = AddressPadding([]byte(AergoSystem))
+
+	receipts := &Receipts{}
+	receipts.SetHardFork(bv, 10)
+	receipts.Set(rs)
+	assert.NoError(t, receipts.MergeBloom(bloom.New(BloomBitBits, BloomHashKNum)))
+	root := receipts.MerkleRoot()
+
+	for i, r := range rs {
+		path, err := receipts.MerklePath(i)
+		assert.NoError(t, err)
+		proof := &ReceiptProof{Receipt: r, BlockNo: 10, Index: uint32(i), MerklePath: path}
+		assert.True(t, VerifyReceiptProof(root, proof, bv), "receipt %d", i)
+	}
+
+	path, _ := receipts.MerklePath(3)
+	origin := *rs[3]
+	origin.ContractAddress = AddressOrigin(origin.ContractAddress)
+	proof := &ReceiptProof{Receipt: &origin, BlockNo: 10, Index: 3, MerklePath: path}
+	assert.True(t, VerifyReceiptProof(root, proof, bv))
+
+	// the other position
+	proof.Index = 2
+	assert.False(t, VerifyReceiptProof(root, proof, bv))
+
+	// the last receipt isn't proved at the positions of its copies, nor at
+	// the position out of the path
+	path, _ = receipts.MerklePath(4)
+	for _, index := range []uint32{5, 6, 7, 4 + 1<<uint(len(path))} {
+		proof = &ReceiptProof{Receipt: rs[4], BlockNo: 10, Index: index, MerklePath: path}
+		assert.False(t, VerifyReceiptProof(root, proof, bv), "index %d", index)
+	}
+
+	// the forged receipt
+	forged := *rs[2]
+	forged.Status = "SUCCESS"
+	path, _ = receipts.MerklePath(2)
+	proof = &ReceiptProof{Receipt: &forged, BlockNo: 10, Index: 2, MerklePath: path}
+	assert.False(t, VerifyReceiptProof(root, proof, bv))
+
+	_, err := receipts.MerklePath(len(rs))
+	assert.Error(t, err)
+}
+
+func TestEventCursor(t *testing.T) {
+	events := []*Event{
+		{BlockNo: 1, TxIndex: 2, EventIdx: 3},
+		{BlockNo: 1, TxIndex: 2, EventIdx: 256},
+		{BlockNo: 1, TxIndex: 3, EventIdx: 0},
+		{BlockNo: 256, TxIndex: 0, EventIdx: 0},
+	}
+	for i, ev := range events {
+		no, txIdx, evIdx, err := ParseEventCursor(ev.Cursor())
+		assert.NoError(t, err)
+		assert.Equal(t, ev.BlockNo, no)
+		assert.Equal(t, ev.TxIndex, txIdx)
+		assert.Equal(t, ev.EventIdx, evIdx)
+		// cursors are ordered as the events in the chain
+		if i > 0 {
+			assert.Equal(t, -1, bytes.Compare(events[i-1].Cursor(), ev.Cursor()))
+		}
+	}
+	_, _, _, err := ParseEventCursor([]byte{1, 2, 3})
+	assert.Equal(t, ErrInvalidEventCursor, err)
+}
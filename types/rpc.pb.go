@@ -730,6 +730,9 @@ type Peer struct {
 	Version              string              `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
 	Certificates         []*AgentCertificate `protobuf:"bytes,8,rep,name=certificates,proto3" json:"certificates,omitempty"`
 	AcceptedRole         PeerRole            `protobuf:"varint,9,opt,name=acceptedRole,proto3,enum=types.PeerRole" json:"acceptedRole,omitempty"`
+	Score                int32               `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+	LastPenalty          string              `protobuf:"bytes,11,opt,name=lastPenalty,proto3" json:"lastPenalty,omitempty"`
+	BannedUntil          int64               `protobuf:"varint,12,opt,name=bannedUntil,proto3" json:"bannedUntil,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -823,6 +826,27 @@ func (m *Peer) GetAcceptedRole() PeerRole {
 	return PeerRole_LegacyVersion
 }
 
+func (m *Peer) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Peer) GetLastPenalty() string {
+	if m != nil {
+		return m.LastPenalty
+	}
+	return ""
+}
+
+func (m *Peer) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
 type PeerList struct {
 	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1924,6 +1948,7 @@ func (m *NameInfo) GetDestination() []byte {
 type PeersParams struct {
 	NoHidden             bool     `protobuf:"varint,1,opt,name=noHidden,proto3" json:"noHidden,omitempty"`
 	ShowSelf             bool     `protobuf:"varint,2,opt,name=showSelf,proto3" json:"showSelf,omitempty"`
+	ShowBanned           bool     `protobuf:"varint,3,opt,name=showBanned,proto3" json:"showBanned,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1968,6 +1993,13 @@ func (m *PeersParams) GetShowSelf() bool {
 	return false
 }
 
+func (m *PeersParams) GetShowBanned() bool {
+	if m != nil {
+		return m.ShowBanned
+	}
+	return false
+}
+
 type KeyParams struct {
 	Key                  []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2095,6 +2127,7 @@ func (m *ConfigItem) GetProps() map[string]string {
 
 type EventList struct {
 	Events               []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2132,6 +2165,13 @@ func (m *EventList) GetEvents() []*Event {
 	return nil
 }
 
+func (m *EventList) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 // info and bps is json string
 type ConsensusInfo struct {
 	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -2282,401 +2322,1117 @@ func (m *EnterpriseConfig) GetValues() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
-	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
-	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
-	proto.RegisterType((*ChainId)(nil), "types.ChainId")
-	proto.RegisterType((*ChainInfo)(nil), "types.ChainInfo")
-	proto.RegisterType((*ChainStats)(nil), "types.ChainStats")
-	proto.RegisterType((*Input)(nil), "types.Input")
-	proto.RegisterType((*Output)(nil), "types.Output")
-	proto.RegisterType((*Empty)(nil), "types.Empty")
-	proto.RegisterType((*SingleBytes)(nil), "types.SingleBytes")
-	proto.RegisterType((*SingleString)(nil), "types.SingleString")
-	proto.RegisterType((*AccountAddress)(nil), "types.AccountAddress")
-	proto.RegisterType((*AccountAndRoot)(nil), "types.AccountAndRoot")
-	proto.RegisterType((*Peer)(nil), "types.Peer")
-	proto.RegisterType((*PeerList)(nil), "types.PeerList")
-	proto.RegisterType((*ListParams)(nil), "types.ListParams")
-	proto.RegisterType((*PageParams)(nil), "types.PageParams")
-	proto.RegisterType((*BlockBodyPaged)(nil), "types.BlockBodyPaged")
-	proto.RegisterType((*BlockBodyParams)(nil), "types.BlockBodyParams")
-	proto.RegisterType((*BlockHeaderList)(nil), "types.BlockHeaderList")
-	proto.RegisterType((*BlockMetadata)(nil), "types.BlockMetadata")
-	proto.RegisterType((*BlockMetadataList)(nil), "types.BlockMetadataList")
-	proto.RegisterType((*CommitResult)(nil), "types.CommitResult")
-	proto.RegisterType((*CommitResultList)(nil), "types.CommitResultList")
-	proto.RegisterType((*VerifyResult)(nil), "types.VerifyResult")
-	proto.RegisterType((*Personal)(nil), "types.Personal")
-	proto.RegisterType((*ImportFormat)(nil), "types.ImportFormat")
-	proto.RegisterType((*Staking)(nil), "types.Staking")
-	proto.RegisterType((*Vote)(nil), "types.Vote")
-	proto.RegisterType((*VoteParams)(nil), "types.VoteParams")
-	proto.RegisterType((*AccountVoteInfo)(nil), "types.AccountVoteInfo")
-	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
-	proto.RegisterType((*VoteList)(nil), "types.VoteList")
-	proto.RegisterType((*NodeReq)(nil), "types.NodeReq")
-	proto.RegisterType((*Name)(nil), "types.Name")
-	proto.RegisterType((*NameInfo)(nil), "types.NameInfo")
-	proto.RegisterType((*PeersParams)(nil), "types.PeersParams")
-	proto.RegisterType((*KeyParams)(nil), "types.KeyParams")
-	proto.RegisterType((*ServerInfo)(nil), "types.ServerInfo")
-	proto.RegisterMapType((map[string]*ConfigItem)(nil), "types.ServerInfo.ConfigEntry")
-	proto.RegisterMapType((map[string]string)(nil), "types.ServerInfo.StatusEntry")
-	proto.RegisterType((*ConfigItem)(nil), "types.ConfigItem")
-	proto.RegisterMapType((map[string]string)(nil), "types.ConfigItem.PropsEntry")
-	proto.RegisterType((*EventList)(nil), "types.EventList")
-	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
-	proto.RegisterType((*EnterpriseConfigKey)(nil), "types.EnterpriseConfigKey")
-	proto.RegisterType((*EnterpriseConfig)(nil), "types.EnterpriseConfig")
+// BPSchedule is the upcoming slots of block producers and their statistics
+type BPSchedule struct {
+	BestBlockNo          uint64    `protobuf:"varint,1,opt,name=bestBlockNo,proto3" json:"bestBlockNo,omitempty"`
+	Slots                []*BPSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	Stats                []*BPStat `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+func (m *BPSchedule) Reset()         { *m = BPSchedule{} }
+func (m *BPSchedule) String() string { return proto.CompactTextString(m) }
+func (*BPSchedule) ProtoMessage()    {}
+func (*BPSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+}
 
-var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 2642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x5b, 0x77, 0x1b, 0xb7,
-	0x11, 0x26, 0x29, 0x51, 0x22, 0x87, 0xa4, 0xb4, 0x82, 0x65, 0x5b, 0x61, 0x1d, 0x47, 0x45, 0xdd,
-	0x44, 0x71, 0x13, 0x35, 0x96, 0x93, 0x34, 0xbd, 0x25, 0xa5, 0x18, 0xda, 0xe2, 0xb1, 0x2c, 0xa9,
-	0x20, 0xe3, 0x2a, 0x2f, 0x65, 0x57, 0xbb, 0x20, 0xb9, 0x47, 0xe4, 0xee, 0x66, 0x17, 0xd4, 0x25,
-	0xe7, 0xf4, 0xa9, 0x4f, 0xfd, 0x07, 0xfd, 0x5d, 0x7d, 0xef, 0x69, 0x1f, 0xfb, 0x33, 0x7a, 0x30,
-	0x00, 0xf6, 0x42, 0xad, 0x7b, 0x9a, 0xbe, 0xed, 0x0c, 0xbe, 0xb9, 0x01, 0x83, 0xc1, 0x00, 0x0b,
-	0xf5, 0x28, 0x74, 0xf6, 0xc3, 0x28, 0x10, 0x01, 0xa9, 0x8a, 0xdb, 0x90, 0xc7, 0x6d, 0xeb, 0x62,
-	0x16, 0x38, 0x97, 0xce, 0xd4, 0xf6, 0x7c, 0x35, 0xd0, 0x6e, 0xd9, 0x8e, 0x13, 0x2c, 0x7c, 0xa1,
-	0x49, 0xf0, 0x03, 0x97, 0xeb, 0xef, 0x7a, 0x78, 0x10, 0xea, 0xcf, 0xe6, 0x9c, 0x8b, 0xc8, 0x73,
-	0x0c, 0x28, 0xb2, 0xc7, 0x5a, 0x80, 0xfe, 0xab, 0x0c, 0xd6, 0x61, 0xa2, 0x74, 0x20, 0x6c, 0xb1,
-	0x88, 0xc9, 0xfb, 0xb0, 0x79, 0xc1, 0x63, 0x31, 0x42, 0x6b, 0xa3, 0xa9, 0x1d, 0x4f, 0x77, 0xca,
-	0xbb, 0xe5, 0xbd, 0x26, 0x6b, 0x49, 0x36, 0xc2, 0x8f, 0xec, 0x78, 0x4a, 0xde, 0x83, 0x06, 0xe2,
-	0xa6, 0xdc, 0x9b, 0x4c, 0xc5, 0x4e, 0x65, 0xb7, 0xbc, 0xb7, 0xca, 0x40, 0xb2, 0x8e, 0x90, 0x43,
-	0x7e, 0x0a, 0x1b, 0x4e, 0xe0, 0xc7, 0xdc, 0x8f, 0x17, 0xf1, 0xc8, 0xf3, 0xc7, 0xc1, 0xce, 0xca,
-	0x6e, 0x79, 0xaf, 0xce, 0x5a, 0x09, 0xb7, 0xef, 0x8f, 0x03, 0xf2, 0x33, 0x20, 0xa8, 0x07, 0x7d,
-	0x18, 0x79, 0xae, 0x32, 0xb9, 0x8a, 0x26, 0xd1, 0x93, 0xae, 0x1c, 0xe8, 0xbb, 0x68, 0xf4, 0xe7,
-	0x00, 0x1a, 0x27, 0xf5, 0x55, 0x77, 0xcb, 0x7b, 0x8d, 0x03, 0x6b, 0x1f, 0xe7, 0x67, 0x5f, 0xe1,
-	0xfc, 0x71, 0xc0, 0xea, 0x8e, 0xf9, 0xa4, 0x7f, 0x2d, 0xc3, 0xba, 0x56, 0x40, 0xb6, 0xa1, 0x3a,
-	0xb7, 0x27, 0x9e, 0x83, 0xf1, 0xd4, 0x99, 0x22, 0xc8, 0x03, 0x58, 0x0b, 0x17, 0x17, 0x33, 0xcf,
-	0xc1, 0x10, 0x6a, 0x4c, 0x53, 0x64, 0x07, 0xd6, 0xe7, 0xb6, 0xe7, 0xfb, 0x5c, 0xa0, 0xdf, 0x35,
-	0x66, 0x48, 0xf2, 0x08, 0xea, 0x49, 0x08, 0xe8, 0x68, 0x9d, 0xa5, 0x0c, 0x29, 0x77, 0xc5, 0xa3,
-	0xd8, 0x0b, 0x7c, 0xf4, 0xaf, 0xca, 0x0c, 0x49, 0xff, 0x59, 0x81, 0x7a, 0xe2, 0x24, 0x79, 0x0c,
-	0x15, 0xcf, 0x45, 0x57, 0x1a, 0x07, 0x1b, 0xb9, 0x10, 0x5c, 0x56, 0xf1, 0x5c, 0xd2, 0x86, 0xda,
-	0x45, 0x78, 0xb2, 0x98, 0x5f, 0xf0, 0x08, 0x3d, 0x6b, 0xb1, 0x84, 0x26, 0x14, 0x9a, 0x73, 0xfb,
-	0x06, 0x57, 0x28, 0xf6, 0xbe, 0xe7, 0xe8, 0xe0, 0x2a, 0xcb, 0xf1, 0xa4, 0x97, 0x73, 0xfb, 0x46,
-	0x04, 0x97, 0xdc, 0x8f, 0xf5, 0x74, 0xa6, 0x0c, 0xf2, 0x3e, 0x6c, 0xc4, 0xc2, 0xbe, 0xf4, 0xfc,
-	0xc9, 0xdc, 0xf3, 0xbd, 0xf9, 0x62, 0x8e, 0xce, 0x36, 0xd9, 0x12, 0x57, 0x5a, 0x12, 0x81, 0xb0,
-	0x67, 0x9a, 0xbd, 0xb3, 0x86, 0xa8, 0x1c, 0x4f, 0x7a, 0x3a, 0xb1, 0xe3, 0x30, 0xf2, 0x1c, 0xbe,
-	0xb3, 0x8e, 0xe3, 0x09, 0x2d, 0xbd, 0xf0, 0xed, 0x39, 0x57, 0x83, 0x35, 0xe5, 0x45, 0xc2, 0x20,
-	0x4f, 0xc1, 0x42, 0x4d, 0x57, 0x81, 0xf0, 0xfc, 0x49, 0x18, 0x5c, 0xf3, 0x68, 0xa7, 0x8e, 0xa0,
-	0x3b, 0x7c, 0xe9, 0x89, 0x22, 0x23, 0x7e, 0x6d, 0x47, 0xee, 0x0e, 0x28, 0x4f, 0xb2, 0x3c, 0xfa,
-	0x04, 0xa0, 0x6b, 0x52, 0x39, 0x96, 0x2b, 0x1b, 0xf1, 0x30, 0x88, 0x84, 0x5e, 0x70, 0x4d, 0x51,
-	0x07, 0xaa, 0x7d, 0x3f, 0x5c, 0x08, 0x42, 0x60, 0x35, 0x93, 0xdf, 0xf8, 0x2d, 0x97, 0xcf, 0x76,
-	0xdd, 0x88, 0xc7, 0xf1, 0x4e, 0x65, 0x77, 0x65, 0xaf, 0xc9, 0x0c, 0x29, 0xd3, 0xe7, 0xca, 0x9e,
-	0x2d, 0xd4, 0x6c, 0x37, 0x99, 0x22, 0xa4, 0x91, 0xd8, 0x89, 0xbc, 0x50, 0xe8, 0x39, 0xd6, 0x14,
-	0x1d, 0xc3, 0xda, 0xe9, 0x42, 0x48, 0x2b, 0xdb, 0x50, 0xf5, 0x7c, 0x97, 0xdf, 0xa0, 0x99, 0x16,
-	0x53, 0x44, 0xde, 0x4e, 0xf9, 0xff, 0xb7, 0xb3, 0x0e, 0xd5, 0xde, 0x3c, 0x14, 0xb7, 0xf4, 0x27,
-	0xd0, 0x18, 0x78, 0xfe, 0x64, 0xc6, 0x0f, 0x6f, 0x05, 0xcf, 0x68, 0x29, 0x67, 0xb4, 0xd0, 0x27,
-	0xd0, 0x54, 0xa0, 0x81, 0x88, 0xe4, 0xd2, 0xe5, 0x50, 0x75, 0x83, 0x7a, 0x1f, 0x36, 0x3a, 0xaa,
-	0xb2, 0x74, 0x96, 0x7d, 0xca, 0x69, 0xfb, 0x63, 0x8a, 0xf3, 0x5d, 0x16, 0x04, 0x42, 0x46, 0xa5,
-	0x39, 0x1a, 0x69, 0x48, 0x39, 0xd7, 0x12, 0xa1, 0x83, 0xc5, 0x6f, 0xf2, 0x18, 0xa0, 0x1b, 0xcc,
-	0x43, 0x69, 0x81, 0xbb, 0x7a, 0x97, 0x65, 0x38, 0xf4, 0x1f, 0x15, 0x58, 0x3d, 0xe3, 0x3c, 0x22,
-	0x1f, 0xa5, 0x93, 0xa5, 0x36, 0x0c, 0xd1, 0x1b, 0x46, 0x8e, 0x6a, 0x1f, 0xd3, 0x09, 0x7c, 0x0e,
-	0x75, 0x59, 0x37, 0x70, 0x2b, 0xa0, 0xbd, 0xc6, 0xc1, 0x7d, 0x8d, 0x3f, 0xe1, 0xd7, 0x58, 0xc1,
-	0x4e, 0x02, 0xe1, 0x39, 0x9c, 0xa5, 0x38, 0x19, 0x61, 0x2c, 0x6c, 0xa1, 0x66, 0xbd, 0xca, 0x14,
-	0x21, 0x67, 0x7d, 0xea, 0xb9, 0x2e, 0xf7, 0x71, 0xd6, 0x6b, 0x4c, 0x53, 0x32, 0xad, 0x67, 0x76,
-	0x3c, 0xed, 0x4e, 0xb9, 0x73, 0x89, 0x3b, 0x67, 0x85, 0xa5, 0x0c, 0xb9, 0x21, 0x62, 0x3e, 0x1b,
-	0x87, 0x9c, 0x47, 0xb8, 0x61, 0x6a, 0x2c, 0xa1, 0xb3, 0xe5, 0x61, 0x1d, 0xe7, 0xdc, 0x90, 0xe4,
-	0xd7, 0xd0, 0x74, 0x78, 0x24, 0xbc, 0xb1, 0xe7, 0xd8, 0x82, 0xc7, 0x3b, 0xb5, 0xdd, 0x95, 0xbd,
-	0xc6, 0xc1, 0x43, 0xed, 0x79, 0x67, 0xc2, 0x7d, 0xd1, 0x4d, 0xc7, 0x59, 0x0e, 0x4c, 0x9e, 0x43,
-	0xd3, 0x76, 0x1c, 0x1e, 0x0a, 0xee, 0xb2, 0x60, 0xc6, 0x71, 0x17, 0x6d, 0x1c, 0x6c, 0x66, 0xa6,
-	0x49, 0xb2, 0x59, 0x0e, 0x44, 0x3f, 0x86, 0x9a, 0x1c, 0x39, 0xf6, 0x62, 0x41, 0x7e, 0x0c, 0x55,
-	0xe9, 0x9f, 0x9c, 0x60, 0x69, 0xb6, 0x91, 0x95, 0x54, 0x23, 0xf4, 0x0a, 0x40, 0x42, 0xcf, 0xec,
-	0xc8, 0x9e, 0xc7, 0x85, 0x9b, 0x47, 0x4e, 0x57, 0xf6, 0x38, 0xd0, 0x94, 0xc4, 0x26, 0x75, 0xaa,
-	0xc5, 0xf0, 0x5b, 0x62, 0x83, 0xf1, 0x38, 0xe6, 0x2a, 0xa1, 0x5b, 0x4c, 0x53, 0xc4, 0x82, 0x15,
-	0x3b, 0x76, 0x70, 0x52, 0x6b, 0x4c, 0x7e, 0xd2, 0x2f, 0x00, 0xce, 0xec, 0x09, 0xd7, 0x76, 0x53,
-	0xb9, 0x72, 0x4e, 0xce, 0xd8, 0xa8, 0xa4, 0x36, 0xe8, 0x0d, 0x6c, 0xe0, 0x72, 0x1f, 0x06, 0xee,
-	0xad, 0x54, 0x81, 0x67, 0x00, 0x56, 0x16, 0xb3, 0x19, 0x91, 0xc8, 0xe8, 0xac, 0x14, 0xea, 0xcc,
-	0xfa, 0xfd, 0x04, 0x56, 0x2f, 0x02, 0xf7, 0x16, 0xbd, 0x4e, 0x0f, 0x9f, 0xc4, 0x0c, 0xc3, 0x51,
-	0xfa, 0x27, 0xd8, 0xcc, 0x58, 0x46, 0xc7, 0x29, 0x34, 0xe5, 0x24, 0x05, 0x91, 0xaf, 0x8a, 0xba,
-	0x9a, 0xb8, 0x1c, 0x8f, 0x7c, 0x08, 0x6b, 0xa1, 0x3d, 0x91, 0x85, 0x56, 0xe5, 0xed, 0x96, 0x59,
-	0x86, 0x24, 0x7e, 0xa6, 0x01, 0xf4, 0x17, 0xda, 0xc2, 0x11, 0xb7, 0x5d, 0xbd, 0x86, 0x4f, 0x60,
-	0x4d, 0xd5, 0x7f, 0xbd, 0x88, 0xcd, 0xac, 0x73, 0x4c, 0x8f, 0xd1, 0x3f, 0x43, 0x0b, 0x19, 0xaf,
-	0xb9, 0xb0, 0x5d, 0x5b, 0xd8, 0x85, 0x2b, 0xf9, 0x54, 0xae, 0xa4, 0x54, 0xac, 0x1d, 0x21, 0x59,
-	0x55, 0xca, 0x24, 0xd3, 0x08, 0x99, 0xd2, 0xe2, 0x46, 0x6d, 0x7a, 0xb5, 0x79, 0x0c, 0x99, 0xcc,
-	0xdf, 0x2a, 0xee, 0x10, 0xb5, 0x26, 0x1d, 0xd8, 0xca, 0x99, 0x47, 0xcf, 0x3f, 0x5a, 0xf2, 0x7c,
-	0x3b, 0x6b, 0xce, 0x20, 0x93, 0x08, 0x38, 0x34, 0xbb, 0xc1, 0x7c, 0xee, 0x09, 0xc6, 0xe3, 0xc5,
-	0xac, 0xb8, 0x8e, 0x7f, 0x08, 0x55, 0x1e, 0x45, 0x81, 0xf2, 0x7f, 0xe3, 0xe0, 0x9e, 0x39, 0x61,
-	0x51, 0x4e, 0xb5, 0x3a, 0x4c, 0x21, 0xe4, 0xea, 0xbb, 0x5c, 0xd8, 0xde, 0x4c, 0x37, 0x28, 0x9a,
-	0xa2, 0x1d, 0xb0, 0xb2, 0x66, 0xd0, 0xd1, 0x8f, 0x61, 0x3d, 0x42, 0xca, 0x78, 0x9a, 0x57, 0xac,
-	0x90, 0xcc, 0x60, 0xe8, 0x10, 0x9a, 0x6f, 0x78, 0xe4, 0x8d, 0x6f, 0xb5, 0xa7, 0xef, 0x40, 0x45,
-	0xdc, 0xe8, 0x1a, 0x56, 0xd7, 0x92, 0xc3, 0x1b, 0x56, 0x11, 0x37, 0x6f, 0x73, 0x58, 0x89, 0xe7,
-	0x1c, 0xa6, 0x43, 0xb9, 0x6f, 0xa3, 0x38, 0xf0, 0xed, 0x99, 0xac, 0xa1, 0xa1, 0x1d, 0xc7, 0xe1,
-	0x34, 0xb2, 0x63, 0x53, 0xc6, 0x33, 0x1c, 0xb2, 0x07, 0xeb, 0xba, 0x4b, 0xd4, 0x2b, 0x69, 0x7a,
-	0x0d, 0x5d, 0x98, 0x99, 0x19, 0xa6, 0x7f, 0x2b, 0x43, 0xb3, 0x3f, 0x97, 0x27, 0xe4, 0x8b, 0x20,
-	0x9a, 0xdb, 0x32, 0x9d, 0x56, 0xae, 0xbd, 0xf1, 0x52, 0xc5, 0xcd, 0x9c, 0x31, 0x4c, 0x0e, 0xcb,
-	0xd5, 0x0f, 0x66, 0xae, 0xb4, 0x88, 0x06, 0xea, 0xcc, 0x90, 0x72, 0xc4, 0xe7, 0xd7, 0x38, 0xa2,
-	0x26, 0xd6, 0x90, 0x64, 0x1f, 0x6a, 0x97, 0xfc, 0x36, 0x16, 0x41, 0xc4, 0xf5, 0x3e, 0x2a, 0x52,
-	0x9f, 0x60, 0xe8, 0x67, 0xb0, 0x3e, 0xd0, 0xcd, 0xc6, 0x03, 0x58, 0xb3, 0xe7, 0x99, 0x03, 0x46,
-	0x53, 0x32, 0x07, 0xae, 0xa7, 0xdc, 0xd7, 0x85, 0x07, 0xbf, 0xe9, 0x6f, 0x60, 0xf5, 0x4d, 0x20,
-	0xb0, 0x09, 0x71, 0x6c, 0xdf, 0xf5, 0x5c, 0x59, 0xdf, 0x95, 0x58, 0xca, 0xc8, 0x68, 0xac, 0x64,
-	0x35, 0xd2, 0x03, 0x00, 0x29, 0xad, 0x77, 0xef, 0x46, 0xd2, 0xae, 0xd5, 0xb1, 0x3d, 0xdb, 0x86,
-	0x6a, 0x3a, 0xab, 0x2d, 0xa6, 0x08, 0xea, 0xc2, 0xa6, 0x9e, 0x57, 0x29, 0x8a, 0x7d, 0xde, 0x1e,
-	0xac, 0x9b, 0xe6, 0x29, 0xdf, 0xec, 0xe9, 0x88, 0x98, 0x19, 0x26, 0x1f, 0xc0, 0x9a, 0xea, 0x66,
-	0xb0, 0xf3, 0x68, 0x24, 0xd5, 0xdb, 0xa8, 0x62, 0x7a, 0x98, 0x32, 0xa8, 0x25, 0xea, 0x97, 0xfd,
-	0x7a, 0x0c, 0x90, 0x84, 0xa6, 0x5a, 0x98, 0x3a, 0xcb, 0x70, 0x32, 0xd1, 0xea, 0x64, 0xd7, 0xd1,
-	0xfe, 0x56, 0xe9, 0x34, 0x67, 0xc1, 0x55, 0x20, 0xc5, 0xf3, 0x67, 0x81, 0x1c, 0x67, 0x6a, 0x44,
-	0x9b, 0xad, 0x18, 0xb3, 0xb4, 0x03, 0xeb, 0x27, 0x81, 0xcb, 0x19, 0xff, 0x0e, 0xcb, 0x81, 0x37,
-	0xe7, 0xc1, 0x22, 0xe9, 0x01, 0x34, 0xa9, 0x1a, 0xe7, 0x79, 0x18, 0xf8, 0x3c, 0x99, 0xec, 0x94,
-	0x41, 0x3f, 0x85, 0xd5, 0x13, 0x7b, 0xce, 0xe5, 0x4a, 0xca, 0x0e, 0x51, 0xc7, 0x84, 0xdf, 0x52,
-	0xe7, 0x85, 0x3a, 0xb7, 0xf5, 0x02, 0x1b, 0x92, 0x3a, 0x50, 0x93, 0x52, 0x38, 0x17, 0xef, 0x65,
-	0x24, 0x53, 0xb7, 0xe5, 0xb0, 0x56, 0xb3, 0x0d, 0xd5, 0xe0, 0xda, 0xd7, 0x45, 0xad, 0xc9, 0x14,
-	0x41, 0x76, 0xa1, 0xe1, 0xf2, 0x58, 0x78, 0xbe, 0x2d, 0xe4, 0xb1, 0xac, 0xda, 0xae, 0x2c, 0x8b,
-	0xf6, 0xa0, 0x21, 0x0f, 0xc2, 0x58, 0xe7, 0x42, 0x1b, 0x6a, 0x7e, 0x70, 0xa4, 0xfa, 0x82, 0xb2,
-	0x3a, 0xdf, 0x0d, 0x8d, 0x67, 0xff, 0x34, 0xb8, 0x1e, 0xf0, 0xd9, 0x58, 0x5f, 0x28, 0x12, 0x9a,
-	0xbe, 0x0b, 0xf5, 0x57, 0xdc, 0x1c, 0x07, 0x16, 0xac, 0x5c, 0xf2, 0x5b, 0x9c, 0xe2, 0x3a, 0x93,
-	0x9f, 0xf4, 0x2f, 0x15, 0x80, 0x01, 0x8f, 0xae, 0x78, 0x84, 0xd1, 0x7c, 0x06, 0x6b, 0x31, 0x6e,
-	0x7b, 0xbd, 0x0c, 0xef, 0x9a, 0xbc, 0x49, 0x20, 0xfb, 0xaa, 0x2c, 0xf4, 0x7c, 0x11, 0xdd, 0x32,
-	0x0d, 0x96, 0x62, 0x4e, 0xe0, 0x8f, 0x3d, 0x93, 0x45, 0x05, 0x62, 0x5d, 0x1c, 0xd7, 0x62, 0x0a,
-	0xdc, 0xfe, 0x25, 0x34, 0x32, 0xda, 0x52, 0xef, 0xca, 0xda, 0xbb, 0xb4, 0x05, 0xac, 0x64, 0x5a,
-	0xc5, 0x5f, 0x55, 0xbe, 0x28, 0xb7, 0x8f, 0xa1, 0x91, 0xd1, 0x58, 0x20, 0xfa, 0x41, 0x56, 0x34,
-	0x3d, 0xd4, 0x94, 0x50, 0x5f, 0xf0, 0x79, 0x46, 0x1b, 0xfd, 0x5e, 0x36, 0x85, 0x66, 0x80, 0x1c,
-	0x40, 0x35, 0x8c, 0x82, 0x30, 0xd6, 0xc1, 0x3c, 0xba, 0x23, 0xba, 0x7f, 0x26, 0x87, 0x55, 0x2c,
-	0x0a, 0xda, 0x96, 0xfd, 0x42, 0xc2, 0xfc, 0x21, 0x91, 0xd0, 0x67, 0x50, 0xef, 0x5d, 0x71, 0x5f,
-	0x98, 0xd3, 0x94, 0x4b, 0x62, 0xf9, 0x34, 0x45, 0x04, 0xd3, 0x63, 0xb4, 0x0f, 0xad, 0x6e, 0xee,
-	0x3e, 0x4b, 0x60, 0x55, 0xe2, 0x4c, 0xfa, 0xca, 0x6f, 0xc9, 0xc3, 0x0b, 0xab, 0x32, 0x88, 0xdf,
-	0xd2, 0xaf, 0x8b, 0x50, 0x56, 0x46, 0x5c, 0xff, 0x8b, 0x30, 0xa6, 0x1f, 0xc0, 0xbd, 0x9e, 0x2f,
-	0x78, 0x14, 0x46, 0x5e, 0xcc, 0x55, 0x84, 0xaf, 0x78, 0x41, 0x00, 0xf4, 0x18, 0xac, 0x65, 0x60,
-	0x41, 0x98, 0x1b, 0x50, 0x09, 0x7c, 0x9d, 0x83, 0x95, 0xc0, 0x97, 0x3b, 0x1f, 0x23, 0x35, 0x36,
-	0x35, 0xf5, 0xf4, 0xef, 0x65, 0x73, 0x9c, 0xea, 0x17, 0x80, 0x3a, 0x54, 0x87, 0xe7, 0xa3, 0xd3,
-	0x57, 0x56, 0x89, 0x6c, 0x83, 0x35, 0x3c, 0x1f, 0x9d, 0x9c, 0x9e, 0x74, 0x7b, 0xa3, 0xe1, 0xe9,
-	0xe9, 0xe8, 0xf8, 0xf4, 0x0f, 0x56, 0x99, 0xdc, 0x87, 0xad, 0xe1, 0xf9, 0xa8, 0x73, 0xcc, 0x7a,
-	0x9d, 0xaf, 0xbf, 0x1d, 0xf5, 0xce, 0xfb, 0x83, 0xe1, 0xc0, 0xaa, 0x90, 0x7b, 0xb0, 0x39, 0x3c,
-	0x1f, 0xf5, 0x4f, 0xde, 0x74, 0x8e, 0xfb, 0x5f, 0x8f, 0x8e, 0x3a, 0x83, 0x23, 0x6b, 0x65, 0x89,
-	0x39, 0xe8, 0xbf, 0x3c, 0xb1, 0x56, 0xb5, 0x02, 0xc3, 0x7c, 0x71, 0xca, 0x5e, 0x77, 0x86, 0x56,
-	0x95, 0xfc, 0x08, 0x1e, 0x22, 0x7b, 0xf0, 0xcd, 0x8b, 0x17, 0xfd, 0x6e, 0xbf, 0x77, 0x32, 0x1c,
-	0x1d, 0x76, 0x8e, 0x3b, 0x27, 0xdd, 0x9e, 0xb5, 0xa6, 0x65, 0x8e, 0x3a, 0x83, 0xd1, 0xa0, 0xf3,
-	0xba, 0xa7, 0x7c, 0xb2, 0xd6, 0x13, 0x55, 0xc3, 0x1e, 0x3b, 0xe9, 0x1c, 0x8f, 0x7a, 0x8c, 0x9d,
-	0x32, 0xab, 0xfe, 0x74, 0x6c, 0x0e, 0x5e, 0x1d, 0xd3, 0x36, 0x58, 0x6f, 0x7a, 0xac, 0xff, 0xe2,
-	0xdb, 0xd1, 0x60, 0xd8, 0x19, 0x7e, 0x33, 0x50, 0xe1, 0xed, 0xc2, 0xa3, 0x3c, 0x57, 0xfa, 0x37,
-	0x3a, 0x39, 0x1d, 0x8e, 0x5e, 0x77, 0x86, 0xdd, 0x23, 0xab, 0x4c, 0x1e, 0x43, 0x3b, 0x8f, 0xc8,
-	0x85, 0x57, 0x39, 0xf8, 0x37, 0x81, 0xcd, 0x0e, 0x8f, 0x26, 0x01, 0x3b, 0xeb, 0xca, 0x1d, 0x26,
-	0x6f, 0xb5, 0xcf, 0xa0, 0x2e, 0x6b, 0xe1, 0x00, 0x6f, 0x10, 0xa6, 0xda, 0xeb, 0xea, 0xd8, 0x2e,
-	0x38, 0xe8, 0x68, 0x89, 0x3c, 0x83, 0xb5, 0xd7, 0xf8, 0x4a, 0x43, 0xcc, 0x4d, 0x45, 0x91, 0x31,
-	0xe3, 0xdf, 0x2d, 0x78, 0x2c, 0xda, 0x1b, 0x79, 0x36, 0x2d, 0x91, 0xcf, 0x00, 0xd2, 0xb7, 0x1b,
-	0x92, 0x24, 0xa7, 0xbc, 0x0b, 0xb6, 0x1f, 0x66, 0xdb, 0xa7, 0xcc, 0xe3, 0x0e, 0x2d, 0x91, 0x4f,
-	0xa0, 0xf9, 0x92, 0x8b, 0xf4, 0x19, 0x22, 0x2f, 0x78, 0xe7, 0x2d, 0x85, 0x96, 0xc8, 0xbe, 0x7e,
-	0xb5, 0x90, 0x2a, 0x96, 0xe0, 0x5b, 0x59, 0x38, 0x5e, 0xba, 0x69, 0x89, 0x7c, 0x05, 0x96, 0xdc,
-	0x3f, 0x99, 0x4e, 0x31, 0x26, 0x06, 0x98, 0xde, 0x1f, 0xda, 0x0f, 0xee, 0x76, 0x94, 0x72, 0x94,
-	0x96, 0xc8, 0x21, 0x6c, 0x25, 0x0a, 0x92, 0x26, 0xb5, 0x40, 0xc3, 0x4e, 0x51, 0x93, 0xa8, 0x75,
-	0x3c, 0x83, 0xcd, 0x44, 0xc7, 0x40, 0x44, 0xdc, 0x9e, 0x2f, 0xb9, 0x9e, 0xeb, 0x8d, 0x69, 0xe9,
-	0x93, 0x32, 0xe9, 0xc0, 0xc3, 0x3b, 0x66, 0x0b, 0x45, 0x0b, 0x9b, 0x53, 0x54, 0xb1, 0x0f, 0xb5,
-	0x97, 0x5c, 0x69, 0x20, 0x05, 0x0b, 0xbd, 0x6c, 0x94, 0x7c, 0x09, 0x96, 0xc1, 0xa7, 0xdd, 0x78,
-	0x81, 0xdc, 0x5b, 0x2c, 0x92, 0xaf, 0x70, 0x31, 0x93, 0x8b, 0x06, 0x79, 0xb0, 0x7c, 0x1b, 0xd1,
-	0x33, 0x75, 0xff, 0x2e, 0x7f, 0xc2, 0x5d, 0x5a, 0x22, 0x7b, 0x50, 0x7d, 0xc9, 0xc5, 0xf0, 0xbc,
-	0xd0, 0x6a, 0xda, 0xa0, 0xd2, 0x12, 0xf9, 0x14, 0xc0, 0x98, 0x7a, 0x0b, 0xdc, 0x4a, 0xe0, 0x7d,
-	0xdf, 0x04, 0x78, 0x80, 0x52, 0x8c, 0x3b, 0xdc, 0x0b, 0x45, 0xa1, 0x94, 0x49, 0x6c, 0x8d, 0xa1,
-	0x25, 0x79, 0xf5, 0x78, 0xc9, 0x45, 0xe7, 0xb0, 0x5f, 0x88, 0x07, 0xd3, 0xbe, 0x1e, 0xf6, 0x15,
-	0x76, 0xc0, 0x7d, 0x77, 0x78, 0x4e, 0x52, 0x67, 0xdb, 0x45, 0x2d, 0x39, 0x95, 0x9b, 0x7d, 0x6d,
-	0xe0, 0x4d, 0xfc, 0x3c, 0x36, 0x17, 0xe3, 0x47, 0x50, 0x53, 0x45, 0xa3, 0x58, 0x5f, 0xb6, 0x93,
-	0xc7, 0x19, 0xa9, 0x29, 0x0b, 0xc3, 0x73, 0xd2, 0x4a, 0xd0, 0x32, 0x85, 0x92, 0xfd, 0xb7, 0x7c,
-	0x7d, 0xc0, 0xdd, 0x24, 0x53, 0x44, 0xd5, 0x86, 0xff, 0x96, 0x22, 0x88, 0xa0, 0x25, 0xf2, 0x3b,
-	0x4c, 0x11, 0xa4, 0x3a, 0xbe, 0x7b, 0x16, 0x05, 0xc1, 0x38, 0xa9, 0x11, 0xf9, 0xc7, 0x97, 0xc4,
-	0x4f, 0xcd, 0x46, 0x2c, 0xae, 0x41, 0xab, 0x1b, 0x71, 0x29, 0xaf, 0x9f, 0x62, 0xd2, 0x57, 0x01,
-	0x75, 0x87, 0x68, 0x2f, 0x5d, 0x09, 0x70, 0xfb, 0x34, 0xe4, 0x1a, 0x28, 0x3a, 0x5e, 0xca, 0x7f,
-	0x92, 0x87, 0xeb, 0xc0, 0x3e, 0x81, 0xc6, 0x71, 0xe0, 0x5c, 0xfe, 0x00, 0x23, 0x07, 0xd0, 0xfa,
-	0xc6, 0x9f, 0xfd, 0x30, 0x99, 0xcf, 0xa1, 0xa5, 0xee, 0x28, 0x46, 0xc6, 0x04, 0x9d, 0xbd, 0xb9,
-	0x14, 0xcb, 0xf5, 0x6e, 0xb2, 0x72, 0x77, 0x6c, 0x15, 0x17, 0xe6, 0x2f, 0xe1, 0x7e, 0x4e, 0xee,
-	0x95, 0xbe, 0x92, 0xfc, 0xaf, 0xf2, 0xcf, 0xa1, 0xf5, 0xfb, 0x05, 0x8f, 0x6e, 0xbb, 0x81, 0x2f,
-	0x22, 0xdb, 0x49, 0x0b, 0x28, 0x72, 0xdf, 0x22, 0xd4, 0x01, 0x92, 0x13, 0x52, 0xd9, 0xb2, 0x95,
-	0xcd, 0x0c, 0x25, 0xfe, 0xe0, 0x0e, 0xcb, 0x2c, 0xfa, 0x33, 0x4c, 0x33, 0x6c, 0x5a, 0x49, 0xf6,
-	0xb1, 0x4c, 0xb7, 0xb0, 0xed, 0xec, 0xcb, 0x50, 0xb2, 0x80, 0x52, 0xe4, 0x0d, 0xb6, 0xf7, 0x5b,
-	0x99, 0x96, 0x7f, 0x49, 0xc2, 0xdc, 0x12, 0xb0, 0x50, 0x6f, 0xa6, 0x59, 0xa2, 0x04, 0x97, 0x53,
-	0x53, 0x3d, 0xc9, 0x25, 0x8e, 0x2e, 0x5d, 0x8e, 0xd4, 0x31, 0xa6, 0xf2, 0x1b, 0xaf, 0x40, 0x6f,
-	0x11, 0x5f, 0xba, 0x32, 0xd1, 0x12, 0xf9, 0x18, 0x13, 0x34, 0xe9, 0xfc, 0xb3, 0xbd, 0x7e, 0xe2,
-	0xa9, 0x19, 0xc5, 0xe5, 0xc7, 0xe3, 0x00, 0x5b, 0x37, 0x5d, 0xd3, 0x4d, 0x88, 0x2f, 0xbc, 0x99,
-	0x50, 0x7d, 0x71, 0x3b, 0xd7, 0xe1, 0x61, 0x41, 0x7f, 0xae, 0x9e, 0xbc, 0x90, 0x11, 0x17, 0x89,
-	0x58, 0x59, 0x11, 0x3d, 0x2d, 0x9f, 0x43, 0x4b, 0x86, 0x94, 0x76, 0xf2, 0x06, 0x94, 0x34, 0xff,
-	0xc9, 0xc1, 0x99, 0x82, 0x68, 0x89, 0x7c, 0x81, 0x5b, 0x3d, 0xdf, 0x4d, 0x16, 0x9f, 0x3c, 0x39,
-	0x0c, 0x2d, 0x91, 0x57, 0x60, 0x75, 0xa7, 0xb6, 0x3f, 0xe1, 0xaf, 0xf9, 0xfc, 0x82, 0x47, 0xf1,
-	0xd4, 0x0b, 0xc9, 0xc3, 0xa4, 0x63, 0x30, 0x2c, 0x05, 0x69, 0x3f, 0x7a, 0xcb, 0x00, 0xe3, 0xe1,
-	0xec, 0x96, 0x96, 0xc8, 0x31, 0xdc, 0x7b, 0xc9, 0xc5, 0x9d, 0x06, 0xb3, 0x6d, 0x3c, 0xb9, 0xdb,
-	0xa2, 0x26, 0xf5, 0x6e, 0x79, 0x8c, 0x96, 0xc8, 0x11, 0xdc, 0x57, 0x41, 0x8d, 0x95, 0x95, 0xb3,
-	0x28, 0x98, 0xe0, 0x2b, 0x6d, 0x51, 0xf1, 0x7b, 0x27, 0xd3, 0xde, 0xe7, 0xe1, 0xb4, 0x74, 0xb1,
-	0x86, 0x3f, 0xad, 0x9e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x33, 0xef, 0x5b, 0x1a, 0x1b,
-	0x00, 0x00,
+func (m *BPSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPSchedule.Unmarshal(m, b)
+}
+func (m *BPSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPSchedule.Marshal(b, m, deterministic)
+}
+func (m *BPSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPSchedule.Merge(m, src)
+}
+func (m *BPSchedule) XXX_Size() int {
+	return xxx_messageInfo_BPSchedule.Size(m)
+}
+func (m *BPSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPSchedule.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_BPSchedule proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BPSchedule) GetBestBlockNo() uint64 {
+	if m != nil {
+		return m.BestBlockNo
+	}
+	return 0
+}
 
-// AergoRPCServiceClient is the client API for AergoRPCService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AergoRPCServiceClient interface {
-	// Returns the current state of this node
-	NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Returns node metrics according to request
-	Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error)
-	// Returns current blockchain status (best block's height and hash)
-	Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error)
-	// Returns current blockchain's basic information
-	GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error)
-	// Returns current chain statistics
-	ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error)
-	// Returns list of Blocks without body according to request
-	ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error)
-	// Returns list of block metadata (hash, header, and number of transactions) according to request
-	ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error)
-	// Returns a stream of new blocks as they get added to the blockchain
-	ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error)
-	// Returns a stream of new block's metadata as they get added to the blockchain
-	ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error)
-	// Return a single block incl. header and body, queried by hash or number
-	GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error)
-	// Return a single block's metdata (hash, header, and number of transactions), queried by hash or number
-	GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error)
-	// Return a single block's body, queried by hash or number and list parameters
-	GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error)
-	// Return a single transaction, queried by transaction hash
-	GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error)
-	// Return information about transaction in block, queried by transaction hash
-	GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error)
-	// Return transaction receipt, queried by transaction hash
-	GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error)
-	// Return ABI stored at contract address
-	GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error)
-	// Sign and send a transaction from an unlocked account
-	SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error)
-	// Sign transaction with unlocked account
-	SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error)
-	// Verify validity of transaction
-	VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error)
-	// Commit a signed transaction
-	CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error)
-	// Return state of account
-	GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error)
-	// Return state of account, including merkle proof
-	GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error)
-	// Create a new account in this node
-	CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Return list of accounts in this node
-	GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error)
-	// Lock account in this node
-	LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Unlock account in this node
-	UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Import account to this node
-	ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error)
-	// Export account stored in this node as wif format
-	ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Export account stored in this node as keystore format
-	ExportAccountKeystore(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Query a contract method
-	QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Query contract state
-	QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error)
-	// Return list of peers of this node and their state
-	GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error)
-	// Return result of vote
-	GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error)
-	// Return staking, voting info for account
-	GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error)
-	// Return staking information
-	GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error)
-	// Return name information
-	GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error)
-	// Returns a stream of event as they get added to the blockchain
-	ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error)
-	// Returns list of event
-	ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error)
-	// Returns configs and statuses of server
-	GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error)
-	// Returns status of consensus and bps
-	GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error)
-	// Add & remove member of raft cluster
-	ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error)
-	// Returns enterprise config
-	GetEnterpriseConfig(ctx context.Context, in *EnterpriseConfigKey, opts ...grpc.CallOption) (*EnterpriseConfig, error)
-	// Return a status of changeCluster enterprise tx,  queried by requestID
-	GetConfChangeProgress(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ConfChangeProgress, error)
+func (m *BPSchedule) GetSlots() []*BPSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
 }
 
-type aergoRPCServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BPSchedule) GetStats() []*BPStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
 
-func NewAergoRPCServiceClient(cc *grpc.ClientConn) AergoRPCServiceClient {
-	return &aergoRPCServiceClient{cc}
+type BPSlot struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	PeerID               string   `protobuf:"bytes,3,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error) {
-	out := new(SingleBytes)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/NodeState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BPSlot) Reset()         { *m = BPSlot{} }
+func (m *BPSlot) String() string { return proto.CompactTextString(m) }
+func (*BPSlot) ProtoMessage()    {}
+func (*BPSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
 }
 
-func (c *aergoRPCServiceClient) Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error) {
-	out := new(Metrics)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Metric", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BPSlot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPSlot.Unmarshal(m, b)
+}
+func (m *BPSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPSlot.Marshal(b, m, deterministic)
+}
+func (m *BPSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPSlot.Merge(m, src)
+}
+func (m *BPSlot) XXX_Size() int {
+	return xxx_messageInfo_BPSlot.Size(m)
+}
+func (m *BPSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPSlot.DiscardUnknown(m)
 }
 
-func (c *aergoRPCServiceClient) Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error) {
-	out := new(BlockchainStatus)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Blockchain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BPSlot proto.InternalMessageInfo
+
+func (m *BPSlot) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error) {
-	out := new(ChainInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetChainInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BPSlot) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error) {
-	out := new(ChainStats)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChainStat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BPSlot) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aergoRPCServiceClient) ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error) {
-	out := new(BlockHeaderList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BPStat struct {
+	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Produced             uint64   `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed               uint64   `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	LastProduced         uint64   `protobuf:"varint,4,opt,name=lastProduced,proto3" json:"lastProduced,omitempty"`
+	LibConfirms          uint64   `protobuf:"varint,5,opt,name=libConfirms,proto3" json:"libConfirms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error) {
-	out := new(BlockMetadataList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BPStat) Reset()         { *m = BPStat{} }
+func (m *BPStat) String() string { return proto.CompactTextString(m) }
+func (*BPStat) ProtoMessage()    {}
+func (*BPStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 
-func (c *aergoRPCServiceClient) ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[0], "/types.AergoRPCService/ListBlockStream", opts...)
+func (m *BPStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPStat.Unmarshal(m, b)
+}
+func (m *BPStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPStat.Marshal(b, m, deterministic)
+}
+func (m *BPStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPStat.Merge(m, src)
+}
+func (m *BPStat) XXX_Size() int {
+	return xxx_messageInfo_BPStat.Size(m)
+}
+func (m *BPStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BPStat proto.InternalMessageInfo
+
+func (m *BPStat) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *BPStat) GetProduced() uint64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *BPStat) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+func (m *BPStat) GetLastProduced() uint64 {
+	if m != nil {
+		return m.LastProduced
+	}
+	return 0
+}
+
+func (m *BPStat) GetLibConfirms() uint64 {
+	if m != nil {
+		return m.LibConfirms
+	}
+	return 0
+}
+
+// the account and the block range of a staking history query
+type StakingHistoryParams struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	BlockFrom            uint64   `protobuf:"varint,2,opt,name=blockFrom,proto3" json:"blockFrom,omitempty"`
+	BlockTo              uint64   `protobuf:"varint,3,opt,name=blockTo,proto3" json:"blockTo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakingHistoryParams) Reset()         { *m = StakingHistoryParams{} }
+func (m *StakingHistoryParams) String() string { return proto.CompactTextString(m) }
+func (*StakingHistoryParams) ProtoMessage()    {}
+func (*StakingHistoryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+}
+
+func (m *StakingHistoryParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakingHistoryParams.Unmarshal(m, b)
+}
+func (m *StakingHistoryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakingHistoryParams.Marshal(b, m, deterministic)
+}
+func (m *StakingHistoryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingHistoryParams.Merge(m, src)
+}
+func (m *StakingHistoryParams) XXX_Size() int {
+	return xxx_messageInfo_StakingHistoryParams.Size(m)
+}
+func (m *StakingHistoryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingHistoryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingHistoryParams proto.InternalMessageInfo
+
+func (m *StakingHistoryParams) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *StakingHistoryParams) GetBlockFrom() uint64 {
+	if m != nil {
+		return m.BlockFrom
+	}
+	return 0
+}
+
+func (m *StakingHistoryParams) GetBlockTo() uint64 {
+	if m != nil {
+		return m.BlockTo
+	}
+	return 0
+}
+
+// an action or a reward of staking, indexed by the staking ledger
+type StakingRecord struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Account              []byte   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               []byte   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,4,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,6,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Timestamp            int64    `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Args                 []string `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakingRecord) Reset()         { *m = StakingRecord{} }
+func (m *StakingRecord) String() string { return proto.CompactTextString(m) }
+func (*StakingRecord) ProtoMessage()    {}
+func (*StakingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+}
+
+func (m *StakingRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakingRecord.Unmarshal(m, b)
+}
+func (m *StakingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakingRecord.Marshal(b, m, deterministic)
+}
+func (m *StakingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingRecord.Merge(m, src)
+}
+func (m *StakingRecord) XXX_Size() int {
+	return xxx_messageInfo_StakingRecord.Size(m)
+}
+func (m *StakingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingRecord proto.InternalMessageInfo
+
+func (m *StakingRecord) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *StakingRecord) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *StakingRecord) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *StakingRecord) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *StakingRecord) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *StakingRecord) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *StakingRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *StakingRecord) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type StakingHistory struct {
+	Records              []*StakingRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StakingHistory) Reset()         { *m = StakingHistory{} }
+func (m *StakingHistory) String() string { return proto.CompactTextString(m) }
+func (*StakingHistory) ProtoMessage()    {}
+func (*StakingHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+}
+
+func (m *StakingHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakingHistory.Unmarshal(m, b)
+}
+func (m *StakingHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakingHistory.Marshal(b, m, deterministic)
+}
+func (m *StakingHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingHistory.Merge(m, src)
+}
+func (m *StakingHistory) XXX_Size() int {
+	return xxx_messageInfo_StakingHistory.Size(m)
+}
+func (m *StakingHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingHistory proto.InternalMessageInfo
+
+func (m *StakingHistory) GetRecords() []*StakingRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// the block number of a hardfork version
+type HardforkInfo struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Scheduled            bool     `protobuf:"varint,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Supported            bool     `protobuf:"varint,4,opt,name=supported,proto3" json:"supported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HardforkInfo) Reset()         { *m = HardforkInfo{} }
+func (m *HardforkInfo) String() string { return proto.CompactTextString(m) }
+func (*HardforkInfo) ProtoMessage()    {}
+func (*HardforkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+}
+
+func (m *HardforkInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HardforkInfo.Unmarshal(m, b)
+}
+func (m *HardforkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HardforkInfo.Marshal(b, m, deterministic)
+}
+func (m *HardforkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HardforkInfo.Merge(m, src)
+}
+func (m *HardforkInfo) XXX_Size() int {
+	return xxx_messageInfo_HardforkInfo.Size(m)
+}
+func (m *HardforkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HardforkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HardforkInfo proto.InternalMessageInfo
+
+func (m *HardforkInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *HardforkInfo) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HardforkInfo) GetScheduled() bool {
+	if m != nil {
+		return m.Scheduled
+	}
+	return false
+}
+
+func (m *HardforkInfo) GetSupported() bool {
+	if m != nil {
+		return m.Supported
+	}
+	return false
+}
+
+type HardforkSchedule struct {
+	BestBlockNo          uint64          `protobuf:"varint,1,opt,name=bestBlockNo,proto3" json:"bestBlockNo,omitempty"`
+	CurrentVersion       int32           `protobuf:"varint,2,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
+	MaxVersion           uint64          `protobuf:"varint,3,opt,name=maxVersion,proto3" json:"maxVersion,omitempty"`
+	Forks                []*HardforkInfo `protobuf:"bytes,4,rep,name=forks,proto3" json:"forks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HardforkSchedule) Reset()         { *m = HardforkSchedule{} }
+func (m *HardforkSchedule) String() string { return proto.CompactTextString(m) }
+func (*HardforkSchedule) ProtoMessage()    {}
+func (*HardforkSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+}
+
+func (m *HardforkSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HardforkSchedule.Unmarshal(m, b)
+}
+func (m *HardforkSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HardforkSchedule.Marshal(b, m, deterministic)
+}
+func (m *HardforkSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HardforkSchedule.Merge(m, src)
+}
+func (m *HardforkSchedule) XXX_Size() int {
+	return xxx_messageInfo_HardforkSchedule.Size(m)
+}
+func (m *HardforkSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HardforkSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HardforkSchedule proto.InternalMessageInfo
+
+func (m *HardforkSchedule) GetBestBlockNo() uint64 {
+	if m != nil {
+		return m.BestBlockNo
+	}
+	return 0
+}
+
+func (m *HardforkSchedule) GetCurrentVersion() int32 {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return 0
+}
+
+func (m *HardforkSchedule) GetMaxVersion() uint64 {
+	if m != nil {
+		return m.MaxVersion
+	}
+	return 0
+}
+
+func (m *HardforkSchedule) GetForks() []*HardforkInfo {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
+type AddressBook struct {
+	Entries              []*AddressBookEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AddressBook) Reset()         { *m = AddressBook{} }
+func (m *AddressBook) String() string { return proto.CompactTextString(m) }
+func (*AddressBook) ProtoMessage()    {}
+func (*AddressBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+}
+
+func (m *AddressBook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBook.Unmarshal(m, b)
+}
+func (m *AddressBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBook.Marshal(b, m, deterministic)
+}
+func (m *AddressBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBook.Merge(m, src)
+}
+func (m *AddressBook) XXX_Size() int {
+	return xxx_messageInfo_AddressBook.Size(m)
+}
+func (m *AddressBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBook proto.InternalMessageInfo
+
+func (m *AddressBook) GetEntries() []*AddressBookEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// AgentCertificateList is the certificates of node and the revocation lists known to it.
+type AgentCertificateList struct {
+	Certificates         []*AgentCertificate          `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	RevocationLists      []*CertificateRevocationList `protobuf:"bytes,2,rep,name=revocationLists,proto3" json:"revocationLists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *AgentCertificateList) Reset()         { *m = AgentCertificateList{} }
+func (m *AgentCertificateList) String() string { return proto.CompactTextString(m) }
+func (*AgentCertificateList) ProtoMessage()    {}
+func (*AgentCertificateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+}
+
+func (m *AgentCertificateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCertificateList.Unmarshal(m, b)
+}
+func (m *AgentCertificateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCertificateList.Marshal(b, m, deterministic)
+}
+func (m *AgentCertificateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCertificateList.Merge(m, src)
+}
+func (m *AgentCertificateList) XXX_Size() int {
+	return xxx_messageInfo_AgentCertificateList.Size(m)
+}
+func (m *AgentCertificateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCertificateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCertificateList proto.InternalMessageInfo
+
+func (m *AgentCertificateList) GetCertificates() []*AgentCertificate {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+func (m *AgentCertificateList) GetRevocationLists() []*CertificateRevocationList {
+	if m != nil {
+		return m.RevocationLists
+	}
+	return nil
+}
+
+type AgentCertRevokeRequest struct {
+	AgentID              []byte   `protobuf:"bytes,1,opt,name=agentID,proto3" json:"agentID,omitempty"`
+	Renewable            bool     `protobuf:"varint,2,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentCertRevokeRequest) Reset()         { *m = AgentCertRevokeRequest{} }
+func (m *AgentCertRevokeRequest) String() string { return proto.CompactTextString(m) }
+func (*AgentCertRevokeRequest) ProtoMessage()    {}
+func (*AgentCertRevokeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+}
+
+func (m *AgentCertRevokeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentCertRevokeRequest.Unmarshal(m, b)
+}
+func (m *AgentCertRevokeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentCertRevokeRequest.Marshal(b, m, deterministic)
+}
+func (m *AgentCertRevokeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentCertRevokeRequest.Merge(m, src)
+}
+func (m *AgentCertRevokeRequest) XXX_Size() int {
+	return xxx_messageInfo_AgentCertRevokeRequest.Size(m)
+}
+func (m *AgentCertRevokeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentCertRevokeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentCertRevokeRequest proto.InternalMessageInfo
+
+func (m *AgentCertRevokeRequest) GetAgentID() []byte {
+	if m != nil {
+		return m.AgentID
+	}
+	return nil
+}
+
+func (m *AgentCertRevokeRequest) GetRenewable() bool {
+	if m != nil {
+		return m.Renewable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
+	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
+	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
+	proto.RegisterType((*ChainId)(nil), "types.ChainId")
+	proto.RegisterType((*ChainInfo)(nil), "types.ChainInfo")
+	proto.RegisterType((*ChainStats)(nil), "types.ChainStats")
+	proto.RegisterType((*Input)(nil), "types.Input")
+	proto.RegisterType((*Output)(nil), "types.Output")
+	proto.RegisterType((*Empty)(nil), "types.Empty")
+	proto.RegisterType((*SingleBytes)(nil), "types.SingleBytes")
+	proto.RegisterType((*SingleString)(nil), "types.SingleString")
+	proto.RegisterType((*AccountAddress)(nil), "types.AccountAddress")
+	proto.RegisterType((*AccountAndRoot)(nil), "types.AccountAndRoot")
+	proto.RegisterType((*Peer)(nil), "types.Peer")
+	proto.RegisterType((*PeerList)(nil), "types.PeerList")
+	proto.RegisterType((*ListParams)(nil), "types.ListParams")
+	proto.RegisterType((*PageParams)(nil), "types.PageParams")
+	proto.RegisterType((*BlockBodyPaged)(nil), "types.BlockBodyPaged")
+	proto.RegisterType((*BlockBodyParams)(nil), "types.BlockBodyParams")
+	proto.RegisterType((*BlockHeaderList)(nil), "types.BlockHeaderList")
+	proto.RegisterType((*BlockMetadata)(nil), "types.BlockMetadata")
+	proto.RegisterType((*BlockMetadataList)(nil), "types.BlockMetadataList")
+	proto.RegisterType((*CommitResult)(nil), "types.CommitResult")
+	proto.RegisterType((*CommitResultList)(nil), "types.CommitResultList")
+	proto.RegisterType((*VerifyResult)(nil), "types.VerifyResult")
+	proto.RegisterType((*Personal)(nil), "types.Personal")
+	proto.RegisterType((*ImportFormat)(nil), "types.ImportFormat")
+	proto.RegisterType((*Staking)(nil), "types.Staking")
+	proto.RegisterType((*Vote)(nil), "types.Vote")
+	proto.RegisterType((*VoteParams)(nil), "types.VoteParams")
+	proto.RegisterType((*AccountVoteInfo)(nil), "types.AccountVoteInfo")
+	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
+	proto.RegisterType((*VoteList)(nil), "types.VoteList")
+	proto.RegisterType((*NodeReq)(nil), "types.NodeReq")
+	proto.RegisterType((*Name)(nil), "types.Name")
+	proto.RegisterType((*NameInfo)(nil), "types.NameInfo")
+	proto.RegisterType((*PeersParams)(nil), "types.PeersParams")
+	proto.RegisterType((*KeyParams)(nil), "types.KeyParams")
+	proto.RegisterType((*ServerInfo)(nil), "types.ServerInfo")
+	proto.RegisterMapType((map[string]*ConfigItem)(nil), "types.ServerInfo.ConfigEntry")
+	proto.RegisterMapType((map[string]string)(nil), "types.ServerInfo.StatusEntry")
+	proto.RegisterType((*ConfigItem)(nil), "types.ConfigItem")
+	proto.RegisterMapType((map[string]string)(nil), "types.ConfigItem.PropsEntry")
+	proto.RegisterType((*EventList)(nil), "types.EventList")
+	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
+	proto.RegisterType((*EnterpriseConfigKey)(nil), "types.EnterpriseConfigKey")
+	proto.RegisterType((*EnterpriseConfig)(nil), "types.EnterpriseConfig")
+	proto.RegisterType((*BPSchedule)(nil), "types.BPSchedule")
+	proto.RegisterType((*BPSlot)(nil), "types.BPSlot")
+	proto.RegisterType((*BPStat)(nil), "types.BPStat")
+	proto.RegisterType((*StakingHistoryParams)(nil), "types.StakingHistoryParams")
+	proto.RegisterType((*StakingRecord)(nil), "types.StakingRecord")
+	proto.RegisterType((*StakingHistory)(nil), "types.StakingHistory")
+	proto.RegisterType((*HardforkInfo)(nil), "types.HardforkInfo")
+	proto.RegisterType((*HardforkSchedule)(nil), "types.HardforkSchedule")
+	proto.RegisterType((*AddressBook)(nil), "types.AddressBook")
+	proto.RegisterType((*AgentCertificateList)(nil), "types.AgentCertificateList")
+	proto.RegisterType((*AgentCertRevokeRequest)(nil), "types.AgentCertRevokeRequest")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 3399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x3a, 0xdb, 0x76, 0x1b, 0x47,
+	0x72, 0x00, 0x08, 0x90, 0x44, 0x11, 0x20, 0xc1, 0x16, 0x25, 0xd1, 0xb0, 0xec, 0x65, 0x7a, 0x1d,
+	0x9b, 0xab, 0xd8, 0x8c, 0x45, 0xd9, 0x1b, 0xe7, 0xb6, 0x6b, 0x90, 0x22, 0x45, 0x58, 0xbc, 0xa5,
+	0x01, 0x6b, 0xb9, 0xe7, 0xe4, 0x04, 0x19, 0xce, 0x34, 0x88, 0x39, 0x04, 0x66, 0x26, 0x33, 0x0d,
+	0x5e, 0xf6, 0x24, 0x4f, 0x79, 0xca, 0x1f, 0xe4, 0xe4, 0x25, 0x8f, 0xf9, 0x84, 0x3c, 0xe4, 0x2f,
+	0x92, 0x0f, 0x48, 0x3e, 0x25, 0xa7, 0xaa, 0xbb, 0xe7, 0x02, 0x82, 0x71, 0xb4, 0x6f, 0x53, 0xd5,
+	0x75, 0xed, 0xea, 0xae, 0xaa, 0x2e, 0x00, 0xea, 0x71, 0xe4, 0xee, 0x44, 0x71, 0xa8, 0x42, 0x56,
+	0x53, 0xf7, 0x91, 0x4c, 0xda, 0xad, 0xcb, 0x71, 0xe8, 0x5e, 0xbb, 0x23, 0xc7, 0x0f, 0xf4, 0x42,
+	0xbb, 0xe9, 0xb8, 0x6e, 0x38, 0x0d, 0x94, 0x01, 0x21, 0x08, 0x3d, 0x69, 0xbe, 0xeb, 0xd1, 0x6e,
+	0x64, 0x3e, 0x1b, 0x13, 0xa9, 0x62, 0xdf, 0xb5, 0x44, 0xb1, 0x33, 0x34, 0x0c, 0xfc, 0x7f, 0xca,
+	0xd0, 0xda, 0x4b, 0x85, 0xf6, 0x94, 0xa3, 0xa6, 0x09, 0xfb, 0x1c, 0xd6, 0x2e, 0x65, 0xa2, 0x06,
+	0xa4, 0x6d, 0x30, 0x72, 0x92, 0xd1, 0x66, 0x79, 0xab, 0xbc, 0xdd, 0x10, 0x4d, 0x44, 0x13, 0xf9,
+	0x91, 0x93, 0x8c, 0xd8, 0xcf, 0x60, 0x85, 0xe8, 0x46, 0xd2, 0xbf, 0x1a, 0xa9, 0xcd, 0xca, 0x56,
+	0x79, 0xbb, 0x2a, 0x00, 0x51, 0x47, 0x84, 0x61, 0x7f, 0x08, 0xab, 0x6e, 0x18, 0x24, 0x32, 0x48,
+	0xa6, 0xc9, 0xc0, 0x0f, 0x86, 0xe1, 0xe6, 0xc2, 0x56, 0x79, 0xbb, 0x2e, 0x9a, 0x29, 0xb6, 0x1b,
+	0x0c, 0x43, 0xf6, 0x47, 0xc0, 0x48, 0x0e, 0xd9, 0x30, 0xf0, 0x3d, 0xad, 0xb2, 0x4a, 0x2a, 0xc9,
+	0x92, 0x7d, 0x5c, 0xe8, 0x7a, 0xa4, 0xf4, 0x8f, 0x01, 0x0c, 0x1d, 0xca, 0xab, 0x6d, 0x95, 0xb7,
+	0x57, 0x76, 0x5b, 0x3b, 0xb4, 0x3f, 0x3b, 0x9a, 0x2e, 0x18, 0x86, 0xa2, 0xee, 0xda, 0x4f, 0xfe,
+	0x4f, 0x65, 0x58, 0x32, 0x02, 0xd8, 0x06, 0xd4, 0x26, 0xce, 0x95, 0xef, 0x92, 0x3f, 0x75, 0xa1,
+	0x01, 0xf6, 0x0c, 0x16, 0xa3, 0xe9, 0xe5, 0xd8, 0x77, 0xc9, 0x85, 0x65, 0x61, 0x20, 0xb6, 0x09,
+	0x4b, 0x13, 0xc7, 0x0f, 0x02, 0xa9, 0xc8, 0xee, 0x65, 0x61, 0x41, 0xf6, 0x02, 0xea, 0xa9, 0x0b,
+	0x64, 0x68, 0x5d, 0x64, 0x08, 0xe4, 0xbb, 0x91, 0x71, 0xe2, 0x87, 0x01, 0xd9, 0x57, 0x13, 0x16,
+	0xe4, 0xff, 0x5d, 0x81, 0x7a, 0x6a, 0x24, 0xfb, 0x14, 0x2a, 0xbe, 0x47, 0xa6, 0xac, 0xec, 0xae,
+	0x16, 0x5c, 0xf0, 0x44, 0xc5, 0xf7, 0x58, 0x1b, 0x96, 0x2f, 0xa3, 0xd3, 0xe9, 0xe4, 0x52, 0xc6,
+	0x64, 0x59, 0x53, 0xa4, 0x30, 0xe3, 0xd0, 0x98, 0x38, 0x77, 0x14, 0xa1, 0xc4, 0xff, 0x9d, 0x24,
+	0x03, 0xab, 0xa2, 0x80, 0x43, 0x2b, 0x27, 0xce, 0x9d, 0x0a, 0xaf, 0x65, 0x90, 0x98, 0xed, 0xcc,
+	0x10, 0xec, 0x73, 0x58, 0x4d, 0x94, 0x73, 0xed, 0x07, 0x57, 0x13, 0x3f, 0xf0, 0x27, 0xd3, 0x09,
+	0x19, 0xdb, 0x10, 0x33, 0x58, 0xd4, 0xa4, 0x42, 0xe5, 0x8c, 0x0d, 0x7a, 0x73, 0x91, 0xa8, 0x0a,
+	0x38, 0xb4, 0xf4, 0xca, 0x49, 0xa2, 0xd8, 0x77, 0xe5, 0xe6, 0x12, 0xad, 0xa7, 0x30, 0x5a, 0x11,
+	0x38, 0x13, 0xa9, 0x17, 0x97, 0xb5, 0x15, 0x29, 0x82, 0xbd, 0x84, 0x16, 0x49, 0xba, 0x09, 0x95,
+	0x1f, 0x5c, 0x45, 0xe1, 0xad, 0x8c, 0x37, 0xeb, 0x44, 0xf4, 0x00, 0x8f, 0x96, 0x68, 0x30, 0x96,
+	0xb7, 0x4e, 0xec, 0x6d, 0x82, 0xb6, 0x24, 0x8f, 0xe3, 0x9f, 0x01, 0xec, 0xdb, 0xa3, 0x9c, 0x60,
+	0x64, 0x63, 0x19, 0x85, 0xb1, 0x32, 0x01, 0x37, 0x10, 0x77, 0xa1, 0xd6, 0x0d, 0xa2, 0xa9, 0x62,
+	0x0c, 0xaa, 0xb9, 0xf3, 0x4d, 0xdf, 0x18, 0x3e, 0xc7, 0xf3, 0x62, 0x99, 0x24, 0x9b, 0x95, 0xad,
+	0x85, 0xed, 0x86, 0xb0, 0x20, 0x1e, 0x9f, 0x1b, 0x67, 0x3c, 0xd5, 0xbb, 0xdd, 0x10, 0x1a, 0x40,
+	0x25, 0x89, 0x1b, 0xfb, 0x91, 0x32, 0x7b, 0x6c, 0x20, 0x3e, 0x84, 0xc5, 0xb3, 0xa9, 0x42, 0x2d,
+	0x1b, 0x50, 0xf3, 0x03, 0x4f, 0xde, 0x91, 0x9a, 0xa6, 0xd0, 0x40, 0x51, 0x4f, 0xf9, 0xf7, 0xd7,
+	0xb3, 0x04, 0xb5, 0x83, 0x49, 0xa4, 0xee, 0xf9, 0xcf, 0x61, 0xa5, 0xe7, 0x07, 0x57, 0x63, 0xb9,
+	0x77, 0xaf, 0x64, 0x4e, 0x4a, 0x39, 0x27, 0x85, 0x7f, 0x06, 0x0d, 0x4d, 0xd4, 0x53, 0x31, 0x86,
+	0xae, 0x40, 0x55, 0xb7, 0x54, 0x9f, 0xc3, 0x6a, 0x47, 0x67, 0x96, 0xce, 0xac, 0x4d, 0x05, 0x69,
+	0x7f, 0x93, 0xd1, 0x05, 0x9e, 0x08, 0x43, 0x85, 0x5e, 0x19, 0x8c, 0xa1, 0xb4, 0x20, 0xee, 0x35,
+	0x52, 0x18, 0x67, 0xe9, 0x9b, 0x7d, 0x0a, 0xb0, 0x1f, 0x4e, 0x22, 0xd4, 0x20, 0x3d, 0x73, 0xcb,
+	0x72, 0x18, 0xfe, 0xef, 0x0b, 0x50, 0x3d, 0x97, 0x32, 0x66, 0x5f, 0x66, 0x9b, 0xa5, 0x2f, 0x0c,
+	0x33, 0x17, 0x06, 0x57, 0x8d, 0x8d, 0xd9, 0x06, 0xbe, 0x86, 0x3a, 0xe6, 0x0d, 0xba, 0x0a, 0xa4,
+	0x6f, 0x65, 0xf7, 0xa9, 0xa1, 0x3f, 0x95, 0xb7, 0x94, 0xc1, 0x4e, 0x43, 0xe5, 0xbb, 0x52, 0x64,
+	0x74, 0xe8, 0x61, 0xa2, 0x1c, 0xa5, 0x77, 0xbd, 0x26, 0x34, 0x80, 0xbb, 0x3e, 0xf2, 0x3d, 0x4f,
+	0x06, 0xb4, 0xeb, 0xcb, 0xc2, 0x40, 0x78, 0xac, 0xc7, 0x4e, 0x32, 0xda, 0x1f, 0x49, 0xf7, 0x9a,
+	0x6e, 0xce, 0x82, 0xc8, 0x10, 0x78, 0x21, 0x12, 0x39, 0x1e, 0x46, 0x52, 0xc6, 0x74, 0x61, 0x96,
+	0x45, 0x0a, 0xe7, 0xd3, 0xc3, 0x12, 0xed, 0xb9, 0x05, 0xd9, 0x9f, 0x43, 0xc3, 0x95, 0xb1, 0xf2,
+	0x87, 0xbe, 0xeb, 0x28, 0x99, 0x6c, 0x2e, 0x6f, 0x2d, 0x6c, 0xaf, 0xec, 0x3e, 0x37, 0x96, 0x77,
+	0xae, 0x64, 0xa0, 0xf6, 0xb3, 0x75, 0x51, 0x20, 0x66, 0xaf, 0xa1, 0xe1, 0xb8, 0xae, 0x8c, 0x94,
+	0xf4, 0x44, 0x38, 0x96, 0x74, 0x8b, 0x56, 0x77, 0xd7, 0x72, 0xdb, 0x84, 0x68, 0x51, 0x20, 0x22,
+	0x9f, 0xdd, 0x30, 0x96, 0x74, 0x97, 0xd0, 0x67, 0x04, 0xd8, 0x16, 0xac, 0x8c, 0x9d, 0x44, 0x9d,
+	0xcb, 0xc0, 0x19, 0xab, 0xfb, 0xcd, 0x15, 0xb2, 0x32, 0x8f, 0x42, 0x8a, 0x4b, 0x27, 0x08, 0xa4,
+	0xf7, 0x63, 0xa0, 0xfc, 0xf1, 0x66, 0x83, 0xfc, 0xcf, 0xa3, 0xf8, 0x57, 0xb0, 0x8c, 0x3a, 0x8f,
+	0xfd, 0x44, 0xb1, 0x3f, 0x80, 0x1a, 0x7a, 0x8e, 0xa1, 0x43, 0x87, 0x56, 0xf2, 0x36, 0xe9, 0x15,
+	0x7e, 0x03, 0x80, 0xa4, 0xe7, 0x4e, 0xec, 0x4c, 0x92, 0xb9, 0xd7, 0x12, 0x03, 0x91, 0x2f, 0x34,
+	0x06, 0x42, 0xda, 0x34, 0x03, 0x36, 0x05, 0x7d, 0x23, 0x6d, 0x38, 0x1c, 0x26, 0x52, 0x5f, 0x95,
+	0xa6, 0x30, 0x10, 0x6b, 0xc1, 0x82, 0x93, 0xb8, 0x14, 0xae, 0x65, 0x81, 0x9f, 0xfc, 0x3b, 0x80,
+	0x73, 0xe7, 0x4a, 0x1a, 0xbd, 0x19, 0x5f, 0xb9, 0xc0, 0x67, 0x75, 0x54, 0x32, 0x1d, 0xfc, 0x0e,
+	0x56, 0xe9, 0x20, 0xed, 0x85, 0xde, 0x3d, 0x8a, 0xa0, 0xea, 0x42, 0x39, 0xcb, 0x5e, 0x73, 0x02,
+	0x72, 0x32, 0x2b, 0x73, 0x65, 0xe6, 0xed, 0xfe, 0x0c, 0xaa, 0x97, 0xa1, 0x77, 0x4f, 0x56, 0x67,
+	0x65, 0x2d, 0x55, 0x23, 0x68, 0x95, 0xff, 0x2d, 0xac, 0xe5, 0x34, 0x93, 0xe1, 0x1c, 0x1a, 0xb8,
+	0x49, 0x61, 0x1c, 0xe8, 0x72, 0xa1, 0x37, 0xae, 0x80, 0x63, 0xbf, 0x80, 0xc5, 0xc8, 0xb9, 0xc2,
+	0x14, 0xae, 0x6f, 0xc4, 0xba, 0x0d, 0x43, 0xea, 0xbf, 0x30, 0x04, 0xfc, 0x4f, 0x8c, 0x86, 0x23,
+	0xe9, 0x78, 0x26, 0x86, 0x9f, 0xc1, 0xa2, 0xae, 0x2c, 0x26, 0x88, 0x8d, 0xbc, 0x71, 0xc2, 0xac,
+	0xf1, 0x7f, 0x80, 0x26, 0x21, 0x4e, 0xa4, 0x72, 0x3c, 0x47, 0x39, 0x73, 0x23, 0xf9, 0x12, 0x23,
+	0x89, 0x82, 0x8d, 0x21, 0x2c, 0x2f, 0x4a, 0xab, 0x14, 0x86, 0x02, 0x2f, 0x8b, 0xba, 0xd3, 0xe9,
+	0x44, 0x5f, 0x4b, 0x0b, 0xa6, 0xfb, 0x57, 0xa5, 0xb3, 0xa7, 0x63, 0xd2, 0x81, 0xf5, 0x82, 0x7a,
+	0xb2, 0xfc, 0xcb, 0x19, 0xcb, 0x37, 0xf2, 0xea, 0x2c, 0x65, 0xea, 0x81, 0x84, 0xc6, 0x7e, 0x38,
+	0x99, 0xf8, 0x4a, 0xc8, 0x64, 0x3a, 0x9e, 0x5f, 0x21, 0x7e, 0x01, 0x35, 0x19, 0xc7, 0xa1, 0xb6,
+	0x7f, 0x75, 0xf7, 0x89, 0xad, 0xdd, 0xc4, 0xa7, 0x9b, 0x28, 0xa1, 0x29, 0x30, 0xfa, 0x9e, 0x54,
+	0x8e, 0x3f, 0x36, 0xad, 0x8f, 0x81, 0x78, 0x07, 0x5a, 0x79, 0x35, 0x64, 0xe8, 0x57, 0xb0, 0x14,
+	0x13, 0x64, 0x2d, 0x2d, 0x0a, 0xd6, 0x94, 0xc2, 0xd2, 0xf0, 0x3e, 0x34, 0xde, 0xcb, 0xd8, 0x1f,
+	0xde, 0x1b, 0x4b, 0x3f, 0x82, 0x8a, 0xba, 0x33, 0xd9, 0xb1, 0x6e, 0x38, 0xfb, 0x77, 0xa2, 0xa2,
+	0xee, 0x1e, 0x33, 0x58, 0xb3, 0x17, 0x0c, 0xe6, 0x7d, 0xbc, 0xb7, 0x71, 0x12, 0x06, 0xce, 0x18,
+	0xb3, 0x73, 0xe4, 0x24, 0x49, 0x34, 0x8a, 0x9d, 0xc4, 0x16, 0x88, 0x1c, 0x86, 0x6d, 0xc3, 0x92,
+	0xe9, 0x3f, 0x4d, 0x24, 0x6d, 0x17, 0x63, 0x52, 0xbe, 0xb0, 0xcb, 0xfc, 0x9f, 0xcb, 0xd0, 0xe8,
+	0x4e, 0xb0, 0xf6, 0x1e, 0x86, 0xf1, 0xc4, 0xc1, 0xe3, 0xb4, 0x70, 0xeb, 0x0f, 0x67, 0x72, 0x79,
+	0xae, 0x7a, 0x09, 0x5c, 0xc6, 0xe8, 0x87, 0x63, 0x0f, 0x35, 0x92, 0x82, 0xba, 0xb0, 0x20, 0xae,
+	0x04, 0xf2, 0x96, 0x56, 0xf4, 0xc6, 0x5a, 0x90, 0xed, 0xc0, 0xf2, 0xb5, 0xbc, 0x4f, 0x14, 0x66,
+	0xb5, 0xea, 0xa3, 0xe2, 0x53, 0x1a, 0xfe, 0x2d, 0x2c, 0xf5, 0x4c, 0x1b, 0xf3, 0x0c, 0x16, 0x9d,
+	0x49, 0xae, 0x74, 0x19, 0x08, 0xcf, 0xc0, 0xed, 0x48, 0x06, 0x26, 0xf1, 0xd0, 0x37, 0xff, 0x0b,
+	0xa8, 0xbe, 0x0f, 0x15, 0xb5, 0x37, 0xae, 0x13, 0x78, 0xbe, 0x87, 0x95, 0x43, 0xb3, 0x65, 0x88,
+	0x9c, 0xc4, 0x4a, 0x5e, 0x22, 0xdf, 0x05, 0x40, 0x6e, 0x73, 0x7b, 0x57, 0xd3, 0x46, 0xb0, 0x4e,
+	0x8d, 0xdf, 0x06, 0xd4, 0xb2, 0x5d, 0x6d, 0x0a, 0x0d, 0x70, 0x0f, 0xd6, 0xcc, 0xbe, 0x22, 0x2b,
+	0x75, 0x90, 0xdb, 0xb0, 0x64, 0xdb, 0xb2, 0x62, 0x1b, 0x69, 0x3c, 0x12, 0x76, 0x99, 0x7d, 0x01,
+	0x8b, 0xba, 0x4f, 0xa2, 0x9e, 0x66, 0x25, 0xad, 0x0b, 0x56, 0x94, 0x30, 0xcb, 0x5c, 0xc0, 0x72,
+	0x2a, 0x7e, 0xd6, 0xae, 0x4f, 0x01, 0x52, 0xd7, 0x74, 0x73, 0x54, 0x17, 0x39, 0x4c, 0xce, 0x5b,
+	0x73, 0xd8, 0x8d, 0xb7, 0x7f, 0xa9, 0x65, 0xda, 0x5a, 0x70, 0x13, 0x22, 0x7b, 0xb1, 0x16, 0xe0,
+	0xba, 0xd0, 0x2b, 0x46, 0x6d, 0xc5, 0xaa, 0xe5, 0x1d, 0x58, 0x3a, 0x0d, 0x3d, 0x29, 0xe4, 0xdf,
+	0x51, 0x3a, 0xf0, 0x27, 0x32, 0x9c, 0xa6, 0xdd, 0x85, 0x01, 0x75, 0x4b, 0x3e, 0x89, 0xc2, 0x40,
+	0xa6, 0x9b, 0x9d, 0x21, 0xf8, 0x37, 0x50, 0x3d, 0x75, 0x26, 0x12, 0x23, 0x89, 0xbd, 0xa7, 0xf1,
+	0x89, 0xbe, 0x51, 0xe6, 0xa5, 0xee, 0x08, 0x4c, 0x80, 0x2d, 0xc8, 0x5d, 0x58, 0x46, 0x2e, 0xda,
+	0x8b, 0x9f, 0xe5, 0x38, 0x33, 0xb3, 0x71, 0xd9, 0x88, 0xd9, 0x80, 0x5a, 0x78, 0x1b, 0x98, 0xa4,
+	0xd6, 0x10, 0x1a, 0xc0, 0x42, 0xe9, 0xc9, 0x44, 0xf9, 0x81, 0xa3, 0xb0, 0xe0, 0xeb, 0x86, 0x2e,
+	0x8f, 0xe2, 0x12, 0x56, 0xb0, 0x10, 0x26, 0xe6, 0x2c, 0xb4, 0x61, 0x39, 0x08, 0x8f, 0x74, 0xc7,
+	0x51, 0xd6, 0x9d, 0x83, 0x85, 0xa9, 0xab, 0x18, 0x85, 0xb7, 0x3d, 0x39, 0x1e, 0x9a, 0xa7, 0x4a,
+	0x0a, 0x63, 0x6c, 0xf0, 0x7b, 0x8f, 0x4a, 0xb0, 0xed, 0xa4, 0x32, 0x0c, 0xff, 0x04, 0xea, 0xef,
+	0xa4, 0x2d, 0x17, 0x2d, 0x58, 0xb8, 0x96, 0xf7, 0x14, 0x82, 0xba, 0xc0, 0x4f, 0xfe, 0x8f, 0x15,
+	0x80, 0x9e, 0x8c, 0x6f, 0x64, 0x4c, 0xde, 0x7e, 0x0b, 0x8b, 0x09, 0xa5, 0x05, 0x13, 0xa6, 0x4f,
+	0xec, 0xb9, 0x4a, 0x49, 0x76, 0x74, 0xda, 0x38, 0x08, 0x54, 0x7c, 0x2f, 0x0c, 0x31, 0xb2, 0xb9,
+	0x61, 0x30, 0xf4, 0xed, 0x29, 0x9b, 0xc3, 0xb6, 0x4f, 0xeb, 0x86, 0x4d, 0x13, 0xb7, 0xff, 0x14,
+	0x56, 0x72, 0xd2, 0x32, 0xeb, 0xca, 0xc6, 0xba, 0xac, 0xf9, 0xac, 0xe4, 0x9a, 0xd4, 0x3f, 0xab,
+	0x7c, 0x57, 0x6e, 0x1f, 0xc3, 0x4a, 0x4e, 0xe2, 0x1c, 0xd6, 0x2f, 0xf2, 0xac, 0x59, 0xd1, 0xd3,
+	0x4c, 0x5d, 0x25, 0x27, 0x39, 0x69, 0xfc, 0x77, 0xd8, 0x8e, 0xda, 0x05, 0xb6, 0x0b, 0xb5, 0x28,
+	0x0e, 0xa3, 0xc4, 0x38, 0xf3, 0xe2, 0x01, 0xeb, 0xce, 0x39, 0x2e, 0x6b, 0x5f, 0x34, 0x69, 0x1b,
+	0xfb, 0x89, 0x14, 0xf9, 0x21, 0x9e, 0xf0, 0x2e, 0xd4, 0x0f, 0x6e, 0x64, 0xa0, 0x6c, 0xb5, 0x95,
+	0x08, 0xcc, 0x56, 0x5b, 0xa2, 0x10, 0x66, 0x0d, 0xef, 0x9b, 0x3b, 0x8d, 0x93, 0xd0, 0x9e, 0x39,
+	0x03, 0xf1, 0x2e, 0x34, 0xf7, 0x0b, 0x2f, 0x6c, 0x06, 0x55, 0xe4, 0xb7, 0xc7, 0x1e, 0xbf, 0x11,
+	0x47, 0x4f, 0x68, 0x6d, 0x08, 0x7d, 0xa3, 0xbd, 0x97, 0x11, 0x66, 0x54, 0x3a, 0x17, 0x97, 0x51,
+	0xc2, 0xbf, 0x80, 0x27, 0x07, 0x81, 0x92, 0x71, 0x14, 0xfb, 0x89, 0xd4, 0x9e, 0xbf, 0x93, 0x73,
+	0x1c, 0xe3, 0xc7, 0xd0, 0x9a, 0x25, 0x9c, 0xe3, 0xfe, 0x2a, 0x54, 0xc2, 0xc0, 0x9c, 0xdd, 0x4a,
+	0x18, 0xa0, 0x07, 0xb4, 0x03, 0x56, 0xa7, 0x81, 0xf8, 0x1d, 0xc0, 0xde, 0x79, 0xcf, 0x1d, 0x49,
+	0x6f, 0x3a, 0xa6, 0x7e, 0x34, 0x9d, 0x3c, 0x9c, 0x86, 0x24, 0xaf, 0x2a, 0xf2, 0x28, 0xf6, 0x73,
+	0xa8, 0x25, 0xe3, 0x50, 0xd9, 0x50, 0x35, 0x6d, 0x89, 0x3f, 0xef, 0x8d, 0x43, 0x25, 0xf4, 0x1a,
+	0x11, 0xe1, 0xb3, 0x90, 0x74, 0x15, 0x88, 0x94, 0xa3, 0x74, 0xbf, 0x9f, 0xf0, 0x1f, 0x60, 0x51,
+	0x73, 0x15, 0x5f, 0x6d, 0x55, 0xfb, 0x6a, 0xc3, 0xad, 0xf4, 0x27, 0x3a, 0x7e, 0x0b, 0x82, 0xbe,
+	0x69, 0x80, 0x20, 0x65, 0xdc, 0x7d, 0x63, 0xf3, 0x9e, 0x86, 0xf8, 0xbf, 0x94, 0x49, 0x98, 0x72,
+	0x54, 0x8e, 0xa4, 0x9c, 0x27, 0xc1, 0x2b, 0x1d, 0xc5, 0xa1, 0x37, 0x75, 0xa5, 0x67, 0xb2, 0x4f,
+	0x0a, 0x23, 0xcf, 0xc4, 0x4f, 0x1f, 0x46, 0x55, 0x61, 0x20, 0x6c, 0xf6, 0xa8, 0x17, 0xb7, 0x7c,
+	0x55, 0xfd, 0xf6, 0xcf, 0xe3, 0xa8, 0x85, 0xf7, 0x2f, 0x29, 0x0e, 0xf1, 0x24, 0xa1, 0x8e, 0xb7,
+	0x2a, 0xf2, 0x28, 0x3e, 0x82, 0x0d, 0x53, 0x25, 0x8e, 0x7c, 0xac, 0x84, 0x36, 0x37, 0x6c, 0x66,
+	0x45, 0xdd, 0xa4, 0x58, 0x03, 0x62, 0x8a, 0xa5, 0xcc, 0x78, 0x18, 0x87, 0x13, 0x63, 0x6c, 0x86,
+	0x48, 0xd3, 0x68, 0x3f, 0x34, 0xe6, 0x5a, 0x90, 0xff, 0x57, 0x19, 0x9a, 0xb6, 0x20, 0x49, 0x37,
+	0x8c, 0x3d, 0x3a, 0x06, 0x91, 0x2d, 0x2c, 0x61, 0x94, 0xd7, 0x59, 0x29, 0xea, 0x2c, 0x96, 0x94,
+	0xac, 0x24, 0xe7, 0x92, 0x76, 0xb5, 0x90, 0xb4, 0x53, 0x2b, 0x8f, 0xb0, 0x6b, 0xd3, 0x23, 0x8d,
+	0x0c, 0x81, 0xf2, 0xd4, 0x1d, 0x2d, 0xe9, 0x39, 0x86, 0x81, 0x90, 0x0b, 0x43, 0x99, 0x28, 0x67,
+	0x12, 0xd1, 0xb3, 0x6c, 0x41, 0x64, 0x08, 0x0c, 0xba, 0x13, 0x5f, 0xe9, 0x07, 0x59, 0x5d, 0xd0,
+	0x37, 0xff, 0x1e, 0x56, 0x8b, 0xfb, 0xc7, 0x76, 0xb0, 0x7f, 0x43, 0xff, 0x66, 0x3b, 0xcd, 0x82,
+	0xf3, 0xc2, 0x12, 0xf1, 0xbf, 0x87, 0xc6, 0x91, 0x13, 0x7b, 0xc3, 0x30, 0xbe, 0xa6, 0x5b, 0x9a,
+	0x7b, 0x18, 0x96, 0x8b, 0x0f, 0xc3, 0xc7, 0xde, 0x3e, 0x2f, 0xa0, 0x9e, 0x98, 0x4b, 0x62, 0x73,
+	0x7e, 0x86, 0xa0, 0xd5, 0x69, 0x84, 0x4d, 0x97, 0x39, 0x24, 0xb8, 0x6a, 0x11, 0xfc, 0xdf, 0xca,
+	0xd0, 0xb2, 0xea, 0x3f, 0xe0, 0xa6, 0x7d, 0x0e, 0xab, 0xee, 0x34, 0x8e, 0x65, 0xa0, 0xde, 0x1b,
+	0x5b, 0x2b, 0xd4, 0x97, 0xcf, 0x60, 0xb1, 0x1e, 0x4d, 0x9c, 0x3b, 0x4b, 0xa3, 0x4f, 0x44, 0x0e,
+	0x83, 0x2d, 0x29, 0x6a, 0x4e, 0x36, 0xab, 0x85, 0x56, 0x37, 0xbf, 0x21, 0x42, 0x53, 0xf0, 0xef,
+	0x61, 0xc5, 0xbc, 0xf0, 0xf7, 0xc2, 0xf0, 0x9a, 0xbd, 0x82, 0x25, 0x19, 0xa8, 0xd8, 0x4f, 0x7b,
+	0x88, 0xf4, 0x81, 0x9c, 0x11, 0xe9, 0x9c, 0x6c, 0xe9, 0xf8, 0xbf, 0x96, 0x61, 0x63, 0xf6, 0xf9,
+	0x4c, 0x79, 0x76, 0xf6, 0xc5, 0x5d, 0xfe, 0x90, 0x17, 0xf7, 0x0f, 0xb0, 0x16, 0xcb, 0x9b, 0xd0,
+	0xa5, 0x3a, 0x8e, 0xe2, 0x6c, 0xfa, 0xd9, 0xb2, 0x95, 0x22, 0xc7, 0x5a, 0x20, 0x14, 0xb3, 0x8c,
+	0xfc, 0x1c, 0x9e, 0xa5, 0xda, 0x90, 0xf6, 0x1a, 0xdb, 0x9d, 0xa9, 0x4c, 0xe8, 0xa4, 0x3b, 0xb8,
+	0x62, 0x52, 0x07, 0xde, 0x0d, 0x0d, 0x62, 0x7c, 0x63, 0x19, 0xc8, 0x5b, 0xe7, 0x72, 0x2c, 0x4d,
+	0x4e, 0xcd, 0x10, 0x2f, 0xff, 0xb3, 0x6c, 0x5f, 0x32, 0x66, 0xac, 0x5b, 0x87, 0x5a, 0xff, 0x62,
+	0x70, 0xf6, 0xae, 0x55, 0x62, 0x1b, 0xd0, 0xea, 0x5f, 0x0c, 0x4e, 0xcf, 0x4e, 0xf7, 0x0f, 0x06,
+	0xfd, 0xb3, 0xb3, 0xc1, 0xf1, 0xd9, 0x6f, 0x5a, 0x65, 0xf6, 0x14, 0xd6, 0xfb, 0x17, 0x83, 0xce,
+	0xb1, 0x38, 0xe8, 0xbc, 0xf9, 0xed, 0xe0, 0xe0, 0xa2, 0xdb, 0xeb, 0xf7, 0x5a, 0x15, 0xf6, 0x04,
+	0xd6, 0xfa, 0x17, 0x83, 0xee, 0xe9, 0xfb, 0xce, 0x71, 0xf7, 0xcd, 0xe0, 0xa8, 0xd3, 0x3b, 0x6a,
+	0x2d, 0xcc, 0x20, 0x7b, 0xdd, 0xb7, 0xa7, 0xad, 0xaa, 0x11, 0x60, 0x91, 0x87, 0x67, 0xe2, 0xa4,
+	0xd3, 0x6f, 0xd5, 0xd8, 0xc7, 0xf0, 0x9c, 0xd0, 0xbd, 0x1f, 0x0f, 0x0f, 0xbb, 0xfb, 0xdd, 0x83,
+	0xd3, 0xfe, 0x60, 0xaf, 0x73, 0xdc, 0x39, 0xdd, 0x3f, 0x68, 0x2d, 0x1a, 0x9e, 0xa3, 0x4e, 0x6f,
+	0xd0, 0xeb, 0x9c, 0x1c, 0x68, 0x9b, 0x5a, 0x4b, 0xa9, 0xa8, 0xfe, 0x81, 0x38, 0xed, 0x1c, 0x0f,
+	0x0e, 0x84, 0x38, 0x13, 0xad, 0xfa, 0xcb, 0xa1, 0x7d, 0xf3, 0x18, 0x9f, 0x36, 0xa0, 0xf5, 0xfe,
+	0x40, 0x74, 0x0f, 0x7f, 0x3b, 0xe8, 0xf5, 0x3b, 0xfd, 0x1f, 0x7b, 0xda, 0xbd, 0x2d, 0x78, 0x51,
+	0xc4, 0xa2, 0x7d, 0x83, 0xd3, 0xb3, 0xfe, 0xe0, 0xa4, 0xd3, 0xdf, 0x3f, 0x6a, 0x95, 0xd9, 0xa7,
+	0xd0, 0x2e, 0x52, 0x14, 0xdc, 0xab, 0xec, 0xfe, 0xc7, 0x47, 0xb0, 0xd6, 0x91, 0xf1, 0x55, 0x28,
+	0xce, 0xf7, 0xb1, 0x79, 0xf1, 0x5d, 0xc9, 0x5e, 0x41, 0x1d, 0xdb, 0xd0, 0x1e, 0x8d, 0x85, 0x6c,
+	0xa3, 0x6d, 0x1a, 0xd3, 0xf6, 0x9c, 0x37, 0x06, 0x2f, 0xb1, 0x57, 0xb0, 0x78, 0x42, 0xa3, 0x77,
+	0x66, 0xc7, 0x4f, 0x1a, 0x4c, 0x4c, 0x70, 0xdb, 0xab, 0x45, 0x34, 0x2f, 0xb1, 0x6f, 0x01, 0xb2,
+	0x81, 0x3c, 0x4b, 0xeb, 0xfe, 0x24, 0x52, 0xf7, 0xed, 0xe7, 0xf9, 0x97, 0x6b, 0x6e, 0x62, 0xcf,
+	0x4b, 0xec, 0x6b, 0x68, 0xbc, 0x95, 0x2a, 0x9b, 0x2d, 0x17, 0x19, 0x1f, 0x0c, 0xc8, 0x79, 0x89,
+	0xed, 0x98, 0x51, 0x34, 0x95, 0xa7, 0x22, 0xf9, 0x7a, 0x9e, 0x9c, 0x26, 0xa9, 0xbc, 0xc4, 0x7e,
+	0x0d, 0x2d, 0x3c, 0xaa, 0xb9, 0x47, 0x7a, 0xc2, 0x2c, 0x61, 0x36, 0xba, 0x69, 0x3f, 0x7b, 0xf8,
+	0x98, 0xc7, 0x55, 0x5e, 0x62, 0x7b, 0xb0, 0x9e, 0x0a, 0x48, 0xe7, 0x03, 0x73, 0x24, 0x6c, 0xce,
+	0x7b, 0x9f, 0x1b, 0x19, 0xaf, 0x60, 0x2d, 0x95, 0xd1, 0x53, 0xb1, 0x74, 0x26, 0x33, 0xa6, 0x17,
+	0xc6, 0x12, 0xbc, 0xf4, 0x75, 0x99, 0x75, 0xe0, 0xf9, 0x03, 0xb5, 0x73, 0x59, 0xe7, 0xce, 0x05,
+	0x48, 0xc4, 0x0e, 0x2c, 0xbf, 0x95, 0x5a, 0x02, 0x9b, 0x13, 0xe8, 0x59, 0xa5, 0xec, 0x57, 0xd0,
+	0xb2, 0xf4, 0xd9, 0x20, 0x64, 0x0e, 0xdf, 0x23, 0x1a, 0xd9, 0xaf, 0x29, 0x98, 0xe9, 0x8c, 0x87,
+	0x3d, 0x9b, 0x1d, 0x04, 0x99, 0x9d, 0x7a, 0xfa, 0x10, 0x7f, 0x25, 0x3d, 0x5e, 0x62, 0xdb, 0x50,
+	0x7b, 0x2b, 0x55, 0xff, 0x62, 0xae, 0xd6, 0x6c, 0x36, 0xc0, 0x4b, 0xec, 0x1b, 0x00, 0xab, 0xea,
+	0x11, 0xf2, 0x56, 0x4a, 0xde, 0x0d, 0xac, 0x83, 0xbb, 0xc4, 0x25, 0xa4, 0x2b, 0xfd, 0x48, 0xcd,
+	0xe5, 0xb2, 0x07, 0xdb, 0xd0, 0xf0, 0x12, 0xfb, 0x1e, 0x9e, 0x64, 0x3c, 0xbf, 0xf1, 0xd5, 0xe8,
+	0x3c, 0x0e, 0xc3, 0xe1, 0x5c, 0xe6, 0x27, 0x45, 0x66, 0x22, 0xe4, 0x25, 0xf6, 0x12, 0x16, 0xdf,
+	0x4a, 0xd5, 0xd9, 0xeb, 0xce, 0x65, 0x02, 0x9b, 0xb4, 0xf7, 0xba, 0x9a, 0xb6, 0x27, 0x03, 0xaf,
+	0x7f, 0xc1, 0x32, 0x77, 0xdb, 0xf3, 0xe6, 0x29, 0x1c, 0xd3, 0xc5, 0x62, 0xcf, 0xbf, 0x0a, 0x8a,
+	0xb4, 0x85, 0x5d, 0xfa, 0x12, 0x96, 0x75, 0xda, 0x99, 0x2f, 0x2f, 0x3f, 0x86, 0xa1, 0x3d, 0x5d,
+	0xd6, 0x1a, 0xfa, 0x17, 0xac, 0x99, 0x52, 0xe3, 0x21, 0x4c, 0x6f, 0xf0, 0xec, 0xec, 0x87, 0xee,
+	0x23, 0x1e, 0x32, 0x9d, 0x5d, 0xfe, 0xaf, 0x43, 0x46, 0x14, 0xb4, 0x9f, 0x2d, 0x4b, 0xdf, 0x09,
+	0x3c, 0xbd, 0x99, 0x4f, 0x8b, 0xf3, 0x17, 0x33, 0x93, 0x4f, 0xed, 0x34, 0x68, 0xbb, 0x9f, 0xbb,
+	0xd0, 0xdc, 0x8f, 0x25, 0xf2, 0x9b, 0x66, 0x2b, 0x1b, 0x16, 0xeb, 0x01, 0x50, 0x7b, 0x66, 0x9e,
+	0x43, 0x17, 0x70, 0x05, 0x63, 0xa0, 0xe1, 0x64, 0xe6, 0x06, 0xb1, 0x22, 0xb9, 0x71, 0xec, 0x6b,
+	0x58, 0x39, 0x0e, 0xdd, 0xeb, 0x0f, 0x50, 0xb2, 0x0b, 0xcd, 0x1f, 0x83, 0xf1, 0x87, 0xf1, 0xfc,
+	0x12, 0x9a, 0x7a, 0xc0, 0x64, 0x79, 0xac, 0xd3, 0xf9, 0xb1, 0xd3, 0x7c, 0xbe, 0x83, 0xbb, 0x3c,
+	0xdf, 0x03, 0x5d, 0xf3, 0x53, 0xfb, 0xaf, 0xe0, 0x69, 0x81, 0xef, 0x9d, 0x99, 0x27, 0xfd, 0x7f,
+	0xf9, 0x5f, 0x43, 0xf3, 0xaf, 0xa6, 0x32, 0xbe, 0xdf, 0x0f, 0x03, 0x15, 0x3b, 0x6e, 0x96, 0x82,
+	0x09, 0xfb, 0x08, 0x53, 0x07, 0x58, 0x81, 0x49, 0x9f, 0x96, 0xf5, 0xfc, 0xc9, 0xd0, 0xec, 0xcf,
+	0x1e, 0xa0, 0x6c, 0xd0, 0x5f, 0xd1, 0x31, 0xa3, 0x89, 0x03, 0xcb, 0xff, 0x86, 0x62, 0xe6, 0x0f,
+	0xed, 0xfc, 0x0f, 0x06, 0x26, 0x80, 0xdf, 0xc0, 0x2a, 0xc6, 0x3c, 0xd7, 0x82, 0x3d, 0x12, 0xf6,
+	0x8c, 0x82, 0x97, 0xd8, 0x1b, 0x78, 0x8a, 0xfc, 0xb3, 0x3d, 0xd4, 0xec, 0x99, 0xf9, 0xf8, 0x91,
+	0x5e, 0xcb, 0xe8, 0xfe, 0x01, 0x9e, 0x76, 0x93, 0x64, 0x2a, 0x67, 0x97, 0xe7, 0x5e, 0x91, 0x9f,
+	0x90, 0xf5, 0xd7, 0xf0, 0x5c, 0xb7, 0x56, 0x0f, 0x6d, 0xfa, 0x64, 0x96, 0xb3, 0xd0, 0x83, 0xb5,
+	0x7f, 0xb2, 0xa1, 0xa3, 0x63, 0x8e, 0x1b, 0xfb, 0x9e, 0x26, 0x58, 0xeb, 0xb9, 0xa9, 0xd6, 0xcc,
+	0xbe, 0xda, 0x41, 0x18, 0x15, 0xc4, 0xb5, 0xec, 0x2e, 0x69, 0xc6, 0xd9, 0x0b, 0xac, 0x77, 0x34,
+	0x0d, 0xe7, 0xcc, 0xfc, 0x4f, 0xb7, 0x0b, 0x3a, 0x0b, 0xd0, 0x94, 0xef, 0x11, 0xf6, 0x99, 0xa9,
+	0x20, 0x2f, 0xb1, 0xaf, 0xe8, 0x1a, 0xa7, 0xc3, 0xad, 0xfc, 0x38, 0x2b, 0xb5, 0xd4, 0xae, 0xd2,
+	0x25, 0xa1, 0xb2, 0x4b, 0xd3, 0x07, 0x53, 0x3b, 0xad, 0x8b, 0x87, 0xfe, 0x58, 0xe9, 0xd1, 0x4e,
+	0xbb, 0x30, 0xa4, 0xa0, 0xc2, 0xf9, 0x5a, 0xff, 0xaa, 0x73, 0xa0, 0xc7, 0x15, 0x73, 0x58, 0x5a,
+	0x79, 0x16, 0xb3, 0x2d, 0xbf, 0x84, 0x26, 0xba, 0x94, 0x0d, 0xa3, 0x2c, 0x51, 0x3a, 0xbf, 0x4a,
+	0x1b, 0x94, 0x8c, 0x88, 0x97, 0xd8, 0x77, 0x94, 0x10, 0x8b, 0x83, 0x8f, 0xf9, 0x15, 0xbe, 0x40,
+	0x43, 0x87, 0xec, 0xd9, 0x5b, 0xa9, 0x0e, 0xfd, 0xc0, 0x19, 0xfb, 0xea, 0xfe, 0xa7, 0x4e, 0x59,
+	0x3b, 0x75, 0xe3, 0x01, 0x3d, 0x59, 0x81, 0xd6, 0xe7, 0x86, 0x17, 0xf3, 0x44, 0xac, 0x67, 0xa3,
+	0x07, 0x43, 0xc6, 0x4b, 0xac, 0x0b, 0xeb, 0x59, 0x28, 0xed, 0x9b, 0xf2, 0xe3, 0x62, 0xe8, 0x0a,
+	0x4f, 0xf5, 0xb4, 0xfe, 0x17, 0x17, 0x29, 0x39, 0x61, 0xad, 0x7d, 0xf0, 0xba, 0x9b, 0xdf, 0x4d,
+	0xce, 0x92, 0xf1, 0x12, 0x3b, 0x81, 0x67, 0x18, 0x8c, 0x37, 0xe1, 0xf4, 0x72, 0x2c, 0xb1, 0x36,
+	0x1e, 0xdc, 0xf8, 0x9e, 0x0c, 0xdc, 0xf9, 0xde, 0xd8, 0xcb, 0xf3, 0x90, 0xdc, 0x44, 0xf4, 0x1d,
+	0xb4, 0xf6, 0x47, 0x4e, 0x70, 0x25, 0x4f, 0xe4, 0xe4, 0x52, 0xc6, 0xc9, 0xc8, 0x8f, 0xd8, 0xf3,
+	0xb4, 0xf3, 0xb5, 0x28, 0x4d, 0xd2, 0x7e, 0xf1, 0xc8, 0x82, 0x90, 0xd1, 0xf8, 0x5e, 0xe7, 0xc0,
+	0x7e, 0xec, 0x04, 0xc9, 0x50, 0xc6, 0xc7, 0xba, 0x0d, 0x45, 0x71, 0x85, 0x13, 0xfc, 0x53, 0x22,
+	0x0e, 0x81, 0xf5, 0xa4, 0x3a, 0x71, 0xfc, 0x40, 0xc9, 0xc0, 0x09, 0x5c, 0x79, 0x12, 0x7a, 0x32,
+	0xed, 0xb2, 0x66, 0xf0, 0xed, 0x47, 0xf0, 0xbc, 0xc4, 0x8e, 0x69, 0x9b, 0x1f, 0x8c, 0xbd, 0xec,
+	0x01, 0x99, 0x33, 0x38, 0x4b, 0x37, 0x7d, 0x76, 0x8d, 0x97, 0xd8, 0x11, 0x3c, 0xd5, 0xe7, 0x77,
+	0xa8, 0xad, 0x3d, 0x8f, 0xc3, 0x2b, 0xfa, 0x35, 0x7b, 0xde, 0x9e, 0x7f, 0x94, 0x1b, 0x46, 0x16,
+	0xc9, 0x79, 0xe9, 0x72, 0x91, 0xfe, 0xdc, 0xf3, 0xfa, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0b,
+	0xa0, 0xaf, 0xae, 0x42, 0x24, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AergoRPCServiceClient is the client API for AergoRPCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AergoRPCServiceClient interface {
+	// Returns the current state of this node
+	NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Returns node metrics according to request
+	Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error)
+	// Returns current blockchain status (best block's height and hash)
+	Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error)
+	// Returns current blockchain's basic information
+	GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error)
+	// Returns current chain statistics
+	ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error)
+	// Returns list of Blocks without body according to request
+	ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error)
+	// Returns list of block metadata (hash, header, and number of transactions) according to request
+	ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error)
+	// Returns a stream of new blocks as they get added to the blockchain
+	ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error)
+	// Returns a stream of new block's metadata as they get added to the blockchain
+	ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error)
+	// Return a single block incl. header and body, queried by hash or number
+	GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error)
+	// Return a single block's metdata (hash, header, and number of transactions), queried by hash or number
+	GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error)
+	// Return a single block's body, queried by hash or number and list parameters
+	GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error)
+	// Return a single transaction, queried by transaction hash
+	GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error)
+	// Return information about transaction in block, queried by transaction hash
+	GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error)
+	// Return transaction receipt, queried by transaction hash
+	GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error)
+	// Return transaction receipt with the merkle proof of its inclusion in the receipts root of the block, queried by transaction hash
+	GetReceiptWithProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ReceiptProof, error)
+	// Return ABI stored at contract address
+	GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error)
+	// Sign and send a transaction from an unlocked account
+	SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error)
+	// Sign transaction with unlocked account
+	SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error)
+	// Verify validity of transaction
+	VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error)
+	// Commit a signed transaction
+	CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error)
+	// Return state of account
+	GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error)
+	// Return state of account, including merkle proof
+	GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error)
+	// Create a new account in this node
+	CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Return list of accounts in this node
+	GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error)
+	// Lock account in this node
+	LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Unlock account in this node
+	UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Import account to this node
+	ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error)
+	// Export account stored in this node as wif format
+	ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Export account stored in this node as keystore format
+	ExportAccountKeystore(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Query a contract method
+	QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Query contract state
+	QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error)
+	// Return list of peers of this node and their state
+	GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error)
+	// Returns the peers in the address book of node
+	GetAddressBook(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressBook, error)
+	// Returns the agent certificates of node and the certificate revocation lists known to it
+	ListAgentCertificates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentCertificateList, error)
+	// Issue new agent certificate without waiting for expiration, revoking the old ones
+	IssueAgentCertificate(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*AgentCertificateList, error)
+	// Revoke the certificates issued to the agent by block producer
+	RevokeAgentCertificates(ctx context.Context, in *AgentCertRevokeRequest, opts ...grpc.CallOption) (*CertificateRevocationList, error)
+	// Return result of vote
+	GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error)
+	// Return staking, voting info for account
+	GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error)
+	// Return staking information
+	GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error)
+	// Return name information
+	GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error)
+	// Returns a stream of event as they get added to the blockchain
+	ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error)
+	// Returns list of event
+	ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error)
+	// Returns configs and statuses of server
+	GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error)
+	// Returns status of consensus and bps
+	GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error)
+	// Returns the finality certificate of the block number, or the last one if the number is not given
+	GetFinalityCertificate(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*FinalityCertificate, error)
+	// GetBPSchedule returns the schedule of block producers for the next slots and their statistics
+	GetBPSchedule(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BPSchedule, error)
+	// Returns the staking, unstaking, voting and voting reward records of an account
+	GetStakingHistory(ctx context.Context, in *StakingHistoryParams, opts ...grpc.CallOption) (*StakingHistory, error)
+	// Returns the block numbers of hardfork versions including those scheduled by the governance
+	GetHardforkSchedule(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HardforkSchedule, error)
+	// Returns the evidences of block producers which signed two blocks for the same slot
+	ListDoubleSignEvidence(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*DoubleSignEvidenceList, error)
+	// Add & remove member of raft cluster
+	ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error)
+	// transfer raft leadership to the member of given name
+	TransferLeadership(ctx context.Context, in *Name, opts ...grpc.CallOption) (*MembershipChangeReply, error)
+	// enable or disable maintenance mode of raft node
+	SetMaintenanceMode(ctx context.Context, in *MaintenanceMode, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	// Returns enterprise config
+	GetEnterpriseConfig(ctx context.Context, in *EnterpriseConfigKey, opts ...grpc.CallOption) (*EnterpriseConfig, error)
+	// Return a status of changeCluster enterprise tx,  queried by requestID
+	GetConfChangeProgress(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ConfChangeProgress, error)
+}
+
+type aergoRPCServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAergoRPCServiceClient(cc *grpc.ClientConn) AergoRPCServiceClient {
+	return &aergoRPCServiceClient{cc}
+}
+
+func (c *aergoRPCServiceClient) NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/NodeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error) {
+	out := new(Metrics)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Metric", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error) {
+	out := new(BlockchainStatus)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Blockchain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error) {
+	out := new(ChainInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error) {
+	out := new(ChainStats)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChainStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error) {
+	out := new(BlockHeaderList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error) {
+	out := new(BlockMetadataList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[0], "/types.AergoRPCService/ListBlockStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2793,6 +3549,15 @@ func (c *aergoRPCServiceClient) GetReceipt(ctx context.Context, in *SingleBytes,
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) GetReceiptWithProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ReceiptProof, error) {
+	out := new(ReceiptProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetReceiptWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aergoRPCServiceClient) GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error) {
 	out := new(ABI)
 	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetABI", in, out, opts...)
@@ -2946,6 +3711,42 @@ func (c *aergoRPCServiceClient) GetPeers(ctx context.Context, in *PeersParams, o
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) GetAddressBook(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressBook, error) {
+	out := new(AddressBook)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAddressBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListAgentCertificates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentCertificateList, error) {
+	out := new(AgentCertificateList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListAgentCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) IssueAgentCertificate(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*AgentCertificateList, error) {
+	out := new(AgentCertificateList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/IssueAgentCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) RevokeAgentCertificates(ctx context.Context, in *AgentCertRevokeRequest, opts ...grpc.CallOption) (*CertificateRevocationList, error) {
+	out := new(CertificateRevocationList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/RevokeAgentCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aergoRPCServiceClient) GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error) {
 	out := new(VoteList)
 	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetVotes", in, out, opts...)
@@ -3041,6 +3842,51 @@ func (c *aergoRPCServiceClient) GetConsensusInfo(ctx context.Context, in *Empty,
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) GetFinalityCertificate(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*FinalityCertificate, error) {
+	out := new(FinalityCertificate)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetFinalityCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBPSchedule(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BPSchedule, error) {
+	out := new(BPSchedule)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBPSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetStakingHistory(ctx context.Context, in *StakingHistoryParams, opts ...grpc.CallOption) (*StakingHistory, error) {
+	out := new(StakingHistory)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStakingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetHardforkSchedule(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HardforkSchedule, error) {
+	out := new(HardforkSchedule)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetHardforkSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListDoubleSignEvidence(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*DoubleSignEvidenceList, error) {
+	out := new(DoubleSignEvidenceList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListDoubleSignEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aergoRPCServiceClient) ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error) {
 	out := new(MembershipChangeReply)
 	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChangeMembership", in, out, opts...)
@@ -3050,6 +3896,24 @@ func (c *aergoRPCServiceClient) ChangeMembership(ctx context.Context, in *Member
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) TransferLeadership(ctx context.Context, in *Name, opts ...grpc.CallOption) (*MembershipChangeReply, error) {
+	out := new(MembershipChangeReply)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SetMaintenanceMode(ctx context.Context, in *MaintenanceMode, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aergoRPCServiceClient) GetEnterpriseConfig(ctx context.Context, in *EnterpriseConfigKey, opts ...grpc.CallOption) (*EnterpriseConfig, error) {
 	out := new(EnterpriseConfig)
 	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetEnterpriseConfig", in, out, opts...)
@@ -3100,6 +3964,8 @@ type AergoRPCServiceServer interface {
 	GetBlockTX(context.Context, *SingleBytes) (*TxInBlock, error)
 	// Return transaction receipt, queried by transaction hash
 	GetReceipt(context.Context, *SingleBytes) (*Receipt, error)
+	// Return transaction receipt with the merkle proof of its inclusion in the receipts root of the block, queried by transaction hash
+	GetReceiptWithProof(context.Context, *SingleBytes) (*ReceiptProof, error)
 	// Return ABI stored at contract address
 	GetABI(context.Context, *SingleBytes) (*ABI, error)
 	// Sign and send a transaction from an unlocked account
@@ -3134,6 +4000,14 @@ type AergoRPCServiceServer interface {
 	QueryContractState(context.Context, *StateQuery) (*StateQueryProof, error)
 	// Return list of peers of this node and their state
 	GetPeers(context.Context, *PeersParams) (*PeerList, error)
+	// Returns the peers in the address book of node
+	GetAddressBook(context.Context, *Empty) (*AddressBook, error)
+	// Returns the agent certificates of node and the certificate revocation lists known to it
+	ListAgentCertificates(context.Context, *Empty) (*AgentCertificateList, error)
+	// Issue new agent certificate without waiting for expiration, revoking the old ones
+	IssueAgentCertificate(context.Context, *SingleBytes) (*AgentCertificateList, error)
+	// Revoke the certificates issued to the agent by block producer
+	RevokeAgentCertificates(context.Context, *AgentCertRevokeRequest) (*CertificateRevocationList, error)
 	// Return result of vote
 	GetVotes(context.Context, *VoteParams) (*VoteList, error)
 	// Return staking, voting info for account
@@ -3150,8 +4024,22 @@ type AergoRPCServiceServer interface {
 	GetServerInfo(context.Context, *KeyParams) (*ServerInfo, error)
 	// Returns status of consensus and bps
 	GetConsensusInfo(context.Context, *Empty) (*ConsensusInfo, error)
+	// Returns the finality certificate of the block number, or the last one if the number is not given
+	GetFinalityCertificate(context.Context, *SingleBytes) (*FinalityCertificate, error)
+	// GetBPSchedule returns the schedule of block producers for the next slots and their statistics
+	GetBPSchedule(context.Context, *SingleBytes) (*BPSchedule, error)
+	// Returns the staking, unstaking, voting and voting reward records of an account
+	GetStakingHistory(context.Context, *StakingHistoryParams) (*StakingHistory, error)
+	// Returns the block numbers of hardfork versions including those scheduled by the governance
+	GetHardforkSchedule(context.Context, *Empty) (*HardforkSchedule, error)
+	// Returns the evidences of block producers which signed two blocks for the same slot
+	ListDoubleSignEvidence(context.Context, *SingleBytes) (*DoubleSignEvidenceList, error)
 	// Add & remove member of raft cluster
 	ChangeMembership(context.Context, *MembershipChange) (*MembershipChangeReply, error)
+	// transfer raft leadership to the member of given name
+	TransferLeadership(context.Context, *Name) (*MembershipChangeReply, error)
+	// enable or disable maintenance mode of raft node
+	SetMaintenanceMode(context.Context, *MaintenanceMode) (*MaintenanceMode, error)
 	// Returns enterprise config
 	GetEnterpriseConfig(context.Context, *EnterpriseConfigKey) (*EnterpriseConfig, error)
 	// Return a status of changeCluster enterprise tx,  queried by requestID
@@ -3438,6 +4326,24 @@ func _AergoRPCService_GetReceipt_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_GetReceiptWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetReceiptWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetReceiptWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetReceiptWithProof(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AergoRPCService_GetABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SingleBytes)
 	if err := dec(in); err != nil {
@@ -3744,6 +4650,78 @@ func _AergoRPCService_GetPeers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_GetAddressBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetAddressBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetAddressBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetAddressBook(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListAgentCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListAgentCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListAgentCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListAgentCertificates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_IssueAgentCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).IssueAgentCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/IssueAgentCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).IssueAgentCertificate(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_RevokeAgentCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentCertRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).RevokeAgentCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/RevokeAgentCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).RevokeAgentCertificates(ctx, req.(*AgentCertRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AergoRPCService_GetVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VoteParams)
 	if err := dec(in); err != nil {
@@ -3891,6 +4869,96 @@ func _AergoRPCService_GetConsensusInfo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_GetFinalityCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetFinalityCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetFinalityCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetFinalityCertificate(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBPSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBPSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBPSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBPSchedule(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetStakingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingHistoryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetStakingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetStakingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetStakingHistory(ctx, req.(*StakingHistoryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetHardforkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetHardforkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetHardforkSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetHardforkSchedule(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListDoubleSignEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListDoubleSignEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListDoubleSignEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListDoubleSignEvidence(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AergoRPCService_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MembershipChange)
 	if err := dec(in); err != nil {
@@ -3909,6 +4977,42 @@ func _AergoRPCService_ChangeMembership_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Name)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).TransferLeadership(ctx, req.(*Name))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).SetMaintenanceMode(ctx, req.(*MaintenanceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AergoRPCService_GetEnterpriseConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnterpriseConfigKey)
 	if err := dec(in); err != nil {
@@ -4001,6 +5105,10 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetReceipt",
 			Handler:    _AergoRPCService_GetReceipt_Handler,
 		},
+		{
+			MethodName: "GetReceiptWithProof",
+			Handler:    _AergoRPCService_GetReceiptWithProof_Handler,
+		},
 		{
 			MethodName: "GetABI",
 			Handler:    _AergoRPCService_GetABI_Handler,
@@ -4069,6 +5177,22 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeers",
 			Handler:    _AergoRPCService_GetPeers_Handler,
 		},
+		{
+			MethodName: "GetAddressBook",
+			Handler:    _AergoRPCService_GetAddressBook_Handler,
+		},
+		{
+			MethodName: "ListAgentCertificates",
+			Handler:    _AergoRPCService_ListAgentCertificates_Handler,
+		},
+		{
+			MethodName: "IssueAgentCertificate",
+			Handler:    _AergoRPCService_IssueAgentCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeAgentCertificates",
+			Handler:    _AergoRPCService_RevokeAgentCertificates_Handler,
+		},
 		{
 			MethodName: "GetVotes",
 			Handler:    _AergoRPCService_GetVotes_Handler,
@@ -4097,10 +5221,38 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConsensusInfo",
 			Handler:    _AergoRPCService_GetConsensusInfo_Handler,
 		},
+		{
+			MethodName: "GetFinalityCertificate",
+			Handler:    _AergoRPCService_GetFinalityCertificate_Handler,
+		},
+		{
+			MethodName: "GetBPSchedule",
+			Handler:    _AergoRPCService_GetBPSchedule_Handler,
+		},
+		{
+			MethodName: "GetStakingHistory",
+			Handler:    _AergoRPCService_GetStakingHistory_Handler,
+		},
+		{
+			MethodName: "GetHardforkSchedule",
+			Handler:    _AergoRPCService_GetHardforkSchedule_Handler,
+		},
+		{
+			MethodName: "ListDoubleSignEvidence",
+			Handler:    _AergoRPCService_ListDoubleSignEvidence_Handler,
+		},
 		{
 			MethodName: "ChangeMembership",
 			Handler:    _AergoRPCService_ChangeMembership_Handler,
 		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _AergoRPCService_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _AergoRPCService_SetMaintenanceMode_Handler,
+		},
 		{
 			MethodName: "GetEnterpriseConfig",
 			Handler:    _AergoRPCService_GetEnterpriseConfig_Handler,
@@ -227,6 +227,32 @@ func ValidateSystemTx(tx *TxBody) error {
 			}
 			unique[encoded]++
 		}
+	case OpreportDoubleSign:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if _, err := base58.Decode(encoded); err != nil {
+			return ErrTxInvalidPayload
+		}
+	case OprotateBPKey:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		raw, err := base58.Decode(encoded)
+		if err != nil {
+			return ErrTxInvalidPayload
+		}
+		if err := proto.Unmarshal(raw, &BPKeyRotation{}); err != nil {
+			return ErrTxInvalidPayload
+		}
 	default:
 		return ErrTxInvalidPayload
 	}
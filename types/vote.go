@@ -57,6 +57,12 @@ const (
 	Opstake
 	// Opunstake represents a unstaking tranaction.
 	Opunstake
+	// OpreportDoubleSign represents a transaction reporting the evidence of
+	// a double-signing BP, which is excluded from the BP election.
+	OpreportDoubleSign
+	// OprotateBPKey represents a transaction binding the voted identity of a
+	// BP to a new signing key.
+	OprotateBPKey
 	// OpSysTxMax is the maximum of system tx OP numbers.
 	OpSysTxMax
 